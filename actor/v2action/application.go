@@ -142,6 +142,12 @@ func (e StartupTimeoutError) Error() string {
 	return fmt.Sprintf("Timed out waiting for application '%s' to start", e.Name)
 }
 
+// CreateApplication creates an application with the provided settings.
+func (actor Actor) CreateApplication(application Application) (Application, Warnings, error) {
+	app, warnings, err := actor.CloudControllerClient.NewApplication(ccv2.Application(application))
+	return Application(app), Warnings(warnings), err
+}
+
 // GetApplication returns the application
 func (actor Actor) GetApplication(guid string) (Application, Warnings, error) {
 	app, warnings, err := actor.CloudControllerClient.GetApplication(guid)
@@ -204,6 +210,13 @@ func (actor Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (
 	return Application(app[0]), Warnings(warnings), nil
 }
 
+// UpdateApplication updates an application with the provided settings. Only
+// the GUID and the set fields of the provided application are sent.
+func (actor Actor) UpdateApplication(application Application) (Application, Warnings, error) {
+	app, warnings, err := actor.CloudControllerClient.UpdateApplication(ccv2.Application(application))
+	return Application(app), Warnings(warnings), err
+}
+
 // GetRouteApplications returns a list of apps associated with the provided
 // Route GUID.
 func (actor Actor) GetRouteApplications(routeGUID string, query []ccv2.Query) ([]Application, Warnings, error) {
@@ -218,8 +231,19 @@ func (actor Actor) GetRouteApplications(routeGUID string, query []ccv2.Query) ([
 	return allApplications, Warnings(warnings), nil
 }
 
-// StartApplication starts a given application.
+// StartApplication starts a given application. Startup is complete as soon as
+// one instance is running.
 func (actor Actor) StartApplication(app Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+	return actor.startApplication(app, client, config, false)
+}
+
+// StartApplicationAndWaitForAllInstances starts a given application. Unlike
+// StartApplication, startup is only complete once every instance is running.
+func (actor Actor) StartApplicationAndWaitForAllInstances(app Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+	return actor.startApplication(app, client, config, true)
+}
+
+func (actor Actor) startApplication(app Application, client NOAAClient, config Config, waitForAllInstances bool) (<-chan *LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
 	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config)
 
 	appStarting := make(chan bool)
@@ -257,7 +281,7 @@ func (actor Actor) StartApplication(app Application, client NOAAClient, config C
 		client.Close()
 		appStarting <- true
 
-		err = actor.pollStartup(app, config, allWarnings)
+		err = actor.pollStartup(app, config, allWarnings, waitForAllInstances)
 		if err != nil {
 			errs <- err
 		}
@@ -290,7 +314,7 @@ func (actor Actor) pollStaging(app Application, config Config, allWarnings chan<
 	return StagingTimeoutError{Name: app.Name, Timeout: config.StagingTimeout()}
 }
 
-func (actor Actor) pollStartup(app Application, config Config, allWarnings chan<- string) error {
+func (actor Actor) pollStartup(app Application, config Config, allWarnings chan<- string, waitForAllInstances bool) error {
 	timeout := time.Now().Add(config.StartupTimeout())
 	for time.Now().Before(timeout) {
 		currentInstances, warnings, err := actor.GetApplicationInstancesByApplication(app.GUID)
@@ -301,16 +325,24 @@ func (actor Actor) pollStartup(app Application, config Config, allWarnings chan<
 			return err
 		}
 
+		runningInstances := 0
 		for _, instance := range currentInstances {
 			switch {
 			case instance.Running():
-				return nil
+				if !waitForAllInstances {
+					return nil
+				}
+				runningInstances++
 			case instance.Crashed():
 				return ApplicationInstanceCrashedError{Name: app.Name}
 			case instance.Flapping():
 				return ApplicationInstanceFlappingError{Name: app.Name}
 			}
 		}
+
+		if waitForAllInstances && len(currentInstances) > 0 && runningInstances == len(currentInstances) {
+			return nil
+		}
 		time.Sleep(config.PollingInterval())
 	}
 
@@ -170,6 +170,43 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("CreateApplication", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.NewApplicationReturns(
+					ccv2.Application{GUID: "some-app-guid", Name: "some-app"},
+					ccv2.Warnings{"new-app-warning"},
+					nil,
+				)
+			})
+
+			It("returns the created application and warnings", func() {
+				app, warnings, err := actor.CreateApplication(Application{Name: "some-app", SpaceGUID: "some-space-guid"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(app).To(Equal(Application{GUID: "some-app-guid", Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("new-app-warning"))
+
+				Expect(fakeCloudControllerClient.NewApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.NewApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					Name:      "some-app",
+					SpaceGUID: "some-space-guid",
+				}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.NewApplicationReturns(ccv2.Application{}, ccv2.Warnings{"new-app-warning"}, errors.New("new-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.CreateApplication(Application{Name: "some-app"})
+				Expect(err).To(MatchError("new-app-error"))
+				Expect(warnings).To(ConsistOf("new-app-warning"))
+			})
+		})
+	})
+
 	Describe("GetApplication", func() {
 		Context("when the application exists", func() {
 			BeforeEach(func() {
@@ -400,6 +437,43 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("UpdateApplication", func() {
+		Context("when the update is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationReturns(
+					ccv2.Application{GUID: "some-app-guid", Name: "some-app", Instances: 3},
+					ccv2.Warnings{"update-app-warning"},
+					nil,
+				)
+			})
+
+			It("returns the updated application and warnings", func() {
+				app, warnings, err := actor.UpdateApplication(Application{GUID: "some-app-guid", Instances: 3})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(app).To(Equal(Application{GUID: "some-app-guid", Name: "some-app", Instances: 3}))
+				Expect(warnings).To(ConsistOf("update-app-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					GUID:      "some-app-guid",
+					Instances: 3,
+				}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-app-warning"}, errors.New("update-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.UpdateApplication(Application{GUID: "some-app-guid"})
+				Expect(err).To(MatchError("update-app-error"))
+				Expect(warnings).To(ConsistOf("update-app-warning"))
+			})
+		})
+	})
+
 	Describe("StartApplication", func() {
 		var (
 			app            Application
@@ -548,6 +622,41 @@ var _ = Describe("Application Actions", func() {
 			})
 		})
 
+		Context("when waiting for all instances", func() {
+			BeforeEach(func() {
+				instanceCount := 0
+				fakeCloudControllerClient.GetApplicationInstancesByApplicationStub = func(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error) {
+					instanceCount++
+					if instanceCount < 3 {
+						return map[int]ccv2.ApplicationInstance{
+							0: {State: ccv2.ApplicationInstanceStarting},
+							1: {State: ccv2.ApplicationInstanceRunning},
+						}, ccv2.Warnings{"app-instance-warnings-1"}, nil
+					}
+
+					return map[int]ccv2.ApplicationInstance{
+						0: {State: ccv2.ApplicationInstanceRunning},
+						1: {State: ccv2.ApplicationInstanceRunning},
+					}, ccv2.Warnings{"app-instance-warnings-2"}, nil
+				}
+			})
+
+			It("polls until every instance is running", func() {
+				messages, logErrs, appStarting, warnings, errs = actor.StartApplicationAndWaitForAllInstances(app, fakeNOAAClient, fakeConfig)
+
+				Eventually(warnings).Should(Receive(Equal("update-warning")))
+				Eventually(warnings).Should(Receive(Equal("app-warnings-1")))
+				Eventually(warnings).Should(Receive(Equal("app-warnings-2")))
+				Eventually(appStarting).Should(Receive(BeTrue()))
+				Eventually(warnings).Should(Receive(Equal("app-instance-warnings-1")))
+				Eventually(warnings).Should(Receive(Equal("app-instance-warnings-1")))
+				Eventually(warnings).Should(Receive(Equal("app-instance-warnings-2")))
+				Consistently(errs).ShouldNot(Receive())
+
+				Expect(fakeCloudControllerClient.GetApplicationInstancesByApplicationCallCount()).To(Equal(3))
+			})
+		})
+
 		Context("when updating the application fails", func() {
 			var expectedErr error
 			BeforeEach(func() {
@@ -0,0 +1,211 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+// BlueGreenStage is the last step of a blue-green swap that completed.
+type BlueGreenStage int
+
+const (
+	// BlueGreenNotSwapped means that the routes have not been touched yet.
+	BlueGreenNotSwapped BlueGreenStage = iota
+
+	// BlueGreenRoutesMoved means that the routes have been mapped to the
+	// temporary application, and may have been unmapped from the current
+	// application.
+	BlueGreenRoutesMoved
+
+	// BlueGreenOldAppRemoved means that the current application has been
+	// deleted, or stopped and renamed, but the temporary application has not
+	// been renamed yet.
+	BlueGreenOldAppRemoved
+
+	// BlueGreenSwapped means that the swap is complete.
+	BlueGreenSwapped
+)
+
+const (
+	// BlueGreenNewAppSuffix is appended to the name of the temporary
+	// application created during a blue-green push.
+	BlueGreenNewAppSuffix = "-new"
+
+	// BlueGreenOldAppSuffix is appended to the name of the previous
+	// application when it is kept after a blue-green push.
+	BlueGreenOldAppSuffix = "-old"
+)
+
+// BlueGreenConfig returns the configuration of the temporary application
+// pushed alongside the current application during a blue-green push. Any
+// setting that is not explicitly requested is copied from the current
+// application, and the temporary application is mapped to the current and
+// desired routes and bound to the current and desired services.
+func (config ApplicationConfig) BlueGreenConfig() ApplicationConfig {
+	current := config.CurrentApplication
+	desired := config.DesiredApplication
+
+	desired.GUID = ""
+	desired.State = ""
+	desired.Name = current.Name + BlueGreenNewAppSuffix
+	if desired.SpaceGUID == "" {
+		desired.SpaceGUID = current.SpaceGUID
+	}
+	if desired.Buildpack == "" && !desired.ResetBuildpack {
+		desired.Buildpack = current.Buildpack
+	}
+	if desired.Command == "" && !desired.ResetCommand {
+		desired.Command = current.Command
+	}
+	if desired.DiskQuota == 0 {
+		desired.DiskQuota = current.DiskQuota
+	}
+	if desired.DockerImage == "" {
+		desired.DockerImage = current.DockerImage
+	}
+	if desired.EnvironmentVariables == nil {
+		desired.EnvironmentVariables = current.EnvironmentVariables
+	}
+	if desired.HealthCheckHTTPEndpoint == "" {
+		desired.HealthCheckHTTPEndpoint = current.HealthCheckHTTPEndpoint
+	}
+	if desired.HealthCheckTimeout == 0 {
+		desired.HealthCheckTimeout = current.HealthCheckTimeout
+	}
+	if desired.HealthCheckType == "" {
+		desired.HealthCheckType = current.HealthCheckType
+	}
+	if desired.Instances == 0 && !desired.ZeroInstances {
+		desired.Instances = current.Instances
+	}
+	if desired.Memory == 0 {
+		desired.Memory = current.Memory
+	}
+	if desired.Ports == nil {
+		desired.Ports = current.Ports
+	}
+	if desired.StackGUID == "" {
+		desired.StackGUID = current.StackGUID
+	}
+
+	var desiredRoutes []Route
+	if !config.NoRoute {
+		desiredRoutes = append(desiredRoutes, config.CurrentRoutes...)
+		for _, route := range config.DesiredRoutes {
+			if route.GUID == "" || !routeInList(route, desiredRoutes) {
+				desiredRoutes = append(desiredRoutes, route)
+			}
+		}
+	}
+
+	desiredServices := append([]string(nil), config.CurrentServices...)
+	for _, name := range config.DesiredServices {
+		if !stringInList(name, desiredServices) {
+			desiredServices = append(desiredServices, name)
+		}
+	}
+
+	return ApplicationConfig{
+		DesiredApplication: desired,
+		DesiredRoutes:      desiredRoutes,
+		DesiredServices:    desiredServices,
+		Path:               config.Path,
+	}
+}
+
+// SwapBlueGreenApplications moves the routes of the current application to
+// the temporary application, removes the current application, and gives the
+// temporary application its name. When keepOldApp is true, the current
+// application is stopped and renamed instead of deleted, replacing any
+// application left by a previous blue-green push under that name. The
+// returned stage is the last step that completed, so that a failed swap can
+// be rolled back with RollbackBlueGreenApplications.
+func (actor Actor) SwapBlueGreenApplications(oldConfig ApplicationConfig, newConfig ApplicationConfig, keepOldApp bool) (BlueGreenStage, Warnings, error) {
+	var allWarnings Warnings
+
+	oldApp := oldConfig.CurrentApplication
+	if keepOldApp {
+		staleApp, warnings, err := actor.GetApplicationByNameAndSpace(oldApp.Name+BlueGreenOldAppSuffix, oldApp.SpaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		switch err.(type) {
+		case nil:
+			ccWarnings, err := actor.CloudControllerClient.DeleteApplication(staleApp.GUID)
+			allWarnings = append(allWarnings, ccWarnings...)
+			if err != nil {
+				return BlueGreenNotSwapped, allWarnings, err
+			}
+		case ApplicationNotFoundError:
+		default:
+			return BlueGreenNotSwapped, allWarnings, err
+		}
+	}
+
+	newConfig, warnings, err := actor.MapRoutes(newConfig)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return BlueGreenNotSwapped, allWarnings, err
+	}
+
+	for _, route := range oldConfig.CurrentRoutes {
+		warnings, err := actor.CloudControllerClient.DeleteRouteApplication(route.GUID, oldApp.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return BlueGreenRoutesMoved, allWarnings, err
+		}
+	}
+
+	if keepOldApp {
+		_, warnings, err = actor.UpdateApplication(Application{
+			GUID:  oldApp.GUID,
+			Name:  oldApp.Name + BlueGreenOldAppSuffix,
+			State: ccv2.ApplicationStopped,
+		})
+	} else {
+		var ccWarnings ccv2.Warnings
+		ccWarnings, err = actor.CloudControllerClient.DeleteApplication(oldApp.GUID)
+		warnings = Warnings(ccWarnings)
+	}
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return BlueGreenRoutesMoved, allWarnings, err
+	}
+
+	_, warnings, err = actor.UpdateApplication(Application{
+		GUID: newConfig.CurrentApplication.GUID,
+		Name: oldApp.Name,
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return BlueGreenOldAppRemoved, allWarnings, err
+	}
+
+	return BlueGreenSwapped, allWarnings, nil
+}
+
+// RollbackBlueGreenApplications undoes the steps of a failed blue-green push
+// up to the provided stage: it restores the routes of the current application
+// if they were moved and deletes the temporary application. Once the current
+// application has been removed, the temporary application is the only one
+// left serving the routes, so nothing is rolled back.
+func (actor Actor) RollbackBlueGreenApplications(oldConfig ApplicationConfig, newConfig ApplicationConfig, stage BlueGreenStage) (Warnings, error) {
+	var allWarnings Warnings
+
+	if stage >= BlueGreenOldAppRemoved {
+		return allWarnings, nil
+	}
+
+	if stage >= BlueGreenRoutesMoved {
+		for _, route := range oldConfig.CurrentRoutes {
+			_, warnings, err := actor.CloudControllerClient.UpdateRouteApplication(route.GUID, oldConfig.CurrentApplication.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+		}
+	}
+
+	if newConfig.CurrentApplication.GUID == "" {
+		return allWarnings, nil
+	}
+
+	warnings, err := actor.CloudControllerClient.DeleteApplication(newConfig.CurrentApplication.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
@@ -0,0 +1,340 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Blue Green Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		oldConfig                 ApplicationConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+
+		oldConfig = ApplicationConfig{
+			CurrentApplication: Application{
+				GUID:      "old-app-guid",
+				Name:      "some-app",
+				Instances: 3,
+				Memory:    256,
+				Ports:     []int{8080},
+				SpaceGUID: "some-space-guid",
+				StackGUID: "some-stack-guid",
+				State:     ccv2.ApplicationStarted,
+			},
+			DesiredApplication: Application{
+				Name:      "some-app",
+				Memory:    512,
+				SpaceGUID: "some-space-guid",
+			},
+			CurrentRoutes: []Route{{GUID: "current-route-guid"}},
+			DesiredRoutes: []Route{{GUID: "current-route-guid"}, {Host: "new-host", DomainGUID: "some-domain-guid"}},
+			Path:          "/some/path",
+		}
+	})
+
+	Describe("BlueGreenConfig", func() {
+		It("returns a config for a new temporary application", func() {
+			newConfig := oldConfig.BlueGreenConfig()
+			Expect(newConfig.Exists()).To(BeFalse())
+			Expect(newConfig.Path).To(Equal("/some/path"))
+			Expect(newConfig.DesiredApplication).To(Equal(Application{
+				Name:      "some-app-new",
+				Instances: 3,
+				Memory:    512,
+				Ports:     []int{8080},
+				SpaceGUID: "some-space-guid",
+				StackGUID: "some-stack-guid",
+			}))
+			Expect(newConfig.DesiredRoutes).To(Equal([]Route{
+				{GUID: "current-route-guid"},
+				{Host: "new-host", DomainGUID: "some-domain-guid"},
+			}))
+		})
+
+		Context("when the buildpack and command are reset", func() {
+			BeforeEach(func() {
+				oldConfig.CurrentApplication.Buildpack = "some-buildpack"
+				oldConfig.CurrentApplication.Command = "some-command"
+				oldConfig.DesiredApplication.ResetBuildpack = true
+				oldConfig.DesiredApplication.ResetCommand = true
+			})
+
+			It("does not copy them from the current application", func() {
+				desired := oldConfig.BlueGreenConfig().DesiredApplication
+				Expect(desired.Buildpack).To(BeEmpty())
+				Expect(desired.Command).To(BeEmpty())
+				Expect(desired.ResetBuildpack).To(BeTrue())
+				Expect(desired.ResetCommand).To(BeTrue())
+			})
+		})
+
+		Context("when the desired instances are 0", func() {
+			BeforeEach(func() {
+				oldConfig.DesiredApplication.ZeroInstances = true
+			})
+
+			It("does not copy them from the current application", func() {
+				desired := oldConfig.BlueGreenConfig().DesiredApplication
+				Expect(desired.Instances).To(Equal(0))
+				Expect(desired.ZeroInstances).To(BeTrue())
+			})
+		})
+
+		Context("when the current app has services bound", func() {
+			BeforeEach(func() {
+				oldConfig.CurrentServices = []string{"some-db", "some-queue"}
+				oldConfig.DesiredServices = []string{"some-queue", "some-cache"}
+			})
+
+			It("binds the temporary application to the current and desired services", func() {
+				newConfig := oldConfig.BlueGreenConfig()
+				Expect(newConfig.CurrentServices).To(BeEmpty())
+				Expect(newConfig.DesiredServices).To(Equal([]string{"some-db", "some-queue", "some-cache"}))
+				Expect(newConfig.UnboundServices()).To(Equal([]string{"some-db", "some-queue", "some-cache"}))
+			})
+		})
+
+		Context("when no-route is set", func() {
+			BeforeEach(func() {
+				oldConfig.NoRoute = true
+			})
+
+			It("does not map any routes to the temporary application", func() {
+				Expect(oldConfig.BlueGreenConfig().DesiredRoutes).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("SwapBlueGreenApplications", func() {
+		var (
+			newConfig  ApplicationConfig
+			keepOldApp bool
+			stage      BlueGreenStage
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			newConfig = ApplicationConfig{
+				CurrentApplication: Application{GUID: "new-app-guid", Name: "some-app-new"},
+				DesiredRoutes:      []Route{{GUID: "current-route-guid"}},
+			}
+			keepOldApp = false
+
+			fakeCloudControllerClient.UpdateRouteApplicationReturns(ccv2.Route{}, ccv2.Warnings{"map-route-warning"}, nil)
+			fakeCloudControllerClient.DeleteRouteApplicationReturns(ccv2.Warnings{"unmap-route-warning"}, nil)
+			fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-app-warning"}, nil)
+			fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-app-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			stage, warnings, executeErr = actor.SwapBlueGreenApplications(oldConfig, newConfig, keepOldApp)
+		})
+
+		It("moves the routes, deletes the old app and renames the new app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(stage).To(Equal(BlueGreenSwapped))
+			Expect(warnings).To(ConsistOf("map-route-warning", "unmap-route-warning", "delete-app-warning", "update-app-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(1))
+			routeGUID, appGUID := fakeCloudControllerClient.UpdateRouteApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("current-route-guid"))
+			Expect(appGUID).To(Equal("new-app-guid"))
+
+			Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(1))
+			routeGUID, appGUID = fakeCloudControllerClient.DeleteRouteApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("current-route-guid"))
+			Expect(appGUID).To(Equal("old-app-guid"))
+
+			Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("old-app-guid"))
+
+			Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+				GUID: "new-app-guid",
+				Name: "some-app",
+			}))
+
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+		})
+
+		Context("when keeping the old app", func() {
+			BeforeEach(func() {
+				keepOldApp = true
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, nil)
+			})
+
+			It("stops and renames the old app instead of deleting it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(stage).To(Equal(BlueGreenSwapped))
+				Expect(warnings).To(ContainElement("get-apps-warning"))
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(0))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{Filter: ccv2.NameFilter, Operator: ccv2.EqualOperator, Value: "some-app-old"},
+					ccv2.Query{Filter: ccv2.SpaceGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-space-guid"},
+				))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					GUID:  "old-app-guid",
+					Name:  "some-app-old",
+					State: ccv2.ApplicationStopped,
+				}))
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(1)).To(Equal(ccv2.Application{
+					GUID: "new-app-guid",
+					Name: "some-app",
+				}))
+			})
+
+			Context("when an app from a previous push already has the old name", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns(
+						[]ccv2.Application{{GUID: "stale-app-guid", Name: "some-app-old"}},
+						ccv2.Warnings{"get-apps-warning"},
+						nil,
+					)
+				})
+
+				It("deletes it before renaming the old app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("stale-app-guid"))
+					Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(2))
+				})
+
+				Context("when deleting it fails", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-app-warning"}, errors.New("delete-app-error"))
+					})
+
+					It("returns the error before moving any route", func() {
+						Expect(executeErr).To(MatchError("delete-app-error"))
+						Expect(stage).To(Equal(BlueGreenNotSwapped))
+						Expect(warnings).To(ConsistOf("get-apps-warning", "delete-app-warning"))
+						Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+
+		Context("when mapping the routes to the new app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateRouteApplicationReturns(ccv2.Route{}, ccv2.Warnings{"map-route-warning"}, errors.New("map-route-error"))
+			})
+
+			It("returns the error without touching the old app", func() {
+				Expect(executeErr).To(MatchError("map-route-error"))
+				Expect(stage).To(Equal(BlueGreenNotSwapped))
+				Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when deleting the old app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-app-warning"}, errors.New("delete-app-error"))
+			})
+
+			It("returns the error and warnings without renaming the new app", func() {
+				Expect(executeErr).To(MatchError("delete-app-error"))
+				Expect(stage).To(Equal(BlueGreenRoutesMoved))
+				Expect(warnings).To(ConsistOf("map-route-warning", "unmap-route-warning", "delete-app-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when renaming the new app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-app-warning"}, errors.New("update-app-error"))
+			})
+
+			It("reports that the old app was removed", func() {
+				Expect(executeErr).To(MatchError("update-app-error"))
+				Expect(stage).To(Equal(BlueGreenOldAppRemoved))
+			})
+		})
+	})
+
+	Describe("RollbackBlueGreenApplications", func() {
+		var (
+			newConfig ApplicationConfig
+			stage     BlueGreenStage
+			warnings  Warnings
+			err       error
+		)
+
+		BeforeEach(func() {
+			newConfig = ApplicationConfig{
+				CurrentApplication: Application{GUID: "new-app-guid"},
+			}
+			stage = BlueGreenRoutesMoved
+			fakeCloudControllerClient.UpdateRouteApplicationReturns(ccv2.Route{}, ccv2.Warnings{"map-route-warning"}, nil)
+			fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-app-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.RollbackBlueGreenApplications(oldConfig, newConfig, stage)
+		})
+
+		It("remaps the old routes and deletes the new app", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("map-route-warning", "delete-app-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(1))
+			routeGUID, appGUID := fakeCloudControllerClient.UpdateRouteApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("current-route-guid"))
+			Expect(appGUID).To(Equal("old-app-guid"))
+
+			Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("new-app-guid"))
+		})
+
+		Context("when the routes were not moved yet", func() {
+			BeforeEach(func() {
+				stage = BlueGreenNotSwapped
+			})
+
+			It("only deletes the new app", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the old app was already removed", func() {
+			BeforeEach(func() {
+				stage = BlueGreenOldAppRemoved
+			})
+
+			It("keeps the new app", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the new app was never created", func() {
+			BeforeEach(func() {
+				newConfig = ApplicationConfig{}
+			})
+
+			It("does not delete anything", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
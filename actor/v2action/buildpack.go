@@ -0,0 +1,33 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// Buildpack represents a CLI Buildpack.
+type Buildpack ccv2.Buildpack
+
+// GetBuildpacks returns all the buildpacks, in the order in which they are
+// used to detect applications.
+func (actor Actor) GetBuildpacks() ([]Buildpack, Warnings, error) {
+	ccBuildpacks, warnings, err := actor.CloudControllerClient.GetBuildpacks(nil)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	buildpacks := make([]Buildpack, len(ccBuildpacks))
+	for i, ccBuildpack := range ccBuildpacks {
+		buildpacks[i] = Buildpack(ccBuildpack)
+	}
+	sort.Stable(sortableBuildpacks(buildpacks))
+
+	return buildpacks, Warnings(warnings), nil
+}
+
+type sortableBuildpacks []Buildpack
+
+func (b sortableBuildpacks) Len() int               { return len(b) }
+func (b sortableBuildpacks) Swap(i int, j int)      { b[i], b[j] = b[j], b[i] }
+func (b sortableBuildpacks) Less(i int, j int) bool { return b[i].Position < b[j].Position }
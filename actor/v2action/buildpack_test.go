@@ -0,0 +1,61 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Buildpack Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetBuildpacks", func() {
+		Context("when getting the buildpacks succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(
+					[]ccv2.Buildpack{
+						{Name: "go_buildpack", Position: 2},
+						{Name: "ruby_buildpack", Position: 1},
+					},
+					ccv2.Warnings{"get-buildpacks-warning"},
+					nil,
+				)
+			})
+
+			It("returns the buildpacks ordered by position and all warnings", func() {
+				buildpacks, warnings, err := actor.GetBuildpacks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+				Expect(buildpacks).To(Equal([]Buildpack{
+					{Name: "ruby_buildpack", Position: 1},
+					{Name: "go_buildpack", Position: 2},
+				}))
+				Expect(fakeCloudControllerClient.GetBuildpacksArgsForCall(0)).To(BeNil())
+			})
+		})
+
+		Context("when getting the buildpacks fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildpacksReturns(nil, ccv2.Warnings{"get-buildpacks-warning"}, errors.New("get-buildpacks-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetBuildpacks()
+				Expect(err).To(MatchError("get-buildpacks-error"))
+				Expect(warnings).To(ConsistOf("get-buildpacks-warning"))
+			})
+		})
+	})
+})
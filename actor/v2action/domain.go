@@ -1,6 +1,8 @@
 package v2action
 
 import (
+	"fmt"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
@@ -10,10 +12,16 @@ type Domain ccv2.Domain
 
 // DomainNotFoundError is an error wrapper that represents the case
 // when the domain is not found.
-type DomainNotFoundError struct{}
+type DomainNotFoundError struct {
+	Name string
+}
 
 // Error method to display the error message.
 func (e DomainNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Domain '%s' not found.", e.Name)
+	}
+
 	return "Domain not found."
 }
 
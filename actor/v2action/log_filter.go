@@ -0,0 +1,79 @@
+package v2action
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LogFilter narrows down the log messages returned for an application. The
+// zero value allows all log messages.
+type LogFilter struct {
+	// SourceTypes only allows messages from the given source types (e.g. APP,
+	// RTR, STG, CELL). Source types match by prefix, so APP matches
+	// APP/PROC/WEB.
+	SourceTypes []string
+
+	// ExcludeSourceTypes drops messages from the given source types.
+	ExcludeSourceTypes []string
+
+	// SourceInstance only allows messages from the given instance index.
+	SourceInstance string
+
+	// Match only allows messages whose body matches the expression.
+	Match *regexp.Regexp
+
+	// ExcludeMatch drops messages whose body matches the expression.
+	ExcludeMatch *regexp.Regexp
+
+	// Since drops messages logged before this time when it is set.
+	Since time.Time
+
+	// Until drops messages logged after this time when it is set.
+	Until time.Time
+}
+
+// Allows returns true if the log message passes all of the filter's
+// conditions.
+func (filter LogFilter) Allows(message LogMessage) bool {
+	if len(filter.SourceTypes) > 0 && !matchesSourceType(message.sourceType, filter.SourceTypes) {
+		return false
+	}
+
+	if matchesSourceType(message.sourceType, filter.ExcludeSourceTypes) {
+		return false
+	}
+
+	if filter.SourceInstance != "" && message.sourceInstance != filter.SourceInstance {
+		return false
+	}
+
+	if filter.Match != nil && !filter.Match.MatchString(message.message) {
+		return false
+	}
+
+	if filter.ExcludeMatch != nil && filter.ExcludeMatch.MatchString(message.message) {
+		return false
+	}
+
+	if !filter.Since.IsZero() && message.timestamp.Before(filter.Since) {
+		return false
+	}
+
+	if !filter.Until.IsZero() && message.timestamp.After(filter.Until) {
+		return false
+	}
+
+	return true
+}
+
+func matchesSourceType(sourceType string, filterTypes []string) bool {
+	sourceType = strings.ToUpper(sourceType)
+	for _, filterType := range filterTypes {
+		filterType = strings.ToUpper(filterType)
+		if sourceType == filterType || strings.HasPrefix(sourceType, filterType+"/") {
+			return true
+		}
+	}
+	return false
+}
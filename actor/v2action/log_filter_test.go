@@ -0,0 +1,63 @@
+package v2action_test
+
+import (
+	"regexp"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogFilter", func() {
+	DescribeTable("Allows",
+		func(filter LogFilter, sourceType string, sourceInstance string, body string, expected bool) {
+			message := NewLogMessage(body, 1, time.Unix(1000, 0), sourceType, sourceInstance)
+			Expect(filter.Allows(*message)).To(Equal(expected))
+		},
+
+		Entry("empty filter allows everything",
+			LogFilter{}, "APP/PROC/WEB", "0", "hello", true),
+
+		Entry("source type matches exactly",
+			LogFilter{SourceTypes: []string{"RTR"}}, "RTR", "0", "hello", true),
+		Entry("source type matches by prefix",
+			LogFilter{SourceTypes: []string{"app"}}, "APP/PROC/WEB", "0", "hello", true),
+		Entry("source type does not match a partial name",
+			LogFilter{SourceTypes: []string{"AP"}}, "APP/PROC/WEB", "0", "hello", false),
+		Entry("source type is not in the list",
+			LogFilter{SourceTypes: []string{"RTR", "STG"}}, "CELL", "0", "hello", false),
+
+		Entry("excluded source type",
+			LogFilter{ExcludeSourceTypes: []string{"RTR"}}, "RTR", "0", "hello", false),
+		Entry("non-excluded source type",
+			LogFilter{ExcludeSourceTypes: []string{"RTR"}}, "APP/PROC/WEB", "0", "hello", true),
+
+		Entry("matching instance",
+			LogFilter{SourceInstance: "1"}, "APP/PROC/WEB", "1", "hello", true),
+		Entry("different instance",
+			LogFilter{SourceInstance: "1"}, "APP/PROC/WEB", "0", "hello", false),
+
+		Entry("body matches",
+			LogFilter{Match: regexp.MustCompile("^hel+o$")}, "APP/PROC/WEB", "0", "hello", true),
+		Entry("body does not match",
+			LogFilter{Match: regexp.MustCompile("error")}, "APP/PROC/WEB", "0", "hello", false),
+
+		Entry("body matches exclusion",
+			LogFilter{ExcludeMatch: regexp.MustCompile("health")}, "APP/PROC/WEB", "0", "healthcheck ok", false),
+		Entry("body does not match exclusion",
+			LogFilter{ExcludeMatch: regexp.MustCompile("health")}, "APP/PROC/WEB", "0", "hello", true),
+
+		Entry("logged after since",
+			LogFilter{Since: time.Unix(999, 0)}, "APP/PROC/WEB", "0", "hello", true),
+		Entry("logged before since",
+			LogFilter{Since: time.Unix(1001, 0)}, "APP/PROC/WEB", "0", "hello", false),
+		Entry("logged before until",
+			LogFilter{Until: time.Unix(1001, 0)}, "APP/PROC/WEB", "0", "hello", true),
+		Entry("logged after until",
+			LogFilter{Until: time.Unix(999, 0)}, "APP/PROC/WEB", "0", "hello", false),
+		Entry("logged within since and until",
+			LogFilter{Since: time.Unix(1000, 0), Until: time.Unix(1000, 0)}, "APP/PROC/WEB", "0", "hello", true),
+	)
+})
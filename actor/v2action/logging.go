@@ -1,6 +1,8 @@
 package v2action
 
 import (
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/cloudfoundry/noaa"
@@ -17,6 +19,7 @@ func (e NOAATimeoutError) Error() string {
 }
 
 type LogMessage struct {
+	appName        string
 	message        string
 	messageType    events.LogMessage_MessageType
 	timestamp      time.Time
@@ -24,6 +27,12 @@ type LogMessage struct {
 	sourceInstance string
 }
 
+// AppName returns the name of the application the message was logged by. It
+// is only set for messages streamed from multiple applications at once.
+func (log LogMessage) AppName() string {
+	return log.appName
+}
+
 func (log LogMessage) Message() string {
 	return log.message
 }
@@ -61,7 +70,30 @@ func NewLogMessage(message string, messageType int, timestamp time.Time, sourceT
 	}
 }
 
+// NewAppLogMessage returns a log message logged by the named application.
+func NewAppLogMessage(appName string, message string, messageType int, timestamp time.Time, sourceType string, sourceInstance string) *LogMessage {
+	logMessage := NewLogMessage(message, messageType, timestamp, sourceType, sourceInstance)
+	logMessage.appName = appName
+	return logMessage
+}
+
 func (actor Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error) {
+	return actor.getStreamingLogs(appGUID, client, LogFilter{})
+}
+
+// GetStreamingLogsForTask streams the log messages of the named task of the
+// application. Other log messages of the application are dropped.
+func (actor Actor) GetStreamingLogsForTask(appGUID string, taskName string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error) {
+	return actor.getStreamingLogs(appGUID, client, LogFilter{SourceTypes: []string{TaskLogSourceType(taskName)}})
+}
+
+// TaskLogSourceType returns the source type of the log messages written by
+// the named task.
+func TaskLogSourceType(taskName string) string {
+	return "APP/TASK/" + taskName
+}
+
+func (actor Actor) getStreamingLogs(appGUID string, client NOAAClient, filter LogFilter) (<-chan *LogMessage, <-chan error) {
 	// Do not pass in token because client should have a TokenRefresher set
 	eventStream, errStream := client.TailingLogs(appGUID, "")
 
@@ -80,13 +112,17 @@ func (actor Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Co
 					break dance
 				}
 
-				messages <- &LogMessage{
+				message := &LogMessage{
 					message:        string(event.GetMessage()),
 					messageType:    event.GetMessageType(),
 					timestamp:      time.Unix(0, event.GetTimestamp()),
 					sourceInstance: event.GetSourceInstance(),
 					sourceType:     event.GetSourceType(),
 				}
+
+				if filter.Allows(*message) {
+					messages <- message
+				}
 			case err, ok := <-errStream:
 				if !ok {
 					break dance
@@ -106,7 +142,9 @@ func (actor Actor) GetStreamingLogs(appGUID string, client NOAAClient, config Co
 	return messages, errs
 }
 
-func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config) ([]LogMessage, Warnings, error) {
+// GetRecentLogsForApplicationByNameAndSpace returns the recent log messages of
+// the application that are allowed by the filter.
+func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, filter LogFilter, client NOAAClient, config Config) ([]LogMessage, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, allWarnings, err
@@ -122,25 +160,175 @@ func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spa
 	var logMessages []LogMessage
 
 	for _, message := range noaaMessages {
-		logMessages = append(logMessages, LogMessage{
+		logMessage := LogMessage{
 			message:        string(message.GetMessage()),
 			messageType:    message.GetMessageType(),
 			timestamp:      time.Unix(0, message.GetTimestamp()),
 			sourceType:     message.GetSourceType(),
 			sourceInstance: message.GetSourceInstance(),
-		})
+		}
+
+		if filter.Allows(logMessage) {
+			logMessages = append(logMessages, logMessage)
+		}
 	}
 
 	return logMessages, allWarnings, nil
 }
 
-func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, Warnings, error) {
+// GetStreamingLogsForApplicationByNameAndSpace streams the log messages of the
+// application that are allowed by the filter.
+func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, filter LogFilter, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, nil, allWarnings, err
 	}
 
-	messages, logErrs := actor.GetStreamingLogs(app.GUID, client, config)
+	messages, logErrs := actor.getStreamingLogs(app.GUID, client, filter)
 
 	return messages, logErrs, allWarnings, err
 }
+
+// GetStreamingLogsForApplicationsByNameAndSpace streams the log messages of
+// all the named applications that are allowed by the filter. Messages are
+// merged into a single stream ordered by timestamp and carry the name of the
+// application that logged them.
+func (actor Actor) GetStreamingLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, filter LogFilter, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	var (
+		apps        []Application
+		allWarnings Warnings
+	)
+
+	for _, appName := range appNames {
+		app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, nil, allWarnings, err
+		}
+		apps = append(apps, app)
+	}
+
+	messages, logErrs := actor.getMergedStreamingLogs(apps, client, filter)
+
+	return messages, logErrs, allWarnings, nil
+}
+
+// GetStreamingLogsForSpace streams the log messages of every application in
+// the space, in the same way as GetStreamingLogsForApplicationsByNameAndSpace.
+func (actor Actor) GetStreamingLogsForSpace(spaceGUID string, filter LogFilter, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	if err != nil {
+		return nil, nil, warnings, err
+	}
+
+	messages, logErrs := actor.getMergedStreamingLogs(apps, client, filter)
+
+	return messages, logErrs, warnings, nil
+}
+
+// getMergedStreamingLogs opens one log stream per application and merges
+// them. Each message is held back for LogMergeWindow so that messages
+// arriving slightly out of order across streams can be sorted by timestamp.
+func (actor Actor) getMergedStreamingLogs(apps []Application, client NOAAClient, filter LogFilter) (<-chan *LogMessage, <-chan error) {
+	received := make(chan *LogMessage)
+	errs := make(chan error)
+
+	var streams sync.WaitGroup
+	for _, app := range apps {
+		appMessages, appErrs := actor.getStreamingLogs(app.GUID, client, filter)
+
+		streams.Add(1)
+		go func(appName string, appMessages <-chan *LogMessage, appErrs <-chan error) {
+			defer streams.Done()
+
+			for appMessages != nil || appErrs != nil {
+				select {
+				case message, ok := <-appMessages:
+					if !ok {
+						appMessages = nil
+						break
+					}
+
+					message.appName = appName
+					received <- message
+				case err, ok := <-appErrs:
+					if !ok {
+						appErrs = nil
+						break
+					}
+
+					errs <- err
+				}
+			}
+		}(app.Name, appMessages, appErrs)
+	}
+
+	go func() {
+		streams.Wait()
+		close(received)
+		close(errs)
+	}()
+
+	return sortLogMessages(received), errs
+}
+
+// LogMergeWindow is how long messages from multiple log streams are held back
+// to be sorted by timestamp.
+var LogMergeWindow = 250 * time.Millisecond
+
+type bufferedLogMessage struct {
+	message  *LogMessage
+	received time.Time
+}
+
+type sortableBufferedLogMessages []bufferedLogMessage
+
+func (messages sortableBufferedLogMessages) Len() int {
+	return len(messages)
+}
+
+func (messages sortableBufferedLogMessages) Swap(i int, j int) {
+	messages[i], messages[j] = messages[j], messages[i]
+}
+
+func (messages sortableBufferedLogMessages) Less(i int, j int) bool {
+	return messages[i].message.timestamp.Before(messages[j].message.timestamp)
+}
+
+func sortLogMessages(received <-chan *LogMessage) <-chan *LogMessage {
+	messages := make(chan *LogMessage)
+
+	go func() {
+		defer close(messages)
+
+		ticker := time.NewTicker(LogMergeWindow / 5)
+		defer ticker.Stop()
+
+		var buffer sortableBufferedLogMessages
+		flush := func(all bool) {
+			sort.Stable(buffer)
+
+			now := time.Now()
+			for len(buffer) > 0 && (all || now.Sub(buffer[0].received) >= LogMergeWindow) {
+				messages <- buffer[0].message
+				buffer = buffer[1:]
+			}
+		}
+
+		for {
+			select {
+			case message, ok := <-received:
+				if !ok {
+					flush(true)
+					return
+				}
+
+				buffer = append(buffer, bufferedLogMessage{message: message, received: time.Now()})
+			case <-ticker.C:
+				flush(false)
+			}
+		}
+	}()
+
+	return messages
+}
@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"regexp"
 	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
@@ -213,6 +214,60 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("GetStreamingLogsForTask", func() {
+		var (
+			messages    <-chan *LogMessage
+			errs        <-chan error
+			eventStream chan *events.LogMessage
+			errStream   chan error
+		)
+
+		BeforeEach(func() {
+			eventStream = make(chan *events.LogMessage)
+			errStream = make(chan error)
+
+			fakeNOAAClient.TailingLogsStub = func(appGUID string, authToken string) (<-chan *events.LogMessage, <-chan error) {
+				Expect(appGUID).To(Equal("some-app-guid"))
+
+				go func() {
+					outMessage := events.LogMessage_OUT
+					ts := int64(10)
+					sourceInstance := "0"
+
+					for _, sourceType := range []string{"APP/PROC/WEB", "APP/TASK/migrate-db", "APP/TASK/migrate", "CELL"} {
+						sourceType := sourceType
+						eventStream <- &events.LogMessage{
+							Message:        []byte("from " + sourceType),
+							MessageType:    &outMessage,
+							Timestamp:      &ts,
+							SourceType:     &sourceType,
+							SourceInstance: &sourceInstance,
+						}
+					}
+					close(eventStream)
+				}()
+
+				return eventStream, errStream
+			}
+		})
+
+		AfterEach(func() {
+			close(errStream)
+
+			Eventually(messages).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		It("only passes through the messages of the task", func() {
+			messages, errs = actor.GetStreamingLogsForTask("some-app-guid", "migrate", fakeNOAAClient, fakeConfig)
+
+			message := <-messages
+			Expect(message.Message()).To(Equal("from APP/TASK/migrate"))
+			Expect(message.SourceType()).To(Equal(TaskLogSourceType("migrate")))
+			Eventually(messages).Should(BeClosed())
+		})
+	})
+
 	Describe("GetRecentLogsForApplicationByNameAndSpace", func() {
 		Context("when the application can be found", func() {
 			BeforeEach(func() {
@@ -256,7 +311,7 @@ var _ = Describe("Logging Actions", func() {
 				})
 
 				It("returns all the recent logs and warnings", func() {
-					messages, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig)
+					messages, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", LogFilter{}, fakeNOAAClient, fakeConfig)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(warnings).To(ConsistOf("some-app-warnings"))
 					Expect(messages[0].Message()).To(Equal("message-1"))
@@ -271,6 +326,15 @@ var _ = Describe("Logging Actions", func() {
 					Expect(messages[1].SourceType()).To(Equal("some-source-type"))
 					Expect(messages[1].SourceInstance()).To(Equal("some-source-instance"))
 				})
+
+				It("only returns the logs allowed by the filter", func() {
+					filter := LogFilter{Match: regexp.MustCompile("message-2")}
+					messages, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", filter, fakeNOAAClient, fakeConfig)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("some-app-warnings"))
+					Expect(messages).To(HaveLen(1))
+					Expect(messages[0].Message()).To(Equal("message-2"))
+				})
 			})
 
 			Context("when NOAA errors", func() {
@@ -282,7 +346,7 @@ var _ = Describe("Logging Actions", func() {
 				})
 
 				It("returns error and warnings", func() {
-					_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig)
+					_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", LogFilter{}, fakeNOAAClient, fakeConfig)
 					Expect(err).To(MatchError(expectedErr))
 					Expect(warnings).To(ConsistOf("some-app-warnings"))
 				})
@@ -302,7 +366,7 @@ var _ = Describe("Logging Actions", func() {
 			})
 
 			It("returns error and warnings", func() {
-				_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig)
+				_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", LogFilter{}, fakeNOAAClient, fakeConfig)
 				Expect(err).To(MatchError(expectedErr))
 				Expect(warnings).To(ConsistOf("some-app-warnings"))
 
@@ -387,7 +451,7 @@ var _ = Describe("Logging Actions", func() {
 			It("converts them to log messages and passes them through the messages channel", func() {
 				var err error
 				var warnings Warnings
-				messages, logErrs, warnings, err = actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig)
+				messages, logErrs, warnings, err = actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", LogFilter{}, fakeNOAAClient, fakeConfig)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(warnings).To(ConsistOf("some-app-warnings"))
@@ -406,6 +470,16 @@ var _ = Describe("Logging Actions", func() {
 				Expect(message.SourceType()).To(Equal("some-source-type"))
 				Expect(message.SourceInstance()).To(Equal("some-source-instance"))
 			})
+
+			It("only passes through the log messages allowed by the filter", func() {
+				var err error
+				filter := LogFilter{ExcludeMatch: regexp.MustCompile("message-1")}
+				messages, logErrs, _, err = actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", filter, fakeNOAAClient, fakeConfig)
+				Expect(err).ToNot(HaveOccurred())
+
+				message := <-messages
+				Expect(message.Message()).To(Equal("message-2"))
+			})
 		})
 
 		Context("when finding the application errors", func() {
@@ -421,7 +495,7 @@ var _ = Describe("Logging Actions", func() {
 			})
 
 			It("returns error and warnings", func() {
-				_, _, warnings, err := actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient, fakeConfig)
+				_, _, warnings, err := actor.GetStreamingLogsForApplicationByNameAndSpace("some-app", "some-space-guid", LogFilter{}, fakeNOAAClient, fakeConfig)
 				Expect(err).To(MatchError(expectedErr))
 				Expect(warnings).To(ConsistOf("some-app-warnings"))
 
@@ -429,4 +503,185 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("merged streaming logs", func() {
+		var (
+			originalMergeWindow time.Duration
+			appStreams          map[string]chan *events.LogMessage
+		)
+
+		sendLog := func(appGUID string, message string, timestamp int64) {
+			outMessage := events.LogMessage_OUT
+			sourceType := "APP/PROC/WEB"
+			sourceInstance := "0"
+			appStreams[appGUID] <- &events.LogMessage{
+				Message:        []byte(message),
+				MessageType:    &outMessage,
+				Timestamp:      &timestamp,
+				SourceType:     &sourceType,
+				SourceInstance: &sourceInstance,
+			}
+		}
+
+		BeforeEach(func() {
+			originalMergeWindow = LogMergeWindow
+			LogMergeWindow = 50 * time.Millisecond
+
+			appStreams = map[string]chan *events.LogMessage{
+				"app-guid-1": make(chan *events.LogMessage),
+				"app-guid-2": make(chan *events.LogMessage),
+			}
+
+			fakeNOAAClient.TailingLogsStub = func(appGUID string, authToken string) (<-chan *events.LogMessage, <-chan error) {
+				return appStreams[appGUID], make(chan error)
+			}
+		})
+
+		AfterEach(func() {
+			LogMergeWindow = originalMergeWindow
+		})
+
+		Describe("GetStreamingLogsForApplicationsByNameAndSpace", func() {
+			Context("when the applications can be found", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(0,
+						[]ccv2.Application{{Name: "app-1", GUID: "app-guid-1"}},
+						ccv2.Warnings{"app-1-warning"},
+						nil,
+					)
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+						[]ccv2.Application{{Name: "app-2", GUID: "app-guid-2"}},
+						ccv2.Warnings{"app-2-warning"},
+						nil,
+					)
+				})
+
+				It("merges the log streams of all applications in timestamp order", func() {
+					messages, logErrs, warnings, err := actor.GetStreamingLogsForApplicationsByNameAndSpace([]string{"app-1", "app-2"}, "some-space-guid", LogFilter{}, fakeNOAAClient, fakeConfig)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("app-1-warning", "app-2-warning"))
+					Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(2))
+
+					go func() {
+						sendLog("app-guid-1", "second", 20)
+						sendLog("app-guid-2", "first", 10)
+						sendLog("app-guid-2", "third", 30)
+						close(appStreams["app-guid-1"])
+						close(appStreams["app-guid-2"])
+					}()
+
+					var received []*LogMessage
+					for message := range messages {
+						received = append(received, message)
+					}
+					Eventually(logErrs).Should(BeClosed())
+
+					Expect(received).To(HaveLen(3))
+					Expect(received[0].Message()).To(Equal("first"))
+					Expect(received[0].AppName()).To(Equal("app-2"))
+					Expect(received[1].Message()).To(Equal("second"))
+					Expect(received[1].AppName()).To(Equal("app-1"))
+					Expect(received[2].Message()).To(Equal("third"))
+					Expect(received[2].AppName()).To(Equal("app-2"))
+				})
+
+				It("applies the filter to every stream", func() {
+					messages, _, _, err := actor.GetStreamingLogsForApplicationsByNameAndSpace([]string{"app-1", "app-2"}, "some-space-guid", LogFilter{ExcludeMatch: regexp.MustCompile("second")}, fakeNOAAClient, fakeConfig)
+					Expect(err).ToNot(HaveOccurred())
+
+					go func() {
+						sendLog("app-guid-1", "second", 20)
+						sendLog("app-guid-2", "first", 10)
+						close(appStreams["app-guid-1"])
+						close(appStreams["app-guid-2"])
+					}()
+
+					var received []string
+					for message := range messages {
+						received = append(received, message.Message())
+					}
+					Expect(received).To(Equal([]string{"first"}))
+				})
+			})
+
+			Context("when one of the applications cannot be found", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("ZOMG")
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(0,
+						[]ccv2.Application{{Name: "app-1", GUID: "app-guid-1"}},
+						ccv2.Warnings{"app-1-warning"},
+						nil,
+					)
+					fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+						nil,
+						ccv2.Warnings{"app-2-warning"},
+						expectedErr,
+					)
+				})
+
+				It("returns the error and all warnings without streaming", func() {
+					_, _, warnings, err := actor.GetStreamingLogsForApplicationsByNameAndSpace([]string{"app-1", "app-2"}, "some-space-guid", LogFilter{}, fakeNOAAClient, fakeConfig)
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("app-1-warning", "app-2-warning"))
+					Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Describe("GetStreamingLogsForSpace", func() {
+			Context("when the space's applications can be listed", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns(
+						[]ccv2.Application{
+							{Name: "app-1", GUID: "app-guid-1"},
+							{Name: "app-2", GUID: "app-guid-2"},
+						},
+						ccv2.Warnings{"apps-warning"},
+						nil,
+					)
+				})
+
+				It("streams the logs of every application in the space", func() {
+					messages, _, warnings, err := actor.GetStreamingLogsForSpace("some-space-guid", LogFilter{}, fakeNOAAClient, fakeConfig)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("apps-warning"))
+
+					query := fakeCloudControllerClient.GetApplicationsArgsForCall(0)
+					Expect(query).To(ConsistOf(ccv2.Query{
+						Filter:   ccv2.SpaceGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-space-guid",
+					}))
+
+					go func() {
+						sendLog("app-guid-2", "from app 2", 10)
+						close(appStreams["app-guid-1"])
+						close(appStreams["app-guid-2"])
+					}()
+
+					message := <-messages
+					Expect(message.AppName()).To(Equal("app-2"))
+					Expect(message.Message()).To(Equal("from app 2"))
+					Eventually(messages).Should(BeClosed())
+				})
+			})
+
+			Context("when listing the applications errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("ZOMG")
+					fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"apps-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					_, _, warnings, err := actor.GetStreamingLogsForSpace("some-space-guid", LogFilter{}, fakeNOAAClient, fakeConfig)
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("apps-warning"))
+				})
+			})
+		})
+	})
 })
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -74,6 +75,23 @@ func (actor Actor) GetOrganizationByName(orgName string) (Organization, Warnings
 	return Organization(orgs[0]), Warnings(warnings), nil
 }
 
+// GetOrganizations returns all the organizations the user can see, ordered
+// by name.
+func (actor Actor) GetOrganizations() ([]Organization, Warnings, error) {
+	ccOrgs, warnings, err := actor.CloudControllerClient.GetOrganizations(nil)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	orgs := make([]Organization, len(ccOrgs))
+	for i, ccOrg := range ccOrgs {
+		orgs[i] = Organization(ccOrg)
+	}
+	sort.Sort(sortableOrganizations(orgs))
+
+	return orgs, Warnings(warnings), nil
+}
+
 // DeleteOrganization deletes the Organization associated with the provided
 // GUID. Once the deletion request is sent, it polls the deletion job until
 // it's finished.
@@ -99,3 +117,9 @@ func (actor Actor) DeleteOrganization(orgName string) (Warnings, error) {
 
 	return allWarnings, err
 }
+
+type sortableOrganizations []Organization
+
+func (orgs sortableOrganizations) Len() int               { return len(orgs) }
+func (orgs sortableOrganizations) Swap(i int, j int)      { orgs[i], orgs[j] = orgs[j], orgs[i] }
+func (orgs sortableOrganizations) Less(i int, j int) bool { return orgs[i].Name < orgs[j].Name }
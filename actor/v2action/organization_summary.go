@@ -3,11 +3,11 @@ package v2action
 import "sort"
 
 type OrganizationSummary struct {
-	Name        string
-	GUID        string
-	QuotaName   string
-	DomainNames []string
-	SpaceNames  []string
+	Name        string   `json:"name"`
+	GUID        string   `json:"guid"`
+	QuotaName   string   `json:"quota"`
+	DomainNames []string `json:"domains"`
+	SpaceNames  []string `json:"spaces"`
 }
 
 func (actor Actor) GetOrganizationSummaryByName(orgName string) (OrganizationSummary, Warnings, error) {
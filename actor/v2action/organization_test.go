@@ -300,4 +300,39 @@ var _ = Describe("Org Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizations", func() {
+		Context("when getting the orgs succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{{GUID: "org-guid-2", Name: "org-b"}, {GUID: "org-guid-1", Name: "org-a"}},
+					ccv2.Warnings{"get-orgs-warning"},
+					nil,
+				)
+			})
+
+			It("returns the orgs ordered by name and all warnings", func() {
+				orgs, warnings, err := actor.GetOrganizations()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-orgs-warning"))
+				Expect(orgs).To(Equal([]Organization{
+					{GUID: "org-guid-1", Name: "org-a"},
+					{GUID: "org-guid-2", Name: "org-b"},
+				}))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(BeNil())
+			})
+		})
+
+		Context("when getting the orgs fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-orgs-warning"}, errors.New("get-orgs-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetOrganizations()
+				Expect(err).To(MatchError("get-orgs-error"))
+				Expect(warnings).To(ConsistOf("get-orgs-warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,648 @@
+package v2action
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/cf/appfiles"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/words/generator"
+)
+
+// AppNotFoundInManifestError is returned when an application name is
+// provided on the command line but is not present in the manifest.
+type AppNotFoundInManifestError struct {
+	Name string
+}
+
+func (e AppNotFoundInManifestError) Error() string {
+	return fmt.Sprintf("specified app: %s not found in manifest", e.Name)
+}
+
+// CommandLineOptionsWithMultipleAppsError is returned when command line
+// overrides are provided while pushing multiple applications from a manifest.
+type CommandLineOptionsWithMultipleAppsError struct{}
+
+func (e CommandLineOptionsWithMultipleAppsError) Error() string {
+	return "cannot use command line options when pushing multiple apps"
+}
+
+// MissingNameError is returned when neither an application name nor a
+// manifest with applications is provided.
+type MissingNameError struct{}
+
+func (e MissingNameError) Error() string {
+	return "an application name is required"
+}
+
+// NoDomainsFoundError is returned when a default route is required but the
+// organization has no domains to create it on.
+type NoDomainsFoundError struct {
+	OrganizationGUID string
+}
+
+func (e NoDomainsFoundError) Error() string {
+	return fmt.Sprintf("no domains found for organization '%s'", e.OrganizationGUID)
+}
+
+// PushSettings are the command line overrides applied to the applications
+// read from a manifest.
+type PushSettings struct {
+	Name string
+
+	AppPorts           []int
+	Buildpack          string
+	Command            string
+	DiskQuota          uint64
+	DockerImage        string
+	Domain             string
+	HealthCheckTimeout int
+	HealthCheckType    string
+	Hostname           string
+	Instances          int
+	Memory             uint64
+	NoHostname         bool
+	NoRoute            bool
+	Path               string
+	RandomRoute        bool
+	RoutePath          string
+	StackName          string
+}
+
+// hasOverrides returns true if any property other than the name is set.
+func (settings PushSettings) hasOverrides() bool {
+	withoutName := settings
+	withoutName.Name = ""
+	return !reflect.DeepEqual(withoutName, PushSettings{})
+}
+
+// hasRouteOverrides returns true if any route related property is set.
+func (settings PushSettings) hasRouteOverrides() bool {
+	return settings.Domain != "" || settings.Hostname != "" || settings.NoHostname ||
+		settings.NoRoute || settings.RandomRoute || settings.RoutePath != ""
+}
+
+// ApplicationConfig is the current and desired state of an application being
+// pushed.
+type ApplicationConfig struct {
+	CurrentApplication Application
+	DesiredApplication Application
+
+	CurrentRoutes []Route
+	DesiredRoutes []Route
+
+	// CurrentServices and DesiredServices are the names of the service
+	// instances bound to the application and of those it should be bound to.
+	CurrentServices []string
+	DesiredServices []string
+
+	// NoRoute is set when all the current routes should be unmapped from the
+	// application.
+	NoRoute bool
+
+	// Path is the directory or zip file containing the application bits.
+	Path string
+}
+
+// Exists returns true if the application already exists in the space.
+func (config ApplicationConfig) Exists() bool {
+	return config.CurrentApplication.GUID != ""
+}
+
+// UnboundServices returns the desired service instances that are not bound
+// to the application yet.
+func (config ApplicationConfig) UnboundServices() []string {
+	var unbound []string
+	for _, name := range config.DesiredServices {
+		if !stringInList(name, config.CurrentServices) {
+			unbound = append(unbound, name)
+		}
+	}
+	return unbound
+}
+
+// ConvertToApplicationConfigs applies the provided settings to the manifest
+// applications and computes the desired state of each of them in the
+// provided space.
+func (actor Actor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string, settings PushSettings, apps []manifest.Application) ([]ApplicationConfig, Warnings, error) {
+	mergedApps, err := mergeSettingsAndManifest(settings, apps)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		configs     []ApplicationConfig
+		allWarnings Warnings
+	)
+
+	for _, app := range mergedApps {
+		config, warnings, err := actor.configureApplication(orgGUID, spaceGUID, settings, app)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		configs = append(configs, config)
+	}
+
+	return configs, allWarnings, nil
+}
+
+// CreateOrUpdateApplication creates the desired application if it does not
+// exist, otherwise it updates it. Existing applications are stopped so new
+// bits can be uploaded.
+func (actor Actor) CreateOrUpdateApplication(config ApplicationConfig) (ApplicationConfig, Warnings, error) {
+	var (
+		app      Application
+		warnings Warnings
+		err      error
+	)
+
+	if config.Exists() {
+		desiredApp := config.DesiredApplication
+		desiredApp.GUID = config.CurrentApplication.GUID
+		if config.CurrentApplication.Started() {
+			desiredApp.State = ccv2.ApplicationStopped
+		}
+		app, warnings, err = actor.UpdateApplication(desiredApp)
+	} else {
+		app, warnings, err = actor.CreateApplication(config.DesiredApplication)
+	}
+	if err != nil {
+		return config, warnings, err
+	}
+
+	config.CurrentApplication = app
+	return config, warnings, nil
+}
+
+// MapRoutes creates any desired routes that do not exist and maps them to
+// the application. When NoRoute is set, all current routes are unmapped
+// instead.
+func (actor Actor) MapRoutes(config ApplicationConfig) (ApplicationConfig, Warnings, error) {
+	var allWarnings Warnings
+
+	if config.NoRoute {
+		for _, route := range config.CurrentRoutes {
+			warnings, err := actor.CloudControllerClient.DeleteRouteApplication(route.GUID, config.CurrentApplication.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return config, allWarnings, err
+			}
+		}
+		config.CurrentRoutes = nil
+		return config, allWarnings, nil
+	}
+
+	for _, desiredRoute := range config.DesiredRoutes {
+		if desiredRoute.GUID == "" {
+			createdRoute, warnings, err := actor.CloudControllerClient.NewRoute(ccv2.Route{
+				DomainGUID: desiredRoute.DomainGUID,
+				Host:       desiredRoute.Host,
+				Path:       desiredRoute.Path,
+				Port:       desiredRoute.Port,
+				SpaceGUID:  desiredRoute.SpaceGUID,
+			})
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return config, allWarnings, err
+			}
+			desiredRoute.GUID = createdRoute.GUID
+		}
+
+		if routeInList(desiredRoute, config.CurrentRoutes) {
+			continue
+		}
+
+		_, warnings, err := actor.CloudControllerClient.UpdateRouteApplication(desiredRoute.GUID, config.CurrentApplication.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return config, allWarnings, err
+		}
+		config.CurrentRoutes = append(config.CurrentRoutes, desiredRoute)
+	}
+
+	return config, allWarnings, nil
+}
+
+// BindServices binds the application to the desired service instances that
+// it is not bound to yet.
+func (actor Actor) BindServices(config ApplicationConfig) (ApplicationConfig, Warnings, error) {
+	var allWarnings Warnings
+
+	for _, name := range config.UnboundServices() {
+		warnings, err := actor.BindServiceBySpace(config.CurrentApplication.Name, name, config.DesiredApplication.SpaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return config, allWarnings, err
+		}
+		config.CurrentServices = append(config.CurrentServices, name)
+	}
+
+	return config, allWarnings, nil
+}
+
+// UploadApplication zips the provided directory, or uses the provided zip
+// file, and uploads it as the bits of the application. It waits until the
+// Cloud Controller has finished processing the upload.
+func (actor Actor) UploadApplication(appGUID string, path string) (Warnings, error) {
+	zipFile, err := ioutil.TempFile("", "cli-app-upload")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(zipFile.Name())
+	defer zipFile.Close()
+
+	err = appfiles.ApplicationZipper{}.Zip(path, zipFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var allWarnings Warnings
+	job, warnings, err := actor.CloudControllerClient.UploadApplication(appGUID, zipFile.Name())
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(job)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func mergeSettingsAndManifest(settings PushSettings, apps []manifest.Application) ([]manifest.Application, error) {
+	if len(apps) == 0 {
+		if settings.Name == "" {
+			return nil, MissingNameError{}
+		}
+		apps = []manifest.Application{{Name: settings.Name}}
+	} else if settings.Name != "" {
+		var found bool
+		for _, app := range apps {
+			if app.Name == settings.Name {
+				apps = []manifest.Application{app}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, AppNotFoundInManifestError{Name: settings.Name}
+		}
+	}
+
+	if len(apps) > 1 && settings.hasOverrides() {
+		return nil, CommandLineOptionsWithMultipleAppsError{}
+	}
+
+	var mergedApps []manifest.Application
+	for _, app := range apps {
+		if settings.Buildpack != "" {
+			app.Buildpack = settings.Buildpack
+		}
+		if settings.Command != "" {
+			app.Command = settings.Command
+		}
+		if settings.DiskQuota != 0 {
+			app.DiskQuota = settings.DiskQuota
+		}
+		if settings.DockerImage != "" {
+			app.DockerImage = settings.DockerImage
+		}
+		if settings.Domain != "" {
+			app.Domains = []string{settings.Domain}
+			app.Routes = nil
+		}
+		if settings.HealthCheckTimeout != 0 {
+			app.HealthCheckTimeout = settings.HealthCheckTimeout
+		}
+		if settings.HealthCheckType != "" {
+			app.HealthCheckType = settings.HealthCheckType
+		}
+		if settings.Hostname != "" {
+			app.Hosts = []string{settings.Hostname}
+			app.Routes = nil
+		}
+		if settings.Instances != 0 {
+			instances := settings.Instances
+			app.Instances = &instances
+		}
+		if settings.Memory != 0 {
+			app.Memory = settings.Memory
+		}
+		if settings.NoHostname {
+			app.NoHostname = true
+		}
+		if settings.NoRoute {
+			app.NoRoute = true
+		}
+		if settings.Path != "" {
+			app.Path = settings.Path
+		}
+		if settings.RandomRoute {
+			app.RandomRoute = true
+		}
+		if settings.StackName != "" {
+			app.StackName = settings.StackName
+		}
+
+		if app.Path == "" && app.DockerImage == "" {
+			pwd, err := os.Getwd()
+			if err != nil {
+				return nil, err
+			}
+			app.Path = pwd
+		}
+
+		mergedApps = append(mergedApps, app)
+	}
+
+	return mergedApps, nil
+}
+
+func (actor Actor) configureApplication(orgGUID string, spaceGUID string, settings PushSettings, app manifest.Application) (ApplicationConfig, Warnings, error) {
+	var allWarnings Warnings
+
+	config := ApplicationConfig{
+		NoRoute: app.NoRoute,
+		Path:    app.Path,
+	}
+
+	currentApp, warnings, err := actor.GetApplicationByNameAndSpace(app.Name, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		config.CurrentApplication = currentApp
+	case ApplicationNotFoundError:
+	default:
+		return ApplicationConfig{}, allWarnings, err
+	}
+
+	config.DesiredApplication = Application{
+		Buildpack:               app.Buildpack,
+		Command:                 app.Command,
+		DiskQuota:               int(app.DiskQuota),
+		DockerImage:             app.DockerImage,
+		EnvironmentVariables:    app.EnvironmentVariables,
+		HealthCheckHTTPEndpoint: app.HealthCheckHTTPEndpoint,
+		HealthCheckTimeout:      app.HealthCheckTimeout,
+		HealthCheckType:         app.HealthCheckType,
+		Memory:                  int(app.Memory),
+		Name:                    app.Name,
+		Ports:                   settings.AppPorts,
+		SpaceGUID:               spaceGUID,
+	}
+
+	if app.Instances != nil {
+		config.DesiredApplication.Instances = *app.Instances
+		config.DesiredApplication.ZeroInstances = *app.Instances == 0
+	}
+
+	// "default" and "null" reset the buildpack and start command to the
+	// detected ones.
+	switch app.Buildpack {
+	case "default", "null":
+		config.DesiredApplication.Buildpack = ""
+		config.DesiredApplication.ResetBuildpack = true
+	}
+	if app.Command == "null" {
+		config.DesiredApplication.Command = ""
+		config.DesiredApplication.ResetCommand = true
+	}
+
+	if app.StackName != "" {
+		stack, warnings, err := actor.GetStackByName(app.StackName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplicationConfig{}, allWarnings, err
+		}
+		config.DesiredApplication.StackGUID = stack.GUID
+	}
+
+	// Service instances are looked up first so that a missing one fails the
+	// push before anything is created. All the services bound to an existing
+	// application are recorded so that they can be carried over by a
+	// blue-green push.
+	if config.Exists() || len(app.Services) > 0 {
+		serviceInstanceNames, warnings, err := actor.getServiceInstanceNamesBySpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplicationConfig{}, allWarnings, err
+		}
+
+		for _, name := range app.Services {
+			if !stringInMap(name, serviceInstanceNames) {
+				return ApplicationConfig{}, allWarnings, ServiceInstanceNotFoundError{Name: name}
+			}
+		}
+		config.DesiredServices = app.Services
+
+		if config.Exists() {
+			config.CurrentServices, warnings, err = actor.getApplicationServiceInstanceNames(config.CurrentApplication.GUID, serviceInstanceNames)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return ApplicationConfig{}, allWarnings, err
+			}
+		}
+	}
+
+	if config.Exists() {
+		routes, warnings, err := actor.GetApplicationRoutes(config.CurrentApplication.GUID, nil)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return ApplicationConfig{}, allWarnings, err
+		}
+		config.CurrentRoutes = routes
+	}
+
+	if app.NoRoute {
+		return config, allWarnings, nil
+	}
+
+	// Existing applications keep their routes unless new ones are requested.
+	if config.Exists() && !settings.hasRouteOverrides() &&
+		len(app.Routes) == 0 && len(app.Hosts) == 0 && len(app.Domains) == 0 && !app.RandomRoute && !app.NoHostname {
+		return config, allWarnings, nil
+	}
+
+	desiredRoutes, warnings, err := actor.calculateRoutes(orgGUID, spaceGUID, settings.RoutePath, app)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationConfig{}, allWarnings, err
+	}
+	config.DesiredRoutes = desiredRoutes
+
+	return config, allWarnings, nil
+}
+
+func (actor Actor) calculateRoutes(orgGUID string, spaceGUID string, routePath string, app manifest.Application) ([]Route, Warnings, error) {
+	domains, allWarnings, err := actor.GetOrganizationDomains(orgGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var routes []Route
+	if len(app.Routes) > 0 {
+		for _, rawRoute := range app.Routes {
+			route, err := parseRoute(rawRoute, domains)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			route.SpaceGUID = spaceGUID
+			routes = append(routes, route)
+		}
+	} else {
+		var routeDomains []Domain
+		if len(app.Domains) == 0 {
+			if len(domains) == 0 {
+				return nil, allWarnings, NoDomainsFoundError{OrganizationGUID: orgGUID}
+			}
+			routeDomains = []Domain{domains[0]}
+		}
+		for _, domainName := range app.Domains {
+			domain, found := findDomain(domainName, domains)
+			if !found {
+				return nil, allWarnings, DomainNotFoundError{Name: domainName}
+			}
+			routeDomains = append(routeDomains, domain)
+		}
+
+		hosts := app.Hosts
+		switch {
+		case app.NoHostname:
+			hosts = []string{""}
+		case len(hosts) == 0 && app.RandomRoute:
+			hosts = []string{fmt.Sprintf("%s-%s", app.Name, generator.NewWordGenerator().Babble())}
+		case len(hosts) == 0:
+			hosts = []string{app.Name}
+		}
+
+		for _, domain := range routeDomains {
+			for _, host := range hosts {
+				routes = append(routes, Route{
+					Host:       strings.ToLower(host),
+					Domain:     domain.Name,
+					DomainGUID: domain.GUID,
+					Path:       routePath,
+					SpaceGUID:  spaceGUID,
+				})
+			}
+		}
+	}
+
+	for i, route := range routes {
+		existingRoute, warnings, err := actor.findRoute(route)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		routes[i].GUID = existingRoute.GUID
+	}
+
+	return routes, allWarnings, nil
+}
+
+// findRoute returns the existing route matching the host, domain, path and
+// port of the provided route. An empty route is returned if none exists.
+func (actor Actor) findRoute(route Route) (Route, Warnings, error) {
+	queries := []ccv2.Query{
+		{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: route.DomainGUID},
+		{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: route.Host},
+		{Filter: ccv2.PathFilter, Operator: ccv2.EqualOperator, Value: route.Path},
+	}
+
+	routes, warnings, err := actor.CloudControllerClient.GetRoutes(queries)
+	if err != nil {
+		return Route{}, Warnings(warnings), err
+	}
+
+	for _, existingRoute := range routes {
+		if existingRoute.Port == route.Port {
+			route.GUID = existingRoute.GUID
+			return route, Warnings(warnings), nil
+		}
+	}
+
+	return Route{}, Warnings(warnings), nil
+}
+
+// parseRoute converts a route string such as "host.example.com/path" or
+// "tcp.example.com:1234" into a Route on the longest matching domain.
+func parseRoute(rawRoute string, domains []Domain) (Route, error) {
+	var route Route
+
+	hostAndDomain := rawRoute
+	if index := strings.Index(hostAndDomain, "/"); index != -1 {
+		route.Path = hostAndDomain[index:]
+		hostAndDomain = hostAndDomain[:index]
+	}
+
+	if index := strings.LastIndex(hostAndDomain, ":"); index != -1 {
+		port, err := strconv.Atoi(hostAndDomain[index+1:])
+		if err != nil {
+			return Route{}, DomainNotFoundError{Name: hostAndDomain}
+		}
+		route.Port = port
+		hostAndDomain = hostAndDomain[:index]
+	}
+
+	var matchingDomain Domain
+	for _, domain := range domains {
+		if hostAndDomain == domain.Name {
+			matchingDomain = domain
+			route.Host = ""
+			break
+		}
+		if strings.HasSuffix(hostAndDomain, "."+domain.Name) && len(domain.Name) > len(matchingDomain.Name) {
+			matchingDomain = domain
+			route.Host = strings.TrimSuffix(hostAndDomain, "."+domain.Name)
+		}
+	}
+
+	if matchingDomain.GUID == "" {
+		return Route{}, DomainNotFoundError{Name: hostAndDomain}
+	}
+
+	route.Domain = matchingDomain.Name
+	route.DomainGUID = matchingDomain.GUID
+	return route, nil
+}
+
+func findDomain(name string, domains []Domain) (Domain, bool) {
+	for _, domain := range domains {
+		if domain.Name == name {
+			return domain, true
+		}
+	}
+	return Domain{}, false
+}
+
+func stringInList(value string, values []string) bool {
+	for _, existingValue := range values {
+		if existingValue == value {
+			return true
+		}
+	}
+	return false
+}
+
+func stringInMap(value string, values map[string]string) bool {
+	for _, existingValue := range values {
+		if existingValue == value {
+			return true
+		}
+	}
+	return false
+}
+
+func routeInList(route Route, routes []Route) bool {
+	for _, existingRoute := range routes {
+		if existingRoute.GUID == route.GUID {
+			return true
+		}
+	}
+	return false
+}
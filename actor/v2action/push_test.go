@@ -0,0 +1,666 @@
+package v2action_test
+
+import (
+	"archive/zip"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/manifest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Push Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("ConvertToApplicationConfigs", func() {
+		var (
+			settings     PushSettings
+			manifestApps []manifest.Application
+
+			configs    []ApplicationConfig
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			settings = PushSettings{Name: "some-app", Path: "/some/path"}
+			manifestApps = nil
+
+			fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, nil)
+			fakeCloudControllerClient.GetSharedDomainsReturns(
+				[]ccv2.Domain{{GUID: "shared-domain-guid", Name: "shared.com"}},
+				ccv2.Warnings{"get-shared-domains-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationPrivateDomainsReturns(
+				[]ccv2.Domain{{GUID: "private-domain-guid", Name: "private.shared.com"}},
+				ccv2.Warnings{"get-private-domains-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			configs, warnings, executeErr = actor.ConvertToApplicationConfigs("some-org-guid", "some-space-guid", settings, manifestApps)
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				settings.Instances = 2
+				settings.Memory = 256
+			})
+
+			It("returns a config for a new application with a default route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-shared-domains-warning", "get-private-domains-warning", "get-routes-warning"))
+				Expect(configs).To(HaveLen(1))
+
+				config := configs[0]
+				Expect(config.Exists()).To(BeFalse())
+				Expect(config.Path).To(Equal("/some/path"))
+				Expect(config.DesiredApplication).To(Equal(Application{
+					Instances: 2,
+					Memory:    256,
+					Name:      "some-app",
+					SpaceGUID: "some-space-guid",
+				}))
+				Expect(config.DesiredRoutes).To(Equal([]Route{{
+					Host:       "some-app",
+					Domain:     "shared.com",
+					DomainGUID: "shared-domain-guid",
+					SpaceGUID:  "some-space-guid",
+				}}))
+
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv2.Query{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: "shared-domain-guid"},
+					ccv2.Query{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: "some-app"},
+					ccv2.Query{Filter: ccv2.PathFilter, Operator: ccv2.EqualOperator, Value: ""},
+				))
+			})
+
+			Context("when the route already exists", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{{GUID: "existing-route-guid"}}, nil, nil)
+				})
+
+				It("sets the GUID of the desired route", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs[0].DesiredRoutes).To(HaveLen(1))
+					Expect(configs[0].DesiredRoutes[0].GUID).To(Equal("existing-route-guid"))
+				})
+			})
+
+			Context("when the domain and hostname are provided", func() {
+				BeforeEach(func() {
+					settings.Domain = "private.shared.com"
+					settings.Hostname = "some-host"
+					settings.RoutePath = "/some-path"
+				})
+
+				It("uses them for the desired route", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs[0].DesiredRoutes).To(Equal([]Route{{
+						Host:       "some-host",
+						Domain:     "private.shared.com",
+						DomainGUID: "private-domain-guid",
+						Path:       "/some-path",
+						SpaceGUID:  "some-space-guid",
+					}}))
+				})
+			})
+
+			Context("when the domain does not exist", func() {
+				BeforeEach(func() {
+					settings.Domain = "does-not-exist.com"
+				})
+
+				It("returns a DomainNotFoundError", func() {
+					Expect(executeErr).To(MatchError(DomainNotFoundError{Name: "does-not-exist.com"}))
+				})
+			})
+
+			Context("when no-hostname is provided", func() {
+				BeforeEach(func() {
+					settings.NoHostname = true
+				})
+
+				It("maps the root of the domain", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs[0].DesiredRoutes).To(HaveLen(1))
+					Expect(configs[0].DesiredRoutes[0].Host).To(BeEmpty())
+				})
+			})
+
+			Context("when random-route is provided", func() {
+				BeforeEach(func() {
+					settings.RandomRoute = true
+				})
+
+				It("generates a hostname based on the application name", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs[0].DesiredRoutes).To(HaveLen(1))
+					Expect(configs[0].DesiredRoutes[0].Host).To(MatchRegexp(`^some-app-\w+-\w+$`))
+				})
+			})
+
+			Context("when no-route is provided", func() {
+				BeforeEach(func() {
+					settings.NoRoute = true
+				})
+
+				It("does not calculate any routes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs[0].NoRoute).To(BeTrue())
+					Expect(configs[0].DesiredRoutes).To(BeEmpty())
+					Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the buildpack is default and the command is null", func() {
+				BeforeEach(func() {
+					settings.Buildpack = "default"
+					settings.Command = "null"
+				})
+
+				It("resets them instead of setting them", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs[0].DesiredApplication.Buildpack).To(BeEmpty())
+					Expect(configs[0].DesiredApplication.ResetBuildpack).To(BeTrue())
+					Expect(configs[0].DesiredApplication.Command).To(BeEmpty())
+					Expect(configs[0].DesiredApplication.ResetCommand).To(BeTrue())
+				})
+			})
+
+			Context("when the manifest sets the instances to 0", func() {
+				BeforeEach(func() {
+					settings.Instances = 0
+					instances := 0
+					manifestApps = []manifest.Application{{Name: "some-app", Instances: &instances}}
+				})
+
+				It("sends the 0 instead of leaving the instances unset", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs[0].DesiredApplication.Instances).To(Equal(0))
+					Expect(configs[0].DesiredApplication.ZeroInstances).To(BeTrue())
+				})
+			})
+
+			Context("when app ports are provided", func() {
+				BeforeEach(func() {
+					settings.AppPorts = []int{8080, 9090}
+				})
+
+				It("sets the ports", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs[0].DesiredApplication.Ports).To(Equal([]int{8080, 9090}))
+				})
+			})
+
+			Context("when the buildpack is null", func() {
+				BeforeEach(func() {
+					settings.Buildpack = "null"
+				})
+
+				It("resets it", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs[0].DesiredApplication.Buildpack).To(BeEmpty())
+					Expect(configs[0].DesiredApplication.ResetBuildpack).To(BeTrue())
+					Expect(configs[0].DesiredApplication.ResetCommand).To(BeFalse())
+				})
+			})
+
+			Context("when a stack is provided", func() {
+				BeforeEach(func() {
+					settings.StackName = "some-stack"
+					fakeCloudControllerClient.GetStacksReturns([]ccv2.Stack{{GUID: "some-stack-guid"}}, ccv2.Warnings{"get-stacks-warning"}, nil)
+				})
+
+				It("sets the stack GUID", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs[0].DesiredApplication.StackGUID).To(Equal("some-stack-guid"))
+					Expect(warnings).To(ContainElement("get-stacks-warning"))
+				})
+			})
+		})
+
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app", State: ccv2.ApplicationStarted}},
+					ccv2.Warnings{"get-apps-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationRoutesReturns(
+					[]ccv2.Route{{GUID: "some-route-guid", Host: "some-app", DomainGUID: "shared-domain-guid"}},
+					ccv2.Warnings{"get-app-routes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{GUID: "shared-domain-guid", Name: "shared.com"}, nil, nil)
+			})
+
+			It("keeps the current routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-app-routes-warning"))
+
+				config := configs[0]
+				Expect(config.Exists()).To(BeTrue())
+				Expect(config.CurrentApplication.GUID).To(Equal("some-app-guid"))
+				Expect(config.CurrentRoutes).To(Equal([]Route{{
+					GUID:       "some-route-guid",
+					Host:       "some-app",
+					Domain:     "shared.com",
+					DomainGUID: "shared-domain-guid",
+				}}))
+				Expect(config.DesiredRoutes).To(BeEmpty())
+			})
+		})
+
+		Context("when the manifest contains multiple applications", func() {
+			BeforeEach(func() {
+				settings = PushSettings{}
+				manifestApps = []manifest.Application{
+					{Name: "app-1", Path: "/app-1", NoRoute: true},
+					{Name: "app-2", Path: "/app-2", NoRoute: true},
+				}
+			})
+
+			It("returns a config for each application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(configs).To(HaveLen(2))
+				Expect(configs[0].DesiredApplication.Name).To(Equal("app-1"))
+				Expect(configs[1].DesiredApplication.Name).To(Equal("app-2"))
+			})
+
+			Context("when an application name is provided", func() {
+				BeforeEach(func() {
+					settings.Name = "app-2"
+				})
+
+				It("only returns that application", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(configs).To(HaveLen(1))
+					Expect(configs[0].Path).To(Equal("/app-2"))
+				})
+			})
+
+			Context("when the provided application name is not in the manifest", func() {
+				BeforeEach(func() {
+					settings.Name = "app-3"
+				})
+
+				It("returns an AppNotFoundInManifestError", func() {
+					Expect(executeErr).To(MatchError(AppNotFoundInManifestError{Name: "app-3"}))
+				})
+			})
+
+			Context("when command line overrides are provided", func() {
+				BeforeEach(func() {
+					settings.Instances = 3
+				})
+
+				It("returns a CommandLineOptionsWithMultipleAppsError", func() {
+					Expect(executeErr).To(MatchError(CommandLineOptionsWithMultipleAppsError{}))
+				})
+			})
+
+			Context("when app ports are provided", func() {
+				BeforeEach(func() {
+					settings.AppPorts = []int{8080}
+				})
+
+				It("returns a CommandLineOptionsWithMultipleAppsError", func() {
+					Expect(executeErr).To(MatchError(CommandLineOptionsWithMultipleAppsError{}))
+				})
+			})
+		})
+
+		Context("when the manifest provides full routes", func() {
+			BeforeEach(func() {
+				settings = PushSettings{}
+				manifestApps = []manifest.Application{
+					{Name: "some-app", Path: "/some/path", Routes: []string{"foo.private.shared.com/bar", "shared.com"}},
+				}
+			})
+
+			It("maps each route on its longest matching domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(configs[0].DesiredRoutes).To(Equal([]Route{
+					{Host: "foo", Domain: "private.shared.com", DomainGUID: "private-domain-guid", Path: "/bar", SpaceGUID: "some-space-guid"},
+					{Domain: "shared.com", DomainGUID: "shared-domain-guid", SpaceGUID: "some-space-guid"},
+				}))
+			})
+		})
+
+		Context("when the manifest lists services", func() {
+			BeforeEach(func() {
+				settings = PushSettings{}
+				manifestApps = []manifest.Application{
+					{Name: "some-app", Path: "/some/path", NoRoute: true, Services: []string{"some-db", "some-queue"}},
+				}
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{
+						{GUID: "some-db-guid", Name: "some-db"},
+						{GUID: "some-queue-guid", Name: "some-queue"},
+						{GUID: "other-guid", Name: "other-service"},
+					},
+					ccv2.Warnings{"get-service-instances-warning"},
+					nil,
+				)
+			})
+
+			It("sets the desired services", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("get-service-instances-warning"))
+				Expect(fakeCloudControllerClient.GetServiceBindingsCallCount()).To(Equal(0))
+				Expect(configs[0].DesiredServices).To(Equal([]string{"some-db", "some-queue"}))
+				Expect(configs[0].UnboundServices()).To(Equal([]string{"some-db", "some-queue"}))
+			})
+
+			Context("when the application is already bound to some of them", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns([]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}}, nil, nil)
+					fakeCloudControllerClient.GetServiceBindingsReturns(
+						[]ccv2.ServiceBinding{
+							{AppGUID: "some-app-guid", ServiceInstanceGUID: "some-db-guid"},
+							{AppGUID: "some-app-guid", ServiceInstanceGUID: "other-guid"},
+						},
+						ccv2.Warnings{"get-bindings-warning"},
+						nil,
+					)
+				})
+
+				It("only leaves the others unbound", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("get-bindings-warning"))
+					Expect(configs[0].CurrentServices).To(Equal([]string{"other-service", "some-db"}))
+					Expect(configs[0].UnboundServices()).To(Equal([]string{"some-queue"}))
+				})
+			})
+
+			Context("when a service instance does not exist", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+						[]ccv2.ServiceInstance{{GUID: "some-queue-guid", Name: "some-queue"}},
+						ccv2.Warnings{"get-service-instances-warning"},
+						nil,
+					)
+				})
+
+				It("returns a ServiceInstanceNotFoundError", func() {
+					Expect(executeErr).To(MatchError(ServiceInstanceNotFoundError{Name: "some-db"}))
+					Expect(warnings).To(ContainElement("get-service-instances-warning"))
+				})
+			})
+		})
+
+		Context("when no application name or manifest is provided", func() {
+			BeforeEach(func() {
+				settings = PushSettings{}
+			})
+
+			It("returns a MissingNameError", func() {
+				Expect(executeErr).To(MatchError(MissingNameError{}))
+			})
+		})
+
+		Context("when getting the application fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"get-apps-warning"}, errors.New("get-apps-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-apps-error"))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+			})
+		})
+	})
+
+	Describe("CreateOrUpdateApplication", func() {
+		var config ApplicationConfig
+
+		BeforeEach(func() {
+			config = ApplicationConfig{
+				DesiredApplication: Application{Name: "some-app", SpaceGUID: "some-space-guid", Instances: 2},
+			}
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.NewApplicationReturns(ccv2.Application{GUID: "new-app-guid", Name: "some-app"}, ccv2.Warnings{"new-app-warning"}, nil)
+			})
+
+			It("creates the application", func() {
+				updatedConfig, warnings, err := actor.CreateOrUpdateApplication(config)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("new-app-warning"))
+				Expect(updatedConfig.CurrentApplication).To(Equal(Application{GUID: "new-app-guid", Name: "some-app"}))
+
+				Expect(fakeCloudControllerClient.NewApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.NewApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					Name:      "some-app",
+					SpaceGUID: "some-space-guid",
+					Instances: 2,
+				}))
+			})
+		})
+
+		Context("when the application exists and is started", func() {
+			BeforeEach(func() {
+				config.CurrentApplication = Application{GUID: "some-app-guid", State: ccv2.ApplicationStarted}
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{GUID: "some-app-guid", State: ccv2.ApplicationStopped}, ccv2.Warnings{"update-app-warning"}, nil)
+			})
+
+			It("updates and stops the application", func() {
+				updatedConfig, warnings, err := actor.CreateOrUpdateApplication(config)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-app-warning"))
+				Expect(updatedConfig.CurrentApplication.State).To(Equal(ccv2.ApplicationStopped))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+					GUID:      "some-app-guid",
+					Name:      "some-app",
+					SpaceGUID: "some-space-guid",
+					Instances: 2,
+					State:     ccv2.ApplicationStopped,
+				}))
+			})
+		})
+
+		Context("when the update fails", func() {
+			BeforeEach(func() {
+				config.CurrentApplication = Application{GUID: "some-app-guid"}
+				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{}, ccv2.Warnings{"update-app-warning"}, errors.New("update-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.CreateOrUpdateApplication(config)
+				Expect(err).To(MatchError("update-app-error"))
+				Expect(warnings).To(ConsistOf("update-app-warning"))
+			})
+		})
+	})
+
+	Describe("MapRoutes", func() {
+		var config ApplicationConfig
+
+		BeforeEach(func() {
+			config = ApplicationConfig{
+				CurrentApplication: Application{GUID: "some-app-guid"},
+				CurrentRoutes:      []Route{{GUID: "bound-route-guid"}},
+				DesiredRoutes: []Route{
+					{GUID: "bound-route-guid"},
+					{GUID: "unbound-route-guid"},
+					{Host: "new-host", DomainGUID: "some-domain-guid", SpaceGUID: "some-space-guid"},
+				},
+			}
+
+			fakeCloudControllerClient.NewRouteReturns(ccv2.Route{GUID: "new-route-guid"}, ccv2.Warnings{"new-route-warning"}, nil)
+			fakeCloudControllerClient.UpdateRouteApplicationReturns(ccv2.Route{}, ccv2.Warnings{"bind-route-warning"}, nil)
+		})
+
+		It("creates missing routes and maps the unmapped ones", func() {
+			updatedConfig, warnings, err := actor.MapRoutes(config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("new-route-warning", "bind-route-warning", "bind-route-warning"))
+
+			Expect(fakeCloudControllerClient.NewRouteCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.NewRouteArgsForCall(0)).To(Equal(ccv2.Route{
+				Host:       "new-host",
+				DomainGUID: "some-domain-guid",
+				SpaceGUID:  "some-space-guid",
+			}))
+
+			Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(2))
+			routeGUID, appGUID := fakeCloudControllerClient.UpdateRouteApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("unbound-route-guid"))
+			Expect(appGUID).To(Equal("some-app-guid"))
+			routeGUID, _ = fakeCloudControllerClient.UpdateRouteApplicationArgsForCall(1)
+			Expect(routeGUID).To(Equal("new-route-guid"))
+
+			Expect(updatedConfig.CurrentRoutes).To(HaveLen(3))
+		})
+
+		Context("when no-route is set", func() {
+			BeforeEach(func() {
+				config.NoRoute = true
+				fakeCloudControllerClient.DeleteRouteApplicationReturns(ccv2.Warnings{"unbind-route-warning"}, nil)
+			})
+
+			It("unmaps all the current routes", func() {
+				updatedConfig, warnings, err := actor.MapRoutes(config)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("unbind-route-warning"))
+				Expect(updatedConfig.CurrentRoutes).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(1))
+				routeGUID, appGUID := fakeCloudControllerClient.DeleteRouteApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("bound-route-guid"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.UpdateRouteApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating a route fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.NewRouteReturns(ccv2.Route{}, ccv2.Warnings{"new-route-warning"}, errors.New("new-route-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.MapRoutes(config)
+				Expect(err).To(MatchError("new-route-error"))
+				Expect(warnings).To(ConsistOf("bind-route-warning", "new-route-warning"))
+			})
+		})
+	})
+
+	Describe("BindServices", func() {
+		var config ApplicationConfig
+
+		BeforeEach(func() {
+			config = ApplicationConfig{
+				CurrentApplication: Application{GUID: "some-app-guid", Name: "some-app"},
+				DesiredApplication: Application{Name: "some-app", SpaceGUID: "some-space-guid"},
+				CurrentServices:    []string{"bound-db"},
+				DesiredServices:    []string{"bound-db", "some-queue"},
+			}
+
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}}, ccv2.Warnings{"get-app-warning"}, nil)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns([]ccv2.ServiceInstance{{GUID: "some-queue-guid", Name: "some-queue"}}, ccv2.Warnings{"get-service-instance-warning"}, nil)
+			fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"bind-warning"}, nil)
+		})
+
+		It("binds the services that are not bound yet", func() {
+			updatedConfig, warnings, err := actor.BindServices(config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-app-warning", "get-service-instance-warning", "bind-warning"))
+			Expect(updatedConfig.CurrentServices).To(Equal([]string{"bound-db", "some-queue"}))
+			Expect(updatedConfig.UnboundServices()).To(BeEmpty())
+
+			Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+			appGUID, serviceInstanceGUID := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(serviceInstanceGUID).To(Equal("some-queue-guid"))
+		})
+
+		Context("when binding fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"bind-warning"}, errors.New("bind-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.BindServices(config)
+				Expect(err).To(MatchError("bind-error"))
+				Expect(warnings).To(ContainElement("bind-warning"))
+			})
+		})
+	})
+
+	Describe("UploadApplication", func() {
+		var appDir string
+
+		BeforeEach(func() {
+			var err error
+			appDir, err = ioutil.TempDir("", "push-upload")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ioutil.WriteFile(filepath.Join(appDir, "some-file"), []byte("some-contents"), 0644)).To(Succeed())
+
+			fakeCloudControllerClient.UploadApplicationStub = func(appGUID string, zipFilePath string) (ccv2.Job, ccv2.Warnings, error) {
+				reader, err := zip.OpenReader(zipFilePath)
+				Expect(err).ToNot(HaveOccurred())
+				defer reader.Close()
+				Expect(reader.File).To(HaveLen(1))
+				Expect(reader.File[0].Name).To(Equal("some-file"))
+
+				return ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"upload-warning"}, nil
+			}
+			fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-job-warning"}, nil)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(appDir)).To(Succeed())
+		})
+
+		It("zips and uploads the directory and waits for the job", func() {
+			warnings, err := actor.UploadApplication("some-app-guid", appDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("upload-warning", "poll-job-warning"))
+
+			Expect(fakeCloudControllerClient.UploadApplicationCallCount()).To(Equal(1))
+			appGUID, _ := fakeCloudControllerClient.UploadApplicationArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+
+			Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+		})
+
+		Context("when the upload fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UploadApplicationStub = nil
+				fakeCloudControllerClient.UploadApplicationReturns(ccv2.Job{}, ccv2.Warnings{"upload-warning"}, errors.New("upload-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := actor.UploadApplication("some-app-guid", appDir)
+				Expect(err).To(MatchError("upload-error"))
+				Expect(warnings).To(ConsistOf("upload-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
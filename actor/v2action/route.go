@@ -8,11 +8,13 @@ import (
 
 // Route represents a CLI Route.
 type Route struct {
-	GUID   string
-	Host   string
-	Domain string
-	Path   string
-	Port   int
+	GUID       string `json:"guid"`
+	Host       string `json:"host"`
+	Domain     string `json:"domain"`
+	DomainGUID string `json:"domain_guid"`
+	Path       string `json:"path"`
+	Port       int    `json:"port,omitempty"`
+	SpaceGUID  string `json:"space_guid"`
 }
 
 // String formats the route in a human readable format.
@@ -122,11 +124,13 @@ func (actor Actor) applyDomain(ccv2Routes []ccv2.Route) ([]Route, Warnings, erro
 			return nil, allWarnings, err
 		}
 		routes = append(routes, Route{
-			GUID:   ccv2Route.GUID,
-			Host:   ccv2Route.Host,
-			Domain: domain.Name,
-			Path:   ccv2Route.Path,
-			Port:   ccv2Route.Port,
+			GUID:       ccv2Route.GUID,
+			Host:       ccv2Route.Host,
+			Domain:     domain.Name,
+			DomainGUID: ccv2Route.DomainGUID,
+			Path:       ccv2Route.Path,
+			Port:       ccv2Route.Port,
+			SpaceGUID:  ccv2Route.SpaceGUID,
 		})
 	}
 
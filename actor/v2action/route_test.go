@@ -83,12 +83,14 @@ var _ = Describe("Route Actions", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(orphanedRoutes).To(ConsistOf([]Route{
 					{
-						GUID:   "orphaned-route-guid-1",
-						Domain: "some-domain.com",
+						GUID:       "orphaned-route-guid-1",
+						Domain:     "some-domain.com",
+						DomainGUID: "some-domain-guid",
 					},
 					{
-						GUID:   "orphaned-route-guid-2",
-						Domain: "some-other-domain.com",
+						GUID:       "orphaned-route-guid-2",
+						Domain:     "some-other-domain.com",
+						DomainGUID: "some-other-domain-guid",
 					},
 				}))
 
@@ -261,18 +263,20 @@ var _ = Describe("Route Actions", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(routes).To(ConsistOf([]Route{
 					{
-						GUID:   "route-guid-1",
-						Host:   "host",
-						Domain: "domain",
-						Path:   "/path",
-						Port:   1234,
+						GUID:       "route-guid-1",
+						Host:       "host",
+						Domain:     "domain",
+						DomainGUID: "domain-1-guid",
+						Path:       "/path",
+						Port:       1234,
 					},
 					{
-						GUID:   "route-guid-2",
-						Host:   "host",
-						Domain: "domain",
-						Path:   "/path",
-						Port:   1234,
+						GUID:       "route-guid-2",
+						Host:       "host",
+						Domain:     "domain",
+						DomainGUID: "domain-2-guid",
+						Path:       "/path",
+						Port:       1234,
 					},
 				}))
 			})
@@ -395,18 +399,20 @@ var _ = Describe("Route Actions", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(routes).To(ConsistOf([]Route{
 					{
-						GUID:   "route-guid-1",
-						Host:   "host",
-						Domain: "domain",
-						Path:   "/path",
-						Port:   1234,
+						GUID:       "route-guid-1",
+						Host:       "host",
+						Domain:     "domain",
+						DomainGUID: "domain-1-guid",
+						Path:       "/path",
+						Port:       1234,
 					},
 					{
-						GUID:   "route-guid-2",
-						Host:   "host",
-						Domain: "domain",
-						Path:   "/path",
-						Port:   1234,
+						GUID:       "route-guid-2",
+						Host:       "host",
+						Domain:     "domain",
+						DomainGUID: "domain-2-guid",
+						Path:       "/path",
+						Port:       1234,
 					},
 				}))
 			})
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"fmt"
+	"sort"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 )
@@ -51,6 +52,68 @@ func (actor Actor) GetServiceBindingByApplicationAndServiceInstance(appGUID stri
 	return ServiceBinding(serviceBindings[0]), Warnings(warnings), err
 }
 
+// BindServiceBySpace binds the service instance to the application in the
+// given space.
+func (actor Actor) BindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string) (Warnings, error) {
+	var allWarnings Warnings
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	_, ccWarnings, err := actor.CloudControllerClient.CreateServiceBinding(app.GUID, serviceInstance.GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+
+	return allWarnings, err
+}
+
+// getServiceInstanceNamesBySpace returns the names of the service instances
+// in the space by GUID.
+func (actor Actor) getServiceInstanceNamesBySpace(spaceGUID string) (map[string]string, Warnings, error) {
+	serviceInstances, warnings, err := actor.GetServiceInstancesBySpace(spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	names := map[string]string{}
+	for _, serviceInstance := range serviceInstances {
+		names[serviceInstance.GUID] = serviceInstance.Name
+	}
+	return names, warnings, nil
+}
+
+// getApplicationServiceInstanceNames returns the sorted names of the service
+// instances bound to the application, looked up in serviceInstanceNames.
+// Bindings to service instances missing from serviceInstanceNames are left
+// out.
+func (actor Actor) getApplicationServiceInstanceNames(appGUID string, serviceInstanceNames map[string]string) ([]string, Warnings, error) {
+	serviceBindings, warnings, err := actor.CloudControllerClient.GetServiceBindings([]ccv2.Query{{
+		Filter:   ccv2.AppGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    appGUID,
+	}})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var names []string
+	for _, serviceBinding := range serviceBindings {
+		if name, ok := serviceInstanceNames[serviceBinding.ServiceInstanceGUID]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, Warnings(warnings), nil
+}
+
 // UnbindServiceBySpace deletes the service binding between an application and
 // service instance for a given space.
 func (actor Actor) UnbindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string) (Warnings, error) {
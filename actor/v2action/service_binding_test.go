@@ -89,6 +89,65 @@ var _ = Describe("Service Binding Actions", func() {
 		})
 	})
 
+	Describe("BindServiceBySpace", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				ccv2.Warnings{"foo-1"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "some-service-instance-guid", Name: "some-service-instance"}},
+				ccv2.Warnings{"foo-2"},
+				nil,
+			)
+			fakeCloudControllerClient.CreateServiceBindingReturns(
+				ccv2.ServiceBinding{GUID: "some-service-binding-guid"},
+				ccv2.Warnings{"foo-3"},
+				nil,
+			)
+		})
+
+		It("creates the service binding", func() {
+			warnings, err := actor.BindServiceBySpace("some-app", "some-service-instance", "some-space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"foo-1", "foo-2", "foo-3"}))
+
+			Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+			appGUID, serviceInstanceGUID := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+		})
+
+		Context("when the service instance does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(nil, ccv2.Warnings{"foo-2"}, nil)
+			})
+
+			It("returns a ServiceInstanceNotFoundError and does not bind", func() {
+				warnings, err := actor.BindServiceBySpace("some-app", "some-service-instance", "some-space-guid")
+				Expect(err).To(MatchError(ServiceInstanceNotFoundError{Name: "some-service-instance"}))
+				Expect(warnings).To(ConsistOf(Warnings{"foo-1", "foo-2"}))
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the binding fails", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CC error")
+				fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"foo-3"}, expectedError)
+			})
+
+			It("returns the warnings and the error", func() {
+				warnings, err := actor.BindServiceBySpace("some-app", "some-service-instance", "some-space-guid")
+				Expect(err).To(MatchError(expectedError))
+				Expect(warnings).To(ConsistOf(Warnings{"foo-1", "foo-2", "foo-3"}))
+			})
+		})
+	})
+
 	Describe("UnbindServiceBySpace", func() {
 		Context("when the service binding exists", func() {
 			BeforeEach(func() {
@@ -0,0 +1,215 @@
+package v2action
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// HiddenValue is displayed in place of environment variable values, which
+// may hold credentials.
+const HiddenValue = "[hidden]"
+
+// SpaceConfiguration is a snapshot of the apps and configuration of a space
+// that can be compared against another space.
+type SpaceConfiguration struct {
+	SpaceSummary
+	RouteNames   []string
+	Applications []ApplicationConfiguration
+}
+
+// ApplicationConfiguration is the configuration of an app in a
+// SpaceConfiguration.
+type ApplicationConfiguration struct {
+	Name                 string
+	Instances            int
+	Memory               int
+	EnvironmentVariables map[string]string
+	RouteNames           []string
+	ServiceInstanceNames []string
+}
+
+// SpaceDifference is a property that differs between two spaces. App is empty
+// for properties of the space itself, and Source or Target is empty when the
+// property is not set in that space.
+type SpaceDifference struct {
+	App      string
+	Property string
+	Source   string
+	Target   string
+}
+
+// GetSpaceConfigurationByOrganizationAndSpaceName returns the configuration
+// of the space with the given name in the organization with the given name.
+func (actor Actor) GetSpaceConfigurationByOrganizationAndSpaceName(orgName string, spaceName string) (SpaceConfiguration, Warnings, error) {
+	var allWarnings Warnings
+
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceConfiguration{}, allWarnings, err
+	}
+
+	spaceSummary, warnings, err := actor.GetSpaceSummaryByOrganizationAndName(org.GUID, spaceName, false)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceConfiguration{}, allWarnings, err
+	}
+
+	configuration := SpaceConfiguration{SpaceSummary: spaceSummary}
+
+	routes, warnings, err := actor.GetSpaceRoutes(spaceSummary.SpaceGUID, nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceConfiguration{}, allWarnings, err
+	}
+	configuration.RouteNames = routeNames(routes)
+
+	serviceInstanceNames, warnings, err := actor.getServiceInstanceNamesBySpace(spaceSummary.SpaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceConfiguration{}, allWarnings, err
+	}
+
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceSummary.SpaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return SpaceConfiguration{}, allWarnings, err
+	}
+
+	for _, app := range apps {
+		appConfiguration := ApplicationConfiguration{
+			Name:                 app.Name,
+			Instances:            app.Instances,
+			Memory:               app.Memory,
+			EnvironmentVariables: app.EnvironmentVariables,
+		}
+
+		routes, warnings, err := actor.GetApplicationRoutes(app.GUID, nil)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceConfiguration{}, allWarnings, err
+		}
+		appConfiguration.RouteNames = routeNames(routes)
+
+		appConfiguration.ServiceInstanceNames, warnings, err = actor.getApplicationServiceInstanceNames(app.GUID, serviceInstanceNames)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return SpaceConfiguration{}, allWarnings, err
+		}
+
+		configuration.Applications = append(configuration.Applications, appConfiguration)
+	}
+	sort.Sort(sortableApplicationConfigurations(configuration.Applications))
+
+	return configuration, allWarnings, nil
+}
+
+// DiffSpaceConfigurations returns the properties that differ between the
+// source and target spaces: first those of the spaces themselves, then those
+// of each app found in both spaces, ordered by app name. Environment
+// variable values are compared but reported as HiddenValue.
+func DiffSpaceConfigurations(source SpaceConfiguration, target SpaceConfiguration) []SpaceDifference {
+	var differences []SpaceDifference
+	add := func(app string, property string, sourceValue string, targetValue string) {
+		if sourceValue != targetValue {
+			differences = append(differences, SpaceDifference{
+				App:      app,
+				Property: property,
+				Source:   sourceValue,
+				Target:   targetValue,
+			})
+		}
+	}
+
+	add("", "apps", joinSorted(source.AppNames), joinSorted(target.AppNames))
+	add("", "services", joinSorted(source.ServiceInstanceNames), joinSorted(target.ServiceInstanceNames))
+	add("", "routes", joinSorted(source.RouteNames), joinSorted(target.RouteNames))
+	add("", "space quota", source.SpaceQuotaName, target.SpaceQuotaName)
+	add("", "security groups", joinSorted(source.SecurityGroupNames), joinSorted(target.SecurityGroupNames))
+
+	targetApps := map[string]ApplicationConfiguration{}
+	for _, app := range target.Applications {
+		targetApps[app.Name] = app
+	}
+
+	for _, sourceApp := range source.Applications {
+		targetApp, ok := targetApps[sourceApp.Name]
+		if !ok {
+			continue
+		}
+
+		add(sourceApp.Name, "instances", strconv.Itoa(sourceApp.Instances), strconv.Itoa(targetApp.Instances))
+		add(sourceApp.Name, "memory", formatMemory(sourceApp.Memory), formatMemory(targetApp.Memory))
+		add(sourceApp.Name, "routes", joinSorted(sourceApp.RouteNames), joinSorted(targetApp.RouteNames))
+		add(sourceApp.Name, "services", joinSorted(sourceApp.ServiceInstanceNames), joinSorted(targetApp.ServiceInstanceNames))
+
+		for _, name := range environmentVariableNames(sourceApp.EnvironmentVariables, targetApp.EnvironmentVariables) {
+			sourceValue, inSource := sourceApp.EnvironmentVariables[name]
+			targetValue, inTarget := targetApp.EnvironmentVariables[name]
+			if inSource && inTarget && sourceValue == targetValue {
+				continue
+			}
+			differences = append(differences, SpaceDifference{
+				App:      sourceApp.Name,
+				Property: "env " + name,
+				Source:   hiddenIf(inSource),
+				Target:   hiddenIf(inTarget),
+			})
+		}
+	}
+
+	return differences
+}
+
+type sortableApplicationConfigurations []ApplicationConfiguration
+
+func (a sortableApplicationConfigurations) Len() int               { return len(a) }
+func (a sortableApplicationConfigurations) Swap(i int, j int)      { a[i], a[j] = a[j], a[i] }
+func (a sortableApplicationConfigurations) Less(i int, j int) bool { return a[i].Name < a[j].Name }
+
+func routeNames(routes []Route) []string {
+	names := make([]string, len(routes))
+	for i, route := range routes {
+		names[i] = route.String()
+	}
+	sort.Strings(names)
+	return names
+}
+
+func joinSorted(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ", ")
+}
+
+func formatMemory(megabytes int) string {
+	if megabytes == 0 {
+		return ""
+	}
+	return bytefmt.ByteSize(uint64(megabytes) * bytefmt.MEGABYTE)
+}
+
+func environmentVariableNames(envs ...map[string]string) []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, env := range envs {
+		for name := range env {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func hiddenIf(set bool) string {
+	if set {
+		return HiddenValue
+	}
+	return ""
+}
@@ -0,0 +1,241 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Diff Actions", func() {
+	Describe("GetSpaceConfigurationByOrganizationAndSpaceName", func() {
+		var (
+			actor                     Actor
+			fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+			configuration             SpaceConfiguration
+			warnings                  Warnings
+			err                       error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil)
+
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv2.Organization{{GUID: "some-org-guid", Name: "some-org"}},
+				ccv2.Warnings{"get-orgs-warning"},
+				nil)
+			fakeCloudControllerClient.GetOrganizationReturns(
+				ccv2.Organization{GUID: "some-org-guid", Name: "some-org"},
+				ccv2.Warnings{"get-org-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv2.Space{{GUID: "some-space-guid", Name: "some-space"}},
+				ccv2.Warnings{"get-spaces-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{
+						GUID:                 "app-guid-2",
+						Name:                 "some-app-2",
+						Instances:            1,
+						Memory:               256,
+						EnvironmentVariables: map[string]string{"SOME_KEY": "some-value"},
+					},
+					{
+						GUID:      "app-guid-1",
+						Name:      "some-app-1",
+						Instances: 2,
+						Memory:    1024,
+					},
+				},
+				ccv2.Warnings{"get-apps-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{
+					{GUID: "service-instance-guid-1", Name: "some-db"},
+					{GUID: "service-instance-guid-2", Name: "some-cache"},
+				},
+				ccv2.Warnings{"get-service-instances-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceRoutesReturns(
+				[]ccv2.Route{
+					{Host: "some-host", DomainGUID: "domain-guid"},
+					{Host: "unmapped", DomainGUID: "domain-guid"},
+				},
+				ccv2.Warnings{"get-space-routes-warning"},
+				nil)
+			fakeCloudControllerClient.GetSharedDomainReturns(
+				ccv2.Domain{GUID: "domain-guid", Name: "example.com"},
+				nil,
+				nil)
+			fakeCloudControllerClient.GetApplicationRoutesStub = func(appGUID string, _ []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+				if appGUID == "app-guid-1" {
+					return []ccv2.Route{{Host: "some-host", DomainGUID: "domain-guid"}}, ccv2.Warnings{"get-app-routes-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetServiceBindingsStub = func(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+				if queries[0].Value == "app-guid-1" {
+					return []ccv2.ServiceBinding{
+						{GUID: "binding-guid-1", AppGUID: "app-guid-1", ServiceInstanceGUID: "service-instance-guid-2"},
+						{GUID: "binding-guid-2", AppGUID: "app-guid-1", ServiceInstanceGUID: "service-instance-guid-1"},
+					}, ccv2.Warnings{"get-bindings-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			configuration, warnings, err = actor.GetSpaceConfigurationByOrganizationAndSpaceName("some-org", "some-space")
+		})
+
+		It("returns the configuration of the space and its apps", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ContainElement("get-orgs-warning"))
+			Expect(warnings).To(ContainElement("get-space-routes-warning"))
+			Expect(warnings).To(ContainElement("get-app-routes-warning"))
+			Expect(warnings).To(ContainElement("get-bindings-warning"))
+
+			Expect(configuration.SpaceName).To(Equal("some-space"))
+			Expect(configuration.AppNames).To(Equal([]string{"some-app-1", "some-app-2"}))
+			Expect(configuration.ServiceInstanceNames).To(Equal([]string{"some-cache", "some-db"}))
+			Expect(configuration.RouteNames).To(Equal([]string{"some-host.example.com", "unmapped.example.com"}))
+			Expect(configuration.Applications).To(Equal([]ApplicationConfiguration{
+				{
+					Name:                 "some-app-1",
+					Instances:            2,
+					Memory:               1024,
+					RouteNames:           []string{"some-host.example.com"},
+					ServiceInstanceNames: []string{"some-cache", "some-db"},
+				},
+				{
+					Name:                 "some-app-2",
+					Instances:            1,
+					Memory:               256,
+					EnvironmentVariables: map[string]string{"SOME_KEY": "some-value"},
+					RouteNames:           []string{},
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+				Filter:   ccv2.NameFilter,
+				Operator: ccv2.EqualOperator,
+				Value:    "some-org",
+			}))
+
+			Expect(fakeCloudControllerClient.GetSpaceRoutesCallCount()).To(Equal(1))
+			spaceGUID, _ := fakeCloudControllerClient.GetSpaceRoutesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeCloudControllerClient.GetServiceBindingsCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+				Filter:   ccv2.AppGUIDFilter,
+				Operator: ccv2.EqualOperator,
+				Value:    "app-guid-2",
+			}))
+		})
+
+		Context("when the organization does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv2.Warnings{"get-orgs-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and warnings", func() {
+				Expect(err).To(MatchError(OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-orgs-warning"))
+			})
+		})
+
+		Context("when getting the service bindings fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("bindings error")
+				fakeCloudControllerClient.GetServiceBindingsStub = nil
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"get-bindings-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("get-apps-warning"))
+				Expect(warnings).To(ContainElement("get-bindings-warning"))
+			})
+		})
+	})
+
+	Describe("DiffSpaceConfigurations", func() {
+		var source, target SpaceConfiguration
+
+		BeforeEach(func() {
+			source = SpaceConfiguration{
+				SpaceSummary: SpaceSummary{
+					AppNames:             []string{"app-1", "app-2"},
+					ServiceInstanceNames: []string{"db"},
+					SpaceQuotaName:       "small",
+					SecurityGroupNames:   []string{"public"},
+				},
+				RouteNames: []string{"app-1.example.com"},
+				Applications: []ApplicationConfiguration{
+					{
+						Name:                 "app-1",
+						Instances:            1,
+						Memory:               512,
+						EnvironmentVariables: map[string]string{"SAME": "value", "CHANGED": "old", "REMOVED": "value"},
+						RouteNames:           []string{"app-1.example.com"},
+						ServiceInstanceNames: []string{"db"},
+					},
+					{Name: "app-2"},
+				},
+			}
+			target = source
+		})
+
+		Context("when the spaces are the same", func() {
+			It("returns no differences", func() {
+				Expect(DiffSpaceConfigurations(source, target)).To(BeEmpty())
+			})
+		})
+
+		Context("when the spaces differ", func() {
+			BeforeEach(func() {
+				target = SpaceConfiguration{
+					SpaceSummary: SpaceSummary{
+						AppNames:             []string{"app-3", "app-1"},
+						ServiceInstanceNames: []string{"db"},
+						SecurityGroupNames:   []string{"public"},
+					},
+					RouteNames: []string{"app-1.example.com"},
+					Applications: []ApplicationConfiguration{
+						{
+							Name:                 "app-1",
+							Instances:            3,
+							Memory:               1024,
+							EnvironmentVariables: map[string]string{"SAME": "value", "CHANGED": "new", "ADDED": "value"},
+							RouteNames:           []string{"app-1.example.com"},
+							ServiceInstanceNames: []string{"cache", "db"},
+						},
+						{Name: "app-3"},
+					},
+				}
+			})
+
+			It("returns the differences of the spaces, then of the apps in both", func() {
+				Expect(DiffSpaceConfigurations(source, target)).To(Equal([]SpaceDifference{
+					{Property: "apps", Source: "app-1, app-2", Target: "app-1, app-3"},
+					{Property: "space quota", Source: "small", Target: ""},
+					{App: "app-1", Property: "instances", Source: "1", Target: "3"},
+					{App: "app-1", Property: "memory", Source: "512M", Target: "1G"},
+					{App: "app-1", Property: "services", Source: "db", Target: "cache, db"},
+					{App: "app-1", Property: "env ADDED", Source: "", Target: HiddenValue},
+					{App: "app-1", Property: "env CHANGED", Source: HiddenValue, Target: HiddenValue},
+					{App: "app-1", Property: "env REMOVED", Source: HiddenValue, Target: ""},
+				}))
+			})
+		})
+	})
+})
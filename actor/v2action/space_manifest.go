@@ -0,0 +1,85 @@
+package v2action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+type sortableManifestApplications []manifest.Application
+
+func (a sortableManifestApplications) Len() int               { return len(a) }
+func (a sortableManifestApplications) Swap(i int, j int)      { a[i], a[j] = a[j], a[i] }
+func (a sortableManifestApplications) Less(i int, j int) bool { return a[i].Name < a[j].Name }
+
+// GetSpaceManifestApplications returns a manifest entry for every app in the
+// space, ordered by name, with the routes, bound services, environment
+// variables, health check, buildpack and stack needed to push it again.
+func (actor Actor) GetSpaceManifestApplications(spaceGUID string) ([]manifest.Application, Warnings, error) {
+	var allWarnings Warnings
+
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	serviceInstanceNames, warnings, err := actor.getServiceInstanceNamesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	stackNames := map[string]string{}
+	manifestApps := make([]manifest.Application, 0, len(apps))
+	for _, app := range apps {
+		instances := app.Instances
+		manifestApp := manifest.Application{
+			Name:                    app.Name,
+			Buildpack:               app.Buildpack,
+			Command:                 app.Command,
+			DiskQuota:               uint64(app.DiskQuota),
+			DockerImage:             app.DockerImage,
+			EnvironmentVariables:    app.EnvironmentVariables,
+			HealthCheckHTTPEndpoint: app.HealthCheckHTTPEndpoint,
+			HealthCheckTimeout:      app.HealthCheckTimeout,
+			HealthCheckType:         app.HealthCheckType,
+			Instances:               &instances,
+			Memory:                  uint64(app.Memory),
+		}
+
+		routes, warnings, err := actor.GetApplicationRoutes(app.GUID, nil)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		if len(routes) > 0 {
+			manifestApp.Routes = routeNames(routes)
+		} else {
+			manifestApp.NoRoute = true
+		}
+
+		manifestApp.Services, warnings, err = actor.getApplicationServiceInstanceNames(app.GUID, serviceInstanceNames)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if app.StackGUID != "" {
+			if _, ok := stackNames[app.StackGUID]; !ok {
+				stack, warnings, err := actor.GetStack(app.StackGUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return nil, allWarnings, err
+				}
+				stackNames[app.StackGUID] = stack.Name
+			}
+			manifestApp.StackName = stackNames[app.StackGUID]
+		}
+
+		manifestApps = append(manifestApps, manifestApp)
+	}
+	sort.Sort(sortableManifestApplications(manifestApps))
+
+	return manifestApps, allWarnings, nil
+}
@@ -0,0 +1,220 @@
+package v2action_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/manifest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Manifest Actions", func() {
+	Describe("GetSpaceManifestApplications", func() {
+		var (
+			actor                     Actor
+			fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+			apps                      []manifest.Application
+			warnings                  Warnings
+			err                       error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil)
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]ccv2.Application{
+					{
+						GUID:        "app-guid-2",
+						Name:        "worker",
+						Instances:   1,
+						Memory:      256,
+						DockerImage: "some-image",
+						StackGUID:   "stack-guid",
+					},
+					{
+						GUID:                    "app-guid-1",
+						Name:                    "web",
+						Buildpack:               "ruby_buildpack",
+						Command:                 "bundle exec rackup",
+						DiskQuota:               1024,
+						EnvironmentVariables:    map[string]string{"SOME_VAR": "some-value"},
+						HealthCheckHTTPEndpoint: "/health",
+						HealthCheckTimeout:      60,
+						HealthCheckType:         "http",
+						Instances:               2,
+						Memory:                  512,
+						StackGUID:               "stack-guid",
+					},
+				},
+				ccv2.Warnings{"get-apps-warning"},
+				nil)
+			fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+				[]ccv2.ServiceInstance{{GUID: "service-instance-guid", Name: "some-db"}},
+				ccv2.Warnings{"get-service-instances-warning"},
+				nil)
+			fakeCloudControllerClient.GetApplicationRoutesStub = func(appGUID string, _ []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+				if appGUID == "app-guid-1" {
+					return []ccv2.Route{
+						{Host: "web", DomainGUID: "domain-guid"},
+						{Path: "/web", DomainGUID: "domain-guid"},
+					}, ccv2.Warnings{"get-app-routes-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetSharedDomainReturns(
+				ccv2.Domain{GUID: "domain-guid", Name: "example.com"},
+				nil,
+				nil)
+			fakeCloudControllerClient.GetServiceBindingsStub = func(queries []ccv2.Query) ([]ccv2.ServiceBinding, ccv2.Warnings, error) {
+				if queries[0].Value == "app-guid-1" {
+					return []ccv2.ServiceBinding{{AppGUID: "app-guid-1", ServiceInstanceGUID: "service-instance-guid"}}, ccv2.Warnings{"get-bindings-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetStackReturns(
+				ccv2.Stack{GUID: "stack-guid", Name: "cflinuxfs2"},
+				ccv2.Warnings{"get-stack-warning"},
+				nil)
+		})
+
+		JustBeforeEach(func() {
+			apps, warnings, err = actor.GetSpaceManifestApplications("some-space-guid")
+		})
+
+		It("returns a manifest application for every app in the space", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"get-apps-warning",
+				"get-service-instances-warning",
+				"get-app-routes-warning",
+				"get-bindings-warning",
+				"get-stack-warning",
+			))
+
+			Expect(apps).To(HaveLen(2))
+			instances := 2
+			Expect(apps[0]).To(Equal(manifest.Application{
+				Name:                    "web",
+				Buildpack:               "ruby_buildpack",
+				Command:                 "bundle exec rackup",
+				DiskQuota:               1024,
+				EnvironmentVariables:    map[string]string{"SOME_VAR": "some-value"},
+				HealthCheckHTTPEndpoint: "/health",
+				HealthCheckTimeout:      60,
+				HealthCheckType:         "http",
+				Instances:               &instances,
+				Memory:                  512,
+				Routes:                  []string{"example.com/web", "web.example.com"},
+				Services:                []string{"some-db"},
+				StackName:               "cflinuxfs2",
+			}))
+			Expect(apps[1].Name).To(Equal("worker"))
+			Expect(apps[1].DockerImage).To(Equal("some-image"))
+			Expect(apps[1].NoRoute).To(BeTrue())
+			Expect(apps[1].Routes).To(BeNil())
+			Expect(apps[1].StackName).To(Equal("cflinuxfs2"))
+
+			Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(ccv2.Query{
+				Filter:   ccv2.SpaceGUIDFilter,
+				Operator: ccv2.EqualOperator,
+				Value:    "some-space-guid",
+			}))
+
+			Expect(fakeCloudControllerClient.GetStackCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetStackArgsForCall(0)).To(Equal("stack-guid"))
+		})
+
+		Context("when the exported manifest is pushed to another space", func() {
+			var (
+				tmpDir       string
+				targetClient *v2actionfakes.FakeCloudControllerClient
+				targetActor  Actor
+			)
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "space-manifest-test")
+				Expect(err).ToNot(HaveOccurred())
+
+				targetClient = new(v2actionfakes.FakeCloudControllerClient)
+				targetActor = NewActor(targetClient, nil)
+
+				targetClient.GetStacksReturns([]ccv2.Stack{{GUID: "target-stack-guid", Name: "cflinuxfs2"}}, nil, nil)
+				targetClient.GetSpaceServiceInstancesReturns([]ccv2.ServiceInstance{{GUID: "target-service-instance-guid", Name: "some-db"}}, nil, nil)
+				targetClient.GetSharedDomainsReturns([]ccv2.Domain{{GUID: "target-domain-guid", Name: "example.com"}}, nil, nil)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(tmpDir)).To(Succeed())
+			})
+
+			It("reads back as the same application configs", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				manifestPath := filepath.Join(tmpDir, "manifest.yml")
+				Expect(manifest.WriteApplicationManifest(manifestPath, apps)).To(Succeed())
+
+				readApps, readErr := manifest.ReadAndMergeManifests(manifestPath, nil)
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(readApps).To(Equal(apps))
+
+				configs, _, convertErr := targetActor.ConvertToApplicationConfigs("target-org-guid", "target-space-guid", PushSettings{}, readApps)
+				Expect(convertErr).ToNot(HaveOccurred())
+				Expect(configs).To(HaveLen(2))
+
+				web := configs[0]
+				Expect(web.Exists()).To(BeFalse())
+				Expect(web.DesiredApplication).To(Equal(Application{
+					Name:                    "web",
+					Buildpack:               "ruby_buildpack",
+					Command:                 "bundle exec rackup",
+					DiskQuota:               1024,
+					EnvironmentVariables:    map[string]string{"SOME_VAR": "some-value"},
+					HealthCheckHTTPEndpoint: "/health",
+					HealthCheckTimeout:      60,
+					HealthCheckType:         "http",
+					Instances:               2,
+					Memory:                  512,
+					SpaceGUID:               "target-space-guid",
+					StackGUID:               "target-stack-guid",
+				}))
+				Expect(web.DesiredServices).To(ConsistOf("some-db"))
+				Expect(web.NoRoute).To(BeFalse())
+				Expect(web.DesiredRoutes).To(HaveLen(2))
+				Expect(web.DesiredRoutes[0].String()).To(Equal("example.com/web"))
+				Expect(web.DesiredRoutes[1].String()).To(Equal("web.example.com"))
+
+				worker := configs[1]
+				Expect(worker.DesiredApplication.Name).To(Equal("worker"))
+				Expect(worker.DesiredApplication.DockerImage).To(Equal("some-image"))
+				Expect(worker.DesiredApplication.StackGUID).To(Equal("target-stack-guid"))
+				Expect(worker.NoRoute).To(BeTrue())
+				Expect(worker.DesiredRoutes).To(BeEmpty())
+				Expect(worker.DesiredServices).To(BeEmpty())
+			})
+		})
+
+		Context("when getting the stack fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("stack error")
+				fakeCloudControllerClient.GetStackReturns(ccv2.Stack{}, ccv2.Warnings{"get-stack-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("get-apps-warning"))
+				Expect(warnings).To(ContainElement("get-stack-warning"))
+			})
+		})
+	})
+})
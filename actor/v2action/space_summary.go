@@ -3,23 +3,23 @@ package v2action
 import "sort"
 
 type SecurityGroupRule struct {
-	Name        string
-	Description string
-	Destination string
-	Lifecycle   string
-	Ports       string
-	Protocol    string
+	Name        string `json:"security_group"`
+	Description string `json:"description"`
+	Destination string `json:"destination"`
+	Lifecycle   string `json:"lifecycle"`
+	Ports       string `json:"ports"`
+	Protocol    string `json:"protocol"`
 }
 
 type SpaceSummary struct {
-	SpaceName            string
-	SpaceGUID            string
-	OrgName              string
-	AppNames             []string
-	ServiceInstanceNames []string
-	SpaceQuotaName       string
-	SecurityGroupNames   []string
-	SecurityGroupRules   []SecurityGroupRule
+	SpaceName            string              `json:"name"`
+	SpaceGUID            string              `json:"guid"`
+	OrgName              string              `json:"org"`
+	AppNames             []string            `json:"apps"`
+	ServiceInstanceNames []string            `json:"services"`
+	SpaceQuotaName       string              `json:"space_quota"`
+	SecurityGroupNames   []string            `json:"security_groups"`
+	SecurityGroupRules   []SecurityGroupRule `json:"security_group_rules,omitempty"`
 }
 
 func (actor Actor) GetSpaceSummaryByOrganizationAndName(orgGUID string, name string, includeStagingSecurityGroupsRules bool) (SpaceSummary, Warnings, error) {
@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"fmt"
+	"sort"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -12,10 +13,15 @@ type Stack ccv2.Stack
 // StackNotFoundError is returned when a requested stack is not found.
 type StackNotFoundError struct {
 	GUID string
+	Name string
 }
 
 func (e StackNotFoundError) Error() string {
-	return fmt.Sprintf("Stack with GUID '%s' not found.", e.GUID)
+	if e.GUID != "" {
+		return fmt.Sprintf("Stack with GUID '%s' not found.", e.GUID)
+	}
+
+	return fmt.Sprintf("Stack '%s' not found.", e.Name)
 }
 
 // GetStack returns the stack information associated with the provided stack GUID.
@@ -28,3 +34,45 @@ func (actor Actor) GetStack(guid string) (Stack, Warnings, error) {
 
 	return Stack(stack), Warnings(warnings), err
 }
+
+// GetStackByName returns the stack with the provided name.
+func (actor Actor) GetStackByName(stackName string) (Stack, Warnings, error) {
+	stacks, warnings, err := actor.CloudControllerClient.GetStacks([]ccv2.Query{
+		{
+			Filter:   ccv2.NameFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    stackName,
+		},
+	})
+	if err != nil {
+		return Stack{}, Warnings(warnings), err
+	}
+
+	if len(stacks) == 0 {
+		return Stack{}, Warnings(warnings), StackNotFoundError{Name: stackName}
+	}
+
+	return Stack(stacks[0]), Warnings(warnings), nil
+}
+
+// GetStacks returns all the stacks, ordered by name.
+func (actor Actor) GetStacks() ([]Stack, Warnings, error) {
+	ccStacks, warnings, err := actor.CloudControllerClient.GetStacks(nil)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	stacks := make([]Stack, len(ccStacks))
+	for i, ccStack := range ccStacks {
+		stacks[i] = Stack(ccStack)
+	}
+	sort.Sort(sortableStacks(stacks))
+
+	return stacks, Warnings(warnings), nil
+}
+
+type sortableStacks []Stack
+
+func (stacks sortableStacks) Len() int               { return len(stacks) }
+func (stacks sortableStacks) Swap(i int, j int)      { stacks[i], stacks[j] = stacks[j], stacks[i] }
+func (stacks sortableStacks) Less(i int, j int) bool { return stacks[i].Name < stacks[j].Name }
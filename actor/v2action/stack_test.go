@@ -80,4 +80,86 @@ var _ = Describe("Stack Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetStackByName", func() {
+		Context("when the stack exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					[]ccv2.Stack{{GUID: "some-stack-guid", Name: "some-stack"}},
+					ccv2.Warnings{"get-stacks-warning"},
+					nil,
+				)
+			})
+
+			It("returns the stack and all warnings", func() {
+				stack, warnings, err := actor.GetStackByName("some-stack")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+				Expect(stack).To(Equal(Stack{GUID: "some-stack-guid", Name: "some-stack"}))
+
+				Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(ConsistOf(ccv2.Query{
+					Filter:   ccv2.NameFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-stack",
+				}))
+			})
+		})
+
+		Context("when the stack does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv2.Warnings{"get-stacks-warning"}, nil)
+			})
+
+			It("returns a StackNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetStackByName("some-stack")
+				Expect(err).To(MatchError(StackNotFoundError{Name: "some-stack"}))
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv2.Warnings{"get-stacks-warning"}, errors.New("get-stacks-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetStackByName("some-stack")
+				Expect(err).To(MatchError("get-stacks-error"))
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+			})
+		})
+	})
+
+	Describe("GetStacks", func() {
+		Context("when getting the stacks succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					[]ccv2.Stack{{Name: "windows2012R2"}, {Name: "cflinuxfs2"}},
+					ccv2.Warnings{"get-stacks-warning"},
+					nil,
+				)
+			})
+
+			It("returns the stacks ordered by name and all warnings", func() {
+				stacks, warnings, err := actor.GetStacks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+				Expect(stacks).To(Equal([]Stack{{Name: "cflinuxfs2"}, {Name: "windows2012R2"}}))
+				Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(BeNil())
+			})
+		})
+
+		Context("when getting the stacks fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(nil, ccv2.Warnings{"get-stacks-warning"}, errors.New("get-stacks-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetStacks()
+				Expect(err).To(MatchError("get-stacks-error"))
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+			})
+		})
+	})
 })
@@ -23,6 +23,35 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	CreateServiceBindingStub        func(appGUID string, serviceInstanceGUID string) (ccv2.ServiceBinding, ccv2.Warnings, error)
+	createServiceBindingMutex       sync.RWMutex
+	createServiceBindingArgsForCall []struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}
+	createServiceBindingReturns struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
+	createServiceBindingReturnsOnCall map[int]struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}
+	DeleteApplicationStub        func(guid string) (ccv2.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		guid string
+	}
+	deleteApplicationReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteOrganizationStub        func(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationMutex       sync.RWMutex
 	deleteOrganizationArgsForCall []struct {
@@ -51,6 +80,20 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	DeleteRouteApplicationStub        func(routeGUID string, appGUID string) (ccv2.Warnings, error)
+	deleteRouteApplicationMutex       sync.RWMutex
+	deleteRouteApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	deleteRouteApplicationReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
+	deleteRouteApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteServiceBindingStub        func(serviceBindingGUID string) (ccv2.Warnings, error)
 	deleteServiceBindingMutex       sync.RWMutex
 	deleteServiceBindingArgsForCall []struct {
@@ -140,6 +183,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetBuildpacksStub        func(queries []ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error)
+	getBuildpacksMutex       sync.RWMutex
+	getBuildpacksArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getBuildpacksReturns struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getBuildpacksReturnsOnCall map[int]struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetJobStub        func(jobGUID string) (ccv2.Job, ccv2.Warnings, error)
 	getJobMutex       sync.RWMutex
 	getJobArgsForCall []struct {
@@ -247,6 +305,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetRoutesStub        func(queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
+	getRoutesMutex       sync.RWMutex
+	getRoutesArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getRoutesReturns struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRoutesReturnsOnCall map[int]struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetSecurityGroupsStub        func(queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error)
 	getSecurityGroupsMutex       sync.RWMutex
 	getSecurityGroupsArgsForCall []struct {
@@ -428,6 +501,51 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetStacksStub        func(queries []ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error)
+	getStacksMutex       sync.RWMutex
+	getStacksArgsForCall []struct {
+		queries []ccv2.Query
+	}
+	getStacksReturns struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getStacksReturnsOnCall map[int]struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}
+	NewApplicationStub        func(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error)
+	newApplicationMutex       sync.RWMutex
+	newApplicationArgsForCall []struct {
+		app ccv2.Application
+	}
+	newApplicationReturns struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}
+	newApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}
+	NewRouteStub        func(route ccv2.Route) (ccv2.Route, ccv2.Warnings, error)
+	newRouteMutex       sync.RWMutex
+	newRouteArgsForCall []struct {
+		route ccv2.Route
+	}
+	newRouteReturns struct {
+		result1 ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}
+	newRouteReturnsOnCall map[int]struct {
+		result1 ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}
 	NewUserStub        func(uaaUserID string) (ccv2.User, ccv2.Warnings, error)
 	newUserMutex       sync.RWMutex
 	newUserArgsForCall []struct {
@@ -484,6 +602,38 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	UpdateRouteApplicationStub        func(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error)
+	updateRouteApplicationMutex       sync.RWMutex
+	updateRouteApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	updateRouteApplicationReturns struct {
+		result1 ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}
+	updateRouteApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}
+	UploadApplicationStub        func(appGUID string, zipFilePath string) (ccv2.Job, ccv2.Warnings, error)
+	uploadApplicationMutex       sync.RWMutex
+	uploadApplicationArgsForCall []struct {
+		appGUID     string
+		zipFilePath string
+	}
+	uploadApplicationReturns struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
+	uploadApplicationReturnsOnCall map[int]struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}
 	APIStub        func() string
 	aPIMutex       sync.RWMutex
 	aPIArgsForCall []struct{}
@@ -603,6 +753,112 @@ func (fake *FakeCloudControllerClient) AssociateSpaceWithSecurityGroupReturnsOnC
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) CreateServiceBinding(appGUID string, serviceInstanceGUID string) (ccv2.ServiceBinding, ccv2.Warnings, error) {
+	fake.createServiceBindingMutex.Lock()
+	ret, specificReturn := fake.createServiceBindingReturnsOnCall[len(fake.createServiceBindingArgsForCall)]
+	fake.createServiceBindingArgsForCall = append(fake.createServiceBindingArgsForCall, struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}{appGUID, serviceInstanceGUID})
+	fake.recordInvocation("CreateServiceBinding", []interface{}{appGUID, serviceInstanceGUID})
+	fake.createServiceBindingMutex.Unlock()
+	if fake.CreateServiceBindingStub != nil {
+		return fake.CreateServiceBindingStub(appGUID, serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createServiceBindingReturns.result1, fake.createServiceBindingReturns.result2, fake.createServiceBindingReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceBindingCallCount() int {
+	fake.createServiceBindingMutex.RLock()
+	defer fake.createServiceBindingMutex.RUnlock()
+	return len(fake.createServiceBindingArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceBindingArgsForCall(i int) (string, string) {
+	fake.createServiceBindingMutex.RLock()
+	defer fake.createServiceBindingMutex.RUnlock()
+	return fake.createServiceBindingArgsForCall[i].appGUID, fake.createServiceBindingArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceBindingReturns(result1 ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceBindingStub = nil
+	fake.createServiceBindingReturns = struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateServiceBindingReturnsOnCall(i int, result1 ccv2.ServiceBinding, result2 ccv2.Warnings, result3 error) {
+	fake.CreateServiceBindingStub = nil
+	if fake.createServiceBindingReturnsOnCall == nil {
+		fake.createServiceBindingReturnsOnCall = make(map[int]struct {
+			result1 ccv2.ServiceBinding
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.createServiceBindingReturnsOnCall[i] = struct {
+		result1 ccv2.ServiceBinding
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplication(guid string) (ccv2.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeleteApplication", []interface{}{guid})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteApplicationReturns.result1, fake.deleteApplicationReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return fake.deleteApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.deleteOrganizationMutex.Lock()
 	ret, specificReturn := fake.deleteOrganizationReturnsOnCall[len(fake.deleteOrganizationArgsForCall)]
@@ -708,6 +964,58 @@ func (fake *FakeCloudControllerClient) DeleteRouteReturnsOnCall(i int, result1 c
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteRouteApplication(routeGUID string, appGUID string) (ccv2.Warnings, error) {
+	fake.deleteRouteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteRouteApplicationReturnsOnCall[len(fake.deleteRouteApplicationArgsForCall)]
+	fake.deleteRouteApplicationArgsForCall = append(fake.deleteRouteApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("DeleteRouteApplication", []interface{}{routeGUID, appGUID})
+	fake.deleteRouteApplicationMutex.Unlock()
+	if fake.DeleteRouteApplicationStub != nil {
+		return fake.DeleteRouteApplicationStub(routeGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteRouteApplicationReturns.result1, fake.deleteRouteApplicationReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) DeleteRouteApplicationCallCount() int {
+	fake.deleteRouteApplicationMutex.RLock()
+	defer fake.deleteRouteApplicationMutex.RUnlock()
+	return len(fake.deleteRouteApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteRouteApplicationArgsForCall(i int) (string, string) {
+	fake.deleteRouteApplicationMutex.RLock()
+	defer fake.deleteRouteApplicationMutex.RUnlock()
+	return fake.deleteRouteApplicationArgsForCall[i].routeGUID, fake.deleteRouteApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) DeleteRouteApplicationReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteRouteApplicationStub = nil
+	fake.deleteRouteApplicationReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) DeleteRouteApplicationReturnsOnCall(i int, result1 ccv2.Warnings, result2 error) {
+	fake.DeleteRouteApplicationStub = nil
+	if fake.deleteRouteApplicationReturnsOnCall == nil {
+		fake.deleteRouteApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Warnings
+			result2 error
+		})
+	}
+	fake.deleteRouteApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error) {
 	fake.deleteServiceBindingMutex.Lock()
 	ret, specificReturn := fake.deleteServiceBindingReturnsOnCall[len(fake.deleteServiceBindingArgsForCall)]
@@ -1040,6 +1348,65 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetBuildpacks(queries []ccv2.Query) ([]ccv2.Buildpack, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getBuildpacksMutex.Lock()
+	ret, specificReturn := fake.getBuildpacksReturnsOnCall[len(fake.getBuildpacksArgsForCall)]
+	fake.getBuildpacksArgsForCall = append(fake.getBuildpacksArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetBuildpacks", []interface{}{queriesCopy})
+	fake.getBuildpacksMutex.Unlock()
+	if fake.GetBuildpacksStub != nil {
+		return fake.GetBuildpacksStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getBuildpacksReturns.result1, fake.getBuildpacksReturns.result2, fake.getBuildpacksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksCallCount() int {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return len(fake.getBuildpacksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksArgsForCall(i int) []ccv2.Query {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return fake.getBuildpacksArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksReturns(result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	fake.getBuildpacksReturns = struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetBuildpacksReturnsOnCall(i int, result1 []ccv2.Buildpack, result2 ccv2.Warnings, result3 error) {
+	fake.GetBuildpacksStub = nil
+	if fake.getBuildpacksReturnsOnCall == nil {
+		fake.getBuildpacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Buildpack
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildpacksReturnsOnCall[i] = struct {
+		result1 []ccv2.Buildpack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetJob(jobGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.getJobMutex.Lock()
 	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
@@ -1435,6 +1802,65 @@ func (fake *FakeCloudControllerClient) GetRouteApplicationsReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRoutes(queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getRoutesMutex.Lock()
+	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
+	fake.getRoutesArgsForCall = append(fake.getRoutesArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetRoutes", []interface{}{queriesCopy})
+	fake.getRoutesMutex.Unlock()
+	if fake.GetRoutesStub != nil {
+		return fake.GetRoutesStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRoutesReturns.result1, fake.getRoutesReturns.result2, fake.getRoutesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesCallCount() int {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return len(fake.getRoutesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesArgsForCall(i int) []ccv2.Query {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return fake.getRoutesArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturns(result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetRoutesStub = nil
+	fake.getRoutesReturns = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturnsOnCall(i int, result1 []ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.GetRoutesStub = nil
+	if fake.getRoutesReturnsOnCall == nil {
+		fake.getRoutesReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Route
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRoutesReturnsOnCall[i] = struct {
+		result1 []ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSecurityGroups(queries []ccv2.Query) ([]ccv2.SecurityGroup, ccv2.Warnings, error) {
 	var queriesCopy []ccv2.Query
 	if queries != nil {
@@ -2108,6 +2534,173 @@ func (fake *FakeCloudControllerClient) GetStackReturnsOnCall(i int, result1 ccv2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetStacks(queries []ccv2.Query) ([]ccv2.Stack, ccv2.Warnings, error) {
+	var queriesCopy []ccv2.Query
+	if queries != nil {
+		queriesCopy = make([]ccv2.Query, len(queries))
+		copy(queriesCopy, queries)
+	}
+	fake.getStacksMutex.Lock()
+	ret, specificReturn := fake.getStacksReturnsOnCall[len(fake.getStacksArgsForCall)]
+	fake.getStacksArgsForCall = append(fake.getStacksArgsForCall, struct {
+		queries []ccv2.Query
+	}{queriesCopy})
+	fake.recordInvocation("GetStacks", []interface{}{queriesCopy})
+	fake.getStacksMutex.Unlock()
+	if fake.GetStacksStub != nil {
+		return fake.GetStacksStub(queries)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStacksReturns.result1, fake.getStacksReturns.result2, fake.getStacksReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetStacksCallCount() int {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return len(fake.getStacksArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetStacksArgsForCall(i int) []ccv2.Query {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return fake.getStacksArgsForCall[i].queries
+}
+
+func (fake *FakeCloudControllerClient) GetStacksReturns(result1 []ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	fake.getStacksReturns = struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetStacksReturnsOnCall(i int, result1 []ccv2.Stack, result2 ccv2.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	if fake.getStacksReturnsOnCall == nil {
+		fake.getStacksReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.Stack
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getStacksReturnsOnCall[i] = struct {
+		result1 []ccv2.Stack
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) NewApplication(app ccv2.Application) (ccv2.Application, ccv2.Warnings, error) {
+	fake.newApplicationMutex.Lock()
+	ret, specificReturn := fake.newApplicationReturnsOnCall[len(fake.newApplicationArgsForCall)]
+	fake.newApplicationArgsForCall = append(fake.newApplicationArgsForCall, struct {
+		app ccv2.Application
+	}{app})
+	fake.recordInvocation("NewApplication", []interface{}{app})
+	fake.newApplicationMutex.Unlock()
+	if fake.NewApplicationStub != nil {
+		return fake.NewApplicationStub(app)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.newApplicationReturns.result1, fake.newApplicationReturns.result2, fake.newApplicationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) NewApplicationCallCount() int {
+	fake.newApplicationMutex.RLock()
+	defer fake.newApplicationMutex.RUnlock()
+	return len(fake.newApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) NewApplicationArgsForCall(i int) ccv2.Application {
+	fake.newApplicationMutex.RLock()
+	defer fake.newApplicationMutex.RUnlock()
+	return fake.newApplicationArgsForCall[i].app
+}
+
+func (fake *FakeCloudControllerClient) NewApplicationReturns(result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.NewApplicationStub = nil
+	fake.newApplicationReturns = struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) NewApplicationReturnsOnCall(i int, result1 ccv2.Application, result2 ccv2.Warnings, result3 error) {
+	fake.NewApplicationStub = nil
+	if fake.newApplicationReturnsOnCall == nil {
+		fake.newApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Application
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.newApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Application
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) NewRoute(route ccv2.Route) (ccv2.Route, ccv2.Warnings, error) {
+	fake.newRouteMutex.Lock()
+	ret, specificReturn := fake.newRouteReturnsOnCall[len(fake.newRouteArgsForCall)]
+	fake.newRouteArgsForCall = append(fake.newRouteArgsForCall, struct {
+		route ccv2.Route
+	}{route})
+	fake.recordInvocation("NewRoute", []interface{}{route})
+	fake.newRouteMutex.Unlock()
+	if fake.NewRouteStub != nil {
+		return fake.NewRouteStub(route)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.newRouteReturns.result1, fake.newRouteReturns.result2, fake.newRouteReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) NewRouteCallCount() int {
+	fake.newRouteMutex.RLock()
+	defer fake.newRouteMutex.RUnlock()
+	return len(fake.newRouteArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) NewRouteArgsForCall(i int) ccv2.Route {
+	fake.newRouteMutex.RLock()
+	defer fake.newRouteMutex.RUnlock()
+	return fake.newRouteArgsForCall[i].route
+}
+
+func (fake *FakeCloudControllerClient) NewRouteReturns(result1 ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.NewRouteStub = nil
+	fake.newRouteReturns = struct {
+		result1 ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) NewRouteReturnsOnCall(i int, result1 ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.NewRouteStub = nil
+	if fake.newRouteReturnsOnCall == nil {
+		fake.newRouteReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Route
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.newRouteReturnsOnCall[i] = struct {
+		result1 ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) NewUser(uaaUserID string) (ccv2.User, ccv2.Warnings, error) {
 	fake.newUserMutex.Lock()
 	ret, specificReturn := fake.newUserReturnsOnCall[len(fake.newUserArgsForCall)]
@@ -2318,6 +2911,116 @@ func (fake *FakeCloudControllerClient) UpdateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateRouteApplication(routeGUID string, appGUID string) (ccv2.Route, ccv2.Warnings, error) {
+	fake.updateRouteApplicationMutex.Lock()
+	ret, specificReturn := fake.updateRouteApplicationReturnsOnCall[len(fake.updateRouteApplicationArgsForCall)]
+	fake.updateRouteApplicationArgsForCall = append(fake.updateRouteApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("UpdateRouteApplication", []interface{}{routeGUID, appGUID})
+	fake.updateRouteApplicationMutex.Unlock()
+	if fake.UpdateRouteApplicationStub != nil {
+		return fake.UpdateRouteApplicationStub(routeGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateRouteApplicationReturns.result1, fake.updateRouteApplicationReturns.result2, fake.updateRouteApplicationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteApplicationCallCount() int {
+	fake.updateRouteApplicationMutex.RLock()
+	defer fake.updateRouteApplicationMutex.RUnlock()
+	return len(fake.updateRouteApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteApplicationArgsForCall(i int) (string, string) {
+	fake.updateRouteApplicationMutex.RLock()
+	defer fake.updateRouteApplicationMutex.RUnlock()
+	return fake.updateRouteApplicationArgsForCall[i].routeGUID, fake.updateRouteApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteApplicationReturns(result1 ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateRouteApplicationStub = nil
+	fake.updateRouteApplicationReturns = struct {
+		result1 ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateRouteApplicationReturnsOnCall(i int, result1 ccv2.Route, result2 ccv2.Warnings, result3 error) {
+	fake.UpdateRouteApplicationStub = nil
+	if fake.updateRouteApplicationReturnsOnCall == nil {
+		fake.updateRouteApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Route
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.updateRouteApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Route
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UploadApplication(appGUID string, zipFilePath string) (ccv2.Job, ccv2.Warnings, error) {
+	fake.uploadApplicationMutex.Lock()
+	ret, specificReturn := fake.uploadApplicationReturnsOnCall[len(fake.uploadApplicationArgsForCall)]
+	fake.uploadApplicationArgsForCall = append(fake.uploadApplicationArgsForCall, struct {
+		appGUID     string
+		zipFilePath string
+	}{appGUID, zipFilePath})
+	fake.recordInvocation("UploadApplication", []interface{}{appGUID, zipFilePath})
+	fake.uploadApplicationMutex.Unlock()
+	if fake.UploadApplicationStub != nil {
+		return fake.UploadApplicationStub(appGUID, zipFilePath)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.uploadApplicationReturns.result1, fake.uploadApplicationReturns.result2, fake.uploadApplicationReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UploadApplicationCallCount() int {
+	fake.uploadApplicationMutex.RLock()
+	defer fake.uploadApplicationMutex.RUnlock()
+	return len(fake.uploadApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UploadApplicationArgsForCall(i int) (string, string) {
+	fake.uploadApplicationMutex.RLock()
+	defer fake.uploadApplicationMutex.RUnlock()
+	return fake.uploadApplicationArgsForCall[i].appGUID, fake.uploadApplicationArgsForCall[i].zipFilePath
+}
+
+func (fake *FakeCloudControllerClient) UploadApplicationReturns(result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.UploadApplicationStub = nil
+	fake.uploadApplicationReturns = struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UploadApplicationReturnsOnCall(i int, result1 ccv2.Job, result2 ccv2.Warnings, result3 error) {
+	fake.UploadApplicationStub = nil
+	if fake.uploadApplicationReturnsOnCall == nil {
+		fake.uploadApplicationReturnsOnCall = make(map[int]struct {
+			result1 ccv2.Job
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.uploadApplicationReturnsOnCall[i] = struct {
+		result1 ccv2.Job
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) API() string {
 	fake.aPIMutex.Lock()
 	ret, specificReturn := fake.aPIReturnsOnCall[len(fake.aPIArgsForCall)]
@@ -2603,10 +3306,16 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.invocationsMutex.RUnlock()
 	fake.associateSpaceWithSecurityGroupMutex.RLock()
 	defer fake.associateSpaceWithSecurityGroupMutex.RUnlock()
+	fake.createServiceBindingMutex.RLock()
+	defer fake.createServiceBindingMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteOrganizationMutex.RLock()
 	defer fake.deleteOrganizationMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
 	defer fake.deleteRouteMutex.RUnlock()
+	fake.deleteRouteApplicationMutex.RLock()
+	defer fake.deleteRouteApplicationMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
 	defer fake.deleteServiceBindingMutex.RUnlock()
 	fake.getApplicationInstanceStatusesByApplicationMutex.RLock()
@@ -2619,6 +3328,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
 	fake.getJobMutex.RLock()
 	defer fake.getJobMutex.RUnlock()
 	fake.getOrganizationMutex.RLock()
@@ -2633,6 +3344,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getPrivateDomainMutex.RUnlock()
 	fake.getRouteApplicationsMutex.RLock()
 	defer fake.getRouteApplicationsMutex.RUnlock()
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
 	fake.getSecurityGroupsMutex.RLock()
 	defer fake.getSecurityGroupsMutex.RUnlock()
 	fake.getServiceBindingsMutex.RLock()
@@ -2657,6 +3370,12 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpaceStagingSecurityGroupsBySpaceMutex.RUnlock()
 	fake.getStackMutex.RLock()
 	defer fake.getStackMutex.RUnlock()
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	fake.newApplicationMutex.RLock()
+	defer fake.newApplicationMutex.RUnlock()
+	fake.newRouteMutex.RLock()
+	defer fake.newRouteMutex.RUnlock()
 	fake.newUserMutex.RLock()
 	defer fake.newUserMutex.RUnlock()
 	fake.pollJobMutex.RLock()
@@ -2665,6 +3384,10 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.targetCFMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateRouteApplicationMutex.RLock()
+	defer fake.updateRouteApplicationMutex.RUnlock()
+	fake.uploadApplicationMutex.RLock()
+	defer fake.uploadApplicationMutex.RUnlock()
 	fake.aPIMutex.RLock()
 	defer fake.aPIMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
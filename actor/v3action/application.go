@@ -11,6 +11,11 @@ import (
 // Application represents a V3 actor application.
 type Application ccv3.Application
 
+// Started returns true when the application is started.
+func (app Application) Started() bool {
+	return app.State == ccv3.ApplicationStateStarted
+}
+
 // ApplicationNotFoundError represents the error that occurs when the
 // application is not found.
 type ApplicationNotFoundError struct {
@@ -66,3 +71,15 @@ func (actor Actor) CreateApplicationByNameAndSpace(appName string, spaceGUID str
 
 	return Application(app), Warnings(warnings), err
 }
+
+// StartApplication starts the application with the given GUID.
+func (actor Actor) StartApplication(appGUID string) (Application, Warnings, error) {
+	app, warnings, err := actor.CloudControllerClient.UpdateApplicationStart(appGUID)
+	return Application(app), Warnings(warnings), err
+}
+
+// StopApplication stops the application with the given GUID.
+func (actor Actor) StopApplication(appGUID string) (Application, Warnings, error) {
+	app, warnings, err := actor.CloudControllerClient.UpdateApplicationStop(appGUID)
+	return Application(app), Warnings(warnings), err
+}
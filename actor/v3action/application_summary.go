@@ -0,0 +1,28 @@
+package v3action
+
+// ApplicationSummary represents an application with its processes and their
+// instance stats.
+type ApplicationSummary struct {
+	Application
+	ProcessSummaries ProcessSummaries
+}
+
+// GetApplicationSummaryByNameAndSpace returns an application with the stats
+// of each of its processes.
+func (actor Actor) GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string) (ApplicationSummary, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return ApplicationSummary{}, allWarnings, err
+	}
+
+	processSummaries, warnings, err := actor.GetProcessSummariesByApplication(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ApplicationSummary{}, allWarnings, err
+	}
+
+	return ApplicationSummary{
+		Application:      app,
+		ProcessSummaries: processSummaries,
+	}, allWarnings, nil
+}
@@ -0,0 +1,94 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Summary Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetApplicationSummaryByNameAndSpace", func() {
+		Context("when the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app-name", GUID: "some-app-guid", State: ccv3.ApplicationStateStarted}},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{{GUID: "web-guid", Type: "web", Instances: 1}},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesReturns(
+					[]ccv3.ProcessInstance{{Index: 0, State: ccv3.ProcessInstanceStateRunning}},
+					ccv3.Warnings{"get-instances-warning"},
+					nil,
+				)
+			})
+
+			It("returns the app with its process summaries and all warnings", func() {
+				summary, warnings, err := actor.GetApplicationSummaryByNameAndSpace("some-app-name", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-processes-warning", "get-instances-warning"))
+
+				Expect(summary).To(Equal(ApplicationSummary{
+					Application: Application{Name: "some-app-name", GUID: "some-app-guid", State: ccv3.ApplicationStateStarted},
+					ProcessSummaries: ProcessSummaries{
+						{
+							Process:         Process{GUID: "web-guid", Type: "web", Instances: 1},
+							InstanceDetails: []ProcessInstance{{Index: 0, State: ccv3.ProcessInstanceStateRunning}},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and the warnings", func() {
+				_, warnings, err := actor.GetApplicationSummaryByNameAndSpace("some-app-name", "some-space-guid")
+				Expect(err).To(MatchError(ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+			})
+		})
+
+		Context("when getting the processes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get processes error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationSummaryByNameAndSpace("some-app-name", "some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-processes-warning"))
+			})
+		})
+	})
+})
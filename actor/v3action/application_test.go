@@ -179,4 +179,86 @@ var _ = Describe("Application Actions", func() {
 			})
 		})
 	})
+
+	Describe("StartApplication", func() {
+		Context("when the cc client starts the application", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationStartReturns(
+					ccv3.Application{GUID: "some-app-guid", State: ccv3.ApplicationStateStarted},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the application and warnings", func() {
+				app, warnings, err := actor.StartApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(app).To(Equal(Application{GUID: "some-app-guid", State: ccv3.ApplicationStateStarted}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationStartCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationStartArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the cc client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.UpdateApplicationStartReturns(
+					ccv3.Application{},
+					ccv3.Warnings{"some-warning"},
+					expectedError,
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.StartApplication("some-app-guid")
+				Expect(err).To(MatchError(expectedError))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("StopApplication", func() {
+		Context("when the cc client stops the application", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationStopReturns(
+					ccv3.Application{GUID: "some-app-guid", State: ccv3.ApplicationStateStopped},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the application and warnings", func() {
+				app, warnings, err := actor.StopApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(app).To(Equal(Application{GUID: "some-app-guid", State: ccv3.ApplicationStateStopped}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationStopCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateApplicationStopArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the cc client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.UpdateApplicationStopReturns(
+					ccv3.Application{},
+					ccv3.Warnings{"some-warning"},
+					expectedError,
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.StopApplication("some-app-guid")
+				Expect(err).To(MatchError(expectedError))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
 })
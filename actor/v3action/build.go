@@ -0,0 +1,76 @@
+package v3action
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// StagingFailedError is returned when staging a package fails.
+type StagingFailedError struct {
+	Reason string
+}
+
+func (e StagingFailedError) Error() string {
+	return e.Reason
+}
+
+// StagingTimeoutError is returned when the staging timeout is reached waiting
+// for a package to stage.
+type StagingTimeoutError struct {
+	AppName string
+	Timeout time.Duration
+}
+
+func (e StagingTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for package to stage for application '%s'", e.AppName)
+}
+
+// StagePackage stages the package with the given GUID into a droplet. The
+// droplet is sent on the returned droplet channel once staging completes;
+// warnings and errors are streamed while staging is polled. All channels are
+// closed when staging is finished.
+func (actor Actor) StagePackage(packageGUID string, appName string) (<-chan Droplet, <-chan Warnings, <-chan error) {
+	dropletStream := make(chan Droplet)
+	warningsStream := make(chan Warnings)
+	errorStream := make(chan error)
+
+	go func() {
+		defer close(dropletStream)
+		defer close(warningsStream)
+		defer close(errorStream)
+
+		build, warnings, err := actor.CloudControllerClient.CreateBuild(ccv3.Build{PackageGUID: packageGUID})
+		warningsStream <- Warnings(warnings)
+		if err != nil {
+			errorStream <- err
+			return
+		}
+
+		timeout := time.Now().Add(actor.Config.StagingTimeout())
+		for time.Now().Before(timeout) {
+			build, warnings, err = actor.CloudControllerClient.GetBuild(build.GUID)
+			warningsStream <- Warnings(warnings)
+			if err != nil {
+				errorStream <- err
+				return
+			}
+
+			switch build.State {
+			case ccv3.BuildStateFailed:
+				errorStream <- StagingFailedError{Reason: build.Error}
+				return
+			case ccv3.BuildStateStaged:
+				dropletStream <- Droplet{GUID: build.DropletGUID}
+				return
+			}
+
+			time.Sleep(actor.Config.PollingInterval())
+		}
+
+		errorStream <- StagingTimeoutError{AppName: appName, Timeout: actor.Config.StagingTimeout()}
+	}()
+
+	return dropletStream, warningsStream, errorStream
+}
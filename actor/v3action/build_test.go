@@ -0,0 +1,161 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeConfig.StagingTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+	})
+
+	Describe("StagePackage", func() {
+		var (
+			droplets      []Droplet
+			allWarnings   Warnings
+			executeErr    error
+			dropletStream <-chan Droplet
+			warnings      <-chan Warnings
+			errs          <-chan error
+		)
+
+		JustBeforeEach(func() {
+			droplets = nil
+			allWarnings = nil
+			executeErr = nil
+
+			dropletStream, warnings, errs = actor.StagePackage("some-package-guid", "some-app-name")
+			for dropletStream != nil || warnings != nil || errs != nil {
+				select {
+				case droplet, ok := <-dropletStream:
+					if !ok {
+						dropletStream = nil
+						break
+					}
+					droplets = append(droplets, droplet)
+				case warning, ok := <-warnings:
+					if !ok {
+						warnings = nil
+						break
+					}
+					allWarnings = append(allWarnings, warning...)
+				case err, ok := <-errs:
+					if !ok {
+						errs = nil
+						break
+					}
+					executeErr = err
+				}
+			}
+		})
+
+		Context("when the build is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateBuildReturns(
+					ccv3.Build{GUID: "some-build-guid", State: ccv3.BuildStateStaging},
+					ccv3.Warnings{"create-build-warning"},
+					nil,
+				)
+			})
+
+			Context("when staging succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetBuildReturnsOnCall(0,
+						ccv3.Build{GUID: "some-build-guid", State: ccv3.BuildStateStaging},
+						ccv3.Warnings{"get-build-warning-1"},
+						nil,
+					)
+					fakeCloudControllerClient.GetBuildReturnsOnCall(1,
+						ccv3.Build{GUID: "some-build-guid", State: ccv3.BuildStateStaged, DropletGUID: "some-droplet-guid"},
+						ccv3.Warnings{"get-build-warning-2"},
+						nil,
+					)
+				})
+
+				It("polls the build and returns the droplet and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(droplets).To(Equal([]Droplet{{GUID: "some-droplet-guid"}}))
+					Expect(allWarnings).To(ConsistOf("create-build-warning", "get-build-warning-1", "get-build-warning-2"))
+
+					Expect(fakeCloudControllerClient.CreateBuildCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.CreateBuildArgsForCall(0)).To(Equal(ccv3.Build{PackageGUID: "some-package-guid"}))
+					Expect(fakeCloudControllerClient.GetBuildCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetBuildArgsForCall(1)).To(Equal("some-build-guid"))
+				})
+			})
+
+			Context("when staging fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetBuildReturns(
+						ccv3.Build{GUID: "some-build-guid", State: ccv3.BuildStateFailed, Error: "some staging error"},
+						ccv3.Warnings{"get-build-warning"},
+						nil,
+					)
+				})
+
+				It("returns a StagingFailedError and all warnings", func() {
+					Expect(executeErr).To(MatchError(StagingFailedError{Reason: "some staging error"}))
+					Expect(droplets).To(BeEmpty())
+					Expect(allWarnings).To(ConsistOf("create-build-warning", "get-build-warning"))
+				})
+			})
+
+			Context("when staging times out", func() {
+				BeforeEach(func() {
+					fakeConfig.StagingTimeoutReturns(0)
+				})
+
+				It("returns a StagingTimeoutError", func() {
+					Expect(executeErr).To(MatchError(StagingTimeoutError{AppName: "some-app-name", Timeout: 0}))
+					Expect(fakeCloudControllerClient.GetBuildCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the build fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get build error")
+					fakeCloudControllerClient.GetBuildReturns(ccv3.Build{}, ccv3.Warnings{"get-build-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(allWarnings).To(ConsistOf("create-build-warning", "get-build-warning"))
+				})
+			})
+		})
+
+		Context("when creating the build fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("create build error")
+				fakeCloudControllerClient.CreateBuildReturns(ccv3.Build{}, ccv3.Warnings{"create-build-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(allWarnings).To(ConsistOf("create-build-warning"))
+				Expect(fakeCloudControllerClient.GetBuildCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
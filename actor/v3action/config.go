@@ -6,4 +6,6 @@ import "time"
 
 type Config interface {
 	PollingInterval() time.Duration
+	StagingTimeout() time.Duration
+	StartupTimeout() time.Duration
 }
@@ -0,0 +1,13 @@
+package v3action
+
+// Droplet represents a V3 actor droplet, the result of staging a package.
+type Droplet struct {
+	GUID string
+}
+
+// SetApplicationDroplet sets the droplet the application runs the next time
+// it is started.
+func (actor Actor) SetApplicationDroplet(appGUID string, dropletGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.SetApplicationDroplet(appGUID, dropletGUID)
+	return Warnings(warnings), err
+}
@@ -0,0 +1,66 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Droplet Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("SetApplicationDroplet", func() {
+		Context("when setting the droplet succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.SetApplicationDropletReturns(
+					ccv3.Relationship{GUID: "some-droplet-guid"},
+					ccv3.Warnings{"set-droplet-warning"},
+					nil,
+				)
+			})
+
+			It("sets the droplet and returns the warnings", func() {
+				warnings, err := actor.SetApplicationDroplet("some-app-guid", "some-droplet-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("set-droplet-warning"))
+
+				Expect(fakeCloudControllerClient.SetApplicationDropletCallCount()).To(Equal(1))
+				appGUID, dropletGUID := fakeCloudControllerClient.SetApplicationDropletArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			})
+		})
+
+		Context("when setting the droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("set droplet error")
+				fakeCloudControllerClient.SetApplicationDropletReturns(
+					ccv3.Relationship{},
+					ccv3.Warnings{"set-droplet-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := actor.SetApplicationDroplet("some-app-guid", "some-droplet-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("set-droplet-warning"))
+			})
+		})
+	})
+})
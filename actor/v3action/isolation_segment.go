@@ -9,8 +9,8 @@ import (
 )
 
 type IsolationSegmentSummary struct {
-	Name         string
-	EntitledOrgs []string
+	Name         string   `json:"name"`
+	EntitledOrgs []string `json:"orgs"`
 }
 
 // IsolationSegment represents a V3 actor IsolationSegment.
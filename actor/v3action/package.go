@@ -28,6 +28,28 @@ func (e PackageProcessingExpiredError) Error() string {
 
 type Package ccv3.Package
 
+// GetApplicationPackages returns the packages of the application with the
+// given name in the given space.
+func (actor Actor) GetApplicationPackages(appName string, spaceGUID string) ([]Package, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	ccv3Packages, warnings, err := actor.CloudControllerClient.GetApplicationPackages(app.GUID, nil)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var packages []Package
+	for _, ccv3Package := range ccv3Packages {
+		packages = append(packages, Package(ccv3Package))
+	}
+
+	return packages, allWarnings, nil
+}
+
 func (actor Actor) CreateAndUploadPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (Package, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
@@ -40,6 +40,80 @@ var _ = Describe("Package Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, fakeConfig)
 	})
 
+	Describe("GetApplicationPackages", func() {
+		Context("when the application can be retrieved", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warning"},
+					nil,
+				)
+			})
+
+			Context("when retrieving the packages succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationPackagesReturns(
+						[]ccv3.Package{
+							{GUID: "some-package-guid-1", Type: ccv3.PackageTypeBits, State: ccv3.PackageStateReady},
+							{GUID: "some-package-guid-2", Type: ccv3.PackageTypeDocker, State: ccv3.PackageStateFailed},
+						},
+						ccv3.Warnings{"some-package-warning"},
+						nil,
+					)
+				})
+
+				It("returns the packages and all warnings", func() {
+					packages, warnings, err := actor.GetApplicationPackages("some-app-name", "some-space-guid")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(packages).To(Equal([]Package{
+						{GUID: "some-package-guid-1", Type: ccv3.PackageTypeBits, State: ccv3.PackageStateReady},
+						{GUID: "some-package-guid-2", Type: ccv3.PackageTypeDocker, State: ccv3.PackageStateFailed},
+					}))
+					Expect(warnings).To(ConsistOf("some-app-warning", "some-package-warning"))
+
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+						"space_guids": []string{"some-space-guid"},
+						"names":       []string{"some-app-name"},
+					}))
+
+					Expect(fakeCloudControllerClient.GetApplicationPackagesCallCount()).To(Equal(1))
+					appGUID, query := fakeCloudControllerClient.GetApplicationPackagesArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+					Expect(query).To(BeNil())
+				})
+			})
+
+			Context("when retrieving the packages fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("package error")
+					fakeCloudControllerClient.GetApplicationPackagesReturns(nil, ccv3.Warnings{"some-package-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					_, warnings, err := actor.GetApplicationPackages("some-app-name", "some-space-guid")
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("some-app-warning", "some-package-warning"))
+				})
+			})
+		})
+
+		Context("when the application cannot be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetApplicationPackages("some-app-name", "some-space-guid")
+				Expect(err).To(MatchError(ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("some-app-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationPackagesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("CreateAndUploadPackageByApplicationNameAndSpace", func() {
 		Context("when the application can be retrieved", func() {
 			BeforeEach(func() {
@@ -0,0 +1,177 @@
+package v3action
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Process represents a V3 actor process.
+type Process ccv3.Process
+
+// ProcessInstance represents a single instance of a V3 actor process.
+type ProcessInstance ccv3.ProcessInstance
+
+// Running returns true if the instance is running.
+func (instance ProcessInstance) Running() bool {
+	return instance.State == ccv3.ProcessInstanceStateRunning
+}
+
+// StartTime returns the time the instance started, based on its uptime.
+func (instance ProcessInstance) StartTime() time.Time {
+	return time.Now().Add(-time.Duration(instance.Uptime) * time.Second)
+}
+
+// ProcessScaleOptions are the values a process is scaled to. Values left nil
+// are not changed.
+type ProcessScaleOptions ccv3.ProcessScaleOptions
+
+// ProcessSummary is a process together with the stats of its instances.
+type ProcessSummary struct {
+	Process
+	InstanceDetails []ProcessInstance
+}
+
+// HealthyInstanceCount returns the number of running instances.
+func (summary ProcessSummary) HealthyInstanceCount() int {
+	count := 0
+	for _, instance := range summary.InstanceDetails {
+		if instance.Running() {
+			count++
+		}
+	}
+	return count
+}
+
+// ProcessSummaries sorts process summaries with the web process first and the
+// remaining processes by type.
+type ProcessSummaries []ProcessSummary
+
+func (ps ProcessSummaries) Len() int      { return len(ps) }
+func (ps ProcessSummaries) Swap(i, j int) { ps[i], ps[j] = ps[j], ps[i] }
+func (ps ProcessSummaries) Less(i, j int) bool {
+	if ps[i].Type == "web" || ps[j].Type == "web" {
+		return ps[i].Type == "web" && ps[j].Type != "web"
+	}
+	return ps[i].Type < ps[j].Type
+}
+
+// ProcessNotFoundError is returned when the application has no process of the
+// requested type.
+type ProcessNotFoundError struct {
+	ProcessType string
+}
+
+func (e ProcessNotFoundError) Error() string {
+	return fmt.Sprintf("Process %s not found", e.ProcessType)
+}
+
+// ApplicationInstanceCrashedError is returned when an instance of one of the
+// application's processes crashes while waiting for it to start.
+type ApplicationInstanceCrashedError struct {
+	Name string
+}
+
+func (e ApplicationInstanceCrashedError) Error() string {
+	return fmt.Sprintf("Application '%s' crashed", e.Name)
+}
+
+// StartupTimeoutError is returned when the startup timeout is reached waiting
+// for an application to start.
+type StartupTimeoutError struct {
+	Name string
+}
+
+func (e StartupTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for application '%s' to start", e.Name)
+}
+
+// WaitForApplicationToStart polls the application's processes until every
+// process with desired instances has at least one running instance.
+func (actor Actor) WaitForApplicationToStart(app Application) (Warnings, error) {
+	var allWarnings Warnings
+
+	timeout := time.Now().Add(actor.Config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		started := true
+		for _, process := range processes {
+			if process.Instances == 0 {
+				continue
+			}
+
+			instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return allWarnings, err
+			}
+
+			running := false
+			for _, instance := range instances {
+				switch instance.State {
+				case ccv3.ProcessInstanceStateCrashed:
+					return allWarnings, ApplicationInstanceCrashedError{Name: app.Name}
+				case ccv3.ProcessInstanceStateRunning:
+					running = true
+				}
+			}
+
+			if !running {
+				started = false
+			}
+		}
+
+		if started {
+			return allWarnings, nil
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	return allWarnings, StartupTimeoutError{Name: app.Name}
+}
+
+// GetProcessSummariesByApplication returns a summary of every process of the
+// application with the given GUID, with the web process first.
+func (actor Actor) GetProcessSummariesByApplication(appGUID string) (ProcessSummaries, Warnings, error) {
+	processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var summaries ProcessSummaries
+	for _, process := range processes {
+		instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		summary := ProcessSummary{Process: Process(process)}
+		for _, instance := range instances {
+			summary.InstanceDetails = append(summary.InstanceDetails, ProcessInstance(instance))
+		}
+		summaries = append(summaries, summary)
+	}
+
+	sort.Sort(summaries)
+	return summaries, allWarnings, nil
+}
+
+// ScaleProcessByApplication scales the process of the given type belonging to
+// the application with the given GUID.
+func (actor Actor) ScaleProcessByApplication(appGUID string, processType string, scale ProcessScaleOptions) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateApplicationProcessScale(appGUID, processType, ccv3.ProcessScaleOptions(scale))
+	if _, ok := err.(cloudcontroller.ResourceNotFoundError); ok {
+		return Warnings(warnings), ProcessNotFoundError{ProcessType: processType}
+	}
+	return Warnings(warnings), err
+}
@@ -0,0 +1,265 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Process Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+		fakeConfig                *v3actionfakes.FakeConfig
+		app                       Application
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v3actionfakes.FakeConfig)
+		fakeConfig.StartupTimeoutReturns(time.Minute)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+		app = Application{Name: "some-app-name", GUID: "some-app-guid"}
+	})
+
+	Describe("WaitForApplicationToStart", func() {
+		Context("when the processes can be retrieved", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{
+						{GUID: "web-guid", Type: "web", Instances: 2},
+						{GUID: "worker-guid", Type: "worker", Instances: 0},
+					},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+			})
+
+			Context("when an instance of each scaled process starts running", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0,
+						[]ccv3.ProcessInstance{
+							{Index: 0, State: ccv3.ProcessInstanceStateStarting},
+							{Index: 1, State: ccv3.ProcessInstanceStateDown},
+						},
+						ccv3.Warnings{"get-instances-warning-1"},
+						nil,
+					)
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+						[]ccv3.ProcessInstance{
+							{Index: 0, State: ccv3.ProcessInstanceStateRunning},
+							{Index: 1, State: ccv3.ProcessInstanceStateStarting},
+						},
+						ccv3.Warnings{"get-instances-warning-2"},
+						nil,
+					)
+				})
+
+				It("polls until the application is started and returns all warnings", func() {
+					warnings, err := actor.WaitForApplicationToStart(app)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf(
+						"get-processes-warning",
+						"get-instances-warning-1",
+						"get-processes-warning",
+						"get-instances-warning-2",
+					))
+
+					Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+					Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("web-guid"))
+				})
+			})
+
+			Context("when an instance crashes", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturns(
+						[]ccv3.ProcessInstance{
+							{Index: 0, State: ccv3.ProcessInstanceStateRunning},
+							{Index: 1, State: ccv3.ProcessInstanceStateCrashed},
+						},
+						ccv3.Warnings{"get-instances-warning"},
+						nil,
+					)
+				})
+
+				It("returns an ApplicationInstanceCrashedError and all warnings", func() {
+					warnings, err := actor.WaitForApplicationToStart(app)
+					Expect(err).To(MatchError(ApplicationInstanceCrashedError{Name: "some-app-name"}))
+					Expect(warnings).To(ConsistOf("get-processes-warning", "get-instances-warning"))
+				})
+			})
+
+			Context("when getting the instances fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("get instances error")
+					fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, expectedErr)
+				})
+
+				It("returns the error and all warnings", func() {
+					warnings, err := actor.WaitForApplicationToStart(app)
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("get-processes-warning", "get-instances-warning"))
+				})
+			})
+
+			Context("when the startup timeout is reached", func() {
+				BeforeEach(func() {
+					fakeConfig.StartupTimeoutReturns(0)
+				})
+
+				It("returns a StartupTimeoutError", func() {
+					_, err := actor.WaitForApplicationToStart(app)
+					Expect(err).To(MatchError(StartupTimeoutError{Name: "some-app-name"}))
+				})
+			})
+		})
+
+		Context("when no process has instances", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{{GUID: "web-guid", Type: "web", Instances: 0}},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns immediately", func() {
+				_, err := actor.WaitForApplicationToStart(app)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the processes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get processes error")
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.WaitForApplicationToStart(app)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+			})
+		})
+	})
+
+	Describe("GetProcessSummariesByApplication", func() {
+		Context("when the processes and their instances can be retrieved", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{
+						{GUID: "worker-guid", Type: "worker", Instances: 1},
+						{GUID: "web-guid", Type: "web", Instances: 2},
+						{GUID: "console-guid", Type: "console", Instances: 0},
+					},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesStub = func(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error) {
+					switch processGUID {
+					case "web-guid":
+						return []ccv3.ProcessInstance{
+							{Index: 0, State: ccv3.ProcessInstanceStateRunning},
+							{Index: 1, State: ccv3.ProcessInstanceStateStarting},
+						}, ccv3.Warnings{"web-instances-warning"}, nil
+					case "worker-guid":
+						return []ccv3.ProcessInstance{
+							{Index: 0, State: ccv3.ProcessInstanceStateRunning},
+						}, ccv3.Warnings{"worker-instances-warning"}, nil
+					}
+					return nil, nil, nil
+				}
+			})
+
+			It("returns the summaries with the web process first and all warnings", func() {
+				summaries, warnings, err := actor.GetProcessSummariesByApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-processes-warning", "web-instances-warning", "worker-instances-warning"))
+
+				Expect(summaries).To(HaveLen(3))
+				Expect(summaries[0].Type).To(Equal("web"))
+				Expect(summaries[0].InstanceDetails).To(HaveLen(2))
+				Expect(summaries[0].HealthyInstanceCount()).To(Equal(1))
+				Expect(summaries[1].Type).To(Equal("console"))
+				Expect(summaries[1].InstanceDetails).To(BeEmpty())
+				Expect(summaries[2].Type).To(Equal("worker"))
+				Expect(summaries[2].HealthyInstanceCount()).To(Equal(1))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the instances fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get instances error")
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{{GUID: "web-guid", Type: "web"}},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetProcessSummariesByApplication("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-processes-warning", "get-instances-warning"))
+			})
+		})
+	})
+
+	Describe("ScaleProcessByApplication", func() {
+		var scale ProcessScaleOptions
+
+		BeforeEach(func() {
+			instances := 3
+			scale = ProcessScaleOptions{Instances: &instances}
+		})
+
+		Context("when the process is scaled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationProcessScaleReturns(ccv3.Process{}, ccv3.Warnings{"scale-warning"}, nil)
+			})
+
+			It("scales the process and returns the warnings", func() {
+				warnings, err := actor.ScaleProcessByApplication("some-app-guid", "worker", scale)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("scale-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateApplicationProcessScaleCallCount()).To(Equal(1))
+				appGUID, processType, ccScale := fakeCloudControllerClient.UpdateApplicationProcessScaleArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("worker"))
+				Expect(*ccScale.Instances).To(Equal(3))
+				Expect(ccScale.MemoryInMB).To(BeNil())
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationProcessScaleReturns(ccv3.Process{}, ccv3.Warnings{"scale-warning"}, cloudcontroller.ResourceNotFoundError{})
+			})
+
+			It("returns a ProcessNotFoundError and the warnings", func() {
+				warnings, err := actor.ScaleProcessByApplication("some-app-guid", "worker", scale)
+				Expect(err).To(MatchError(ProcessNotFoundError{ProcessType: "worker"}))
+				Expect(warnings).To(ConsistOf("scale-warning"))
+			})
+		})
+	})
+})
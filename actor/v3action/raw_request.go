@@ -0,0 +1,18 @@
+package v3action
+
+import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+// RawRequest represents an arbitrary V3 actor request to the Cloud
+// Controller API.
+type RawRequest ccv3.RawRequest
+
+// RawResponse represents the unparsed Cloud Controller response to a
+// RawRequest.
+type RawResponse ccv3.RawResponse
+
+// MakeRawRequest sends the request to the Cloud Controller and returns its
+// response, including responses with error status codes.
+func (actor Actor) MakeRawRequest(request RawRequest) (RawResponse, Warnings, error) {
+	response, warnings, err := actor.CloudControllerClient.MakeRawRequest(ccv3.RawRequest(request))
+	return RawResponse(response), Warnings(warnings), err
+}
@@ -0,0 +1,61 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Raw Request Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("MakeRawRequest", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.MakeRawRequestReturns(
+					ccv3.RawResponse{StatusCode: http.StatusOK, Body: []byte("some-body")},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the response and all warnings", func() {
+				response, warnings, err := actor.MakeRawRequest(RawRequest{Method: http.MethodGet, Path: "/v2/apps"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response).To(Equal(RawResponse{StatusCode: http.StatusOK, Body: []byte("some-body")}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.MakeRawRequestCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.MakeRawRequestArgsForCall(0)).To(Equal(ccv3.RawRequest{Method: http.MethodGet, Path: "/v2/apps"}))
+			})
+		})
+
+		Context("when the request fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("request failed")
+				fakeCloudControllerClient.MakeRawRequestReturns(ccv3.RawResponse{}, ccv3.Warnings{"some-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.MakeRawRequest(RawRequest{Path: "/v2/apps"})
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+})
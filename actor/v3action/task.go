@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"sort"
@@ -12,6 +14,19 @@ import (
 // Task represents a V3 actor Task.
 type Task ccv3.Task
 
+// TaskFilter narrows down the tasks returned by GetFilteredApplicationTasks.
+// Zero values do not filter.
+type TaskFilter struct {
+	// States only includes tasks in any of these states.
+	States []string
+	// Name only includes tasks with this name.
+	Name string
+	// Since only includes tasks created after this time.
+	Since time.Time
+	// Limit only includes this many of the most recently created tasks.
+	Limit int
+}
+
 // TaskWorkersUnavailableError is returned when there are no workers to run a
 // given task.
 type TaskWorkersUnavailableError struct {
@@ -31,6 +46,17 @@ func (e TaskNotFoundError) Error() string {
 	return fmt.Sprintf("Task sequence ID %d not found.", e.SequenceID)
 }
 
+// TaskFailedError is returned when a task that is waited on fails.
+type TaskFailedError struct {
+	Name       string
+	SequenceID int
+	Reason     string
+}
+
+func (e TaskFailedError) Error() string {
+	return fmt.Sprintf("Task %s (%d) failed: %s", e.Name, e.SequenceID, e.Reason)
+}
+
 // RunTask runs the provided command in the application environment associated
 // with the provided application GUID.
 func (actor Actor) RunTask(appGUID string, command string, name string, memory uint64, disk uint64) (Task, Warnings, error) {
@@ -47,9 +73,36 @@ func (actor Actor) RunTask(appGUID string, command string, name string, memory u
 // GetApplicationTasks returns a list of tasks associated with the provided
 // appplication GUID.
 func (actor Actor) GetApplicationTasks(appGUID string, sortOrder SortOrder) ([]Task, Warnings, error) {
+	return actor.GetFilteredApplicationTasks(appGUID, TaskFilter{}, sortOrder)
+}
+
+// GetFilteredApplicationTasks returns the tasks associated with the provided
+// application GUID that match the filter. The filtering is done by the Cloud
+// Controller.
+func (actor Actor) GetFilteredApplicationTasks(appGUID string, filter TaskFilter, sortOrder SortOrder) ([]Task, Warnings, error) {
 	query := url.Values{}
+	if len(filter.States) > 0 {
+		query.Set(ccv3.StateFilter, strings.Join(filter.States, ","))
+	}
+	if filter.Name != "" {
+		query.Set(ccv3.NameFilter, filter.Name)
+	}
+	if !filter.Since.IsZero() {
+		query.Set(ccv3.CreatedAfterFilter, filter.Since.UTC().Format(time.RFC3339))
+	}
 
-	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query)
+	var (
+		tasks    []ccv3.Task
+		warnings ccv3.Warnings
+		err      error
+	)
+	if filter.Limit > 0 {
+		query.Set(ccv3.OrderBy, "-created_at")
+		query.Set(ccv3.PerPage, strconv.Itoa(filter.Limit))
+		tasks, warnings, err = actor.CloudControllerClient.GetApplicationTasksPage(appGUID, query)
+	} else {
+		tasks, warnings, err = actor.CloudControllerClient.GetApplicationTasks(appGUID, query)
+	}
 	actorWarnings := Warnings(warnings)
 	if err != nil {
 		return nil, actorWarnings, err
@@ -86,6 +139,34 @@ func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID str
 	return Task(tasks[0]), Warnings(warnings), nil
 }
 
+// WaitForTaskToComplete polls the task with the provided GUID until it has
+// either succeeded or failed. A TaskFailedError is returned when the task
+// fails.
+func (actor Actor) WaitForTaskToComplete(taskGUID string) (Task, Warnings, error) {
+	var allWarnings Warnings
+
+	for {
+		task, warnings, err := actor.CloudControllerClient.GetTask(taskGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Task{}, allWarnings, err
+		}
+
+		switch task.State {
+		case ccv3.TaskStateSucceeded:
+			return Task(task), allWarnings, nil
+		case ccv3.TaskStateFailed:
+			return Task(task), allWarnings, TaskFailedError{
+				Name:       task.Name,
+				SequenceID: task.SequenceID,
+				Reason:     task.FailureReason,
+			}
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
+
 func (actor Actor) TerminateTask(taskGUID string) (Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTask(taskGUID)
 	return Task(task), Warnings(warnings), err
@@ -3,6 +3,7 @@ package v3action_test
 import (
 	"errors"
 	"net/url"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -193,6 +194,98 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("GetFilteredApplicationTasks", func() {
+		var (
+			filter   TaskFilter
+			tasks    []Task
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			filter = TaskFilter{}
+		})
+
+		JustBeforeEach(func() {
+			tasks, warnings, err = actor.GetFilteredApplicationTasks("some-app-guid", filter, Descending)
+		})
+
+		Context("when filtering by state, name and creation time", func() {
+			BeforeEach(func() {
+				filter = TaskFilter{
+					States: []string{"FAILED", "RUNNING"},
+					Name:   "some-task",
+					Since:  time.Date(2017, 6, 1, 10, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)),
+				}
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{{SequenceID: 1}, {SequenceID: 2}},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("sends the filters to the cloud controller", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tasks).To(Equal([]Task{{SequenceID: 2}, {SequenceID: 1}}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(1))
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{
+					ccv3.StateFilter:        []string{"FAILED,RUNNING"},
+					ccv3.NameFilter:         []string{"some-task"},
+					ccv3.CreatedAfterFilter: []string{"2017-06-01T08:00:00Z"},
+				}))
+				Expect(fakeCloudControllerClient.GetApplicationTasksPageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when limiting the number of tasks", func() {
+			BeforeEach(func() {
+				filter = TaskFilter{Limit: 2}
+				fakeCloudControllerClient.GetApplicationTasksPageReturns(
+					[]ccv3.Task{{SequenceID: 5}, {SequenceID: 4}},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("requests a single page of the most recently created tasks", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tasks).To(Equal([]Task{{SequenceID: 5}, {SequenceID: 4}}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(fakeCloudControllerClient.GetApplicationTasksPageCallCount()).To(Equal(1))
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksPageArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{
+					ccv3.OrderBy: []string{"-created_at"},
+					ccv3.PerPage: []string{"2"},
+				}))
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(0))
+			})
+
+			Context("when the cloud controller client returns an error", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("I am a CloudControllerClient Error")
+					fakeCloudControllerClient.GetApplicationTasksPageReturns(
+						nil,
+						ccv3.Warnings{"warning-1"},
+						expectedErr,
+					)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(err).To(MatchError(expectedErr))
+					Expect(warnings).To(ConsistOf("warning-1"))
+				})
+			})
+		})
+	})
+
 	Describe("GetTaskBySequenceIDAndApplication", func() {
 		Context("when the cloud controller client does not return an error", func() {
 			Context("when the task is found", func() {
@@ -255,6 +348,68 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("WaitForTaskToComplete", func() {
+		var fakeConfig *v3actionfakes.FakeConfig
+
+		BeforeEach(func() {
+			fakeConfig = new(v3actionfakes.FakeConfig)
+			fakeConfig.PollingIntervalReturns(0)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig)
+		})
+
+		Context("when the task succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTaskReturnsOnCall(0, ccv3.Task{GUID: "task-guid", State: ccv3.TaskStatePending}, ccv3.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetTaskReturnsOnCall(1, ccv3.Task{GUID: "task-guid", State: ccv3.TaskStateRunning}, ccv3.Warnings{"warning-2"}, nil)
+				fakeCloudControllerClient.GetTaskReturnsOnCall(2, ccv3.Task{GUID: "task-guid", State: ccv3.TaskStateSucceeded}, ccv3.Warnings{"warning-3"}, nil)
+			})
+
+			It("polls until the task completes and returns all warnings", func() {
+				task, warnings, err := actor.WaitForTaskToComplete("task-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task).To(Equal(Task{GUID: "task-guid", State: ccv3.TaskStateSucceeded}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3"))
+
+				Expect(fakeCloudControllerClient.GetTaskCallCount()).To(Equal(3))
+				Expect(fakeCloudControllerClient.GetTaskArgsForCall(0)).To(Equal("task-guid"))
+				Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetTaskReturns(ccv3.Task{
+					GUID:          "task-guid",
+					Name:          "migrate",
+					SequenceID:    4,
+					State:         ccv3.TaskStateFailed,
+					FailureReason: "Exited with status 1",
+				}, ccv3.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns a TaskFailedError with the failure reason", func() {
+				_, warnings, err := actor.WaitForTaskToComplete("task-guid")
+				Expect(err).To(MatchError(TaskFailedError{Name: "migrate", SequenceID: 4, Reason: "Exited with status 1"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when getting the task fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get task error")
+				fakeCloudControllerClient.GetTaskReturns(ccv3.Task{}, ccv3.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.WaitForTaskToComplete("task-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("TerminateTask", func() {
 		Context("when the task exists", func() {
 			var returnedTask ccv3.Task
@@ -50,6 +50,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateBuildStub        func(build ccv3.Build) (ccv3.Build, ccv3.Warnings, error)
+	createBuildMutex       sync.RWMutex
+	createBuildArgsForCall []struct {
+		build ccv3.Build
+	}
+	createBuildReturns struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createBuildReturnsOnCall map[int]struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateIsolationSegmentStub        func(name string) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	createIsolationSegmentMutex       sync.RWMutex
 	createIsolationSegmentArgsForCall []struct {
@@ -109,6 +124,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationPackagesStub        func(appGUID string, query url.Values) ([]ccv3.Package, ccv3.Warnings, error)
+	getApplicationPackagesMutex       sync.RWMutex
+	getApplicationPackagesArgsForCall []struct {
+		appGUID string
+		query   url.Values
+	}
+	getApplicationPackagesReturns struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationPackagesReturnsOnCall map[int]struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetApplicationProcessesStub        func(appGUID string) ([]ccv3.Process, ccv3.Warnings, error)
+	getApplicationProcessesMutex       sync.RWMutex
+	getApplicationProcessesArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationProcessesReturns struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationProcessesReturnsOnCall map[int]struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationsStub        func(query url.Values) ([]ccv3.Application, ccv3.Warnings, error)
 	getApplicationsMutex       sync.RWMutex
 	getApplicationsArgsForCall []struct {
@@ -140,6 +186,37 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationTasksPageStub        func(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
+	getApplicationTasksPageMutex       sync.RWMutex
+	getApplicationTasksPageArgsForCall []struct {
+		appGUID string
+		query   url.Values
+	}
+	getApplicationTasksPageReturns struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationTasksPageReturnsOnCall map[int]struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetBuildStub        func(guid string) (ccv3.Build, ccv3.Warnings, error)
+	getBuildMutex       sync.RWMutex
+	getBuildArgsForCall []struct {
+		guid string
+	}
+	getBuildReturns struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getBuildReturnsOnCall map[int]struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetIsolationSegmentStub        func(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	getIsolationSegmentMutex       sync.RWMutex
 	getIsolationSegmentArgsForCall []struct {
@@ -215,6 +292,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetProcessInstancesStub        func(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error)
+	getProcessInstancesMutex       sync.RWMutex
+	getProcessInstancesArgsForCall []struct {
+		processGUID string
+	}
+	getProcessInstancesReturns struct {
+		result1 []ccv3.ProcessInstance
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getProcessInstancesReturnsOnCall map[int]struct {
+		result1 []ccv3.ProcessInstance
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpaceIsolationSegmentStub        func(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	getSpaceIsolationSegmentMutex       sync.RWMutex
 	getSpaceIsolationSegmentArgsForCall []struct {
@@ -230,6 +322,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetTaskStub        func(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
+	getTaskMutex       sync.RWMutex
+	getTaskArgsForCall []struct {
+		taskGUID string
+	}
+	getTaskReturns struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getTaskReturnsOnCall map[int]struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}
+	MakeRawRequestStub        func(request ccv3.RawRequest) (ccv3.RawResponse, ccv3.Warnings, error)
+	makeRawRequestMutex       sync.RWMutex
+	makeRawRequestArgsForCall []struct {
+		request ccv3.RawRequest
+	}
+	makeRawRequestReturns struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}
+	makeRawRequestReturnsOnCall map[int]struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}
 	NewTaskStub        func(appGUID string, command string, name string, memory uint64, disk uint64) (ccv3.Task, ccv3.Warnings, error)
 	newTaskMutex       sync.RWMutex
 	newTaskArgsForCall []struct {
@@ -263,6 +385,69 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	SetApplicationDropletStub        func(appGUID string, dropletGUID string) (ccv3.Relationship, ccv3.Warnings, error)
+	setApplicationDropletMutex       sync.RWMutex
+	setApplicationDropletArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	setApplicationDropletReturns struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}
+	setApplicationDropletReturnsOnCall map[int]struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateApplicationStartStub        func(appGUID string) (ccv3.Application, ccv3.Warnings, error)
+	updateApplicationStartMutex       sync.RWMutex
+	updateApplicationStartArgsForCall []struct {
+		appGUID string
+	}
+	updateApplicationStartReturns struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateApplicationStartReturnsOnCall map[int]struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateApplicationProcessScaleStub        func(appGUID string, processType string, scale ccv3.ProcessScaleOptions) (ccv3.Process, ccv3.Warnings, error)
+	updateApplicationProcessScaleMutex       sync.RWMutex
+	updateApplicationProcessScaleArgsForCall []struct {
+		appGUID     string
+		processType string
+		scale       ccv3.ProcessScaleOptions
+	}
+	updateApplicationProcessScaleReturns struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateApplicationProcessScaleReturnsOnCall map[int]struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateApplicationStopStub        func(appGUID string) (ccv3.Application, ccv3.Warnings, error)
+	updateApplicationStopMutex       sync.RWMutex
+	updateApplicationStopArgsForCall []struct {
+		appGUID string
+	}
+	updateApplicationStopReturns struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateApplicationStopReturnsOnCall map[int]struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateTaskStub        func(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	updateTaskMutex       sync.RWMutex
 	updateTaskArgsForCall []struct {
@@ -447,6 +632,60 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateBuild(build ccv3.Build) (ccv3.Build, ccv3.Warnings, error) {
+	fake.createBuildMutex.Lock()
+	ret, specificReturn := fake.createBuildReturnsOnCall[len(fake.createBuildArgsForCall)]
+	fake.createBuildArgsForCall = append(fake.createBuildArgsForCall, struct {
+		build ccv3.Build
+	}{build})
+	fake.recordInvocation("CreateBuild", []interface{}{build})
+	fake.createBuildMutex.Unlock()
+	if fake.CreateBuildStub != nil {
+		return fake.CreateBuildStub(build)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createBuildReturns.result1, fake.createBuildReturns.result2, fake.createBuildReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildCallCount() int {
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
+	return len(fake.createBuildArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildArgsForCall(i int) ccv3.Build {
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
+	return fake.createBuildArgsForCall[i].build
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildReturns(result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.CreateBuildStub = nil
+	fake.createBuildReturns = struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateBuildReturnsOnCall(i int, result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.CreateBuildStub = nil
+	if fake.createBuildReturnsOnCall == nil {
+		fake.createBuildReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Build
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createBuildReturnsOnCall[i] = struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateIsolationSegment(name string) (ccv3.IsolationSegment, ccv3.Warnings, error) {
 	fake.createIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.createIsolationSegmentReturnsOnCall[len(fake.createIsolationSegmentArgsForCall)]
@@ -666,6 +905,115 @@ func (fake *FakeCloudControllerClient) EntitleIsolationSegmentToOrganizationsRet
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationPackages(appGUID string, query url.Values) ([]ccv3.Package, ccv3.Warnings, error) {
+	fake.getApplicationPackagesMutex.Lock()
+	ret, specificReturn := fake.getApplicationPackagesReturnsOnCall[len(fake.getApplicationPackagesArgsForCall)]
+	fake.getApplicationPackagesArgsForCall = append(fake.getApplicationPackagesArgsForCall, struct {
+		appGUID string
+		query   url.Values
+	}{appGUID, query})
+	fake.recordInvocation("GetApplicationPackages", []interface{}{appGUID, query})
+	fake.getApplicationPackagesMutex.Unlock()
+	if fake.GetApplicationPackagesStub != nil {
+		return fake.GetApplicationPackagesStub(appGUID, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationPackagesReturns.result1, fake.getApplicationPackagesReturns.result2, fake.getApplicationPackagesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationPackagesCallCount() int {
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	return len(fake.getApplicationPackagesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationPackagesArgsForCall(i int) (string, url.Values) {
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	return fake.getApplicationPackagesArgsForCall[i].appGUID, fake.getApplicationPackagesArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationPackagesReturns(result1 []ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationPackagesStub = nil
+	fake.getApplicationPackagesReturns = struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationPackagesReturnsOnCall(i int, result1 []ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationPackagesStub = nil
+	if fake.getApplicationPackagesReturnsOnCall == nil {
+		fake.getApplicationPackagesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Package
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationPackagesReturnsOnCall[i] = struct {
+		result1 []ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcesses(appGUID string) ([]ccv3.Process, ccv3.Warnings, error) {
+	fake.getApplicationProcessesMutex.Lock()
+	ret, specificReturn := fake.getApplicationProcessesReturnsOnCall[len(fake.getApplicationProcessesArgsForCall)]
+	fake.getApplicationProcessesArgsForCall = append(fake.getApplicationProcessesArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationProcesses", []interface{}{appGUID})
+	fake.getApplicationProcessesMutex.Unlock()
+	if fake.GetApplicationProcessesStub != nil {
+		return fake.GetApplicationProcessesStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationProcessesReturns.result1, fake.getApplicationProcessesReturns.result2, fake.getApplicationProcessesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcessesCallCount() int {
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
+	return len(fake.getApplicationProcessesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcessesArgsForCall(i int) string {
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
+	return fake.getApplicationProcessesArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcessesReturns(result1 []ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationProcessesStub = nil
+	fake.getApplicationProcessesReturns = struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcessesReturnsOnCall(i int, result1 []ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationProcessesStub = nil
+	if fake.getApplicationProcessesReturnsOnCall == nil {
+		fake.getApplicationProcessesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Process
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationProcessesReturnsOnCall[i] = struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplications(query url.Values) ([]ccv3.Application, ccv3.Warnings, error) {
 	fake.getApplicationsMutex.Lock()
 	ret, specificReturn := fake.getApplicationsReturnsOnCall[len(fake.getApplicationsArgsForCall)]
@@ -775,6 +1123,115 @@ func (fake *FakeCloudControllerClient) GetApplicationTasksReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationTasksPage(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error) {
+	fake.getApplicationTasksPageMutex.Lock()
+	ret, specificReturn := fake.getApplicationTasksPageReturnsOnCall[len(fake.getApplicationTasksPageArgsForCall)]
+	fake.getApplicationTasksPageArgsForCall = append(fake.getApplicationTasksPageArgsForCall, struct {
+		appGUID string
+		query   url.Values
+	}{appGUID, query})
+	fake.recordInvocation("GetApplicationTasksPage", []interface{}{appGUID, query})
+	fake.getApplicationTasksPageMutex.Unlock()
+	if fake.GetApplicationTasksPageStub != nil {
+		return fake.GetApplicationTasksPageStub(appGUID, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationTasksPageReturns.result1, fake.getApplicationTasksPageReturns.result2, fake.getApplicationTasksPageReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationTasksPageCallCount() int {
+	fake.getApplicationTasksPageMutex.RLock()
+	defer fake.getApplicationTasksPageMutex.RUnlock()
+	return len(fake.getApplicationTasksPageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationTasksPageArgsForCall(i int) (string, url.Values) {
+	fake.getApplicationTasksPageMutex.RLock()
+	defer fake.getApplicationTasksPageMutex.RUnlock()
+	return fake.getApplicationTasksPageArgsForCall[i].appGUID, fake.getApplicationTasksPageArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationTasksPageReturns(result1 []ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationTasksPageStub = nil
+	fake.getApplicationTasksPageReturns = struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationTasksPageReturnsOnCall(i int, result1 []ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationTasksPageStub = nil
+	if fake.getApplicationTasksPageReturnsOnCall == nil {
+		fake.getApplicationTasksPageReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Task
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationTasksPageReturnsOnCall[i] = struct {
+		result1 []ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetBuild(guid string) (ccv3.Build, ccv3.Warnings, error) {
+	fake.getBuildMutex.Lock()
+	ret, specificReturn := fake.getBuildReturnsOnCall[len(fake.getBuildArgsForCall)]
+	fake.getBuildArgsForCall = append(fake.getBuildArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetBuild", []interface{}{guid})
+	fake.getBuildMutex.Unlock()
+	if fake.GetBuildStub != nil {
+		return fake.GetBuildStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getBuildReturns.result1, fake.getBuildReturns.result2, fake.getBuildReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetBuildCallCount() int {
+	fake.getBuildMutex.RLock()
+	defer fake.getBuildMutex.RUnlock()
+	return len(fake.getBuildArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetBuildArgsForCall(i int) string {
+	fake.getBuildMutex.RLock()
+	defer fake.getBuildMutex.RUnlock()
+	return fake.getBuildArgsForCall[i].guid
+}
+
+func (fake *FakeCloudControllerClient) GetBuildReturns(result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.GetBuildStub = nil
+	fake.getBuildReturns = struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetBuildReturnsOnCall(i int, result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.GetBuildStub = nil
+	if fake.getBuildReturnsOnCall == nil {
+		fake.getBuildReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Build
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildReturnsOnCall[i] = struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetIsolationSegment(guid string) (ccv3.IsolationSegment, ccv3.Warnings, error) {
 	fake.getIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.getIsolationSegmentReturnsOnCall[len(fake.getIsolationSegmentArgsForCall)]
@@ -1045,6 +1502,60 @@ func (fake *FakeCloudControllerClient) GetPackageReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetProcessInstances(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error) {
+	fake.getProcessInstancesMutex.Lock()
+	ret, specificReturn := fake.getProcessInstancesReturnsOnCall[len(fake.getProcessInstancesArgsForCall)]
+	fake.getProcessInstancesArgsForCall = append(fake.getProcessInstancesArgsForCall, struct {
+		processGUID string
+	}{processGUID})
+	fake.recordInvocation("GetProcessInstances", []interface{}{processGUID})
+	fake.getProcessInstancesMutex.Unlock()
+	if fake.GetProcessInstancesStub != nil {
+		return fake.GetProcessInstancesStub(processGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getProcessInstancesReturns.result1, fake.getProcessInstancesReturns.result2, fake.getProcessInstancesReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetProcessInstancesCallCount() int {
+	fake.getProcessInstancesMutex.RLock()
+	defer fake.getProcessInstancesMutex.RUnlock()
+	return len(fake.getProcessInstancesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetProcessInstancesArgsForCall(i int) string {
+	fake.getProcessInstancesMutex.RLock()
+	defer fake.getProcessInstancesMutex.RUnlock()
+	return fake.getProcessInstancesArgsForCall[i].processGUID
+}
+
+func (fake *FakeCloudControllerClient) GetProcessInstancesReturns(result1 []ccv3.ProcessInstance, result2 ccv3.Warnings, result3 error) {
+	fake.GetProcessInstancesStub = nil
+	fake.getProcessInstancesReturns = struct {
+		result1 []ccv3.ProcessInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetProcessInstancesReturnsOnCall(i int, result1 []ccv3.ProcessInstance, result2 ccv3.Warnings, result3 error) {
+	fake.GetProcessInstancesStub = nil
+	if fake.getProcessInstancesReturnsOnCall == nil {
+		fake.getProcessInstancesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.ProcessInstance
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessInstancesReturnsOnCall[i] = struct {
+		result1 []ccv3.ProcessInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.getSpaceIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.getSpaceIsolationSegmentReturnsOnCall[len(fake.getSpaceIsolationSegmentArgsForCall)]
@@ -1099,6 +1610,114 @@ func (fake *FakeCloudControllerClient) GetSpaceIsolationSegmentReturnsOnCall(i i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error) {
+	fake.getTaskMutex.Lock()
+	ret, specificReturn := fake.getTaskReturnsOnCall[len(fake.getTaskArgsForCall)]
+	fake.getTaskArgsForCall = append(fake.getTaskArgsForCall, struct {
+		taskGUID string
+	}{taskGUID})
+	fake.recordInvocation("GetTask", []interface{}{taskGUID})
+	fake.getTaskMutex.Unlock()
+	if fake.GetTaskStub != nil {
+		return fake.GetTaskStub(taskGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getTaskReturns.result1, fake.getTaskReturns.result2, fake.getTaskReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetTaskCallCount() int {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return len(fake.getTaskArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetTaskArgsForCall(i int) string {
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	return fake.getTaskArgsForCall[i].taskGUID
+}
+
+func (fake *FakeCloudControllerClient) GetTaskReturns(result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetTaskStub = nil
+	fake.getTaskReturns = struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetTaskReturnsOnCall(i int, result1 ccv3.Task, result2 ccv3.Warnings, result3 error) {
+	fake.GetTaskStub = nil
+	if fake.getTaskReturnsOnCall == nil {
+		fake.getTaskReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Task
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getTaskReturnsOnCall[i] = struct {
+		result1 ccv3.Task
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequest(request ccv3.RawRequest) (ccv3.RawResponse, ccv3.Warnings, error) {
+	fake.makeRawRequestMutex.Lock()
+	ret, specificReturn := fake.makeRawRequestReturnsOnCall[len(fake.makeRawRequestArgsForCall)]
+	fake.makeRawRequestArgsForCall = append(fake.makeRawRequestArgsForCall, struct {
+		request ccv3.RawRequest
+	}{request})
+	fake.recordInvocation("MakeRawRequest", []interface{}{request})
+	fake.makeRawRequestMutex.Unlock()
+	if fake.MakeRawRequestStub != nil {
+		return fake.MakeRawRequestStub(request)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.makeRawRequestReturns.result1, fake.makeRawRequestReturns.result2, fake.makeRawRequestReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestCallCount() int {
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
+	return len(fake.makeRawRequestArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestArgsForCall(i int) ccv3.RawRequest {
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
+	return fake.makeRawRequestArgsForCall[i].request
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestReturns(result1 ccv3.RawResponse, result2 ccv3.Warnings, result3 error) {
+	fake.MakeRawRequestStub = nil
+	fake.makeRawRequestReturns = struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) MakeRawRequestReturnsOnCall(i int, result1 ccv3.RawResponse, result2 ccv3.Warnings, result3 error) {
+	fake.MakeRawRequestStub = nil
+	if fake.makeRawRequestReturnsOnCall == nil {
+		fake.makeRawRequestReturnsOnCall = make(map[int]struct {
+			result1 ccv3.RawResponse
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.makeRawRequestReturnsOnCall[i] = struct {
+		result1 ccv3.RawResponse
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) NewTask(appGUID string, command string, name string, memory uint64, disk uint64) (ccv3.Task, ccv3.Warnings, error) {
 	fake.newTaskMutex.Lock()
 	ret, specificReturn := fake.newTaskReturnsOnCall[len(fake.newTaskArgsForCall)]
@@ -1209,6 +1828,225 @@ func (fake *FakeCloudControllerClient) RevokeIsolationSegmentFromOrganizationRet
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) SetApplicationDroplet(appGUID string, dropletGUID string) (ccv3.Relationship, ccv3.Warnings, error) {
+	fake.setApplicationDropletMutex.Lock()
+	ret, specificReturn := fake.setApplicationDropletReturnsOnCall[len(fake.setApplicationDropletArgsForCall)]
+	fake.setApplicationDropletArgsForCall = append(fake.setApplicationDropletArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("SetApplicationDroplet", []interface{}{appGUID, dropletGUID})
+	fake.setApplicationDropletMutex.Unlock()
+	if fake.SetApplicationDropletStub != nil {
+		return fake.SetApplicationDropletStub(appGUID, dropletGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.setApplicationDropletReturns.result1, fake.setApplicationDropletReturns.result2, fake.setApplicationDropletReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) SetApplicationDropletCallCount() int {
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	return len(fake.setApplicationDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) SetApplicationDropletArgsForCall(i int) (string, string) {
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	return fake.setApplicationDropletArgsForCall[i].appGUID, fake.setApplicationDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) SetApplicationDropletReturns(result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
+	fake.SetApplicationDropletStub = nil
+	fake.setApplicationDropletReturns = struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) SetApplicationDropletReturnsOnCall(i int, result1 ccv3.Relationship, result2 ccv3.Warnings, result3 error) {
+	fake.SetApplicationDropletStub = nil
+	if fake.setApplicationDropletReturnsOnCall == nil {
+		fake.setApplicationDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Relationship
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.setApplicationDropletReturnsOnCall[i] = struct {
+		result1 ccv3.Relationship
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStart(appGUID string) (ccv3.Application, ccv3.Warnings, error) {
+	fake.updateApplicationStartMutex.Lock()
+	ret, specificReturn := fake.updateApplicationStartReturnsOnCall[len(fake.updateApplicationStartArgsForCall)]
+	fake.updateApplicationStartArgsForCall = append(fake.updateApplicationStartArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("UpdateApplicationStart", []interface{}{appGUID})
+	fake.updateApplicationStartMutex.Unlock()
+	if fake.UpdateApplicationStartStub != nil {
+		return fake.UpdateApplicationStartStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateApplicationStartReturns.result1, fake.updateApplicationStartReturns.result2, fake.updateApplicationStartReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStartCallCount() int {
+	fake.updateApplicationStartMutex.RLock()
+	defer fake.updateApplicationStartMutex.RUnlock()
+	return len(fake.updateApplicationStartArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStartArgsForCall(i int) string {
+	fake.updateApplicationStartMutex.RLock()
+	defer fake.updateApplicationStartMutex.RUnlock()
+	return fake.updateApplicationStartArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStartReturns(result1 ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationStartStub = nil
+	fake.updateApplicationStartReturns = struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStartReturnsOnCall(i int, result1 ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationStartStub = nil
+	if fake.updateApplicationStartReturnsOnCall == nil {
+		fake.updateApplicationStartReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Application
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationStartReturnsOnCall[i] = struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationProcessScale(appGUID string, processType string, scale ccv3.ProcessScaleOptions) (ccv3.Process, ccv3.Warnings, error) {
+	fake.updateApplicationProcessScaleMutex.Lock()
+	ret, specificReturn := fake.updateApplicationProcessScaleReturnsOnCall[len(fake.updateApplicationProcessScaleArgsForCall)]
+	fake.updateApplicationProcessScaleArgsForCall = append(fake.updateApplicationProcessScaleArgsForCall, struct {
+		appGUID     string
+		processType string
+		scale       ccv3.ProcessScaleOptions
+	}{appGUID, processType, scale})
+	fake.recordInvocation("UpdateApplicationProcessScale", []interface{}{appGUID, processType, scale})
+	fake.updateApplicationProcessScaleMutex.Unlock()
+	if fake.UpdateApplicationProcessScaleStub != nil {
+		return fake.UpdateApplicationProcessScaleStub(appGUID, processType, scale)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateApplicationProcessScaleReturns.result1, fake.updateApplicationProcessScaleReturns.result2, fake.updateApplicationProcessScaleReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationProcessScaleCallCount() int {
+	fake.updateApplicationProcessScaleMutex.RLock()
+	defer fake.updateApplicationProcessScaleMutex.RUnlock()
+	return len(fake.updateApplicationProcessScaleArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationProcessScaleArgsForCall(i int) (string, string, ccv3.ProcessScaleOptions) {
+	fake.updateApplicationProcessScaleMutex.RLock()
+	defer fake.updateApplicationProcessScaleMutex.RUnlock()
+	return fake.updateApplicationProcessScaleArgsForCall[i].appGUID, fake.updateApplicationProcessScaleArgsForCall[i].processType, fake.updateApplicationProcessScaleArgsForCall[i].scale
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationProcessScaleReturns(result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationProcessScaleStub = nil
+	fake.updateApplicationProcessScaleReturns = struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationProcessScaleReturnsOnCall(i int, result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationProcessScaleStub = nil
+	if fake.updateApplicationProcessScaleReturnsOnCall == nil {
+		fake.updateApplicationProcessScaleReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Process
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationProcessScaleReturnsOnCall[i] = struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStop(appGUID string) (ccv3.Application, ccv3.Warnings, error) {
+	fake.updateApplicationStopMutex.Lock()
+	ret, specificReturn := fake.updateApplicationStopReturnsOnCall[len(fake.updateApplicationStopArgsForCall)]
+	fake.updateApplicationStopArgsForCall = append(fake.updateApplicationStopArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("UpdateApplicationStop", []interface{}{appGUID})
+	fake.updateApplicationStopMutex.Unlock()
+	if fake.UpdateApplicationStopStub != nil {
+		return fake.UpdateApplicationStopStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateApplicationStopReturns.result1, fake.updateApplicationStopReturns.result2, fake.updateApplicationStopReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStopCallCount() int {
+	fake.updateApplicationStopMutex.RLock()
+	defer fake.updateApplicationStopMutex.RUnlock()
+	return len(fake.updateApplicationStopArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStopArgsForCall(i int) string {
+	fake.updateApplicationStopMutex.RLock()
+	defer fake.updateApplicationStopMutex.RUnlock()
+	return fake.updateApplicationStopArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStopReturns(result1 ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationStopStub = nil
+	fake.updateApplicationStopReturns = struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStopReturnsOnCall(i int, result1 ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationStopStub = nil
+	if fake.updateApplicationStopReturnsOnCall == nil {
+		fake.updateApplicationStopReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Application
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationStopReturnsOnCall[i] = struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error) {
 	fake.updateTaskMutex.Lock()
 	ret, specificReturn := fake.updateTaskReturnsOnCall[len(fake.updateTaskArgsForCall)]
@@ -1327,6 +2165,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
 	fake.createIsolationSegmentMutex.RLock()
 	defer fake.createIsolationSegmentMutex.RUnlock()
 	fake.createPackageMutex.RLock()
@@ -1335,10 +2175,18 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
+	fake.getApplicationTasksPageMutex.RLock()
+	defer fake.getApplicationTasksPageMutex.RUnlock()
+	fake.getBuildMutex.RLock()
+	defer fake.getBuildMutex.RUnlock()
 	fake.getIsolationSegmentMutex.RLock()
 	defer fake.getIsolationSegmentMutex.RUnlock()
 	fake.getIsolationSegmentOrganizationsByIsolationSegmentMutex.RLock()
@@ -1349,12 +2197,26 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPackageMutex.RLock()
 	defer fake.getPackageMutex.RUnlock()
+	fake.getProcessInstancesMutex.RLock()
+	defer fake.getProcessInstancesMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
+	fake.getTaskMutex.RLock()
+	defer fake.getTaskMutex.RUnlock()
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
 	fake.newTaskMutex.RLock()
 	defer fake.newTaskMutex.RUnlock()
 	fake.revokeIsolationSegmentFromOrganizationMutex.RLock()
 	defer fake.revokeIsolationSegmentFromOrganizationMutex.RUnlock()
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	fake.updateApplicationStartMutex.RLock()
+	defer fake.updateApplicationStartMutex.RUnlock()
+	fake.updateApplicationProcessScaleMutex.RLock()
+	defer fake.updateApplicationProcessScaleMutex.RUnlock()
+	fake.updateApplicationStopMutex.RLock()
+	defer fake.updateApplicationStopMutex.RUnlock()
 	fake.updateTaskMutex.RLock()
 	defer fake.updateTaskMutex.RUnlock()
 	fake.uploadPackageMutex.RLock()
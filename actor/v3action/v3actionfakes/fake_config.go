@@ -18,6 +18,24 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	StagingTimeoutStub        func() time.Duration
+	stagingTimeoutMutex       sync.RWMutex
+	stagingTimeoutArgsForCall []struct{}
+	stagingTimeoutReturns     struct {
+		result1 time.Duration
+	}
+	stagingTimeoutReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	StartupTimeoutStub        func() time.Duration
+	startupTimeoutMutex       sync.RWMutex
+	startupTimeoutArgsForCall []struct{}
+	startupTimeoutReturns     struct {
+		result1 time.Duration
+	}
+	startupTimeoutReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -62,11 +80,95 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) StagingTimeout() time.Duration {
+	fake.stagingTimeoutMutex.Lock()
+	ret, specificReturn := fake.stagingTimeoutReturnsOnCall[len(fake.stagingTimeoutArgsForCall)]
+	fake.stagingTimeoutArgsForCall = append(fake.stagingTimeoutArgsForCall, struct{}{})
+	fake.recordInvocation("StagingTimeout", []interface{}{})
+	fake.stagingTimeoutMutex.Unlock()
+	if fake.StagingTimeoutStub != nil {
+		return fake.StagingTimeoutStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.stagingTimeoutReturns.result1
+}
+
+func (fake *FakeConfig) StagingTimeoutCallCount() int {
+	fake.stagingTimeoutMutex.RLock()
+	defer fake.stagingTimeoutMutex.RUnlock()
+	return len(fake.stagingTimeoutArgsForCall)
+}
+
+func (fake *FakeConfig) StagingTimeoutReturns(result1 time.Duration) {
+	fake.StagingTimeoutStub = nil
+	fake.stagingTimeoutReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) StagingTimeoutReturnsOnCall(i int, result1 time.Duration) {
+	fake.StagingTimeoutStub = nil
+	if fake.stagingTimeoutReturnsOnCall == nil {
+		fake.stagingTimeoutReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.stagingTimeoutReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) StartupTimeout() time.Duration {
+	fake.startupTimeoutMutex.Lock()
+	ret, specificReturn := fake.startupTimeoutReturnsOnCall[len(fake.startupTimeoutArgsForCall)]
+	fake.startupTimeoutArgsForCall = append(fake.startupTimeoutArgsForCall, struct{}{})
+	fake.recordInvocation("StartupTimeout", []interface{}{})
+	fake.startupTimeoutMutex.Unlock()
+	if fake.StartupTimeoutStub != nil {
+		return fake.StartupTimeoutStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.startupTimeoutReturns.result1
+}
+
+func (fake *FakeConfig) StartupTimeoutCallCount() int {
+	fake.startupTimeoutMutex.RLock()
+	defer fake.startupTimeoutMutex.RUnlock()
+	return len(fake.startupTimeoutArgsForCall)
+}
+
+func (fake *FakeConfig) StartupTimeoutReturns(result1 time.Duration) {
+	fake.StartupTimeoutStub = nil
+	fake.startupTimeoutReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) StartupTimeoutReturnsOnCall(i int, result1 time.Duration) {
+	fake.StartupTimeoutStub = nil
+	if fake.startupTimeoutReturnsOnCall == nil {
+		fake.startupTimeoutReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.startupTimeoutReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.stagingTimeoutMutex.RLock()
+	defer fake.stagingTimeoutMutex.RUnlock()
+	fake.startupTimeoutMutex.RLock()
+	defer fake.startupTimeoutMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -30,53 +30,137 @@ const (
 // Application represents a Cloud Controller Application.
 type Application struct {
 	// Buildpack is the buildpack set by the user.
-	Buildpack string `json:"-"`
+	Buildpack string
+
+	// Command is the user specified start command.
+	Command string
+
+	// ResetBuildpack clears the buildpack set by the user so that the
+	// buildpack is detected again. It is only used when sending the
+	// application to the Cloud Controller.
+	ResetBuildpack bool
+
+	// ResetCommand clears the start command set by the user so that the
+	// detected start command is used again. It is only used when sending the
+	// application to the Cloud Controller.
+	ResetCommand bool
 
 	// DetectedBuildpack is the buildpack automatically detected.
-	DetectedBuildpack string `json:"-"`
+	DetectedBuildpack string
 
 	// DetectedStartCommand is the command used to start the application.
-	DetectedStartCommand string `json:"-"`
+	DetectedStartCommand string
 
 	// DiskQuota is the disk given to each instance, in megabytes.
-	DiskQuota int `json:"-"`
+	DiskQuota int
+
+	// DockerImage is the docker image location.
+	DockerImage string
+
+	// EnvironmentVariables are the user specified environment variables.
+	EnvironmentVariables map[string]string
 
 	// GUID is the unique application identifier.
-	GUID string `json:"-"`
+	GUID string
+
+	// HealthCheckTimeout is the number of seconds for health checking of an
+	// staged app when starting up.
+	HealthCheckTimeout int
 
 	// HealthCheckType is the type of health check that will be done to the app.
-	HealthCheckType string `json:"health_check_type,omitempty"`
+	HealthCheckType string
 
 	// HealthCheckHTTPEndpoint is the url of the http health check endpoint.
-	HealthCheckHTTPEndpoint string `json:"health_check_http_endpoint,omitempty"`
+	HealthCheckHTTPEndpoint string
 
 	// Instances is the total number of app instances.
-	Instances int `json:"-"`
+	Instances int
+
+	// ZeroInstances sends an Instances of 0, which is otherwise left out as
+	// unset. It is only used when sending the application to the Cloud
+	// Controller.
+	ZeroInstances bool
 
 	// Memory is the memory given to each instance, in megabytes.
-	Memory int `json:"-"`
+	Memory int
 
 	// Name is the name given to the application.
-	Name string `json:"-"`
+	Name string
+
+	// Ports are the ports the application may listen on.
+	Ports []int
 
 	// PackageState represents the staging state of the application bits.
-	PackageState ApplicationPackageState `json:"-"`
+	PackageState ApplicationPackageState
 
 	// PackageUpdatedAt is the last time the app bits were updated. In RFC3339.
-	PackageUpdatedAt time.Time `json:"-"`
+	PackageUpdatedAt time.Time
+
+	// SpaceGUID is the GUID of the app's space.
+	SpaceGUID string
 
 	// StackGUID is the GUID for the Stack the application is running on.
-	StackGUID string `json:"-"`
+	StackGUID string
 
 	// StagingFailedDescription is the verbose description of why the package
 	// failed to stage.
-	StagingFailedDescription string `json:"-"`
+	StagingFailedDescription string
 
 	// StagingFailedReason is the reason why the package failed to stage.
-	StagingFailedReason string `json:"-"`
+	StagingFailedReason string
 
 	// State is the desired state of the application.
-	State ApplicationState `json:"state,omitempty"`
+	State ApplicationState
+}
+
+// MarshalJSON converts an application into a Cloud Controller Application.
+// Only the fields that are set are sent to the Cloud Controller.
+func (application Application) MarshalJSON() ([]byte, error) {
+	ccApp := struct {
+		Buildpack               interface{}       `json:"buildpack,omitempty"`
+		Command                 *string           `json:"command,omitempty"`
+		DiskQuota               int               `json:"disk_quota,omitempty"`
+		DockerImage             string            `json:"docker_image,omitempty"`
+		EnvironmentJSON         map[string]string `json:"environment_json,omitempty"`
+		HealthCheckHTTPEndpoint string            `json:"health_check_http_endpoint,omitempty"`
+		HealthCheckTimeout      int               `json:"health_check_timeout,omitempty"`
+		HealthCheckType         string            `json:"health_check_type,omitempty"`
+		Instances               *int              `json:"instances,omitempty"`
+		Memory                  int               `json:"memory,omitempty"`
+		Name                    string            `json:"name,omitempty"`
+		Ports                   []int             `json:"ports,omitempty"`
+		SpaceGUID               string            `json:"space_guid,omitempty"`
+		StackGUID               string            `json:"stack_guid,omitempty"`
+		State                   ApplicationState  `json:"state,omitempty"`
+	}{
+		DiskQuota:               application.DiskQuota,
+		DockerImage:             application.DockerImage,
+		EnvironmentJSON:         application.EnvironmentVariables,
+		HealthCheckHTTPEndpoint: application.HealthCheckHTTPEndpoint,
+		HealthCheckTimeout:      application.HealthCheckTimeout,
+		HealthCheckType:         application.HealthCheckType,
+		Memory:                  application.Memory,
+		Name:                    application.Name,
+		Ports:                   application.Ports,
+		SpaceGUID:               application.SpaceGUID,
+		StackGUID:               application.StackGUID,
+		State:                   application.State,
+	}
+
+	// A null buildpack and an empty command reset them to the detected ones.
+	if application.Buildpack != "" {
+		ccApp.Buildpack = application.Buildpack
+	} else if application.ResetBuildpack {
+		ccApp.Buildpack = json.RawMessage("null")
+	}
+	if application.Command != "" || application.ResetCommand {
+		ccApp.Command = &application.Command
+	}
+	if application.Instances != 0 || application.ZeroInstances {
+		ccApp.Instances = &application.Instances
+	}
+
+	return json.Marshal(ccApp)
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Application response.
@@ -84,21 +168,27 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	var ccApp struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Buildpack                string     `json:"buildpack"`
-			DetectedBuildpack        string     `json:"detected_buildpack"`
-			DetectedStartCommand     string     `json:"detected_start_command"`
-			DiskQuota                int        `json:"disk_quota"`
-			HealthCheckType          string     `json:"health_check_type"`
-			HealthCheckHTTPEndpoint  string     `json:"health_check_http_endpoint"`
-			Instances                int        `json:"instances"`
-			Memory                   int        `json:"memory"`
-			Name                     string     `json:"name"`
-			PackageState             string     `json:"package_state"`
-			PackageUpdatedAt         *time.Time `json:"package_updated_at"`
-			StackGUID                string     `json:"stack_guid"`
-			StagingFailedDescription string     `json:"staging_failed_description"`
-			StagingFailedReason      string     `json:"staging_failed_reason"`
-			State                    string     `json:"state"`
+			Buildpack                string            `json:"buildpack"`
+			Command                  string            `json:"command"`
+			DetectedBuildpack        string            `json:"detected_buildpack"`
+			DetectedStartCommand     string            `json:"detected_start_command"`
+			DiskQuota                int               `json:"disk_quota"`
+			DockerImage              string            `json:"docker_image"`
+			EnvironmentJSON          map[string]string `json:"environment_json"`
+			HealthCheckTimeout       int               `json:"health_check_timeout"`
+			HealthCheckType          string            `json:"health_check_type"`
+			HealthCheckHTTPEndpoint  string            `json:"health_check_http_endpoint"`
+			Instances                int               `json:"instances"`
+			Memory                   int               `json:"memory"`
+			Name                     string            `json:"name"`
+			PackageState             string            `json:"package_state"`
+			PackageUpdatedAt         *time.Time        `json:"package_updated_at"`
+			Ports                    []int             `json:"ports"`
+			SpaceGUID                string            `json:"space_guid"`
+			StackGUID                string            `json:"stack_guid"`
+			StagingFailedDescription string            `json:"staging_failed_description"`
+			StagingFailedReason      string            `json:"staging_failed_reason"`
+			State                    string            `json:"state"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccApp); err != nil {
@@ -107,15 +197,21 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 
 	application.GUID = ccApp.Metadata.GUID
 	application.Buildpack = ccApp.Entity.Buildpack
+	application.Command = ccApp.Entity.Command
 	application.DetectedBuildpack = ccApp.Entity.DetectedBuildpack
 	application.DetectedStartCommand = ccApp.Entity.DetectedStartCommand
 	application.DiskQuota = ccApp.Entity.DiskQuota
+	application.DockerImage = ccApp.Entity.DockerImage
+	application.EnvironmentVariables = ccApp.Entity.EnvironmentJSON
+	application.HealthCheckTimeout = ccApp.Entity.HealthCheckTimeout
 	application.HealthCheckType = ccApp.Entity.HealthCheckType
 	application.HealthCheckHTTPEndpoint = ccApp.Entity.HealthCheckHTTPEndpoint
 	application.Instances = ccApp.Entity.Instances
 	application.Memory = ccApp.Entity.Memory
 	application.Name = ccApp.Entity.Name
 	application.PackageState = ApplicationPackageState(ccApp.Entity.PackageState)
+	application.Ports = ccApp.Entity.Ports
+	application.SpaceGUID = ccApp.Entity.SpaceGUID
 	application.StackGUID = ccApp.Entity.StackGUID
 	application.StagingFailedDescription = ccApp.Entity.StagingFailedDescription
 	application.StagingFailedReason = ccApp.Entity.StagingFailedReason
@@ -127,6 +223,22 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// DeleteApplication deletes the Application associated with the provided
+// GUID.
+func (client *Client) DeleteApplication(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppRequest,
+		URIParams:   Params{"app_guid": guid},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetApplication returns back an Application.
 func (client *Client) GetApplication(guid string) (Application, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -173,6 +285,33 @@ func (client *Client) GetApplications(queries []Query) ([]Application, Warnings,
 	return fullAppsList, warnings, err
 }
 
+// NewApplication creates a cloud controller application with the given
+// settings. SpaceGUID and Name are the only required fields.
+func (client *Client) NewApplication(app Application) (Application, Warnings, error) {
+	body, err := json.Marshal(app)
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostAppRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	var createdApp Application
+	response := cloudcontroller.Response{
+		Result: &createdApp,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdApp, response.Warnings, err
+}
+
+// UpdateApplication updates the application with the given GUID. Only the
+// fields that are set on the provided application are updated.
 func (client *Client) UpdateApplication(app Application) (Application, Warnings, error) {
 	body, err := json.Marshal(app)
 	if err != nil {
@@ -18,6 +18,49 @@ var _ = Describe("Application", func() {
 		client = NewTestClient()
 	})
 
+	Describe("DeleteApplication", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the application and returns all warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and all warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetApplication", func() {
 		BeforeEach(func() {
 			response := `{
@@ -198,6 +241,95 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("NewApplication", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-app-guid"
+					},
+					"entity": {
+						"name": "some-app-name",
+						"space_guid": "some-space-guid",
+						"instances": 2,
+						"memory": 128,
+						"stack_guid": "some-stack-guid",
+						"state": "STOPPED"
+					}
+				}`
+				expectedBody := map[string]interface{}{
+					"name":       "some-app-name",
+					"space_guid": "some-space-guid",
+					"instances":  2,
+					"memory":     128,
+					"stack_guid": "some-stack-guid",
+					"environment_json": map[string]string{
+						"key": "value",
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/apps"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created object and warnings", func() {
+				app, warnings, err := client.NewApplication(Application{
+					Name:                 "some-app-name",
+					SpaceGUID:            "some-space-guid",
+					Instances:            2,
+					Memory:               128,
+					StackGUID:            "some-stack-guid",
+					EnvironmentVariables: map[string]string{"key": "value"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(app).To(Equal(Application{
+					GUID:      "some-app-guid",
+					Name:      "some-app-name",
+					SpaceGUID: "some-space-guid",
+					Instances: 2,
+					Memory:    128,
+					StackGUID: "some-stack-guid",
+					State:     ApplicationStopped,
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the create returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100002,
+					"description": "The app name is taken: some-app-name",
+					"error_code": "CF-AppNameTaken"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/apps"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.NewApplication(Application{
+					Name:      "some-app-name",
+					SpaceGUID: "some-space-guid",
+				})
+				Expect(err).To(MatchError(cloudcontroller.BadRequestError{
+					Message: "The app name is taken: some-app-name",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("UpdateApplication", func() {
 		Context("when the update is successful", func() {
 			Context("when updating all fields", func() { //are we encoding everything correctly?
@@ -268,6 +400,68 @@ var _ = Describe("Application", func() {
 				})
 			})
 
+			Context("when resetting the buildpack and command", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyBody([]byte(`{"buildpack":null,"command":""}`)),
+							RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-app-guid"}, "entity": {}}`, nil),
+						),
+					)
+				})
+
+				It("sends a null buildpack and an empty command", func() {
+					_, _, err := client.UpdateApplication(Application{
+						GUID:           "some-app-guid",
+						ResetBuildpack: true,
+						ResetCommand:   true,
+					})
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("when setting the instances to 0", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyBody([]byte(`{"instances":0}`)),
+							RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-app-guid"}, "entity": {}}`, nil),
+						),
+					)
+				})
+
+				It("sends the 0 instances", func() {
+					_, _, err := client.UpdateApplication(Application{
+						GUID:          "some-app-guid",
+						ZeroInstances: true,
+					})
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("when setting the ports", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+							VerifyBody([]byte(`{"ports":[8080,9090]}`)),
+							RespondWith(http.StatusCreated, `{"metadata": {"guid": "some-app-guid"}, "entity": {"ports": [8080, 9090]}}`, nil),
+						),
+					)
+				})
+
+				It("sends the ports", func() {
+					app, _, err := client.UpdateApplication(Application{
+						GUID:  "some-app-guid",
+						Ports: []int{8080, 9090},
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(app.Ports).To(Equal([]int{8080, 9090}))
+				})
+			})
+
 			Context("when only updating one field", func() { // are we **only** encoding the things we want
 				BeforeEach(func() {
 					response1 := `{
@@ -0,0 +1,70 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Buildpack represents a Cloud Controller Buildpack.
+type Buildpack struct {
+	GUID     string
+	Name     string
+	Position int
+	Enabled  bool
+	Locked   bool
+	Filename string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Buildpack response.
+func (buildpack *Buildpack) UnmarshalJSON(data []byte) error {
+	var ccBuildpack struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name     string `json:"name"`
+			Position int    `json:"position"`
+			Enabled  bool   `json:"enabled"`
+			Locked   bool   `json:"locked"`
+			Filename string `json:"filename"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccBuildpack); err != nil {
+		return err
+	}
+
+	buildpack.GUID = ccBuildpack.Metadata.GUID
+	buildpack.Name = ccBuildpack.Entity.Name
+	buildpack.Position = ccBuildpack.Entity.Position
+	buildpack.Enabled = ccBuildpack.Entity.Enabled
+	buildpack.Locked = ccBuildpack.Entity.Locked
+	buildpack.Filename = ccBuildpack.Entity.Filename
+	return nil
+}
+
+// GetBuildpacks returns a list of Buildpacks based off of the provided
+// queries.
+func (client *Client) GetBuildpacks(queries []Query) ([]Buildpack, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildpacksRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullBuildpacksList []Buildpack
+	warnings, err := client.paginate(request, Buildpack{}, func(item interface{}) error {
+		if buildpack, ok := item.(Buildpack); ok {
+			fullBuildpacksList = append(fullBuildpacksList, buildpack)
+		} else {
+			return cloudcontroller.UnknownObjectInListError{
+				Expected:   Buildpack{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullBuildpacksList, warnings, err
+}
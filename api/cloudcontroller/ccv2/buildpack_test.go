@@ -0,0 +1,91 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Buildpack", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetBuildpacks", func() {
+		Context("when the buildpacks are found", func() {
+			BeforeEach(func() {
+				response1 := `{
+					"next_url": "/v2/buildpacks?page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "buildpack-guid-1"
+							},
+							"entity": {
+								"name": "ruby_buildpack",
+								"position": 1,
+								"enabled": true,
+								"locked": false,
+								"filename": "ruby_buildpack-v1.6.0.zip"
+							}
+						}
+					]
+				}`
+				response2 := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "buildpack-guid-2"
+							},
+							"entity": {
+								"name": "go_buildpack",
+								"position": 2,
+								"enabled": false,
+								"locked": true,
+								"filename": "go_buildpack-v1.8.0.zip"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/buildpacks", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns all the buildpacks and warnings", func() {
+				buildpacks, warnings, err := client.GetBuildpacks(nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buildpacks).To(Equal([]Buildpack{
+					{
+						GUID:     "buildpack-guid-1",
+						Name:     "ruby_buildpack",
+						Position: 1,
+						Enabled:  true,
+						Filename: "ruby_buildpack-v1.6.0.zip",
+					},
+					{
+						GUID:     "buildpack-guid-2",
+						Name:     "go_buildpack",
+						Position: 2,
+						Locked:   true,
+						Filename: "go_buildpack-v1.8.0.zip",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+			})
+		})
+	})
+})
@@ -15,7 +15,9 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
+	DeleteAppRequest                      = "DeleteApp"
 	DeleteOrganizationRequest             = "DeleteOrganization"
+	DeleteRouteAppRequest                 = "DeleteRouteApp"
 	DeleteRouteRequest                    = "DeleteRoute"
 	DeleteServiceBindingRequest           = "DeleteServiceBinding"
 	GetAppInstancesRequest                = "GetAppInstances"
@@ -23,6 +25,7 @@ const (
 	GetAppRoutesRequest                   = "GetAppRoutes"
 	GetAppStatsRequest                    = "GetAppStats"
 	GetAppsRequest                        = "GetApps"
+	GetBuildpacksRequest                  = "GetBuildpacks"
 	GetInfoRequest                        = "GetInfo"
 	GetJobRequest                         = "GetJob"
 	GetOrganizationPrivateDomainsRequest  = "GetOrganizationPrivateDomains"
@@ -32,6 +35,7 @@ const (
 	GetPrivateDomainRequest               = "GetPrivateDomain"
 	GetRouteAppsRequest                   = "GetRouteApps"
 	GetRouteRouteMappingsRequest          = "GetRouteRouteMappings"
+	GetRoutesRequest                      = "GetRoutes"
 	GetSecurityGroupsRequest              = "GetSecurityGroups"
 	GetServiceBindingsRequest             = "GetServiceBindings"
 	GetServiceInstancesRequest            = "GetServiceInstances"
@@ -44,8 +48,14 @@ const (
 	GetSpaceStagingSecurityGroupsRequest  = "GetSpaceStagingSecurityGroups"
 	GetSpacesRequest                      = "GetSpaces"
 	GetStackRequest                       = "GetStack"
+	GetStacksRequest                      = "GetStacks"
 	GetUsersRequest                       = "GetUsers"
+	PostAppRequest                        = "PostApp"
+	PostRouteRequest                      = "PostRoute"
+	PostServiceBindingRequest             = "PostServiceBinding"
+	PutAppBitsRequest                     = "PutAppBits"
 	PutAppRequest                         = "PutApp"
+	PutRouteAppRequest                    = "PutRouteApp"
 	PutSecurityGroupSpaceRequest          = "PutSecurityGroupSpace"
 )
 
@@ -53,11 +63,15 @@ const (
 // URLs.
 var APIRoutes = rata.Routes{
 	{Path: "/v2/apps", Method: http.MethodGet, Name: GetAppsRequest},
+	{Path: "/v2/apps", Method: http.MethodPost, Name: PostAppRequest},
+	{Path: "/v2/apps/:app_guid", Method: http.MethodDelete, Name: DeleteAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodGet, Name: GetAppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodPut, Name: PutAppRequest},
+	{Path: "/v2/apps/:app_guid/bits", Method: http.MethodPut, Name: PutAppBitsRequest},
 	{Path: "/v2/apps/:app_guid/instances", Method: http.MethodGet, Name: GetAppInstancesRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: GetAppRoutesRequest},
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: GetAppStatsRequest},
+	{Path: "/v2/buildpacks", Method: http.MethodGet, Name: GetBuildpacksRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: GetInfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: GetJobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: GetOrganizationsRequest},
@@ -66,12 +80,17 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/organizations/:organization_guid/private_domains", Method: http.MethodGet, Name: GetOrganizationPrivateDomainsRequest},
 	{Path: "/v2/private_domains/:private_domain_guid", Method: http.MethodGet, Name: GetPrivateDomainRequest},
 	{Path: "/v2/quota_definitions/:organization_quota_guid", Method: http.MethodGet, Name: GetOrganizationQuotaDefinitionRequest},
+	{Path: "/v2/routes", Method: http.MethodGet, Name: GetRoutesRequest},
+	{Path: "/v2/routes", Method: http.MethodPost, Name: PostRouteRequest},
 	{Path: "/v2/routes/:route_guid", Method: http.MethodDelete, Name: DeleteRouteRequest},
 	{Path: "/v2/routes/:route_guid/apps", Method: http.MethodGet, Name: GetRouteAppsRequest},
+	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodDelete, Name: DeleteRouteAppRequest},
+	{Path: "/v2/routes/:route_guid/apps/:app_guid", Method: http.MethodPut, Name: PutRouteAppRequest},
 	{Path: "/v2/routes/:route_guid/route_mappings", Method: http.MethodGet, Name: GetRouteRouteMappingsRequest},
 	{Path: "/v2/security_groups", Method: http.MethodGet, Name: GetSecurityGroupsRequest},
 	{Path: "/v2/security_groups/:security_group_guid/spaces/:space_guid", Method: http.MethodPut, Name: PutSecurityGroupSpaceRequest},
 	{Path: "/v2/service_bindings", Method: http.MethodGet, Name: GetServiceBindingsRequest},
+	{Path: "/v2/service_bindings", Method: http.MethodPost, Name: PostServiceBindingRequest},
 	{Path: "/v2/service_bindings/:service_binding_guid", Method: http.MethodDelete, Name: DeleteServiceBindingRequest},
 	{Path: "/v2/service_instances", Method: http.MethodGet, Name: GetServiceInstancesRequest},
 	{Path: "/v2/shared_domains", Method: http.MethodGet, Name: GetSharedDomainsRequest},
@@ -82,6 +101,7 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/spaces/:space_guid/routes", Method: http.MethodGet, Name: GetSpaceRoutesRequest},
 	{Path: "/v2/spaces/:space_guid/security_groups", Method: http.MethodGet, Name: GetSpaceRunningSecurityGroupsRequest},
 	{Path: "/v2/spaces/:space_guid/staging_security_groups", Method: http.MethodGet, Name: GetSpaceStagingSecurityGroupsRequest},
+	{Path: "/v2/stacks", Method: http.MethodGet, Name: GetStacksRequest},
 	{Path: "/v2/stacks/:stack_guid", Method: http.MethodGet, Name: GetStackRequest},
 	{Path: "/v2/users", Method: http.MethodPost, Name: GetUsersRequest},
 }
@@ -1,9 +1,14 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/url"
+	"os"
+	"path/filepath"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -169,3 +174,67 @@ func (client *Client) DeleteOrganization(orgGUID string) (Job, Warnings, error)
 	err = client.connection.Make(request, &response)
 	return job, response.Warnings, err
 }
+
+// UploadApplication uploads the provided zip file as the bits of the
+// application associated with the provided GUID. The upload is processed
+// asynchronously, the returned job can be polled with PollJob.
+func (client *Client) UploadApplication(appGUID string, zipFilePath string) (Job, Warnings, error) {
+	body, contentType, err := createApplicationBitsUploadStream(zipFilePath)
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutAppBitsRequest,
+		URIParams:   Params{"app_guid": appGUID},
+		Query: url.Values{
+			"async": {"true"},
+		},
+		Body: body,
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+	request.Header.Set("Content-Type", contentType)
+
+	var job Job
+	response := cloudcontroller.Response{
+		Result: &job,
+	}
+
+	err = client.connection.Make(request, &response)
+	return job, response.Warnings, err
+}
+
+func createApplicationBitsUploadStream(zipFilePath string) (io.Reader, string, error) {
+	file, err := os.Open(zipFilePath)
+	if err != nil {
+		return nil, "", err
+	}
+	defer file.Close()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	err = writer.WriteField("resources", "[]")
+	if err != nil {
+		return nil, "", err
+	}
+
+	part, err := writer.CreateFormFile("application", filepath.Base(zipFilePath))
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, err = io.Copy(part, file)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, writer.FormDataContentType(), nil
+}
@@ -2,7 +2,9 @@ package ccv2_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -405,4 +407,70 @@ var _ = Describe("Job", func() {
 			})
 		})
 	})
+
+	Describe("UploadApplication", func() {
+		var zipFile *os.File
+
+		BeforeEach(func() {
+			client = NewTestClient()
+
+			var err error
+			zipFile, err = ioutil.TempFile("", "upload-application-test")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = zipFile.WriteString("some-zip-contents")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(zipFile.Close()).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(zipFile.Name())).To(Succeed())
+		})
+
+		Context("when the upload is accepted", func() {
+			BeforeEach(func() {
+				jsonResponse := `{
+					"metadata": {
+						"guid": "job-guid"
+					},
+					"entity": {
+						"guid": "job-guid",
+						"status": "queued"
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid/bits", "async=true"),
+						func(_ http.ResponseWriter, req *http.Request) {
+							Expect(req.ParseMultipartForm(1024)).To(Succeed())
+							Expect(req.MultipartForm.Value["resources"]).To(ConsistOf("[]"))
+
+							files := req.MultipartForm.File["application"]
+							Expect(files).To(HaveLen(1))
+							file, err := files[0].Open()
+							Expect(err).NotTo(HaveOccurred())
+							contents, err := ioutil.ReadAll(file)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(contents)).To(Equal("some-zip-contents"))
+						},
+						RespondWith(http.StatusCreated, jsonResponse, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					))
+			})
+
+			It("uploads the bits and returns the job and all warnings", func() {
+				job, warnings, err := client.UploadApplication("some-app-guid", zipFile.Name())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1", "warning-2"}))
+				Expect(job.GUID).To(Equal("job-guid"))
+				Expect(job.Status).To(Equal(JobStatusQueued))
+			})
+		})
+
+		Context("when the zip file does not exist", func() {
+			It("returns the error", func() {
+				_, _, err := client.UploadApplication("some-app-guid", "/does/not/exist.zip")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
 })
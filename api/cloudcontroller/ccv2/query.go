@@ -14,6 +14,8 @@ type QueryOperator string
 const (
 	// AppGUIDFilter is the name of the App GUID filter.
 	AppGUIDFilter QueryFilter = "app_guid"
+	// DomainGUIDFilter is the name of the domain GUID filter.
+	DomainGUIDFilter QueryFilter = "domain_guid"
 	// OrganizationGUIDFilter is the name of the organization GUID filter.
 	OrganizationGUIDFilter QueryFilter = "organization_guid"
 	// RouteGUIDFilter is the name of the route GUID filter.
@@ -23,8 +25,12 @@ const (
 	// SpaceGUIDFilter is the name of the space GUID filter.
 	SpaceGUIDFilter QueryFilter = "space_guid"
 
+	// HostFilter is the name of the host filter.
+	HostFilter QueryFilter = "host"
 	// NameFilter is the name of the name filter.
 	NameFilter QueryFilter = "name"
+	// PathFilter is the name of the path filter.
+	PathFilter QueryFilter = "path"
 )
 
 const (
@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -14,6 +15,25 @@ type Route struct {
 	Path       string
 	Port       int
 	DomainGUID string
+	SpaceGUID  string
+}
+
+// MarshalJSON converts a route into a Cloud Controller Route.
+func (route Route) MarshalJSON() ([]byte, error) {
+	ccRoute := struct {
+		DomainGUID string `json:"domain_guid"`
+		Host       string `json:"host,omitempty"`
+		Path       string `json:"path,omitempty"`
+		Port       int    `json:"port,omitempty"`
+		SpaceGUID  string `json:"space_guid"`
+	}{
+		DomainGUID: route.DomainGUID,
+		Host:       route.Host,
+		Path:       route.Path,
+		Port:       route.Port,
+		SpaceGUID:  route.SpaceGUID,
+	}
+	return json.Marshal(ccRoute)
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Route response.
@@ -25,6 +45,7 @@ func (route *Route) UnmarshalJSON(data []byte) error {
 			Path       string `json:"path"`
 			Port       int    `json:"port"`
 			DomainGUID string `json:"domain_guid"`
+			SpaceGUID  string `json:"space_guid"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccRoute); err != nil {
@@ -36,6 +57,7 @@ func (route *Route) UnmarshalJSON(data []byte) error {
 	route.Path = ccRoute.Entity.Path
 	route.Port = ccRoute.Entity.Port
 	route.DomainGUID = ccRoute.Entity.DomainGUID
+	route.SpaceGUID = ccRoute.Entity.SpaceGUID
 	return nil
 }
 
@@ -67,6 +89,32 @@ func (client *Client) GetApplicationRoutes(appGUID string, queryParams []Query)
 	return fullRoutesList, warnings, err
 }
 
+// GetRoutes returns a list of Routes based off of the provided queries.
+func (client *Client) GetRoutes(queryParams []Query) ([]Route, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRoutesRequest,
+		Query:       FormatQueryParameters(queryParams),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullRoutesList []Route
+	warnings, err := client.paginate(request, Route{}, func(item interface{}) error {
+		if route, ok := item.(Route); ok {
+			fullRoutesList = append(fullRoutesList, route)
+		} else {
+			return cloudcontroller.UnknownObjectInListError{
+				Expected:   Route{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullRoutesList, warnings, err
+}
+
 // GetSpaceRoutes returns a list of Routes associated with the provided Space
 // GUID, and filtered by the provided queries.
 func (client *Client) GetSpaceRoutes(spaceGUID string, queryParams []Query) ([]Route, Warnings, error) {
@@ -109,3 +157,70 @@ func (client *Client) DeleteRoute(routeGUID string) (Warnings, error) {
 	err = client.connection.Make(request, &response)
 	return response.Warnings, err
 }
+
+// NewRoute creates the provided route in the Cloud Controller. DomainGUID and
+// SpaceGUID are required.
+func (client *Client) NewRoute(route Route) (Route, Warnings, error) {
+	body, err := json.Marshal(route)
+	if err != nil {
+		return Route{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostRouteRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Route{}, nil, err
+	}
+
+	var createdRoute Route
+	response := cloudcontroller.Response{
+		Result: &createdRoute,
+	}
+
+	err = client.connection.Make(request, &response)
+	return createdRoute, response.Warnings, err
+}
+
+// UpdateRouteApplication binds the Route associated with the provided Route
+// GUID to the Application associated with the provided Application GUID.
+func (client *Client) UpdateRouteApplication(routeGUID string, appGUID string) (Route, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutRouteAppRequest,
+		URIParams: map[string]string{
+			"app_guid":   appGUID,
+			"route_guid": routeGUID,
+		},
+	})
+	if err != nil {
+		return Route{}, nil, err
+	}
+
+	var route Route
+	response := cloudcontroller.Response{
+		Result: &route,
+	}
+
+	err = client.connection.Make(request, &response)
+	return route, response.Warnings, err
+}
+
+// DeleteRouteApplication unbinds the Route associated with the provided Route
+// GUID from the Application associated with the provided Application GUID.
+func (client *Client) DeleteRouteApplication(routeGUID string, appGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteRouteAppRequest,
+		URIParams: map[string]string{
+			"app_guid":   appGUID,
+			"route_guid": routeGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
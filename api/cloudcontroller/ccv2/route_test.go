@@ -382,4 +382,173 @@ var _ = Describe("Route", func() {
 			})
 		})
 	})
+
+	Describe("GetRoutes", func() {
+		Context("when the routes exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "route-guid-1"
+							},
+							"entity": {
+								"host": "host-1",
+								"path": "/path",
+								"port": null,
+								"domain_guid": "some-domain-guid",
+								"space_guid": "some-space-guid"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/routes", "q=host:host-1&q=domain_guid:some-domain-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the routes and all warnings", func() {
+				routes, warnings, err := client.GetRoutes([]Query{
+					{Filter: HostFilter, Operator: EqualOperator, Value: "host-1"},
+					{Filter: DomainGUIDFilter, Operator: EqualOperator, Value: "some-domain-guid"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(routes).To(ConsistOf([]Route{
+					{
+						GUID:       "route-guid-1",
+						Host:       "host-1",
+						Path:       "/path",
+						DomainGUID: "some-domain-guid",
+						SpaceGUID:  "some-space-guid",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("NewRoute", func() {
+		Context("when the route is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-route-guid"
+					},
+					"entity": {
+						"host": "some-host",
+						"domain_guid": "some-domain-guid",
+						"space_guid": "some-space-guid"
+					}
+				}`
+				expectedBody := map[string]string{
+					"domain_guid": "some-domain-guid",
+					"host":        "some-host",
+					"space_guid":  "some-space-guid",
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/routes"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created route and all warnings", func() {
+				route, warnings, err := client.NewRoute(Route{
+					Host:       "some-host",
+					DomainGUID: "some-domain-guid",
+					SpaceGUID:  "some-space-guid",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(route).To(Equal(Route{
+					GUID:       "some-route-guid",
+					Host:       "some-host",
+					DomainGUID: "some-domain-guid",
+					SpaceGUID:  "some-space-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UpdateRouteApplication", func() {
+		Context("when the route is bound to the app", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-route-guid"
+					},
+					"entity": {
+						"host": "some-host",
+						"domain_guid": "some-domain-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/routes/some-route-guid/apps/some-app-guid"),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the route and all warnings", func() {
+				route, warnings, err := client.UpdateRouteApplication("some-route-guid", "some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(route).To(Equal(Route{
+					GUID:       "some-route-guid",
+					Host:       "some-host",
+					DomainGUID: "some-domain-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10003,
+					"description": "You are not authorized to perform the requested action",
+					"error_code": "CF-NotAuthorized"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/routes/some-route-guid/apps/some-app-guid"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateRouteApplication("some-route-guid", "some-app-guid")
+				Expect(err).To(MatchError(cloudcontroller.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("DeleteRouteApplication", func() {
+		Context("when the route is unbound from the app", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/routes/some-route-guid/apps/some-app-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				warnings, err := client.DeleteRouteApplication("some-route-guid", "some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -9,13 +10,19 @@ import (
 
 // ServiceBinding represents a Cloud Controller Service Binding.
 type ServiceBinding struct {
-	GUID string
+	GUID                string
+	AppGUID             string
+	ServiceInstanceGUID string
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Binding response.
 func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	var ccServiceBinding struct {
 		Metadata internal.Metadata
+		Entity   struct {
+			AppGUID             string `json:"app_guid"`
+			ServiceInstanceGUID string `json:"service_instance_guid"`
+		}
 	}
 	err := json.Unmarshal(data, &ccServiceBinding)
 	if err != nil {
@@ -23,6 +30,8 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	}
 
 	serviceBinding.GUID = ccServiceBinding.Metadata.GUID
+	serviceBinding.AppGUID = ccServiceBinding.Entity.AppGUID
+	serviceBinding.ServiceInstanceGUID = ccServiceBinding.Entity.ServiceInstanceGUID
 	return nil
 }
 
@@ -53,6 +62,36 @@ func (client *Client) GetServiceBindings(queries []Query) ([]ServiceBinding, War
 	return fullBindingsList, warnings, err
 }
 
+// CreateServiceBinding binds the service instance to the application.
+func (client *Client) CreateServiceBinding(appGUID string, serviceInstanceGUID string) (ServiceBinding, Warnings, error) {
+	body, err := json.Marshal(struct {
+		AppGUID             string `json:"app_guid"`
+		ServiceInstanceGUID string `json:"service_instance_guid"`
+	}{
+		AppGUID:             appGUID,
+		ServiceInstanceGUID: serviceInstanceGUID,
+	})
+	if err != nil {
+		return ServiceBinding{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceBindingRequest,
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return ServiceBinding{}, nil, err
+	}
+
+	var serviceBinding ServiceBinding
+	response := cloudcontroller.Response{
+		Result: &serviceBinding,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceBinding, response.Warnings, err
+}
+
 // DeleteServiceBinding will destroy the requested Service Binding.
 func (client *Client) DeleteServiceBinding(serviceBindingGUID string) (Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
@@ -25,11 +25,19 @@ var _ = Describe("Service Binding", func() {
 					{
 						"metadata": {
 							"guid": "service-binding-guid-1"
+						},
+						"entity": {
+							"app_guid": "some-app-guid",
+							"service_instance_guid": "some-service-instance-guid-1"
 						}
 					},
 					{
 						"metadata": {
 							"guid": "service-binding-guid-2"
+						},
+						"entity": {
+							"app_guid": "some-app-guid",
+							"service_instance_guid": "some-service-instance-guid-2"
 						}
 					}
 				]
@@ -72,8 +80,8 @@ var _ = Describe("Service Binding", func() {
 				}})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(serviceBindings).To(ConsistOf([]ServiceBinding{
-					{GUID: "service-binding-guid-1"},
-					{GUID: "service-binding-guid-2"},
+					{GUID: "service-binding-guid-1", AppGUID: "some-app-guid", ServiceInstanceGUID: "some-service-instance-guid-1"},
+					{GUID: "service-binding-guid-2", AppGUID: "some-app-guid", ServiceInstanceGUID: "some-service-instance-guid-2"},
 					{GUID: "service-binding-guid-3"},
 					{GUID: "service-binding-guid-4"},
 				}))
@@ -82,6 +90,44 @@ var _ = Describe("Service Binding", func() {
 		})
 	})
 
+	Describe("CreateServiceBinding", func() {
+		Context("when the service binding is created", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-binding-guid"
+					},
+					"entity": {
+						"app_guid": "some-app-guid",
+						"service_instance_guid": "some-service-instance-guid"
+					}
+				}`
+				expectedBody := map[string]string{
+					"app_guid":              "some-app-guid",
+					"service_instance_guid": "some-service-instance-guid",
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_bindings"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created service binding and all warnings", func() {
+				serviceBinding, warnings, err := client.CreateServiceBinding("some-app-guid", "some-service-instance-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceBinding).To(Equal(ServiceBinding{
+					GUID:                "some-service-binding-guid",
+					AppGUID:             "some-app-guid",
+					ServiceInstanceGUID: "some-service-instance-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("DeleteServiceBinding", func() {
 		Context("when the service binding exist", func() {
 			BeforeEach(func() {
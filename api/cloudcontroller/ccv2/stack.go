@@ -51,3 +51,29 @@ func (client *Client) GetStack(guid string) (Stack, Warnings, error) {
 	err = client.connection.Make(request, &response)
 	return stack, response.Warnings, err
 }
+
+// GetStacks returns a list of Stacks based off of the provided queries.
+func (client *Client) GetStacks(queries []Query) ([]Stack, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetStacksRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullStacksList []Stack
+	warnings, err := client.paginate(request, Stack{}, func(item interface{}) error {
+		if stack, ok := item.(Stack); ok {
+			fullStacksList = append(fullStacksList, stack)
+		} else {
+			return cloudcontroller.UnknownObjectInListError{
+				Expected:   Stack{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullStacksList, warnings, err
+}
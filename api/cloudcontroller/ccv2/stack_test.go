@@ -74,4 +74,46 @@ var _ = Describe("Stack", func() {
 			})
 		})
 	})
+
+	Describe("GetStacks", func() {
+		Context("when the stacks are found", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {
+								"guid": "some-stack-guid"
+							},
+							"entity": {
+								"name": "some-stack-name",
+								"description": "some stack description"
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/stacks", "q=name:some-stack-name"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the stacks and all warnings", func() {
+				stacks, warnings, err := client.GetStacks([]Query{{
+					Filter:   NameFilter,
+					Operator: EqualOperator,
+					Value:    "some-stack-name",
+				}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stacks).To(ConsistOf(Stack{
+					GUID:        "some-stack-guid",
+					Name:        "some-stack-name",
+					Description: "some stack description",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
 })
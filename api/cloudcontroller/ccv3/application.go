@@ -9,10 +9,18 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
+type ApplicationState string
+
+const (
+	ApplicationStateStarted ApplicationState = "STARTED"
+	ApplicationStateStopped ApplicationState = "STOPPED"
+)
+
 // Application represents a Cloud Controller V3 Application.
 type Application struct {
 	Name          string                   `json:"name"`
 	GUID          string                   `json:"guid,omitempty"`
+	State         ApplicationState         `json:"state,omitempty"`
 	Relationships ApplicationRelationships `json:"relationships"`
 }
 
@@ -66,3 +74,32 @@ func (client *Client) CreateApplication(app Application) (Application, Warnings,
 
 	return responseApp, response.Warnings, err
 }
+
+// UpdateApplicationStart starts the application with the given GUID using its
+// current droplet.
+func (client *Client) UpdateApplicationStart(appGUID string) (Application, Warnings, error) {
+	return client.updateApplicationState(internal.PostApplicationStartRequest, appGUID)
+}
+
+// UpdateApplicationStop stops the application with the given GUID.
+func (client *Client) UpdateApplicationStop(appGUID string) (Application, Warnings, error) {
+	return client.updateApplicationState(internal.PostApplicationStopRequest, appGUID)
+}
+
+func (client *Client) updateApplicationState(requestName string, appGUID string) (Application, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   internal.Params{"guid": appGUID},
+	})
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	var responseApp Application
+	response := cloudcontroller.Response{
+		Result: &responseApp,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseApp, response.Warnings, err
+}
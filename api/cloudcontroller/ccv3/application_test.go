@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -219,4 +220,116 @@ var _ = Describe("Application", func() {
 			})
 		})
 	})
+
+	Describe("UpdateApplicationStart", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				response := `{
+	"guid": "some-app-guid",
+	"name": "some-app-name",
+	"state": "STARTED"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/actions/start"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the application and all warnings", func() {
+				app, warnings, err := client.UpdateApplicationStart("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(app).To(Equal(Application{
+					GUID:  "some-app-guid",
+					Name:  "some-app-name",
+					State: ApplicationStateStarted,
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10010,
+			"detail": "App not found",
+			"title": "CF-ResourceNotFound"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/actions/start"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateApplicationStart("some-app-guid")
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationStop", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				response := `{
+	"guid": "some-app-guid",
+	"name": "some-app-name",
+	"state": "STOPPED"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/actions/stop"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the application and all warnings", func() {
+				app, warnings, err := client.UpdateApplicationStop("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(app).To(Equal(Application{
+					GUID:  "some-app-guid",
+					Name:  "some-app-name",
+					State: ApplicationStateStopped,
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10010,
+			"detail": "App not found",
+			"title": "CF-ResourceNotFound"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/actions/stop"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateApplicationStop("some-app-guid")
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
@@ -0,0 +1,107 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+type BuildState string
+
+const (
+	BuildStateStaging BuildState = "STAGING"
+	BuildStateStaged  BuildState = "STAGED"
+	BuildStateFailed  BuildState = "FAILED"
+)
+
+// Build represents the staging of a package into a droplet.
+type Build struct {
+	GUID        string
+	State       BuildState
+	Error       string
+	PackageGUID string
+	DropletGUID string
+}
+
+func (b Build) MarshalJSON() ([]byte, error) {
+	var ccBuild struct {
+		Package struct {
+			GUID string `json:"guid"`
+		} `json:"package"`
+	}
+
+	ccBuild.Package.GUID = b.PackageGUID
+	return json.Marshal(ccBuild)
+}
+
+func (b *Build) UnmarshalJSON(data []byte) error {
+	var ccBuild struct {
+		GUID    string     `json:"guid"`
+		State   BuildState `json:"state"`
+		Error   string     `json:"error"`
+		Package struct {
+			GUID string `json:"guid"`
+		} `json:"package"`
+		Droplet struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+	}
+
+	err := json.Unmarshal(data, &ccBuild)
+	if err != nil {
+		return err
+	}
+
+	b.GUID = ccBuild.GUID
+	b.State = ccBuild.State
+	b.Error = ccBuild.Error
+	b.PackageGUID = ccBuild.Package.GUID
+	b.DropletGUID = ccBuild.Droplet.GUID
+	return nil
+}
+
+// CreateBuild stages the build's package into a droplet. The PackageGUID must
+// be set.
+func (client *Client) CreateBuild(build Build) (Build, Warnings, error) {
+	bodyBytes, err := json.Marshal(build)
+	if err != nil {
+		return Build{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostBuildRequest,
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Build{}, nil, err
+	}
+
+	var responseBuild Build
+	response := cloudcontroller.Response{
+		Result: &responseBuild,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseBuild, response.Warnings, err
+}
+
+// GetBuild returns the build with the given GUID.
+func (client *Client) GetBuild(guid string) (Build, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildRequest,
+		URIParams:   internal.Params{"guid": guid},
+	})
+	if err != nil {
+		return Build{}, nil, err
+	}
+
+	var responseBuild Build
+	response := cloudcontroller.Response{
+		Result: &responseBuild,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseBuild, response.Warnings, err
+}
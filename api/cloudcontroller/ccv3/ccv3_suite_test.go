@@ -91,6 +91,9 @@ func SetupV3Response() {
 			"apps": {
 				"href": "SERVER_URL/v3/apps"
 			},
+			"builds": {
+				"href": "SERVER_URL/v3/builds"
+			},
 			"tasks": {
 				"href": "SERVER_URL/v3/tasks"
 			},
@@ -105,6 +108,9 @@ func SetupV3Response() {
 			},
 			"packages": {
 				"href": "SERVER_URL/v3/packages"
+			},
+			"processes": {
+				"href": "SERVER_URL/v3/processes"
 			}
 		}
 	}`, "SERVER_URL", serverURL, -1)
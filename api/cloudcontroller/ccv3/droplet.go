@@ -0,0 +1,35 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// SetApplicationDroplet sets the current droplet of the application. The
+// droplet is used the next time the application is started.
+func (client *Client) SetApplicationDroplet(appGUID string, dropletGUID string) (Relationship, Warnings, error) {
+	body, err := json.Marshal(Relationship{GUID: dropletGUID})
+	if err != nil {
+		return Relationship{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchApplicationCurrentDropletRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Relationship{}, nil, err
+	}
+
+	var relationship Relationship
+	response := cloudcontroller.Response{
+		Result: &relationship,
+	}
+	err = client.connection.Make(request, &response)
+
+	return relationship, response.Warnings, err
+}
@@ -0,0 +1,71 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Droplet", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("SetApplicationDroplet", func() {
+		Context("when the droplet is set successfully", func() {
+			BeforeEach(func() {
+				response := `{
+	"data": {
+		"guid": "some-droplet-guid"
+	}
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid/relationships/current_droplet"),
+						VerifyJSON(`{"data":{"guid":"some-droplet-guid"}}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the droplet relationship and all warnings", func() {
+				relationship, warnings, err := client.SetApplicationDroplet("some-app-guid", "some-droplet-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(relationship).To(Equal(Relationship{GUID: "some-droplet-guid"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10008,
+			"detail": "Unable to assign current droplet. Ensure the droplet exists and belongs to this app.",
+			"title": "CF-UnprocessableEntity"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid/relationships/current_droplet"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.SetApplicationDroplet("some-app-guid", "some-droplet-guid")
+				Expect(err).To(MatchError(cloudcontroller.UnprocessableEntityError{Message: "Unable to assign current droplet. Ensure the droplet exists and belongs to this app."}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -7,6 +7,8 @@ import (
 	"net/url"
 	"path"
 	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
 // Params map path keys to values.  For example, if your route has the path
@@ -66,6 +68,9 @@ type Route struct {
 	// Resource is a key specifying which resource root the router should
 	// associate with the endpoint at runtime.
 	Resource string
+	// Idempotent marks POST endpoints that can safely be resent, such as
+	// actions that move a resource into an absolute state.
+	Idempotent bool
 }
 
 // CreatePath combines the route's path pattern with a Params map
@@ -134,7 +139,15 @@ func (router Router) CreateRequest(name string, params Params, body io.Reader) (
 		return &http.Request{}, err
 	}
 
-	return http.NewRequest(route.Method, url, body)
+	request, err := http.NewRequest(route.Method, url, body)
+	if err != nil {
+		return &http.Request{}, err
+	}
+
+	if route.Idempotent {
+		request = cloudcontroller.MarkIdempotent(request)
+	}
+	return request, nil
 }
 
 func (router Router) urlFrom(resource string, uri string) (string, error) {
@@ -3,6 +3,7 @@ package internal_test
 import (
 	"net/http"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 
 	. "github.com/onsi/ginkgo"
@@ -105,6 +106,18 @@ var _ = Describe("Routing", func() {
 						Expect(err).ToNot(HaveOccurred())
 						Expect(request.URL.String()).To(Equal("https://foo.bar.baz/this/is/very/good/Henry%2520the%25208th"))
 					})
+
+					Context("when the route is idempotent", func() {
+						BeforeEach(func() {
+							routes = append(routes, Route{Name: "apple", Resource: "exists", Path: "/very/safe", Method: http.MethodPost, Idempotent: true})
+						})
+
+						It("marks the request as idempotent", func() {
+							request, err := router.CreateRequest("apple", nil, nil)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(cloudcontroller.IsIdempotent(request)).To(BeTrue())
+						})
+					})
 				})
 
 				Context("when the resource exists exists", func() {
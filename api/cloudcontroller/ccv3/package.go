@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	"net/url"
 	"os"
 	"path/filepath"
 
@@ -33,6 +34,7 @@ const (
 
 type Package struct {
 	GUID          string               `json:"guid,omitempty"`
+	CreatedAt     string               `json:"created_at,omitempty"`
 	Links         APILinks             `json:"links,omitempty"`
 	Relationships PackageRelationships `json:"relationships"`
 	State         PackageState         `json:"state,omitempty"`
@@ -70,6 +72,34 @@ func (client *Client) GetPackage(guid string) (Package, Warnings, error) {
 	return responsePackage, response.Warnings, err
 }
 
+// GetApplicationPackages returns a list of packages associated with the
+// provided application GUID.
+func (client *Client) GetApplicationPackages(appGUID string, query url.Values) ([]Package, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppPackagesRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullPackagesList []Package
+	warnings, err := client.paginate(request, Package{}, func(item interface{}) error {
+		if pkg, ok := item.(Package); ok {
+			fullPackagesList = append(fullPackagesList, pkg)
+		} else {
+			return cloudcontroller.UnknownObjectInListError{
+				Expected:   Package{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullPackagesList, warnings, err
+}
+
 // CreatePackage creates a package with the given settings, Type and the Space
 // must be set.
 func (client *Client) CreatePackage(pkg Package) (Package, Warnings, error) {
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -106,6 +108,100 @@ var _ = Describe("Package", func() {
 		})
 	})
 
+	Describe("GetApplicationPackages", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+  "pagination": {
+    "next": {
+      "href": "%s/v3/apps/some-app-guid/packages?per_page=1&page=2"
+    }
+  },
+  "resources": [
+    {
+      "guid": "package-1-guid",
+      "type": "bits",
+      "state": "READY",
+      "created_at": "2017-04-20T12:00:00Z"
+    }
+  ]
+}`, server.URL())
+				response2 := `{
+  "pagination": {
+    "next": null
+  },
+  "resources": [
+    {
+      "guid": "package-2-guid",
+      "type": "docker",
+      "state": "PROCESSING_UPLOAD",
+      "created_at": "2017-04-21T12:00:00Z"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/packages", "per_page=1"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/packages", "per_page=1&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the packages of the application and all warnings", func() {
+				packages, warnings, err := client.GetApplicationPackages("some-app-guid", url.Values{"per_page": []string{"1"}})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(packages).To(Equal([]Package{
+					{
+						GUID:      "package-1-guid",
+						Type:      PackageTypeBits,
+						State:     PackageStateReady,
+						CreatedAt: "2017-04-20T12:00:00Z",
+					},
+					{
+						GUID:      "package-2-guid",
+						Type:      PackageTypeDocker,
+						State:     PackageStateProcessingUpload,
+						CreatedAt: "2017-04-21T12:00:00Z",
+					},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "App not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/packages"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationPackages("some-app-guid", nil)
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("CreatePackage", func() {
 		Context("when the package successfully is created", func() {
 			BeforeEach(func() {
@@ -0,0 +1,152 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Process represents a Cloud Controller V3 Process.
+type Process struct {
+	GUID       string `json:"guid"`
+	Type       string `json:"type"`
+	Instances  int    `json:"instances"`
+	MemoryInMB uint64 `json:"memory_in_mb"`
+	DiskInMB   uint64 `json:"disk_in_mb"`
+}
+
+// ProcessScaleOptions are the values a process is scaled to. Values left nil
+// are not changed.
+type ProcessScaleOptions struct {
+	Instances  *int    `json:"instances,omitempty"`
+	MemoryInMB *uint64 `json:"memory_in_mb,omitempty"`
+	DiskInMB   *uint64 `json:"disk_in_mb,omitempty"`
+}
+
+type ProcessInstanceState string
+
+const (
+	ProcessInstanceStateRunning  ProcessInstanceState = "RUNNING"
+	ProcessInstanceStateCrashed  ProcessInstanceState = "CRASHED"
+	ProcessInstanceStateStarting ProcessInstanceState = "STARTING"
+	ProcessInstanceStateDown     ProcessInstanceState = "DOWN"
+)
+
+// ProcessInstance represents a single instance of a process.
+type ProcessInstance struct {
+	Index       int
+	State       ProcessInstanceState
+	Uptime      int
+	CPU         float64
+	MemoryUsage uint64
+	MemoryQuota uint64
+	DiskUsage   uint64
+	DiskQuota   uint64
+}
+
+func (instance *ProcessInstance) UnmarshalJSON(data []byte) error {
+	var ccInstance struct {
+		Index     int                  `json:"index"`
+		State     ProcessInstanceState `json:"state"`
+		Uptime    int                  `json:"uptime"`
+		MemQuota  uint64               `json:"mem_quota"`
+		DiskQuota uint64               `json:"disk_quota"`
+		Usage     struct {
+			CPU  float64 `json:"cpu"`
+			Mem  uint64  `json:"mem"`
+			Disk uint64  `json:"disk"`
+		} `json:"usage"`
+	}
+
+	err := json.Unmarshal(data, &ccInstance)
+	if err != nil {
+		return err
+	}
+
+	instance.Index = ccInstance.Index
+	instance.State = ccInstance.State
+	instance.Uptime = ccInstance.Uptime
+	instance.CPU = ccInstance.Usage.CPU
+	instance.MemoryUsage = ccInstance.Usage.Mem
+	instance.MemoryQuota = ccInstance.MemQuota
+	instance.DiskUsage = ccInstance.Usage.Disk
+	instance.DiskQuota = ccInstance.DiskQuota
+	return nil
+}
+
+// GetApplicationProcesses lists the processes of the application with the
+// given GUID.
+func (client *Client) GetApplicationProcesses(appGUID string) ([]Process, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppProcessesRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullProcessesList []Process
+	warnings, err := client.paginate(request, Process{}, func(item interface{}) error {
+		if process, ok := item.(Process); ok {
+			fullProcessesList = append(fullProcessesList, process)
+		} else {
+			return cloudcontroller.UnknownObjectInListError{
+				Expected:   Process{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullProcessesList, warnings, err
+}
+
+// GetProcessInstances returns the state of each instance of the process with
+// the given GUID.
+func (client *Client) GetProcessInstances(processGUID string) ([]ProcessInstance, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetProcessStatsRequest,
+		URIParams:   internal.Params{"guid": processGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var instances struct {
+		Resources []ProcessInstance `json:"resources"`
+	}
+	response := cloudcontroller.Response{
+		Result: &instances,
+	}
+	err = client.connection.Make(request, &response)
+
+	return instances.Resources, response.Warnings, err
+}
+
+// UpdateApplicationProcessScale scales the process of the given type belonging
+// to the application with the given GUID.
+func (client *Client) UpdateApplicationProcessScale(appGUID string, processType string, scale ProcessScaleOptions) (Process, Warnings, error) {
+	body, err := json.Marshal(scale)
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostApplicationProcessScaleRequest,
+		URIParams:   internal.Params{"guid": appGUID, "type": processType},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	var process Process
+	response := cloudcontroller.Response{
+		Result: &process,
+	}
+	err = client.connection.Make(request, &response)
+
+	return process, response.Warnings, err
+}
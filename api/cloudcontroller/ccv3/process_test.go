@@ -0,0 +1,271 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Process", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationProcesses", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+	"pagination": {
+		"next": {
+			"href": "%s/v3/apps/some-app-guid/processes?page=2"
+		}
+	},
+	"resources": [
+		{
+			"guid": "process-1-guid",
+			"type": "web",
+			"instances": 2,
+			"memory_in_mb": 32,
+			"disk_in_mb": 1024
+		}
+	]
+}`, server.URL())
+				response2 := `{
+	"pagination": {
+		"next": null
+	},
+	"resources": [
+		{
+			"guid": "process-2-guid",
+			"type": "worker",
+			"instances": 0
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/processes"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/processes", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+					),
+				)
+			})
+
+			It("returns a list of processes and all warnings", func() {
+				processes, warnings, err := client.GetApplicationProcesses("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(processes).To(ConsistOf(
+					Process{GUID: "process-1-guid", Type: "web", Instances: 2, MemoryInMB: 32, DiskInMB: 1024},
+					Process{GUID: "process-2-guid", Type: "worker", Instances: 0},
+				))
+				Expect(warnings).To(ConsistOf("this is a warning", "this is another warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10010,
+			"detail": "App not found",
+			"title": "CF-ResourceNotFound"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/processes"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationProcesses("some-app-guid")
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetProcessInstances", func() {
+		Context("when the process exists", func() {
+			BeforeEach(func() {
+				response := `{
+	"resources": [
+		{
+			"type": "web",
+			"index": 0,
+			"state": "RUNNING",
+			"usage": {
+				"cpu": 0.01,
+				"mem": 1000000,
+				"disk": 2000000
+			},
+			"mem_quota": 2000000,
+			"disk_quota": 4000000,
+			"uptime": 123
+		},
+		{
+			"type": "web",
+			"index": 1,
+			"state": "STARTING",
+			"uptime": 0
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/processes/some-process-guid/stats"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the instances and all warnings", func() {
+				instances, warnings, err := client.GetProcessInstances("some-process-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(instances).To(Equal([]ProcessInstance{
+					{
+						Index:       0,
+						State:       ProcessInstanceStateRunning,
+						Uptime:      123,
+						CPU:         0.01,
+						MemoryUsage: 1000000,
+						MemoryQuota: 2000000,
+						DiskUsage:   2000000,
+						DiskQuota:   4000000,
+					},
+					{Index: 1, State: ProcessInstanceStateStarting, Uptime: 0},
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10010,
+			"detail": "Process not found",
+			"title": "CF-ResourceNotFound"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/processes/some-process-guid/stats"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetProcessInstances("some-process-guid")
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "Process not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationProcessScale", func() {
+		Context("when the process is scaled", func() {
+			BeforeEach(func() {
+				response := `{
+	"guid": "some-process-guid",
+	"type": "worker",
+	"instances": 3,
+	"memory_in_mb": 32,
+	"disk_in_mb": 1024
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/processes/worker/actions/scale"),
+						VerifyJSON(`{"instances": 3}`),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("only sends the values being changed and returns the process and all warnings", func() {
+				instances := 3
+				process, warnings, err := client.UpdateApplicationProcessScale("some-app-guid", "worker", ProcessScaleOptions{Instances: &instances})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(process).To(Equal(Process{
+					GUID:       "some-process-guid",
+					Type:       "worker",
+					Instances:  3,
+					MemoryInMB: 32,
+					DiskInMB:   1024,
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when scaling to zero instances with new limits", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/processes/web/actions/scale"),
+						VerifyJSON(`{"instances": 0, "memory_in_mb": 64, "disk_in_mb": 512}`),
+						RespondWith(http.StatusAccepted, `{}`),
+					),
+				)
+			})
+
+			It("sends every value", func() {
+				instances := 0
+				var memory, disk uint64 = 64, 512
+				_, _, err := client.UpdateApplicationProcessScale("some-app-guid", "web", ProcessScaleOptions{
+					Instances:  &instances,
+					MemoryInMB: &memory,
+					DiskInMB:   &disk,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	"errors": [
+		{
+			"code": 10010,
+			"detail": "Process not found",
+			"title": "CF-ResourceNotFound"
+		}
+	]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/processes/worker/actions/scale"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateApplicationProcessScale("some-app-guid", "worker", ProcessScaleOptions{})
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "Process not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
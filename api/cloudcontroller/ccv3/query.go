@@ -9,4 +9,15 @@ const (
 	OrganizationGUIDFilter = "organization_guids"
 	// SpaceGUIDFilter is a query paramater for listing objects by Space GUID.
 	SpaceGUIDFilter = "space_guids"
+	// StateFilter is a query paramater for listing objects by state.
+	StateFilter = "states"
+	// CreatedAfterFilter is a query paramater for listing objects created
+	// after a timestamp.
+	CreatedAfterFilter = "created_ats[gt]"
+
+	// OrderBy is a query paramater for sorting listed objects. Prefix the
+	// field with a '-' to sort in descending order.
+	OrderBy = "order_by"
+	// PerPage is a query paramater for the number of objects on a page.
+	PerPage = "per_page"
 )
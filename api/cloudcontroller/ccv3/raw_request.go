@@ -0,0 +1,79 @@
+package ccv3
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// RawRequest represents an arbitrary request to the Cloud Controller API.
+type RawRequest struct {
+	// Method is the HTTP method. Defaults to GET.
+	Method string
+
+	// Path is the request path, including any query string, relative to the
+	// Cloud Controller API root (e.g. /v2/apps?q=name:some-app).
+	Path string
+
+	// Headers are set on the request in addition to the client defaults,
+	// overriding them where both are present.
+	Headers http.Header
+
+	// Body is the content of the request.
+	Body []byte
+}
+
+// RawResponse represents the unparsed Cloud Controller response to a
+// RawRequest.
+type RawResponse struct {
+	StatusCode int
+	Headers    http.Header
+	Body       []byte
+}
+
+// MakeRawRequest sends the request through the client's connection, so it is
+// authenticated, retried and logged like any other client request. Responses
+// with 4xx and 5xx status codes are returned rather than converted to errors;
+// an error is only returned when no response was received.
+func (client *Client) MakeRawRequest(rawRequest RawRequest) (RawResponse, Warnings, error) {
+	method := rawRequest.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body io.Reader
+	if rawRequest.Body != nil {
+		body = bytes.NewReader(rawRequest.Body)
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		URL:    strings.TrimSuffix(client.cloudControllerURL, "/") + "/" + strings.TrimPrefix(rawRequest.Path, "/"),
+		Method: method,
+		Body:   body,
+	})
+	if err != nil {
+		return RawResponse{}, nil, err
+	}
+
+	for name, values := range rawRequest.Headers {
+		request.Header.Del(name)
+		for _, value := range values {
+			request.Header.Add(name, value)
+		}
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	if response.HTTPResponse == nil {
+		return RawResponse{}, response.Warnings, err
+	}
+
+	return RawResponse{
+		StatusCode: response.HTTPResponse.StatusCode,
+		Headers:    response.HTTPResponse.Header,
+		Body:       response.RawResponse,
+	}, response.Warnings, nil
+}
@@ -0,0 +1,101 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Raw Request", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("MakeRawRequest", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/apps", "q=name:some-app"),
+						VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
+						VerifyHeaderKV("X-Custom", "some-value"),
+						VerifyBody([]byte("some-body")),
+						RespondWith(http.StatusCreated, `{"some":"response"}`, http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+							"X-Some-Header": {"some-header-value"},
+						}),
+					),
+				)
+			})
+
+			It("returns the raw response and all warnings", func() {
+				response, warnings, err := client.MakeRawRequest(RawRequest{
+					Method: http.MethodPost,
+					Path:   "/v2/apps?q=name:some-app",
+					Headers: http.Header{
+						"Content-Type": {"application/x-www-form-urlencoded"},
+						"X-Custom":     {"some-value"},
+					},
+					Body: []byte("some-body"),
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				Expect(response.Headers.Get("X-Some-Header")).To(Equal("some-header-value"))
+				Expect(string(response.Body)).To(Equal(`{"some":"response"}`))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when no method is provided", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps"),
+						RespondWith(http.StatusOK, `{}`),
+					),
+				)
+			})
+
+			It("makes a GET request", func() {
+				response, _, err := client.MakeRawRequest(RawRequest{Path: "v3/apps"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when the cloud controller returns an error status code", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the response rather than an error", func() {
+				response, warnings, err := client.MakeRawRequest(RawRequest{Path: "/v3/apps/some-guid"})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				Expect(string(response.Body)).To(ContainSubstring("CF-ResourceNotFound"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
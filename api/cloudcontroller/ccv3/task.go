@@ -11,16 +11,47 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
+const (
+	TaskStatePending   = "PENDING"
+	TaskStateRunning   = "RUNNING"
+	TaskStateSucceeded = "SUCCEEDED"
+	TaskStateFailed    = "FAILED"
+	TaskStateCanceling = "CANCELING"
+)
+
 // Task represents a Cloud Controller V3 Task.
 type Task struct {
-	GUID       string `json:"guid,omitempty"`
-	SequenceID int    `json:"sequence_id,omitempty"`
-	Name       string `json:"name,omitempty"`
-	Command    string `json:"command"`
-	State      string `json:"state,omitempty"`
-	CreatedAt  string `json:"created_at,omitempty"`
-	MemoryInMB uint64 `json:"memory_in_mb,omitempty"`
-	DiskInMB   uint64 `json:"disk_in_mb,omitempty"`
+	GUID        string `json:"guid,omitempty"`
+	SequenceID  int    `json:"sequence_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Command     string `json:"command"`
+	State       string `json:"state,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	MemoryInMB  uint64 `json:"memory_in_mb,omitempty"`
+	DiskInMB    uint64 `json:"disk_in_mb,omitempty"`
+	DropletGUID string `json:"droplet_guid,omitempty"`
+
+	// FailureReason is set by the Cloud Controller when the task has failed.
+	FailureReason string `json:"failure_reason,omitempty"`
+}
+
+func (t *Task) UnmarshalJSON(data []byte) error {
+	type rawTask Task
+	var ccTask struct {
+		rawTask
+		Result struct {
+			FailureReason string `json:"failure_reason"`
+		} `json:"result"`
+	}
+
+	err := json.Unmarshal(data, &ccTask)
+	if err != nil {
+		return err
+	}
+
+	*t = Task(ccTask.rawTask)
+	t.FailureReason = ccTask.Result.FailureReason
+	return nil
 }
 
 // NewTask runs a command in the Application environment associated with the
@@ -90,6 +121,74 @@ func (client *Client) GetApplicationTasks(appGUID string, query url.Values) ([]T
 	return fullTasksList, warnings, err
 }
 
+// GetApplicationTasksPage returns only the first page of tasks associated
+// with the provided application GUID. Use the PerPage query to set the
+// number of tasks returned.
+func (client *Client) GetApplicationTasksPage(appGUID string, query url.Values) ([]Task, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppTasksRequest,
+		URIParams: internal.Params{
+			"guid": appGUID,
+		},
+		Query: query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wrapper := NewPaginatedResources(Task{})
+	response := cloudcontroller.Response{
+		Result: &wrapper,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, response.Warnings, err
+	}
+
+	list, err := wrapper.Resources()
+	if err != nil {
+		return nil, response.Warnings, err
+	}
+
+	var tasks []Task
+	for _, item := range list {
+		task, ok := item.(Task)
+		if !ok {
+			return nil, response.Warnings, cloudcontroller.UnknownObjectInListError{
+				Expected:   Task{},
+				Unexpected: item,
+			}
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, response.Warnings, nil
+}
+
+// GetTask returns the task with the provided GUID.
+func (client *Client) GetTask(taskGUID string) (Task, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		URL:    fmt.Sprintf("%s/v3/tasks/%s", client.cloudControllerURL, taskGUID),
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return Task{}, nil, err
+	}
+
+	var task Task
+	response := cloudcontroller.Response{
+		Result: &task,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return Task{}, response.Warnings, err
+	}
+
+	return task, response.Warnings, nil
+}
+
 // UpdateTask cancels a task.
 func (client *Client) UpdateTask(taskGUID string) (Task, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
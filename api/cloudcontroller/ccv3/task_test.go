@@ -332,6 +332,154 @@ var _ = Describe("Task", func() {
 		})
 	})
 
+	Describe("GetApplicationTasksPage", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				response := fmt.Sprintf(`{
+  "pagination": {
+    "next": {
+      "href": "%s/v3/apps/some-app-guid/tasks?per_page=1&page=2"
+    }
+  },
+  "resources": [
+    {
+      "guid": "task-2-guid",
+      "sequence_id": 2,
+      "name": "task-2",
+      "command": "some-command",
+      "state": "FAILED",
+      "created_at": "2016-11-07T06:59:01Z",
+      "droplet_guid": "some-droplet-guid",
+      "result": {
+        "failure_reason": "Exited with status 1"
+      }
+    }
+  ]
+}`, server.URL())
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/tasks", "order_by=-created_at&per_page=1"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns only the first page of tasks and all warnings", func() {
+				tasks, warnings, err := client.GetApplicationTasksPage("some-app-guid", url.Values{
+					OrderBy: []string{"-created_at"},
+					PerPage: []string{"1"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(tasks).To(ConsistOf(
+					Task{
+						GUID:          "task-2-guid",
+						SequenceID:    2,
+						Name:          "task-2",
+						State:         "FAILED",
+						CreatedAt:     "2016-11-07T06:59:01Z",
+						Command:       "some-command",
+						DropletGUID:   "some-droplet-guid",
+						FailureReason: "Exited with status 1",
+					},
+				))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "App not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/tasks"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and all warnings", func() {
+				_, warnings, err := client.GetApplicationTasksPage("some-app-guid", nil)
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
+	Describe("GetTask", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+          "guid": "task-3-guid",
+          "sequence_id": 3,
+          "name": "task-3",
+          "command": "some-command",
+          "state": "FAILED",
+          "result": {
+            "failure_reason": "Exited with status 1"
+          },
+          "created_at": "2016-11-07T07:59:01Z"
+        }`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks/some-task-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the task with its failure reason and warnings", func() {
+				task, warnings, err := client.GetTask("some-task-guid")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(task).To(Equal(Task{
+					GUID:          "task-3-guid",
+					SequenceID:    3,
+					Name:          "task-3",
+					Command:       "some-command",
+					State:         TaskStateFailed,
+					CreatedAt:     "2016-11-07T07:59:01Z",
+					FailureReason: "Exited with status 1",
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		Context("when the task does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Task not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/tasks/some-task-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetTask("some-task-guid")
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "Task not found"}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
 	Describe("UpdateTask", func() {
 		Context("when the request succeeds", func() {
 			BeforeEach(func() {
@@ -0,0 +1,26 @@
+package cloudcontroller
+
+import (
+	"context"
+	"net/http"
+)
+
+type idempotentKey struct{}
+
+// MarkIdempotent returns a copy of the request flagged as safe to resend. POST
+// requests are only retried by connection wrappers when they carry this flag.
+func MarkIdempotent(request *http.Request) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), idempotentKey{}, true))
+}
+
+// IsIdempotent returns true if the request can be resent without side
+// effects. All methods other than POST are considered idempotent; POST
+// requests must be flagged with MarkIdempotent.
+func IsIdempotent(request *http.Request) bool {
+	if request.Method != http.MethodPost {
+		return true
+	}
+
+	idempotent, _ := request.Context().Value(idempotentKey{}).(bool)
+	return idempotent
+}
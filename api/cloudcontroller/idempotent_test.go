@@ -0,0 +1,33 @@
+package cloudcontroller_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Idempotent", func() {
+	Describe("IsIdempotent", func() {
+		It("returns true for non-POST requests", func() {
+			for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+				request, err := http.NewRequest(method, "https://foo.bar.com/banana", nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(IsIdempotent(request)).To(BeTrue())
+			}
+		})
+
+		It("returns false for POST requests", func() {
+			request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(IsIdempotent(request)).To(BeFalse())
+		})
+
+		It("returns true for POST requests marked with MarkIdempotent", func() {
+			request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(IsIdempotent(MarkIdempotent(request))).To(BeTrue())
+		})
+	})
+})
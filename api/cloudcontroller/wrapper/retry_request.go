@@ -2,23 +2,83 @@ package wrapper
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryPolicy configures when and how often RetryRequest retries a failed
+// request.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a request is retried after the first
+	// attempt fails.
+	MaxRetries int
+
+	// BaseDelay is the delay before the first retry. Each subsequent retry
+	// doubles the delay of the previous one.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between two attempts, including delays requested
+	// by a Retry-After header. A zero value leaves the delay uncapped.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of each backoff delay that is
+	// randomized to avoid clients retrying in lockstep.
+	Jitter float64
+}
+
+// DefaultRetryPolicy returns the policy used by the CLI: exponential backoff
+// starting at half a second, capped at 30 seconds, with 50% jitter.
+func DefaultRetryPolicy(maxRetries int) RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: maxRetries,
+		BaseDelay:  500 * time.Millisecond,
+		MaxDelay:   30 * time.Second,
+		Jitter:     0.5,
+	}
+}
+
+//go:generate counterfeiter . RetryConfig
+
+// RetryConfig provides the user configurable settings of the retry policy.
+type RetryConfig interface {
+	RetryMax() int
+	RetryMaxDelay() time.Duration
+}
+
+// NewRetryPolicy returns the DefaultRetryPolicy with the number of retries and
+// the maximum delay taken from the config.
+func NewRetryPolicy(config RetryConfig) RetryPolicy {
+	policy := DefaultRetryPolicy(config.RetryMax())
+	policy.MaxDelay = config.RetryMaxDelay()
+	return policy
+}
+
+// RetryRequest is a wrapper that retries failed requests if they are rate
+// limited (429) or contain a 500, 502, 503 or 504 status code.
 type RetryRequest struct {
-	maxRetries int
+	policy     RetryPolicy
+	outputs    []RequestLoggerOutput
 	connection cloudcontroller.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
+// NewRetryRequest returns a pointer to a RetryRequest wrapper that retries
+// immediately, without any backoff.
 func NewRetryRequest(maxRetries int) *RetryRequest {
+	return NewRetryRequestWithPolicy(RetryPolicy{MaxRetries: maxRetries})
+}
+
+// NewRetryRequestWithPolicy returns a pointer to a RetryRequest wrapper using
+// the given policy. Every retry is reported to the provided outputs.
+func NewRetryRequestWithPolicy(policy RetryPolicy, outputs ...RequestLoggerOutput) *RetryRequest {
 	return &RetryRequest{
-		maxRetries: maxRetries,
+		policy:  policy,
+		outputs: outputs,
 	}
 }
 
@@ -28,12 +88,16 @@ func (retry *RetryRequest) Wrap(innerconnection cloudcontroller.Connection) clou
 	return retry
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it is rate limited or comes back with a 5XX
+// status code. Rate limited requests are always retried since the Cloud
+// Controller did not process them; otherwise POST requests are only retried
+// when they are marked idempotent. A Retry-After header in the response takes
+// precedence over the backoff delay.
 func (retry *RetryRequest) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
 	var err error
 	var rawRequestBody []byte
 
-	if request.Body != nil && request.Method != http.MethodPost {
+	if request.Body != nil {
 		rawRequestBody, err = ioutil.ReadAll(request.Body)
 		defer request.Body.Close()
 		if err != nil {
@@ -41,7 +105,13 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *cloudcont
 		}
 	}
 
-	for i := 0; i < retry.maxRetries+1; i += 1 {
+	for attempt := 0; attempt < retry.policy.MaxRetries+1; attempt += 1 {
+		if attempt > 0 {
+			delay := retry.delay(attempt, passedResponse.HTTPResponse)
+			retry.displayRetry(attempt, err, passedResponse.HTTPResponse, delay)
+			time.Sleep(delay)
+		}
+
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
@@ -50,14 +120,111 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *cloudcont
 			return nil
 		}
 
-		if request.Method == http.MethodPost ||
-			passedResponse.HTTPResponse != nil &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusInternalServerError &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusBadGateway &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusServiceUnavailable &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusGatewayTimeout {
+		if !retry.shouldRetry(request, passedResponse.HTTPResponse) {
 			break
 		}
 	}
 	return err
 }
+
+func (*RetryRequest) shouldRetry(request *http.Request, response *http.Response) bool {
+	if response == nil {
+		return cloudcontroller.IsIdempotent(request)
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return cloudcontroller.IsIdempotent(request)
+	default:
+		return false
+	}
+}
+
+// delay returns how long to wait before the given attempt. The Retry-After
+// header is honored when present, otherwise the delay grows exponentially
+// with each attempt.
+func (retry *RetryRequest) delay(attempt int, response *http.Response) time.Duration {
+	delay, ok := retryAfter(response)
+	if !ok {
+		delay = retry.policy.BaseDelay << uint(attempt-1)
+		if delay < 0 || retry.policy.MaxDelay > 0 && delay > retry.policy.MaxDelay {
+			delay = retry.policy.MaxDelay
+		}
+		if retry.policy.Jitter > 0 {
+			delay -= time.Duration(rand.Float64() * retry.policy.Jitter * float64(delay))
+		}
+	}
+
+	if retry.policy.MaxDelay > 0 && delay > retry.policy.MaxDelay {
+		delay = retry.policy.MaxDelay
+	}
+	return delay
+}
+
+// retryAfter parses the Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := date.Sub(time.Now())
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+func (retry *RetryRequest) displayRetry(attempt int, err error, response *http.Response, delay time.Duration) {
+	reason := err.Error()
+	if response != nil {
+		reason = fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode))
+	}
+
+	for _, output := range retry.outputs {
+		displayErr := retry.displayRetryToOutput(output, attempt, reason, delay)
+		if displayErr != nil {
+			output.HandleInternalError(displayErr)
+		}
+	}
+}
+
+func (retry *RetryRequest) displayRetryToOutput(output RequestLoggerOutput, attempt int, reason string, delay time.Duration) error {
+	err := output.Start()
+	if err != nil {
+		return err
+	}
+	defer output.Stop()
+
+	err = output.DisplayType("RETRY", time.Now())
+	if err != nil {
+		return err
+	}
+	err = output.DisplayHeader("Attempt", fmt.Sprintf("%d of %d", attempt, retry.policy.MaxRetries))
+	if err != nil {
+		return err
+	}
+	err = output.DisplayHeader("Reason", reason)
+	if err != nil {
+		return err
+	}
+	return output.DisplayHeader("Delay", delay.String())
+}
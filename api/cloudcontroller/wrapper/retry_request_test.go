@@ -1,13 +1,16 @@
 package wrapper_test
 
 import (
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -34,9 +37,6 @@ var _ = Describe("Retry Request", func() {
 				StatusCode: responseStatusCode,
 			}
 			fakeConnection.MakeStub = func(req *http.Request, passedResponse *cloudcontroller.Response) error {
-				if request.Method == http.MethodPost {
-					Expect(originalBody).To(Equal(request.Body))
-				}
 				defer req.Body.Close()
 				body, err := ioutil.ReadAll(request.Body)
 				Expect(err).ToNot(HaveOccurred())
@@ -60,6 +60,9 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
 
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+
 		Entry("1 for Post 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
@@ -79,4 +82,211 @@ var _ = Describe("Retry Request", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
+
+	It("retries idempotent POST requests on 5XX errors", func() {
+		request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana/actions/start", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request = cloudcontroller.MarkIdempotent(request)
+
+		response := &cloudcontroller.Response{
+			HTTPResponse: &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+			},
+		}
+
+		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeReturns(cloudcontroller.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable})
+		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+
+		err = wrapper.Make(request, response)
+		Expect(err).To(MatchError(cloudcontroller.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable}))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+	})
+
+	It("retries non-POST requests that fail without a response", func() {
+		request, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedErr := errors.New("connection reset")
+		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeReturns(expectedErr)
+		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+
+		err = wrapper.Make(request, &cloudcontroller.Response{})
+		Expect(err).To(MatchError(expectedErr))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+	})
+
+	Describe("NewRetryPolicy", func() {
+		It("uses the retry limits from the config", func() {
+			fakeConfig := new(wrapperfakes.FakeRetryConfig)
+			fakeConfig.RetryMaxReturns(5)
+			fakeConfig.RetryMaxDelayReturns(3 * time.Second)
+
+			policy := NewRetryPolicy(fakeConfig)
+			Expect(policy.MaxRetries).To(Equal(5))
+			Expect(policy.MaxDelay).To(Equal(3 * time.Second))
+			Expect(policy.BaseDelay).To(Equal(DefaultRetryPolicy(5).BaseDelay))
+			Expect(policy.Jitter).To(Equal(DefaultRetryPolicy(5).Jitter))
+		})
+	})
+
+	Describe("retry policy", func() {
+		var (
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+			fakeOutput     *wrapperfakes.FakeRequestLoggerOutput
+			policy         RetryPolicy
+			header         http.Header
+
+			request  *http.Request
+			response *cloudcontroller.Response
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+			fakeOutput = new(wrapperfakes.FakeRequestLoggerOutput)
+			policy = RetryPolicy{
+				MaxRetries: 2,
+				BaseDelay:  time.Millisecond,
+				MaxDelay:   10 * time.Millisecond,
+			}
+			header = http.Header{}
+
+			request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			response = &cloudcontroller.Response{}
+
+			fakeConnection.MakeStub = func(_ *http.Request, passedResponse *cloudcontroller.Response) error {
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     header,
+				}
+				return cloudcontroller.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+			}
+		})
+
+		JustBeforeEach(func() {
+			wrapper := NewRetryRequestWithPolicy(policy, fakeOutput).Wrap(fakeConnection)
+			err = wrapper.Make(request, response)
+		})
+
+		delays := func() []string {
+			var values []string
+			for i := 0; i < fakeOutput.DisplayHeaderCallCount(); i++ {
+				name, value := fakeOutput.DisplayHeaderArgsForCall(i)
+				if name == "Delay" {
+					values = append(values, value)
+				}
+			}
+			return values
+		}
+
+		It("reports every retry to the outputs", func() {
+			Expect(err).To(MatchError(cloudcontroller.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+
+			Expect(fakeOutput.StartCallCount()).To(Equal(2))
+			Expect(fakeOutput.StopCallCount()).To(Equal(2))
+			Expect(fakeOutput.DisplayTypeCallCount()).To(Equal(2))
+			name, _ := fakeOutput.DisplayTypeArgsForCall(0)
+			Expect(name).To(Equal("RETRY"))
+
+			Expect(fakeOutput.DisplayHeaderCallCount()).To(Equal(6))
+			name, value := fakeOutput.DisplayHeaderArgsForCall(0)
+			Expect(name).To(Equal("Attempt"))
+			Expect(value).To(Equal("1 of 2"))
+			name, value = fakeOutput.DisplayHeaderArgsForCall(1)
+			Expect(name).To(Equal("Reason"))
+			Expect(value).To(Equal("429 Too Many Requests"))
+			name, value = fakeOutput.DisplayHeaderArgsForCall(3)
+			Expect(name).To(Equal("Attempt"))
+			Expect(value).To(Equal("2 of 2"))
+		})
+
+		It("doubles the delay between each retry", func() {
+			Expect(delays()).To(Equal([]string{"1ms", "2ms"}))
+		})
+
+		Context("when the backoff exceeds the max delay", func() {
+			BeforeEach(func() {
+				policy.BaseDelay = 8 * time.Millisecond
+			})
+
+			It("caps the delay", func() {
+				Expect(delays()).To(Equal([]string{"8ms", "10ms"}))
+			})
+		})
+
+		Context("when jitter is set", func() {
+			BeforeEach(func() {
+				policy.Jitter = 0.5
+				policy.BaseDelay = 4 * time.Millisecond
+				policy.MaxDelay = 0
+			})
+
+			It("randomizes part of the delay", func() {
+				values := delays()
+				Expect(values).To(HaveLen(2))
+
+				first, parseErr := time.ParseDuration(values[0])
+				Expect(parseErr).ToNot(HaveOccurred())
+				Expect(first).To(BeNumerically(">", 2*time.Millisecond))
+				Expect(first).To(BeNumerically("<=", 4*time.Millisecond))
+
+				second, parseErr := time.ParseDuration(values[1])
+				Expect(parseErr).ToNot(HaveOccurred())
+				Expect(second).To(BeNumerically(">", 4*time.Millisecond))
+				Expect(second).To(BeNumerically("<=", 8*time.Millisecond))
+			})
+		})
+
+		Context("when the response contains a Retry-After header in seconds", func() {
+			BeforeEach(func() {
+				policy.BaseDelay = time.Hour
+				policy.MaxDelay = 0
+				header.Set("Retry-After", "0")
+			})
+
+			It("waits for the requested delay instead of backing off", func() {
+				Expect(delays()).To(Equal([]string{"0s", "0s"}))
+			})
+		})
+
+		Context("when the Retry-After header exceeds the max delay", func() {
+			BeforeEach(func() {
+				header.Set("Retry-After", "120")
+			})
+
+			It("caps the delay", func() {
+				Expect(delays()).To(Equal([]string{"10ms", "10ms"}))
+			})
+		})
+
+		Context("when the response contains a Retry-After header as an HTTP date", func() {
+			BeforeEach(func() {
+				policy.BaseDelay = time.Hour
+				policy.MaxDelay = 0
+				header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+			})
+
+			It("waits until that date", func() {
+				Expect(delays()).To(Equal([]string{"0s", "0s"}))
+			})
+		})
+
+		Context("when the request succeeds after a retry", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = nil
+				fakeConnection.MakeReturnsOnCall(0, cloudcontroller.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests})
+				fakeConnection.MakeReturnsOnCall(1, nil)
+			})
+
+			It("stops retrying", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(fakeOutput.DisplayTypeCallCount()).To(Equal(1))
+			})
+		})
+	})
 })
@@ -0,0 +1,136 @@
+// This file was generated by counterfeiter
+package wrapperfakes
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+)
+
+type FakeRetryConfig struct {
+	RetryMaxStub        func() int
+	retryMaxMutex       sync.RWMutex
+	retryMaxArgsForCall []struct{}
+	retryMaxReturns     struct {
+		result1 int
+	}
+	retryMaxReturnsOnCall map[int]struct {
+		result1 int
+	}
+	RetryMaxDelayStub        func() time.Duration
+	retryMaxDelayMutex       sync.RWMutex
+	retryMaxDelayArgsForCall []struct{}
+	retryMaxDelayReturns     struct {
+		result1 time.Duration
+	}
+	retryMaxDelayReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRetryConfig) RetryMax() int {
+	fake.retryMaxMutex.Lock()
+	ret, specificReturn := fake.retryMaxReturnsOnCall[len(fake.retryMaxArgsForCall)]
+	fake.retryMaxArgsForCall = append(fake.retryMaxArgsForCall, struct{}{})
+	fake.recordInvocation("RetryMax", []interface{}{})
+	fake.retryMaxMutex.Unlock()
+	if fake.RetryMaxStub != nil {
+		return fake.RetryMaxStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.retryMaxReturns.result1
+}
+
+func (fake *FakeRetryConfig) RetryMaxCallCount() int {
+	fake.retryMaxMutex.RLock()
+	defer fake.retryMaxMutex.RUnlock()
+	return len(fake.retryMaxArgsForCall)
+}
+
+func (fake *FakeRetryConfig) RetryMaxReturns(result1 int) {
+	fake.RetryMaxStub = nil
+	fake.retryMaxReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeRetryConfig) RetryMaxReturnsOnCall(i int, result1 int) {
+	fake.RetryMaxStub = nil
+	if fake.retryMaxReturnsOnCall == nil {
+		fake.retryMaxReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.retryMaxReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeRetryConfig) RetryMaxDelay() time.Duration {
+	fake.retryMaxDelayMutex.Lock()
+	ret, specificReturn := fake.retryMaxDelayReturnsOnCall[len(fake.retryMaxDelayArgsForCall)]
+	fake.retryMaxDelayArgsForCall = append(fake.retryMaxDelayArgsForCall, struct{}{})
+	fake.recordInvocation("RetryMaxDelay", []interface{}{})
+	fake.retryMaxDelayMutex.Unlock()
+	if fake.RetryMaxDelayStub != nil {
+		return fake.RetryMaxDelayStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.retryMaxDelayReturns.result1
+}
+
+func (fake *FakeRetryConfig) RetryMaxDelayCallCount() int {
+	fake.retryMaxDelayMutex.RLock()
+	defer fake.retryMaxDelayMutex.RUnlock()
+	return len(fake.retryMaxDelayArgsForCall)
+}
+
+func (fake *FakeRetryConfig) RetryMaxDelayReturns(result1 time.Duration) {
+	fake.RetryMaxDelayStub = nil
+	fake.retryMaxDelayReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeRetryConfig) RetryMaxDelayReturnsOnCall(i int, result1 time.Duration) {
+	fake.RetryMaxDelayStub = nil
+	if fake.retryMaxDelayReturnsOnCall == nil {
+		fake.retryMaxDelayReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.retryMaxDelayReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeRetryConfig) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.retryMaxMutex.RLock()
+	defer fake.retryMaxMutex.RUnlock()
+	fake.retryMaxDelayMutex.RLock()
+	defer fake.retryMaxDelayMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRetryConfig) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ wrapper.RetryConfig = new(FakeRetryConfig)
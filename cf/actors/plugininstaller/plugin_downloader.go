@@ -1,6 +1,7 @@
 package plugininstaller
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -8,6 +9,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/downloader"
 	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/web"
 )
@@ -37,25 +39,56 @@ func (downloader *PluginDownloader) downloadFromPath(pluginSourceFilepath string
 }
 
 func (downloader *PluginDownloader) downloadFromPlugin(plugin clipr.Plugin) (string, string) {
+	platform := CurrentPlatform()
+	if platform == "" {
+		downloader.binaryNotAvailable()
+		return "", ""
+	}
+
+	return downloader.downloadFromPath(downloader.getBinaryURL(plugin, platform)), downloader.getBinaryChecksum(plugin, platform)
+}
+
+// CurrentPlatform returns the name plugin repositories use for binaries of
+// the running OS and architecture, or an empty string if there is none.
+func CurrentPlatform() string {
 	arch := runtime.GOARCH
 
 	switch runtime.GOOS {
 	case "darwin":
-		return downloader.downloadFromPath(downloader.getBinaryURL(plugin, "osx")), downloader.getBinaryChecksum(plugin, "osx")
+		return "osx"
 	case "linux":
 		if arch == "386" {
-			return downloader.downloadFromPath(downloader.getBinaryURL(plugin, "linux32")), downloader.getBinaryChecksum(plugin, "linux32")
+			return "linux32"
 		}
-		return downloader.downloadFromPath(downloader.getBinaryURL(plugin, "linux64")), downloader.getBinaryChecksum(plugin, "linux64")
+		return "linux64"
 	case "windows":
 		if arch == "386" {
-			return downloader.downloadFromPath(downloader.getBinaryURL(plugin, "win32")), downloader.getBinaryChecksum(plugin, "win32")
+			return "win32"
 		}
-		return downloader.downloadFromPath(downloader.getBinaryURL(plugin, "win64")), downloader.getBinaryChecksum(plugin, "win64")
+		return "win64"
 	default:
-		downloader.binaryNotAvailable()
+		return ""
 	}
-	return "", ""
+}
+
+// DownloadFromURL downloads the plugin binary at the given url and returns
+// its path. The caller is responsible for verifying its checksum.
+func (downloader *PluginDownloader) DownloadFromURL(url string) string {
+	return downloader.downloadFromPath(url)
+}
+
+// DownloadAndVerify downloads the binary of a repository plugin for the
+// current platform and returns an error unless its sha1 matches the
+// repository metadata.
+func (downloader *PluginDownloader) DownloadAndVerify(plugin clipr.Plugin, checksummer util.Sha1Checksum) (string, error) {
+	outputSourceFilepath, sha1 := downloader.downloadFromPlugin(plugin)
+
+	checksummer.SetFilePath(outputSourceFilepath)
+	if !checksummer.CheckSha1(sha1) {
+		return outputSourceFilepath, errors.New(T("Downloaded plugin binary's checksum does not match repo metadata"))
+	}
+
+	return outputSourceFilepath, nil
 }
 
 func (downloader *PluginDownloader) getBinaryURL(plugin clipr.Plugin, os string) string {
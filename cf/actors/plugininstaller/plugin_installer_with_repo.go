@@ -40,15 +40,12 @@ func (installer *pluginInstallerWithRepo) Install(inputSourceFilepath string) st
 	}
 
 	found := false
-	sha1 := ""
 	for _, plugin := range findRepoCaseInsensity(pluginList, installer.RepoName) {
 		if strings.ToLower(plugin.Name) == targetPluginName {
 			found = true
-			outputSourceFilepath, sha1 = installer.PluginDownloader.downloadFromPlugin(plugin)
-
-			installer.Checksummer.SetFilePath(outputSourceFilepath)
-			if !installer.Checksummer.CheckSha1(sha1) {
-				installer.UI.Failed(T("Downloaded plugin binary's checksum does not match repo metadata"))
+			outputSourceFilepath, err = installer.PluginDownloader.DownloadAndVerify(plugin, installer.Checksummer)
+			if err != nil {
+				installer.UI.Failed(err.Error())
 			}
 		}
 
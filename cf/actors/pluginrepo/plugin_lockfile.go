@@ -0,0 +1,124 @@
+package pluginrepo
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+// Lockfile pins a set of plugins to the exact versions and binaries published
+// by their plugin repositories.
+type Lockfile struct {
+	Plugins []LockedPlugin `json:"plugins"`
+}
+
+// LockedPlugin is a plugin entry of a Lockfile.
+type LockedPlugin struct {
+	Name     string         `json:"name"`
+	Version  string         `json:"version"`
+	Repo     LockedRepo     `json:"repo"`
+	Binaries []LockedBinary `json:"binaries"`
+}
+
+// LockedRepo is the plugin repository a locked plugin is installed from.
+type LockedRepo struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// LockedBinary is the download url and sha1 checksum of the plugin binary
+// for a platform.
+type LockedBinary struct {
+	Platform string `json:"platform"`
+	URL      string `json:"url,omitempty"`
+	Checksum string `json:"checksum"`
+}
+
+// NewLockedPlugin locks a plugin listed by the given repository.
+func NewLockedPlugin(repo models.PluginRepo, repoPlugin RepoPlugin) LockedPlugin {
+	lockedPlugin := LockedPlugin{
+		Name:     repoPlugin.Plugin.Name,
+		Version:  repoPlugin.Plugin.Version,
+		Repo:     LockedRepo{Name: repo.Name, URL: repo.URL},
+		Binaries: []LockedBinary{},
+	}
+
+	for _, binary := range repoPlugin.Plugin.Binaries {
+		lockedPlugin.Binaries = append(lockedPlugin.Binaries, LockedBinary{
+			Platform: binary.Platform,
+			URL:      binary.Url,
+			Checksum: binary.Checksum,
+		})
+	}
+
+	return lockedPlugin
+}
+
+// Binary returns the locked binary for the given platform. Binaries without
+// a checksum are ignored.
+func (lockedPlugin LockedPlugin) Binary(platform string) (LockedBinary, bool) {
+	for _, binary := range lockedPlugin.Binaries {
+		if binary.Platform == platform && binary.Checksum != "" {
+			return binary, true
+		}
+	}
+	return LockedBinary{}, false
+}
+
+// Checksum returns the locked checksum of the binary for the given platform.
+func (lockedPlugin LockedPlugin) Checksum(platform string) (string, bool) {
+	binary, found := lockedPlugin.Binary(platform)
+	return binary.Checksum, found
+}
+
+// PluginRepo returns the repository the plugin is locked to.
+func (lockedPlugin LockedPlugin) PluginRepo() models.PluginRepo {
+	return models.PluginRepo{Name: lockedPlugin.Repo.Name, URL: lockedPlugin.Repo.URL}
+}
+
+// ReadLockfile reads and validates the lockfile at the given path.
+func ReadLockfile(path string) (Lockfile, error) {
+	var lockfile Lockfile
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Lockfile{}, err
+	}
+
+	err = json.Unmarshal(contents, &lockfile)
+	if err != nil {
+		return Lockfile{}, errors.New(T("Invalid plugin lockfile {{.Path}}: {{.Error}}",
+			map[string]interface{}{
+				"Path":  path,
+				"Error": err.Error(),
+			}))
+	}
+
+	for _, lockedPlugin := range lockfile.Plugins {
+		if lockedPlugin.Name == "" || lockedPlugin.Version == "" || lockedPlugin.Repo.URL == "" {
+			return Lockfile{}, errors.New(T("Invalid plugin lockfile {{.Path}}: every plugin requires a name, version and repo url",
+				map[string]interface{}{
+					"Path": path,
+				}))
+		}
+	}
+
+	return lockfile, nil
+}
+
+// WriteLockfile writes the lockfile to the given path.
+func WriteLockfile(path string, lockfile Lockfile) error {
+	if lockfile.Plugins == nil {
+		lockfile.Plugins = []LockedPlugin{}
+	}
+
+	contents, err := json.MarshalIndent(lockfile, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(contents, '\n'), 0644)
+}
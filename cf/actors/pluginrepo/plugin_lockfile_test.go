@@ -0,0 +1,112 @@
+package pluginrepo_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/cf/actors/pluginrepo"
+	"code.cloudfoundry.org/cli/cf/models"
+	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/web"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin Lockfile", func() {
+	var (
+		dir          string
+		lockfilePath string
+		lockedPlugin LockedPlugin
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "plugin-lockfile")
+		Expect(err).ToNot(HaveOccurred())
+		lockfilePath = filepath.Join(dir, "plugins.lock")
+
+		lockedPlugin = NewLockedPlugin(
+			models.PluginRepo{Name: "repo1", URL: "https://repo1.example.com"},
+			RepoPlugin{
+				RepoName: "repo1",
+				Plugin: clipr.Plugin{
+					Name:    "plugin1",
+					Version: "1.2.3",
+					Binaries: []clipr.Binary{
+						{Platform: "linux64", Url: "https://repo1.example.com/plugin1-linux64", Checksum: "linux-sha1"},
+						{Platform: "osx", Url: "https://repo1.example.com/plugin1-osx", Checksum: "osx-sha1"},
+					},
+				},
+			},
+		)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Describe("NewLockedPlugin", func() {
+		It("records the plugin version, repository and binary checksums", func() {
+			Expect(lockedPlugin.Name).To(Equal("plugin1"))
+			Expect(lockedPlugin.Version).To(Equal("1.2.3"))
+			Expect(lockedPlugin.PluginRepo()).To(Equal(models.PluginRepo{Name: "repo1", URL: "https://repo1.example.com"}))
+
+			checksum, found := lockedPlugin.Checksum("osx")
+			Expect(found).To(BeTrue())
+			Expect(checksum).To(Equal("osx-sha1"))
+
+			_, found = lockedPlugin.Checksum("win64")
+			Expect(found).To(BeFalse())
+		})
+
+		It("records the binary urls", func() {
+			binary, found := lockedPlugin.Binary("linux64")
+			Expect(found).To(BeTrue())
+			Expect(binary).To(Equal(LockedBinary{
+				Platform: "linux64",
+				URL:      "https://repo1.example.com/plugin1-linux64",
+				Checksum: "linux-sha1",
+			}))
+		})
+	})
+
+	Describe("WriteLockfile and ReadLockfile", func() {
+		It("round trips the lockfile", func() {
+			Expect(WriteLockfile(lockfilePath, Lockfile{Plugins: []LockedPlugin{lockedPlugin}})).To(Succeed())
+
+			lockfile, err := ReadLockfile(lockfilePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lockfile.Plugins).To(Equal([]LockedPlugin{lockedPlugin}))
+		})
+
+		It("writes an empty plugin list", func() {
+			Expect(WriteLockfile(lockfilePath, Lockfile{})).To(Succeed())
+
+			contents, err := ioutil.ReadFile(lockfilePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("{\n  \"plugins\": []\n}\n"))
+		})
+	})
+
+	Describe("ReadLockfile", func() {
+		It("returns an error when the file does not exist", func() {
+			_, err := ReadLockfile(lockfilePath)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns an error when the file is not valid json", func() {
+			Expect(ioutil.WriteFile(lockfilePath, []byte("banana"), 0644)).To(Succeed())
+
+			_, err := ReadLockfile(lockfilePath)
+			Expect(err).To(MatchError(ContainSubstring("Invalid plugin lockfile " + lockfilePath)))
+		})
+
+		It("returns an error when a plugin is incomplete", func() {
+			Expect(ioutil.WriteFile(lockfilePath, []byte(`{"plugins":[{"name":"plugin1","version":"1.2.3"}]}`), 0644)).To(Succeed())
+
+			_, err := ReadLockfile(lockfilePath)
+			Expect(err).To(MatchError(ContainSubstring("every plugin requires a name, version and repo url")))
+		})
+	})
+})
@@ -0,0 +1,119 @@
+package pluginrepo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/plugin"
+	"github.com/blang/semver"
+	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/web"
+)
+
+// RepoPlugin is a plugin listed by a plugin repository along with the name of
+// that repository.
+type RepoPlugin struct {
+	RepoName string
+	Plugin   clipr.Plugin
+}
+
+// FindNewestPlugin returns the highest version of the named plugin listed by
+// any of the repositories. Listings with unparsable versions are ignored.
+func FindNewestPlugin(repoPlugins map[string][]clipr.Plugin, pluginName string) (RepoPlugin, bool) {
+	var (
+		newest        RepoPlugin
+		newestVersion semver.Version
+		found         bool
+	)
+
+	for _, candidate := range findPlugins(repoPlugins, pluginName) {
+		version, err := semver.ParseTolerant(candidate.Plugin.Version)
+		if err != nil {
+			continue
+		}
+
+		if !found || version.GT(newestVersion) {
+			newest = candidate
+			newestVersion = version
+			found = true
+		}
+	}
+
+	return newest, found
+}
+
+// FindPluginVersion returns the named plugin at exactly the given version from
+// the first repository listing it.
+func FindPluginVersion(repoPlugins map[string][]clipr.Plugin, pluginName string, version string) (RepoPlugin, bool) {
+	wanted, err := semver.ParseTolerant(version)
+	if err != nil {
+		return RepoPlugin{}, false
+	}
+
+	for _, candidate := range findPlugins(repoPlugins, pluginName) {
+		candidateVersion, err := semver.ParseTolerant(candidate.Plugin.Version)
+		if err == nil && candidateVersion.EQ(wanted) {
+			return candidate, true
+		}
+	}
+
+	return RepoPlugin{}, false
+}
+
+// IsNewerVersion returns true if version is greater than the installed
+// version. Unparsable versions are never considered newer.
+func IsNewerVersion(version string, installed plugin.VersionType) bool {
+	repoVersion, err := semver.ParseTolerant(version)
+	if err != nil {
+		return false
+	}
+
+	return repoVersion.GT(installedVersion(installed))
+}
+
+// IsSameVersion returns true if version is equal to the installed version.
+func IsSameVersion(version string, installed plugin.VersionType) bool {
+	repoVersion, err := semver.ParseTolerant(version)
+	if err != nil {
+		return false
+	}
+
+	return repoVersion.EQ(installedVersion(installed))
+}
+
+// FormatVersion renders an installed plugin version, using N/A for plugins
+// that do not report one.
+func FormatVersion(version plugin.VersionType) string {
+	if version.Major == 0 && version.Minor == 0 && version.Build == 0 {
+		return "N/A"
+	}
+	return fmt.Sprintf("%d.%d.%d", version.Major, version.Minor, version.Build)
+}
+
+func installedVersion(version plugin.VersionType) semver.Version {
+	return semver.Version{
+		Major: uint64(version.Major),
+		Minor: uint64(version.Minor),
+		Patch: uint64(version.Build),
+	}
+}
+
+// findPlugins returns every listing of the named plugin, ordered by repository
+// name so results do not depend on map iteration order.
+func findPlugins(repoPlugins map[string][]clipr.Plugin, pluginName string) []RepoPlugin {
+	var repoNames []string
+	for repoName := range repoPlugins {
+		repoNames = append(repoNames, repoName)
+	}
+	sort.Strings(repoNames)
+
+	var found []RepoPlugin
+	for _, repoName := range repoNames {
+		for _, repoPlugin := range repoPlugins[repoName] {
+			if strings.EqualFold(repoPlugin.Name, pluginName) {
+				found = append(found, RepoPlugin{RepoName: repoName, Plugin: repoPlugin})
+			}
+		}
+	}
+	return found
+}
@@ -0,0 +1,101 @@
+package pluginrepo_test
+
+import (
+	. "code.cloudfoundry.org/cli/cf/actors/pluginrepo"
+	"code.cloudfoundry.org/cli/plugin"
+	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/web"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin Versions", func() {
+	var repoPlugins map[string][]clipr.Plugin
+
+	BeforeEach(func() {
+		repoPlugins = map[string][]clipr.Plugin{
+			"repo-b": {
+				{Name: "plugin1", Version: "1.2.0"},
+				{Name: "plugin2", Version: "banana"},
+			},
+			"repo-a": {
+				{Name: "Plugin1", Version: "1.10.0"},
+				{Name: "plugin3", Version: "0.1.0"},
+			},
+			"repo-c": {
+				{Name: "plugin1", Version: "1.2.0"},
+			},
+		}
+	})
+
+	Describe("FindNewestPlugin", func() {
+		It("returns the highest version across all repos, ignoring case", func() {
+			newest, found := FindNewestPlugin(repoPlugins, "plugin1")
+			Expect(found).To(BeTrue())
+			Expect(newest.RepoName).To(Equal("repo-a"))
+			Expect(newest.Plugin.Version).To(Equal("1.10.0"))
+		})
+
+		It("ignores listings with unparsable versions", func() {
+			_, found := FindNewestPlugin(repoPlugins, "plugin2")
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns false when no repo lists the plugin", func() {
+			_, found := FindNewestPlugin(repoPlugins, "plugin4")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("FindPluginVersion", func() {
+		It("returns the first repo, by name, listing the requested version", func() {
+			pinned, found := FindPluginVersion(repoPlugins, "plugin1", "v1.2")
+			Expect(found).To(BeTrue())
+			Expect(pinned.RepoName).To(Equal("repo-b"))
+			Expect(pinned.Plugin.Version).To(Equal("1.2.0"))
+		})
+
+		It("returns false when the version is not listed", func() {
+			_, found := FindPluginVersion(repoPlugins, "plugin1", "1.3.0")
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns false when the requested version is invalid", func() {
+			_, found := FindPluginVersion(repoPlugins, "plugin1", "banana")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("IsNewerVersion", func() {
+		It("compares the version with the installed version", func() {
+			installed := plugin.VersionType{Major: 1, Minor: 2, Build: 0}
+			Expect(IsNewerVersion("1.10.0", installed)).To(BeTrue())
+			Expect(IsNewerVersion("1.2.0", installed)).To(BeFalse())
+			Expect(IsNewerVersion("1.1.9", installed)).To(BeFalse())
+			Expect(IsNewerVersion("banana", installed)).To(BeFalse())
+		})
+
+		It("treats plugins without a version as outdated", func() {
+			Expect(IsNewerVersion("0.0.1", plugin.VersionType{})).To(BeTrue())
+		})
+	})
+
+	Describe("IsSameVersion", func() {
+		It("compares the version with the installed version", func() {
+			installed := plugin.VersionType{Major: 1, Minor: 2, Build: 0}
+			Expect(IsSameVersion("v1.2", installed)).To(BeTrue())
+			Expect(IsSameVersion("1.2.1", installed)).To(BeFalse())
+			Expect(IsSameVersion("banana", installed)).To(BeFalse())
+		})
+	})
+
+	Describe("FormatVersion", func() {
+		It("renders the version", func() {
+			Expect(FormatVersion(plugin.VersionType{Major: 1, Minor: 2, Build: 3})).To(Equal("1.2.3"))
+		})
+
+		It("renders N/A for plugins without a version", func() {
+			Expect(FormatVersion(plugin.VersionType{})).To(Equal("N/A"))
+		})
+	})
+})
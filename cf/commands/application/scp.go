@@ -0,0 +1,149 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+type SCP struct {
+	ui            terminal.UI
+	config        coreconfig.Reader
+	gateway       net.Gateway
+	appReq        requirements.ApplicationRequirement
+	sshCodeGetter commands.SSHCodeGetter
+	opts          *options.SCPOptions
+	secureShell   sshCmd.SecureShell
+}
+
+func init() {
+	commandregistry.Register(&SCP{})
+}
+
+func (cmd *SCP) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["app-instance-index"] = &flags.IntFlag{Name: "app-instance-index", ShortName: "i", Usage: T("Application instance index")}
+	fs["skip-host-validation"] = &flags.BoolFlag{Name: "skip-host-validation", ShortName: "k", Usage: T("Skip host key validation")}
+	fs["recursive"] = &flags.BoolFlag{Name: "recursive", ShortName: "r", Usage: T("Recursively copy directories")}
+	fs["quiet"] = &flags.BoolFlag{Name: "quiet", ShortName: "q", Usage: T("Do not show transfer progress")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "scp",
+		Description: T("Copy files between the local machine and an application container instance"),
+		Usage: []string{
+			T("CF_NAME scp [-i app-instance-index] [-r] [-q] [--skip-host-validation] APP_NAME:REMOTE_PATH LOCAL_PATH\n"),
+			T("   CF_NAME scp [-i app-instance-index] [-r] [-q] [--skip-host-validation] LOCAL_PATH APP_NAME:REMOTE_PATH"),
+		},
+		Examples: []string{
+			"CF_NAME scp my-app:/home/vcap/app/heap.hprof .",
+			"CF_NAME scp -i 1 -r ./config my-app:/tmp",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *SCP) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 2 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires SOURCE and TARGET as arguments") + "\n\n" + commandregistry.Commands.CommandUsage("scp"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 2)
+	}
+
+	if fc.IsSet("i") && fc.Int("i") < 0 {
+		cmd.ui.Failed(fmt.Sprintf(T("Incorrect Usage:")+" %s\n\n%s", T("Value for flag 'app-instance-index' cannot be negative"), commandregistry.Commands.CommandUsage("scp")))
+		return nil, fmt.Errorf("Incorrect usage: app-instance-index cannot be negative")
+	}
+
+	var err error
+	cmd.opts, err = options.NewSCPOptions(fc)
+
+	if err != nil {
+		cmd.ui.Failed(fmt.Sprintf(T("Incorrect Usage:")+" %s\n\n%s", err.Error(), commandregistry.Commands.CommandUsage("scp")))
+		return nil, err
+	}
+
+	cmd.appReq = requirementsFactory.NewApplicationRequirement(cmd.opts.AppName)
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.appReq,
+	}
+
+	return reqs, nil
+}
+
+func (cmd *SCP) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.gateway = deps.Gateways["cloud-controller"]
+
+	if deps.WildcardDependency != nil {
+		cmd.secureShell = deps.WildcardDependency.(sshCmd.SecureShell)
+	}
+
+	//get ssh-code for dependency
+	sshCodeGetter := commandregistry.Commands.FindCommand("ssh-code")
+	sshCodeGetter = sshCodeGetter.SetDependency(deps, false)
+	cmd.sshCodeGetter = sshCodeGetter.(commands.SSHCodeGetter)
+
+	return cmd
+}
+
+func (cmd *SCP) Execute(fc flags.FlagContext) error {
+	app := cmd.appReq.GetApplication()
+	info := sshInfo{}
+	err := cmd.gateway.GetResource(cmd.config.APIEndpoint()+"/v2/info", &info)
+	if err != nil {
+		return errors.New(T("Error getting SSH info:") + err.Error())
+	}
+
+	sshAuthCode, err := cmd.sshCodeGetter.Get()
+	if err != nil {
+		return errors.New(T("Error getting one time auth code: ") + err.Error())
+	}
+
+	//init secureShell if it is not already set by SetDependency() with fakes
+	if cmd.secureShell == nil {
+		cmd.secureShell = sshCmd.NewSecureShell(
+			sshCmd.DefaultSecureDialer(),
+			sshTerminal.DefaultHelper(),
+			sshCmd.DefaultListenerFactory(),
+			30*time.Second,
+			app,
+			info.SSHEndpointFingerprint,
+			info.SSHEndpoint,
+			sshAuthCode,
+		)
+	}
+
+	err = cmd.secureShell.Connect(cmd.opts.SSHOptions())
+	if err != nil {
+		return errors.New(T("Error opening SSH connection: ") + err.Error())
+	}
+	defer cmd.secureShell.Close()
+
+	err = cmd.secureShell.Copy(cmd.opts)
+	if err != nil {
+		if exitError, ok := err.(*ssh.ExitError); ok {
+			return errors.New(T("Error copying files: remote scp exited with status {{.ExitCode}}", map[string]interface{}{
+				"ExitCode": exitError.ExitStatus(),
+			}))
+		}
+		return errors.New(T("Error copying files: ") + err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package application_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/commandsfakes"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("scp command", func() {
+	var (
+		ui *testterm.FakeUI
+
+		sshCodeGetter         *commandsfakes.FakeSSHCodeGetter
+		originalSSHCodeGetter commandregistry.Command
+
+		requirementsFactory *requirementsfakes.FakeFactory
+		configRepo          coreconfig.Repository
+		deps                commandregistry.Dependency
+
+		fakeSecureShell *sshfakes.FakeSecureShell
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		deps.Gateways = make(map[string]net.Gateway)
+		deps.WildcardDependency = nil
+
+		originalSSHCodeGetter = commandregistry.Commands.FindCommand("ssh-code")
+
+		sshCodeGetter = new(commandsfakes.FakeSSHCodeGetter)
+		sshCodeGetter.SetDependencyStub = func(_ commandregistry.Dependency, _ bool) commandregistry.Command {
+			return sshCodeGetter
+		}
+		sshCodeGetter.MetaDataReturns(commandregistry.CommandMetadata{Name: "ssh-code"})
+	})
+
+	AfterEach(func() {
+		commandregistry.Register(originalSSHCodeGetter)
+	})
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = configRepo
+
+		commandregistry.Register(sshCodeGetter)
+
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("scp").SetDependency(deps, pluginCall))
+	}
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("scp", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("Requirements", func() {
+		BeforeEach(func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+			requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+		})
+
+		It("fails with usage when not provided exactly two args", func() {
+			Expect(runCommand("my-app:/tmp/file")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires SOURCE and TARGET as arguments"},
+			))
+		})
+
+		It("fails with usage when neither path is in an app", func() {
+			Expect(runCommand("a", "b")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "APP_NAME:PATH"},
+				[]string{"USAGE:"},
+			))
+		})
+
+		It("fails with usage when the instance index is negative", func() {
+			Expect(runCommand("-i", "-1", "my-app:a", "b")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "cannot be negative"},
+			))
+		})
+
+		It("fails requirements when not logged in", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
+			Expect(runCommand("my-app:a", "b")).To(BeFalse())
+		})
+
+		It("requires the app named in the remote path", func() {
+			applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+			applicationReq.ExecuteReturns(errors.New("no app"))
+			requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+			Expect(runCommand("local-file", "my-app:/tmp")).To(BeFalse())
+			Expect(requirementsFactory.NewApplicationRequirementArgsForCall(0)).To(Equal("my-app"))
+		})
+	})
+
+	Describe("copying files", func() {
+		var testServer *httptest.Server
+
+		BeforeEach(func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+			requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+
+			applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+			applicationReq.GetApplicationReturns(models.Application{
+				ApplicationFields: models.ApplicationFields{Name: "my-app", GUID: "my-app-guid", State: "started", Diego: true},
+			})
+			requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+			fakeSecureShell = new(sshfakes.FakeSecureShell)
+			deps.WildcardDependency = fakeSecureShell
+
+			getRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/info",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   getInfoResponseBody,
+				},
+			})
+
+			testServer, _ = testnet.NewServer([]testnet.TestRequest{getRequest})
+			configRepo.SetAPIEndpoint(testServer.URL)
+			deps.Gateways["cloud-controller"] = net.NewCloudControllerGateway(configRepo, time.Now, &testterm.FakeUI{}, new(tracefakes.FakePrinter), "")
+		})
+
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It("connects to the instance and copies the files", func() {
+			Expect(runCommand("-i", "2", "-r", "-k", "my-app:/tmp/dumps", "local-dir")).To(BeTrue())
+
+			Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+			Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+				AppName:            "my-app",
+				Index:              2,
+				SkipHostValidation: true,
+			}))
+
+			Expect(fakeSecureShell.CopyCallCount()).To(Equal(1))
+			Expect(fakeSecureShell.CopyArgsForCall(0)).To(Equal(&options.SCPOptions{
+				AppName:            "my-app",
+				Index:              2,
+				SkipHostValidation: true,
+				Recursive:          true,
+				Direction:          options.CopyFromApp,
+				LocalPath:          "local-dir",
+				RemotePath:         "/tmp/dumps",
+			}))
+			Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+		})
+
+		Context("when connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShell.ConnectReturns(errors.New("dial error"))
+			})
+
+			It("notifies users", func() {
+				Expect(runCommand("local-file", "my-app:")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Error opening SSH connection", "dial error"},
+				))
+				Expect(fakeSecureShell.CopyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when copying fails", func() {
+			BeforeEach(func() {
+				fakeSecureShell.CopyReturns(errors.New("scp: /tmp/nope: No such file or directory"))
+			})
+
+			It("notifies users", func() {
+				Expect(runCommand("my-app:/tmp/nope", ".")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Error copying files", "No such file or directory"},
+				))
+			})
+		})
+	})
+})
@@ -0,0 +1,166 @@
+package application
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
+)
+
+type instanceResult struct {
+	exitCode string
+	details  string
+	failed   bool
+}
+
+func (cmd *SSH) executeOnAllInstances(app models.Application, info sshInfo) error {
+	if app.InstanceCount < 1 {
+		return errors.New(T("Application {{.AppName}} has no instances", map[string]interface{}{"AppName": app.Name}))
+	}
+
+	results := make([]instanceResult, app.InstanceCount)
+	sayMutex := &sync.Mutex{}
+	codeMutex := &sync.Mutex{}
+	say := func(line string) {
+		sayMutex.Lock()
+		defer sayMutex.Unlock()
+		cmd.ui.Say("%s", line)
+	}
+
+	inFlight := make(chan struct{}, cmd.opts.MaxInFlight)
+	wg := &sync.WaitGroup{}
+
+	for index := 0; index < app.InstanceCount; index++ {
+		wg.Add(1)
+		inFlight <- struct{}{}
+
+		go func(index int) {
+			defer func() {
+				<-inFlight
+				wg.Done()
+			}()
+
+			codeMutex.Lock()
+			sshAuthCode, err := cmd.sshCodeGetter.Get()
+			codeMutex.Unlock()
+			if err != nil {
+				results[index] = instanceResult{failed: true, details: T("Error getting one time auth code: ") + err.Error()}
+				return
+			}
+
+			results[index] = cmd.executeOnInstance(app, info, sshAuthCode, uint(index), say)
+		}(index)
+	}
+	wg.Wait()
+
+	table := cmd.ui.Table([]string{T("instance"), T("exit code"), T("details")})
+	failedCount := 0
+	for index, result := range results {
+		if result.failed {
+			failedCount++
+		}
+		table.Add(fmt.Sprintf("#%d", index), result.exitCode, result.details)
+	}
+
+	cmd.ui.Say("")
+	err := table.Print()
+	if err != nil {
+		return err
+	}
+
+	if failedCount > 0 {
+		return errors.New(T("Command failed on {{.FailedCount}} of {{.InstanceCount}} instances", map[string]interface{}{
+			"FailedCount":   failedCount,
+			"InstanceCount": app.InstanceCount,
+		}))
+	}
+	return nil
+}
+
+func (cmd *SSH) executeOnInstance(app models.Application, info sshInfo, sshAuthCode string, index uint, say func(string)) instanceResult {
+	secureShell := cmd.secureShell
+	if secureShell == nil {
+		secureShell = sshCmd.NewSecureShell(
+			sshCmd.DefaultSecureDialer(),
+			sshTerminal.DefaultHelper(),
+			sshCmd.DefaultListenerFactory(),
+			30*time.Second,
+			app,
+			info.SSHEndpointFingerprint,
+			info.SSHEndpoint,
+			sshAuthCode,
+		)
+	}
+
+	opts := *cmd.opts
+	opts.Index = index
+
+	err := secureShell.Connect(&opts)
+	if err != nil {
+		return instanceResult{failed: true, details: T("Error opening SSH connection: ") + err.Error()}
+	}
+	defer secureShell.Close()
+
+	output := &instanceOutput{prefix: fmt.Sprintf("[%d] ", index), say: say}
+	err = secureShell.ExecuteCommand(output, output)
+	output.Flush()
+
+	switch exitError := err.(type) {
+	case nil:
+		return instanceResult{exitCode: "0"}
+	case *ssh.ExitError:
+		result := instanceResult{exitCode: strconv.Itoa(exitError.ExitStatus()), failed: exitError.ExitStatus() != 0}
+		if sig := exitError.Signal(); sig != "" {
+			result.details = T("Process terminated by signal: {{.Signal}}", map[string]interface{}{"Signal": sig})
+		}
+		return result
+	default:
+		return instanceResult{failed: true, details: T("Error: ") + err.Error()}
+	}
+}
+
+// instanceOutput hands every complete line written to it to say, prefixed
+// with the instance index.
+type instanceOutput struct {
+	prefix string
+	say    func(string)
+
+	mutex  sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (o *instanceOutput) Write(p []byte) (int, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	o.buffer.Write(p)
+	for {
+		line, err := o.buffer.ReadString('\n')
+		if err != nil {
+			o.buffer.WriteString(line)
+			break
+		}
+		o.say(o.prefix + line[:len(line)-1])
+	}
+	return len(p), nil
+}
+
+// Flush writes any output that did not end in a newline.
+func (o *instanceOutput) Flush() {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if o.buffer.Len() > 0 {
+		o.say(o.prefix + o.buffer.String())
+		o.buffer.Reset()
+	}
+}
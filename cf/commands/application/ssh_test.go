@@ -2,6 +2,8 @@ package application_test
 
 import (
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"time"
@@ -14,6 +16,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/net"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
 	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
 	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
 	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
@@ -270,6 +273,104 @@ var _ = Describe("SSH command", func() {
 				deps.Gateways["cloud-controller"] = ccGateway
 			})
 
+			Context("when --all-instances is provided", func() {
+				BeforeEach(func() {
+					currentApp.InstanceCount = 3
+
+					applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+					applicationReq.GetApplicationReturns(currentApp)
+					requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+					fakeSecureShell.ExecuteCommandStub = func(stdout io.Writer, stderr io.Writer) error {
+						fmt.Fprint(stdout, "root 1 init\nroot 2 diego-")
+						fmt.Fprint(stdout, "sshd\n")
+						fmt.Fprint(stderr, "no newline")
+						return nil
+					}
+				})
+
+				It("runs the command on every instance", func() {
+					Expect(runCommand("my-app", "--all-instances", "-c", "ps", "-k")).To(BeTrue())
+
+					Expect(sshCodeGetter.GetCallCount()).To(Equal(3))
+					Expect(fakeSecureShell.ConnectCallCount()).To(Equal(3))
+					Expect(fakeSecureShell.ExecuteCommandCallCount()).To(Equal(3))
+					Expect(fakeSecureShell.CloseCallCount()).To(Equal(3))
+
+					indexes := []uint{}
+					for i := 0; i < 3; i++ {
+						opts := fakeSecureShell.ConnectArgsForCall(i)
+						Expect(opts.Command).To(Equal([]string{"ps"}))
+						Expect(opts.SkipHostValidation).To(BeTrue())
+						indexes = append(indexes, opts.Index)
+					}
+					Expect(indexes).To(ConsistOf(uint(0), uint(1), uint(2)))
+				})
+
+				It("prefixes the output with the instance index", func() {
+					runCommand("my-app", "--all-instances", "-c", "ps")
+
+					Expect(ui.Outputs()).To(ContainElement("[1] root 1 init"))
+					Expect(ui.Outputs()).To(ContainElement("[1] root 2 diego-sshd"))
+					Expect(ui.Outputs()).To(ContainElement("[2] no newline"))
+				})
+
+				It("prints a summary of the exit codes", func() {
+					runCommand("my-app", "--all-instances", "-c", "ps")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"instance", "exit code", "details"},
+						[]string{"#0", "0"},
+						[]string{"#1", "0"},
+						[]string{"#2", "0"},
+					))
+				})
+
+				Context("when the command fails on an instance", func() {
+					BeforeEach(func() {
+						fakeSecureShell.ExecuteCommandStub = func(stdout io.Writer, stderr io.Writer) error {
+							if fakeSecureShell.ExecuteCommandCallCount() == 2 {
+								return errors.New("connection reset")
+							}
+							return nil
+						}
+					})
+
+					It("reports the failure in the summary and fails", func() {
+						Expect(runCommand("my-app", "--all-instances", "-c", "ps", "--max-in-flight", "1")).To(BeFalse())
+
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"#0", "0"},
+							[]string{"#1", "Error:", "connection reset"},
+							[]string{"#2", "0"},
+							[]string{"FAILED"},
+							[]string{"Command failed on 1 of 3 instances"},
+						))
+					})
+				})
+
+				Context("when connecting to an instance fails", func() {
+					BeforeEach(func() {
+						fakeSecureShell.ConnectStub = func(opts *options.SSHOptions) error {
+							if opts.Index == 0 {
+								return errors.New("instance not running")
+							}
+							return nil
+						}
+					})
+
+					It("continues with the other instances", func() {
+						Expect(runCommand("my-app", "--all-instances", "-c", "ps")).To(BeFalse())
+
+						Expect(fakeSecureShell.ExecuteCommandCallCount()).To(Equal(2))
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"#0", "Error opening SSH connection", "instance not running"},
+							[]string{"Command failed on 1 of 3 instances"},
+						))
+					})
+				})
+			})
+
 			Context("Error when connecting", func() {
 				It("notifies users", func() {
 					fakeSecureShell.ConnectReturns(errors.New("dial errorrr"))
@@ -296,6 +397,47 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("Error port forwarding when -R is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.RemotePortForwardReturns(errors.New("tcpip-forward request denied by peer"))
+
+					runCommand("my-app", "-R", "8000:localhost:8000")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding port", "tcpip-forward request denied by peer"},
+					))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("Error port forwarding when -D is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.DynamicPortForwardReturns(errors.New("listen error"))
+
+					runCommand("my-app", "-D", "1080")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding port", "listen error"},
+					))
+					Expect(fakeSecureShell.InteractiveSessionCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when -R and -D are provided", func() {
+				It("starts remote and dynamic forwarding before waiting", func() {
+					runCommand("my-app", "-N", "-R", "9000:localhost:3000", "-D", "1080")
+
+					Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+					opts := fakeSecureShell.ConnectArgsForCall(0)
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(options.ForwardSpec{ListenAddress: "localhost:9000", ConnectAddress: "localhost:3000"}))
+					Expect(opts.DynamicForwardSpecs).To(ConsistOf("localhost:1080"))
+
+					Expect(fakeSecureShell.RemotePortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.DynamicPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.WaitCallCount()).To(Equal(1))
+				})
+			})
+
 			Context("when -N is provided", func() {
 				It("calls secureShell.Wait()", func() {
 					fakeSecureShell.ConnectReturns(nil)
@@ -42,12 +42,13 @@ func (cmd *PluginInstall) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["r"] = &flags.StringFlag{ShortName: "r", Usage: T("Name of a registered repository where the specified plugin is located")}
 	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force install of plugin without confirmation")}
+	fs["from-lockfile"] = &flags.StringFlag{Name: "from-lockfile", Usage: T("Install the plugins listed in a lockfile written by 'plugins --export'")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "install-plugin",
 		Description: T("Install CLI plugin"),
 		Usage: []string{
-			T(`CF_NAME install-plugin (LOCAL-PATH/TO/PLUGIN | URL | -r REPO_NAME PLUGIN_NAME) [-f]
+			T(`CF_NAME install-plugin (LOCAL-PATH/TO/PLUGIN | URL | -r REPO_NAME PLUGIN_NAME | --from-lockfile LOCKFILE) [-f]
 
    Prompts for confirmation unless '-f' is provided.`),
 		},
@@ -55,6 +56,7 @@ func (cmd *PluginInstall) MetaData() commandregistry.CommandMetadata {
 			"CF_NAME install-plugin ~/Downloads/plugin-foobar",
 			"CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64",
 			"CF_NAME install-plugin -r My-Repo plugin-echo",
+			"CF_NAME install-plugin --from-lockfile plugins.lock",
 		},
 		Flags:     fs,
 		TotalArgs: 1,
@@ -62,6 +64,15 @@ func (cmd *PluginInstall) MetaData() commandregistry.CommandMetadata {
 }
 
 func (cmd *PluginInstall) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if fc.String("from-lockfile") != "" {
+		if len(fc.Args()) != 0 || fc.String("r") != "" {
+			cmd.ui.Failed(T("Incorrect Usage. '--from-lockfile' cannot be used with a plugin argument or '-r'\n\n") + commandregistry.Commands.CommandUsage("install-plugin"))
+			return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 0)
+		}
+
+		return []requirements.Requirement{}, nil
+	}
+
 	if len(fc.Args()) != 1 {
 		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("install-plugin"))
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 1)
@@ -93,6 +104,10 @@ func (cmd *PluginInstall) SetDependency(deps commandregistry.Dependency, pluginC
 }
 
 func (cmd *PluginInstall) Execute(c flags.FlagContext) error {
+	if c.String("from-lockfile") != "" {
+		return cmd.installFromLockfile(c, c.String("from-lockfile"))
+	}
+
 	if !cmd.confirmWithUser(
 		c,
 		T("**Attention: Plugins are binaries written by potentially untrusted authors. Install and use plugins at your own risk.**\n\nDo you want to install the plugin {{.Plugin}}?",
@@ -140,7 +155,7 @@ func (cmd *PluginInstall) Execute(c flags.FlagContext) error {
 		return err
 	}
 
-	pluginMetadata, err := cmd.runBinaryAndObtainPluginMetadata(pluginSourceFilepath)
+	pluginMetadata, err := runBinaryAndObtainPluginMetadata(cmd.rpcService, pluginSourceFilepath)
 	if err != nil {
 		return err
 	}
@@ -219,6 +234,13 @@ func (cmd *PluginInstall) ensurePluginIsSafeForInstallation(pluginMetadata *plug
 		)
 	}
 
+	return ensurePluginCommandsDoNotConflict(pluginMetadata, plugins)
+}
+
+// ensurePluginCommandsDoNotConflict returns an error if any command or alias of
+// the plugin clashes with a core command or with a command of the given
+// installed plugins.
+func ensurePluginCommandsDoNotConflict(pluginMetadata *plugin.PluginMetadata, plugins map[string]pluginconfig.PluginMetadata) error {
 	for _, pluginCmd := range pluginMetadata.Commands {
 		//check for command conflicting core commands/alias
 		if pluginCmd.Name == "help" || commandregistry.Commands.CommandExists(pluginCmd.Name) {
@@ -291,25 +313,27 @@ func (cmd *PluginInstall) installPlugin(pluginMetadata *plugin.PluginMetadata, p
 	return nil
 }
 
-func (cmd *PluginInstall) runBinaryAndObtainPluginMetadata(pluginSourceFilepath string) (*plugin.PluginMetadata, error) {
-	err := cmd.rpcService.Start()
+// runBinaryAndObtainPluginMetadata runs the plugin binary against the RPC
+// service and returns the metadata it reports.
+func runBinaryAndObtainPluginMetadata(rpcService *pluginRPCService.CliRpcService, pluginSourceFilepath string) (*plugin.PluginMetadata, error) {
+	err := rpcService.Start()
 	if err != nil {
 		return nil, err
 	}
-	defer cmd.rpcService.Stop()
+	defer rpcService.Stop()
 
-	err = cmd.runPluginBinary(pluginSourceFilepath, cmd.rpcService.Port())
+	err = runPluginBinary(pluginSourceFilepath, rpcService.Port())
 	if err != nil {
 		return nil, err
 	}
 
-	c := cmd.rpcService.RpcCmd
+	c := rpcService.RpcCmd
 	c.MetadataMutex.RLock()
 	defer c.MetadataMutex.RUnlock()
 	return c.PluginMetadata, nil
 }
 
-func (cmd *PluginInstall) runPluginBinary(location string, servicePort string) error {
+func runPluginBinary(location string, servicePort string) error {
 	pluginInvocation := exec.Command(location, servicePort, "SendMetadata")
 
 	err := pluginInvocation.Run()
@@ -0,0 +1,179 @@
+package plugin
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/cf/actors/plugininstaller"
+	"code.cloudfoundry.org/cli/cf/actors/pluginrepo"
+	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/downloader"
+)
+
+func (cmd *PluginInstall) installFromLockfile(c flags.FlagContext, lockfilePath string) error {
+	lockfile, err := pluginrepo.ReadLockfile(lockfilePath)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.confirmWithUser(
+		c,
+		T("**Attention: Plugins are binaries written by potentially untrusted authors. Install and use plugins at your own risk.**\n\nDo you want to install the plugins listed in {{.Lockfile}}?",
+			map[string]interface{}{
+				"Lockfile": lockfilePath,
+			}),
+	) {
+		return errors.New(T("Plugin installation cancelled"))
+	}
+
+	platform := plugininstaller.CurrentPlatform()
+	for _, lockedPlugin := range lockfile.Plugins {
+		installed, isInstalled := cmd.pluginConfig.Plugins()[lockedPlugin.Name]
+		if isInstalled {
+			err = ensureInstalledVersionIsLocked(lockedPlugin, installed)
+			if err != nil {
+				return err
+			}
+
+			cmd.ui.Say(T("Plugin {{.PluginName}} v{{.Version}} is already installed.",
+				map[string]interface{}{
+					"PluginName": lockedPlugin.Name,
+					"Version":    lockedPlugin.Version,
+				}))
+			continue
+		}
+
+		err = cmd.installLockedPlugin(lockedPlugin, platform)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.ui.Ok()
+	return nil
+}
+
+func (cmd *PluginInstall) installLockedPlugin(lockedPlugin pluginrepo.LockedPlugin, platform string) error {
+	binary, found := lockedPlugin.Binary(platform)
+	if !found {
+		return errors.New(T("The lockfile does not list a checksum for plugin {{.PluginName}} on platform {{.Platform}}.",
+			map[string]interface{}{
+				"PluginName": lockedPlugin.Name,
+				"Platform":   platform,
+			}))
+	}
+
+	repo := lockedPlugin.PluginRepo()
+	cmd.ui.Say(T("Installing plugin {{.PluginName}} v{{.Version}} from repository {{.RepoName}}...",
+		map[string]interface{}{
+			"PluginName": lockedPlugin.Name,
+			"Version":    lockedPlugin.Version,
+			"RepoName":   repo.Name,
+		}))
+
+	fileDownloader := downloader.NewDownloader(os.TempDir())
+	defer func() {
+		err := fileDownloader.RemoveFile()
+		if err != nil {
+			cmd.ui.Say(T("Problem removing downloaded binary in temp directory: ") + err.Error())
+		}
+	}()
+
+	pluginDownloader := &plugininstaller.PluginDownloader{UI: cmd.ui, FileDownloader: fileDownloader}
+	pluginSourceFilepath, err := cmd.downloadLockedPlugin(pluginDownloader, lockedPlugin, binary)
+	if err != nil {
+		return err
+	}
+
+	cmd.checksum.SetFilePath(pluginSourceFilepath)
+	if !cmd.checksum.CheckSha1(binary.Checksum) {
+		return errors.New(T("The checksum of the downloaded binary for plugin {{.PluginName}} does not match the lockfile; the plugin was not installed.",
+			map[string]interface{}{
+				"PluginName": lockedPlugin.Name,
+			}))
+	}
+
+	_, pluginExecutableName := filepath.Split(pluginSourceFilepath)
+	pluginDestinationFilepath := filepath.Join(cmd.pluginConfig.GetPluginPath(), pluginExecutableName)
+
+	err = cmd.ensurePluginBinaryWithSameFileNameDoesNotAlreadyExist(pluginDestinationFilepath, pluginExecutableName)
+	if err != nil {
+		return err
+	}
+
+	pluginMetadata, err := runBinaryAndObtainPluginMetadata(cmd.rpcService, pluginSourceFilepath)
+	if err != nil {
+		return err
+	}
+
+	if pluginMetadata.Name != lockedPlugin.Name {
+		return errors.New(T("The downloaded binary reports plugin name {{.ActualName}} instead of {{.PluginName}}; the plugin was not installed.",
+			map[string]interface{}{
+				"ActualName": pluginMetadata.Name,
+				"PluginName": lockedPlugin.Name,
+			}))
+	}
+
+	err = cmd.ensurePluginIsSafeForInstallation(pluginMetadata, pluginDestinationFilepath, pluginSourceFilepath)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.installPlugin(pluginMetadata, pluginDestinationFilepath, pluginSourceFilepath)
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say(T(
+		"Plugin {{.PluginName}} v{{.Version}} successfully installed.",
+		map[string]interface{}{
+			"PluginName": pluginMetadata.Name,
+			"Version":    pluginrepo.FormatVersion(pluginMetadata.Version),
+		}),
+	)
+	return nil
+}
+
+func ensureInstalledVersionIsLocked(lockedPlugin pluginrepo.LockedPlugin, installed pluginconfig.PluginMetadata) error {
+	if !pluginrepo.IsSameVersion(lockedPlugin.Version, installed.Version) {
+		return errors.New(T("Plugin {{.PluginName}} v{{.InstalledVersion}} is already installed. Uninstall it to install v{{.Version}} from the lockfile.",
+			map[string]interface{}{
+				"PluginName":       lockedPlugin.Name,
+				"InstalledVersion": pluginrepo.FormatVersion(installed.Version),
+				"Version":          lockedPlugin.Version,
+			}))
+	}
+
+	return nil
+}
+
+// downloadLockedPlugin downloads the locked binary from the url recorded in
+// the lockfile. Lockfiles written without binary urls fall back to looking up
+// the locked version in the plugin repository.
+func (cmd *PluginInstall) downloadLockedPlugin(pluginDownloader *plugininstaller.PluginDownloader, lockedPlugin pluginrepo.LockedPlugin, binary pluginrepo.LockedBinary) (string, error) {
+	if binary.URL != "" {
+		return pluginDownloader.DownloadFromURL(binary.URL), nil
+	}
+
+	repo := lockedPlugin.PluginRepo()
+	repoPlugins, repoErrors := cmd.pluginRepo.GetPlugins([]models.PluginRepo{repo})
+	if len(repoErrors) > 0 {
+		return "", errors.New(repoErrors[0])
+	}
+
+	repoPlugin, found := pluginrepo.FindPluginVersion(repoPlugins, lockedPlugin.Name, lockedPlugin.Version)
+	if !found {
+		return "", errors.New(T("Plugin {{.PluginName}} version {{.Version}} is not available in repository {{.RepoName}}.",
+			map[string]interface{}{
+				"PluginName": lockedPlugin.Name,
+				"Version":    lockedPlugin.Version,
+				"RepoName":   repo.Name,
+			}))
+	}
+
+	return pluginDownloader.DownloadAndVerify(repoPlugin.Plugin, cmd.checksum)
+}
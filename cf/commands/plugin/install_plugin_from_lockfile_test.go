@@ -0,0 +1,309 @@
+package plugin_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/cf/actors/pluginrepo"
+	"code.cloudfoundry.org/cli/cf/actors/pluginrepo/pluginrepofakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
+	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig/pluginconfigfakes"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/plugin"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+	"code.cloudfoundry.org/cli/util/utilfakes"
+
+	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/web"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Install from lockfile", func() {
+	var (
+		ui                  *testterm.FakeUI
+		requirementsFactory *requirementsfakes.FakeFactory
+		config              coreconfig.Repository
+		pluginConfig        *pluginconfigfakes.FakePluginConfiguration
+		fakePluginRepo      *pluginrepofakes.FakePluginRepo
+		fakeChecksum        *utilfakes.FakeSha1Checksum
+		deps                commandregistry.Dependency
+
+		testServer   *httptest.Server
+		tempDir      string
+		pluginDir    string
+		lockfilePath string
+		lockedPlugin pluginrepo.LockedPlugin
+		test_1       string
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = config
+		deps.PluginConfig = pluginConfig
+		deps.PluginRepo = fakePluginRepo
+		deps.ChecksumUtil = fakeChecksum
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("install-plugin").SetDependency(deps, pluginCall))
+	}
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("install-plugin", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	repoPlugin := func(name string, version string, checksum string) clipr.Plugin {
+		p := clipr.Plugin{Name: name, Version: version}
+		for _, platform := range []string{"osx", "win32", "win64", "linux32", "linux64"} {
+			p.Binaries = append(p.Binaries, clipr.Binary{Platform: platform, Url: testServer.URL + "/test_1.exe", Checksum: checksum})
+		}
+		return p
+	}
+
+	writeLockfile := func(lockedPlugins ...pluginrepo.LockedPlugin) {
+		Expect(pluginrepo.WriteLockfile(lockfilePath, pluginrepo.Lockfile{Plugins: lockedPlugins})).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		config = testconfig.NewRepositoryWithDefaults()
+		pluginConfig = new(pluginconfigfakes.FakePluginConfiguration)
+		fakePluginRepo = new(pluginrepofakes.FakePluginRepo)
+		fakeChecksum = new(utilfakes.FakeSha1Checksum)
+		fakeChecksum.CheckSha1Returns(true)
+
+		dir, err := os.Getwd()
+		Expect(err).ToNot(HaveOccurred())
+		test_1 = filepath.Join(dir, "..", "..", "..", "fixtures", "plugins", "test_1.exe")
+		if runtime.GOOS != "windows" {
+			Expect(os.Chmod(test_1, 0700)).To(Succeed())
+		}
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.ServeFile(w, r, test_1)
+		}))
+
+		tempDir, err = ioutil.TempDir("", "install-plugin-lockfile")
+		Expect(err).ToNot(HaveOccurred())
+		pluginDir = filepath.Join(tempDir, "plugins")
+		Expect(os.Mkdir(pluginDir, 0700)).To(Succeed())
+		pluginConfig.GetPluginPathReturns(pluginDir)
+		pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{})
+
+		lockfilePath = filepath.Join(tempDir, "plugins.lock")
+		repo := models.PluginRepo{Name: "repo1", URL: "https://repo1.example.com"}
+		lockedPlugin = pluginrepo.NewLockedPlugin(repo, pluginrepo.RepoPlugin{
+			RepoName: "repo1",
+			Plugin:   repoPlugin("Test1", "1.2.4", "locked-sha1"),
+		})
+		writeLockfile(lockedPlugin)
+
+		fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+			"repo1": {repoPlugin("Test1", "1.2.4", "repo-sha1")},
+		}, nil)
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(tempDir)
+	})
+
+	Describe("requirements", func() {
+		It("does not accept a plugin argument", func() {
+			Expect(runCommand("--from-lockfile", lockfilePath, "Test1")).ToNot(HavePassedRequirements())
+		})
+
+		It("does not accept a repository", func() {
+			Expect(runCommand("--from-lockfile", lockfilePath, "-r", "repo1")).ToNot(HavePassedRequirements())
+		})
+	})
+
+	Context("when the lockfile cannot be read", func() {
+		It("fails with an error", func() {
+			runCommand("--from-lockfile", filepath.Join(tempDir, "missing.lock"), "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}))
+			Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the user declines the installation", func() {
+		It("does not install anything", func() {
+			ui.Inputs = []string{"n"}
+			runCommand("--from-lockfile", lockfilePath)
+
+			Expect(ui.Outputs()).To(ContainSubstrings([]string{"Plugin installation cancelled"}))
+			Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(0))
+		})
+	})
+
+	It("installs the locked binary from the url in the lockfile", func() {
+		runCommand("--from-lockfile", lockfilePath, "-f")
+
+		Expect(ui.Outputs()).To(ContainSubstrings(
+			[]string{"Installing plugin Test1 v1.2.4 from repository repo1..."},
+			[]string{"bytes downloaded"},
+			[]string{"Plugin Test1 v1.2.4 successfully installed."},
+			[]string{"OK"},
+		))
+
+		Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(0))
+
+		Expect(fakeChecksum.CheckSha1CallCount()).To(Equal(1))
+		Expect(fakeChecksum.CheckSha1ArgsForCall(0)).To(Equal("locked-sha1"))
+
+		Expect(pluginConfig.SetPluginCallCount()).To(Equal(1))
+		name, metadata := pluginConfig.SetPluginArgsForCall(0)
+		Expect(name).To(Equal("Test1"))
+		Expect(metadata.Location).To(Equal(filepath.Join(pluginDir, "test_1.exe")))
+		Expect(metadata.Version).To(Equal(plugin.VersionType{Major: 1, Minor: 2, Build: 4}))
+	})
+
+	Context("when the downloaded binary does not match the lockfile checksum", func() {
+		BeforeEach(func() {
+			fakeChecksum.CheckSha1Stub = func(sha1 string) bool {
+				return sha1 != "locked-sha1"
+			}
+		})
+
+		It("refuses to install the plugin", func() {
+			runCommand("--from-lockfile", lockfilePath, "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"The checksum of the downloaded binary for plugin Test1 does not match the lockfile; the plugin was not installed."},
+			))
+			Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+
+			files, err := ioutil.ReadDir(pluginDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(BeEmpty())
+		})
+	})
+
+	Context("when the lockfile does not record the binary urls", func() {
+		BeforeEach(func() {
+			for i := range lockedPlugin.Binaries {
+				lockedPlugin.Binaries[i].URL = ""
+			}
+			writeLockfile(lockedPlugin)
+		})
+
+		It("installs the locked version from the locked repository", func() {
+			runCommand("--from-lockfile", lockfilePath, "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Installing plugin Test1 v1.2.4 from repository repo1..."},
+				[]string{"bytes downloaded"},
+				[]string{"Plugin Test1 v1.2.4 successfully installed."},
+				[]string{"OK"},
+			))
+
+			Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(1))
+			Expect(fakePluginRepo.GetPluginsArgsForCall(0)).To(Equal([]models.PluginRepo{{Name: "repo1", URL: "https://repo1.example.com"}}))
+
+			Expect(fakeChecksum.CheckSha1CallCount()).To(Equal(2))
+			Expect(fakeChecksum.CheckSha1ArgsForCall(0)).To(Equal("repo-sha1"))
+			Expect(fakeChecksum.CheckSha1ArgsForCall(1)).To(Equal("locked-sha1"))
+			Expect(pluginConfig.SetPluginCallCount()).To(Equal(1))
+		})
+
+		Context("when the downloaded binary does not match the repository checksum", func() {
+			BeforeEach(func() {
+				fakeChecksum.CheckSha1Returns(false)
+			})
+
+			It("refuses to install the plugin", func() {
+				runCommand("--from-lockfile", lockfilePath, "-f")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"checksum does not match repo metadata"},
+				))
+				Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the repository no longer lists the locked version", func() {
+			BeforeEach(func() {
+				fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+					"repo1": {repoPlugin("Test1", "1.2.5", "repo-sha1")},
+				}, nil)
+			})
+
+			It("fails with an error", func() {
+				runCommand("--from-lockfile", lockfilePath, "-f")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Plugin Test1 version 1.2.4 is not available in repository repo1."},
+				))
+				Expect(fakeChecksum.CheckSha1CallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the lockfile has no checksum for this platform", func() {
+		BeforeEach(func() {
+			lockedPlugin.Binaries = []pluginrepo.LockedBinary{}
+			writeLockfile(lockedPlugin)
+		})
+
+		It("fails with an error", func() {
+			runCommand("--from-lockfile", lockfilePath, "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"The lockfile does not list a checksum for plugin Test1 on platform"},
+			))
+			Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the plugin is already installed", func() {
+		Context("at the locked version", func() {
+			BeforeEach(func() {
+				pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+					"Test1": {Version: plugin.VersionType{Major: 1, Minor: 2, Build: 4}},
+				})
+			})
+
+			It("skips the plugin", func() {
+				runCommand("--from-lockfile", lockfilePath, "-f")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Plugin Test1 v1.2.4 is already installed."},
+					[]string{"OK"},
+				))
+				Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("at a different version", func() {
+			BeforeEach(func() {
+				pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+					"Test1": {Version: plugin.VersionType{Major: 1, Minor: 2, Build: 0}},
+				})
+			})
+
+			It("fails with an error", func() {
+				runCommand("--from-lockfile", lockfilePath, "-f")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Plugin Test1 v1.2.0 is already installed. Uninstall it to install v1.2.4 from the lockfile."},
+				))
+				Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -1,13 +1,20 @@
 package plugin
 
 import (
+	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
+	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/actors/plugininstaller"
+	"code.cloudfoundry.org/cli/cf/actors/pluginrepo"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements"
 	"code.cloudfoundry.org/cli/cf/terminal"
 	"code.cloudfoundry.org/cli/util"
@@ -15,8 +22,10 @@ import (
 )
 
 type Plugins struct {
-	ui     terminal.UI
-	config pluginconfig.PluginConfiguration
+	ui         terminal.UI
+	config     pluginconfig.PluginConfiguration
+	coreConfig coreconfig.Reader
+	pluginRepo pluginrepo.PluginRepo
 }
 
 func init() {
@@ -26,12 +35,17 @@ func init() {
 func (cmd *Plugins) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["checksum"] = &flags.BoolFlag{Name: "checksum", Usage: T("Compute and show the sha1 value of the plugin binary file")}
+	fs["outdated"] = &flags.BoolFlag{Name: "outdated", Usage: T("Search the plugin repositories for new versions of installed plugins")}
+	fs["export"] = &flags.StringFlag{Name: "export", Usage: T("Write the name, version, source repository and checksums of installed plugins to a lockfile")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "plugins",
 		Description: T("List all available plugin commands"),
 		Usage: []string{
-			T("CF_NAME plugins"),
+			T("CF_NAME plugins [--checksum | --outdated | --export LOCKFILE]"),
+		},
+		Examples: []string{
+			"CF_NAME plugins --export plugins.lock",
 		},
 		Flags: fs,
 	}
@@ -45,8 +59,22 @@ func (cmd *Plugins) Requirements(requirementsFactory requirements.Factory, fc fl
 		},
 	)
 
+	flagsReq := requirements.NewUsageRequirement(commandregistry.CLICommandUsagePresenter(cmd),
+		T("'--checksum', '--outdated' and '--export' cannot be used together"),
+		func() bool {
+			exclusiveFlags := 0
+			for _, isSet := range []bool{fc.Bool("checksum"), fc.Bool("outdated"), fc.String("export") != ""} {
+				if isSet {
+					exclusiveFlags++
+				}
+			}
+			return exclusiveFlags > 1
+		},
+	)
+
 	reqs := []requirements.Requirement{
 		usageReq,
+		flagsReq,
 	}
 	return reqs, nil
 }
@@ -54,10 +82,20 @@ func (cmd *Plugins) Requirements(requirementsFactory requirements.Factory, fc fl
 func (cmd *Plugins) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
 	cmd.ui = deps.UI
 	cmd.config = deps.PluginConfig
+	cmd.coreConfig = deps.Config
+	cmd.pluginRepo = deps.PluginRepo
 	return cmd
 }
 
 func (cmd *Plugins) Execute(c flags.FlagContext) error {
+	if c.Bool("outdated") {
+		return cmd.listOutdatedPlugins()
+	}
+
+	if c.String("export") != "" {
+		return cmd.exportPlugins(c.String("export"))
+	}
+
 	var version string
 
 	cmd.ui.Say(T("Listing Installed Plugins..."))
@@ -119,3 +157,156 @@ func (cmd *Plugins) Execute(c flags.FlagContext) error {
 	}
 	return nil
 }
+
+func (cmd *Plugins) listOutdatedPlugins() error {
+	repos := cmd.coreConfig.PluginRepos()
+	if len(repos) == 0 {
+		return errors.New(T("No plugin repositories registered to search for newer plugin versions.\nTip: use `add-plugin-repo` command to add repos."))
+	}
+
+	var repoNames []string
+	for _, repo := range repos {
+		repoNames = append(repoNames, repo.Name)
+	}
+
+	cmd.ui.Say(T("Searching {{.RepoNames}} for newer versions of installed plugins...",
+		map[string]interface{}{
+			"RepoNames": strings.Join(repoNames, ", "),
+		}))
+
+	repoPlugins, repoErrors := cmd.pluginRepo.GetPlugins(repos)
+	for _, repoError := range repoErrors {
+		cmd.ui.Warn("%s", repoError)
+	}
+
+	plugins := cmd.config.Plugins()
+
+	var sortedPluginNames sorting.Alphabetic
+	for k := range plugins {
+		sortedPluginNames = append(sortedPluginNames, k)
+	}
+	sort.Sort(sortedPluginNames)
+
+	table := cmd.ui.Table([]string{T("plugin"), T("version"), T("latest version"), T("repository")})
+	outdated := 0
+	for _, pluginName := range sortedPluginNames {
+		if plugins[pluginName].Pinned {
+			continue
+		}
+
+		newest, found := pluginrepo.FindNewestPlugin(repoPlugins, pluginName)
+		if !found || !pluginrepo.IsNewerVersion(newest.Plugin.Version, plugins[pluginName].Version) {
+			continue
+		}
+
+		table.Add(pluginName, pluginrepo.FormatVersion(plugins[pluginName].Version), newest.Plugin.Version, newest.RepoName)
+		outdated++
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if outdated == 0 {
+		cmd.ui.Say(T("All installed plugins are up to date."))
+		return nil
+	}
+
+	err := table.Print()
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Use '{{.Command}}' to update a plugin to the latest version.",
+		map[string]interface{}{
+			"Command": terminal.CommandColor(cf.Name + " update-plugin PLUGIN_NAME"),
+		}))
+	return nil
+}
+
+func (cmd *Plugins) exportPlugins(lockfilePath string) error {
+	repos := cmd.coreConfig.PluginRepos()
+	if len(repos) == 0 {
+		return errors.New(T("No plugin repositories registered to look up the source of installed plugins.\nTip: use `add-plugin-repo` command to add repos."))
+	}
+
+	cmd.ui.Say(T("Exporting installed plugins to {{.Path}}...",
+		map[string]interface{}{
+			"Path": lockfilePath,
+		}))
+
+	repoPlugins, repoErrors := cmd.pluginRepo.GetPlugins(repos)
+	for _, repoError := range repoErrors {
+		cmd.ui.Warn("%s", repoError)
+	}
+
+	plugins := cmd.config.Plugins()
+
+	var sortedPluginNames sorting.Alphabetic
+	for k := range plugins {
+		sortedPluginNames = append(sortedPluginNames, k)
+	}
+	sort.Sort(sortedPluginNames)
+
+	lockfile := pluginrepo.Lockfile{}
+	for _, pluginName := range sortedPluginNames {
+		metadata := plugins[pluginName]
+		version := pluginrepo.FormatVersion(metadata.Version)
+
+		repoPlugin, found := pluginrepo.FindPluginVersion(repoPlugins, pluginName, version)
+		if !found {
+			cmd.ui.Warn("%s", T("Plugin {{.PluginName}} v{{.Version}} is not listed by any registered plugin repository and was not exported.",
+				map[string]interface{}{
+					"PluginName": pluginName,
+					"Version":    version,
+				}))
+			continue
+		}
+
+		var repo models.PluginRepo
+		for _, r := range repos {
+			if r.Name == repoPlugin.RepoName {
+				repo = r
+			}
+		}
+		lockedPlugin := pluginrepo.NewLockedPlugin(repo, repoPlugin)
+
+		if !cmd.installedBinaryMatches(metadata.Location, lockedPlugin) {
+			cmd.ui.Warn("%s", T("The installed binary of plugin {{.PluginName}} does not match the one published by repository {{.RepoName}}; the plugin was not exported.",
+				map[string]interface{}{
+					"PluginName": pluginName,
+					"RepoName":   repo.Name,
+				}))
+			continue
+		}
+
+		lockfile.Plugins = append(lockfile.Plugins, lockedPlugin)
+	}
+
+	err := pluginrepo.WriteLockfile(lockfilePath, lockfile)
+	if err != nil {
+		return errors.New(T("Could not write plugin lockfile: \n{{.Error}}",
+			map[string]interface{}{
+				"Error": err.Error(),
+			}))
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("Exported {{.Count}} plugins to {{.Path}}.",
+		map[string]interface{}{
+			"Count": len(lockfile.Plugins),
+			"Path":  lockfilePath,
+		}))
+	return nil
+}
+
+// installedBinaryMatches returns true if the sha1 of the installed plugin
+// binary is the checksum published for the current platform.
+func (cmd *Plugins) installedBinaryMatches(location string, lockedPlugin pluginrepo.LockedPlugin) bool {
+	checksum, found := lockedPlugin.Checksum(plugininstaller.CurrentPlatform())
+	if !found {
+		return false
+	}
+
+	return util.NewSha1Checksum(location).CheckSha1(checksum)
+}
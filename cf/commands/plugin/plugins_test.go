@@ -1,18 +1,30 @@
 package plugin_test
 
 import (
+	"crypto/sha1"
+	"fmt"
+	"io/ioutil"
 	"net/rpc"
+	"os"
+	"path/filepath"
 
+	"code.cloudfoundry.org/cli/cf/actors/plugininstaller"
+	"code.cloudfoundry.org/cli/cf/actors/pluginrepo"
+	"code.cloudfoundry.org/cli/cf/actors/pluginrepo/pluginrepofakes"
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	plugincmd "code.cloudfoundry.org/cli/cf/commands/plugin"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
 	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig/pluginconfigfakes"
 	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
 	"code.cloudfoundry.org/cli/plugin"
 	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
 	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
 	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/web"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -23,12 +35,16 @@ var _ = Describe("Plugins", func() {
 		ui                  *testterm.FakeUI
 		requirementsFactory *requirementsfakes.FakeFactory
 		config              *pluginconfigfakes.FakePluginConfiguration
+		coreConfig          coreconfig.Repository
+		fakePluginRepo      *pluginrepofakes.FakePluginRepo
 		deps                commandregistry.Dependency
 	)
 
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
 		deps.PluginConfig = config
+		deps.Config = coreConfig
+		deps.PluginRepo = fakePluginRepo
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("plugins").SetDependency(deps, pluginCall))
 	}
 
@@ -36,6 +52,8 @@ var _ = Describe("Plugins", func() {
 		ui = &testterm.FakeUI{}
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 		config = new(pluginconfigfakes.FakePluginConfiguration)
+		coreConfig = testconfig.NewRepositoryWithDefaults()
+		fakePluginRepo = new(pluginrepofakes.FakePluginRepo)
 
 		rpc.DefaultServer = rpc.NewServer()
 	})
@@ -64,6 +82,197 @@ var _ = Describe("Plugins", func() {
 		})
 	})
 
+	Context("If --outdated flag is provided", func() {
+		BeforeEach(func() {
+			config.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+				"Test1": {Version: plugin.VersionType{Major: 1, Minor: 2, Build: 3}},
+				"Test2": {Version: plugin.VersionType{Major: 2, Minor: 0, Build: 0}},
+				"Test3": {},
+				"Test4": {Version: plugin.VersionType{Major: 1}},
+			})
+		})
+
+		Context("when no plugin repositories are registered", func() {
+			It("fails with an error", func() {
+				runCommand("--outdated")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"No plugin repositories registered"},
+				))
+				Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when plugin repositories are registered", func() {
+			BeforeEach(func() {
+				coreConfig.SetPluginRepo(models.PluginRepo{Name: "repo1", URL: "https://repo1.example.com"})
+				coreConfig.SetPluginRepo(models.PluginRepo{Name: "repo2", URL: "https://repo2.example.com"})
+			})
+
+			It("lists the installed plugins that have a newer version in any repository", func() {
+				fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+					"repo1": {
+						{Name: "Test1", Version: "1.3.0"},
+						{Name: "Test2", Version: "2.0.0"},
+					},
+					"repo2": {
+						{Name: "test1", Version: "1.4.0"},
+						{Name: "Test3", Version: "0.1.0"},
+					},
+				}, []string{"Error requesting from 'repo3'"})
+
+				runCommand("--outdated")
+
+				Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(1))
+				Expect(fakePluginRepo.GetPluginsArgsForCall(0)).To(HaveLen(2))
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Searching repo1, repo2 for newer versions of installed plugins..."},
+					[]string{"Error requesting from 'repo3'"},
+					[]string{"OK"},
+					[]string{"plugin", "version", "latest version", "repository"},
+					[]string{"Test1", "1.2.3", "1.4.0", "repo2"},
+					[]string{"Test3", "N/A", "0.1.0", "repo2"},
+					[]string{"Use 'cf update-plugin PLUGIN_NAME' to update a plugin to the latest version."},
+				))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"Test2"}))
+				Expect(ui.Outputs()).ToNot(ContainSubstrings([]string{"Test4"}))
+			})
+
+			It("skips pinned plugins", func() {
+				config.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+					"Test1": {Version: plugin.VersionType{Major: 1, Minor: 2, Build: 3}, Pinned: true},
+				})
+				fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+					"repo1": {{Name: "Test1", Version: "1.3.0"}},
+				}, nil)
+
+				runCommand("--outdated")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"OK"},
+					[]string{"All installed plugins are up to date."},
+				))
+			})
+
+			It("says so when every plugin is up to date", func() {
+				fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+					"repo1": {{Name: "Test2", Version: "2.0.0"}},
+				}, nil)
+
+				runCommand("--outdated")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"OK"},
+					[]string{"All installed plugins are up to date."},
+				))
+			})
+		})
+
+		It("cannot be combined with --checksum", func() {
+			Expect(runCommand("--outdated", "--checksum")).To(BeFalse())
+		})
+	})
+
+	Context("If --export flag is provided", func() {
+		var (
+			tempDir      string
+			lockfilePath string
+		)
+
+		writeBinary := func(name string, contents string) (string, string) {
+			path := filepath.Join(tempDir, name)
+			Expect(ioutil.WriteFile(path, []byte(contents), 0700)).To(Succeed())
+			return path, fmt.Sprintf("%x", sha1.Sum([]byte(contents)))
+		}
+
+		repoPlugin := func(name string, version string, checksum string) clipr.Plugin {
+			return clipr.Plugin{
+				Name:    name,
+				Version: version,
+				Binaries: []clipr.Binary{
+					{Platform: plugininstaller.CurrentPlatform(), Url: "https://example.com/" + name, Checksum: checksum},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "plugins-export")
+			Expect(err).ToNot(HaveOccurred())
+			lockfilePath = filepath.Join(tempDir, "plugins.lock")
+
+			plugin1Path, plugin1Sha1 := writeBinary("plugin1", "plugin1 binary")
+			plugin3Path, _ := writeBinary("plugin3", "plugin3 binary")
+
+			config.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+				"plugin1": {Location: plugin1Path, Version: plugin.VersionType{Major: 1, Minor: 2, Build: 3}},
+				"plugin2": {Location: filepath.Join(tempDir, "plugin2"), Version: plugin.VersionType{Major: 2}},
+				"plugin3": {Location: plugin3Path, Version: plugin.VersionType{Major: 0, Minor: 1}},
+			})
+
+			coreConfig.SetPluginRepo(models.PluginRepo{Name: "repo1", URL: "https://repo1.example.com"})
+			coreConfig.SetPluginRepo(models.PluginRepo{Name: "repo2", URL: "https://repo2.example.com"})
+
+			fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+				"repo1": {
+					repoPlugin("plugin1", "1.2.2", "old-sha1"),
+					repoPlugin("plugin3", "0.1.0", "published-sha1"),
+				},
+				"repo2": {
+					repoPlugin("plugin1", "1.2.3", plugin1Sha1),
+				},
+			}, []string{"repo error"})
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tempDir)
+		})
+
+		It("writes the installed plugins published by the repositories to the lockfile", func() {
+			runCommand("--export", lockfilePath)
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Exporting installed plugins to " + lockfilePath + "..."},
+				[]string{"OK"},
+				[]string{"Exported 1 plugins to " + lockfilePath + "."},
+			))
+			Expect(ui.WarnOutputs).To(ContainElement("repo error"))
+			Expect(ui.WarnOutputs).To(ContainElement("Plugin plugin2 v2.0.0 is not listed by any registered plugin repository and was not exported."))
+			Expect(ui.WarnOutputs).To(ContainElement("The installed binary of plugin plugin3 does not match the one published by repository repo1; the plugin was not exported."))
+
+			lockfile, err := pluginrepo.ReadLockfile(lockfilePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lockfile.Plugins).To(HaveLen(1))
+			Expect(lockfile.Plugins[0].Name).To(Equal("plugin1"))
+			Expect(lockfile.Plugins[0].Version).To(Equal("1.2.3"))
+			Expect(lockfile.Plugins[0].Repo).To(Equal(pluginrepo.LockedRepo{Name: "repo2", URL: "https://repo2.example.com"}))
+			checksum, found := lockfile.Plugins[0].Checksum(plugininstaller.CurrentPlatform())
+			Expect(found).To(BeTrue())
+			Expect(checksum).To(Equal(fmt.Sprintf("%x", sha1.Sum([]byte("plugin1 binary")))))
+		})
+
+		Context("when there are no plugin repositories", func() {
+			BeforeEach(func() {
+				coreConfig.UnSetPluginRepo(0)
+				coreConfig.UnSetPluginRepo(0)
+			})
+
+			It("fails without writing the lockfile", func() {
+				runCommand("--export", lockfilePath)
+
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"FAILED"}))
+				_, err := os.Stat(lockfilePath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		It("cannot be combined with --outdated", func() {
+			Expect(runCommand("--export", lockfilePath, "--outdated")).To(BeFalse())
+		})
+	})
+
 	Context("when arguments are provided", func() {
 		var cmd commandregistry.Command
 		var flagContext flags.FlagContext
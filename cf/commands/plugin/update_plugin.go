@@ -0,0 +1,371 @@
+package plugin
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/rpc"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/actors/plugininstaller"
+	"code.cloudfoundry.org/cli/cf/actors/pluginrepo"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/terminal"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/downloader"
+	"code.cloudfoundry.org/cli/util/sorting"
+
+	pluginRPCService "code.cloudfoundry.org/cli/plugin/rpc"
+	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/web"
+)
+
+type PluginUpdate struct {
+	ui           terminal.UI
+	config       coreconfig.Reader
+	pluginConfig pluginconfig.PluginConfiguration
+	pluginRepo   pluginrepo.PluginRepo
+	checksum     util.Sha1Checksum
+	rpcService   *pluginRPCService.CliRpcService
+}
+
+func init() {
+	commandregistry.Register(&PluginUpdate{})
+}
+
+func (cmd *PluginUpdate) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["all"] = &flags.BoolFlag{Name: "all", Usage: T("Update every installed plugin that has a newer version available")}
+	fs["r"] = &flags.StringFlag{ShortName: "r", Usage: T("Name of a registered repository to search for the new version")}
+	fs["version"] = &flags.StringFlag{Name: "version", Usage: T("Install the given version instead of the latest one and pin the plugin to it")}
+	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force update of plugin without confirmation")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "update-plugin",
+		Description: T("Update installed CLI plugins from the registered plugin repositories"),
+		Usage: []string{
+			T(`CF_NAME update-plugin (PLUGIN_NAME | --all) [-r REPO_NAME] [--version VERSION] [-f]
+
+   Prompts for confirmation unless '-f' is provided.
+
+   A plugin updated with '--version' is pinned to that version. Pinned plugins are skipped by '--all' and 'plugins --outdated' until they are updated without '--version'.`),
+		},
+		Examples: []string{
+			"CF_NAME update-plugin plugin-echo",
+			"CF_NAME update-plugin plugin-echo -r My-Repo --version 1.2.0",
+			"CF_NAME update-plugin --all -f",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *PluginUpdate) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	argsReq := requirements.NewUsageRequirement(commandregistry.CLICommandUsagePresenter(cmd),
+		T("Requires PLUGIN_NAME or '--all', but not both"),
+		func() bool {
+			if fc.Bool("all") {
+				return len(fc.Args()) != 0
+			}
+			return len(fc.Args()) != 1
+		},
+	)
+
+	versionReq := requirements.NewUsageRequirement(commandregistry.CLICommandUsagePresenter(cmd),
+		T("'--version' cannot be used with '--all'"),
+		func() bool {
+			return fc.Bool("all") && fc.String("version") != ""
+		},
+	)
+
+	reqs := []requirements.Requirement{
+		argsReq,
+		versionReq,
+	}
+	return reqs, nil
+}
+
+func (cmd *PluginUpdate) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.pluginConfig = deps.PluginConfig
+	cmd.pluginRepo = deps.PluginRepo
+	cmd.checksum = deps.ChecksumUtil
+
+	//reset rpc registration in case there is other running instance,
+	//each service can only be registered once
+	server := rpc.NewServer()
+
+	rpcService, err := pluginRPCService.NewRpcService(deps.TeePrinter, deps.TeePrinter, deps.Config, deps.RepoLocator, pluginRPCService.NewCommandRunner(), deps.Logger, cmd.ui.Writer(), server)
+	if err != nil {
+		cmd.ui.Failed("Error initializing RPC service: " + err.Error())
+	}
+
+	cmd.rpcService = rpcService
+
+	return cmd
+}
+
+func (cmd *PluginUpdate) Execute(c flags.FlagContext) error {
+	installedPlugins := cmd.pluginConfig.Plugins()
+
+	var pluginNames sorting.Alphabetic
+	if c.Bool("all") {
+		for name := range installedPlugins {
+			pluginNames = append(pluginNames, name)
+		}
+		sort.Sort(pluginNames)
+	} else {
+		pluginName := c.Args()[0]
+		if _, ok := installedPlugins[pluginName]; !ok {
+			return errors.New(T("Plugin {{.PluginName}} is not installed.",
+				map[string]interface{}{
+					"PluginName": pluginName,
+				}))
+		}
+		pluginNames = append(pluginNames, pluginName)
+	}
+
+	repos, err := cmd.findRepos(c.String("r"))
+	if err != nil {
+		return err
+	}
+
+	var repoNames []string
+	for _, repo := range repos {
+		repoNames = append(repoNames, repo.Name)
+	}
+
+	cmd.ui.Say(T("Searching {{.RepoNames}} for plugin updates...",
+		map[string]interface{}{
+			"RepoNames": strings.Join(repoNames, ", "),
+		}))
+
+	repoPlugins, repoErrors := cmd.pluginRepo.GetPlugins(repos)
+	for _, repoError := range repoErrors {
+		cmd.ui.Warn("%s", repoError)
+	}
+	cmd.ui.Say("")
+
+	for _, pluginName := range pluginNames {
+		installed := installedPlugins[pluginName]
+
+		if c.Bool("all") && installed.Pinned {
+			cmd.ui.Say(T("Plugin {{.PluginName}} is pinned to v{{.Version}}.",
+				map[string]interface{}{
+					"PluginName": pluginName,
+					"Version":    pluginrepo.FormatVersion(installed.Version),
+				}))
+			continue
+		}
+
+		candidate, found, err := cmd.findUpdate(repoPlugins, pluginName, installed, c.String("version"), c.Bool("all"))
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		if !c.Bool("f") && !cmd.ui.Confirm(T("**Attention: Plugins are binaries written by potentially untrusted authors. Install and use plugins at your own risk.**\n\nDo you want to update the plugin {{.PluginName}} from v{{.CurrentVersion}} to v{{.Version}}?",
+			map[string]interface{}{
+				"PluginName":     pluginName,
+				"CurrentVersion": pluginrepo.FormatVersion(installed.Version),
+				"Version":        candidate.Plugin.Version,
+			})) {
+			cmd.ui.Say(T("Update of plugin {{.PluginName}} cancelled",
+				map[string]interface{}{
+					"PluginName": pluginName,
+				}))
+			continue
+		}
+
+		err = cmd.updatePlugin(pluginName, installed, candidate, c.String("version") != "")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd *PluginUpdate) findRepos(repoName string) ([]models.PluginRepo, error) {
+	repos := cmd.config.PluginRepos()
+
+	if repoName != "" {
+		for _, repo := range repos {
+			if strings.EqualFold(repo.Name, repoName) {
+				return []models.PluginRepo{repo}, nil
+			}
+		}
+		return nil, errors.New(repoName + T(" does not exist as an available plugin repo."+"\nTip: use `add-plugin-repo` command to add repos."))
+	}
+
+	if len(repos) == 0 {
+		return nil, errors.New(T("No plugin repositories registered to search for newer plugin versions.\nTip: use `add-plugin-repo` command to add repos."))
+	}
+	return repos, nil
+}
+
+// findUpdate returns the repository plugin the installed plugin should be
+// updated to. With a pinned version that exact release is required; otherwise
+// the newest release is used if it is newer than the installed one.
+func (cmd *PluginUpdate) findUpdate(repoPlugins map[string][]clipr.Plugin, pluginName string, installed pluginconfig.PluginMetadata, pinnedVersion string, all bool) (pluginrepo.RepoPlugin, bool, error) {
+	if pinnedVersion != "" {
+		pinned, found := pluginrepo.FindPluginVersion(repoPlugins, pluginName, pinnedVersion)
+		if !found {
+			return pluginrepo.RepoPlugin{}, false, errors.New(T("Plugin {{.PluginName}} version {{.Version}} is not available in the searched repositories.",
+				map[string]interface{}{
+					"PluginName": pluginName,
+					"Version":    pinnedVersion,
+				}))
+		}
+		return pinned, true, nil
+	}
+
+	newest, found := pluginrepo.FindNewestPlugin(repoPlugins, pluginName)
+	if !found {
+		message := T("Plugin {{.PluginName}} is not available in the searched repositories.",
+			map[string]interface{}{
+				"PluginName": pluginName,
+			})
+		if !all {
+			return pluginrepo.RepoPlugin{}, false, errors.New(message)
+		}
+		cmd.ui.Say(message)
+		return pluginrepo.RepoPlugin{}, false, nil
+	}
+
+	if !pluginrepo.IsNewerVersion(newest.Plugin.Version, installed.Version) {
+		cmd.ui.Say(T("Plugin {{.PluginName}} v{{.Version}} is already up to date.",
+			map[string]interface{}{
+				"PluginName": pluginName,
+				"Version":    pluginrepo.FormatVersion(installed.Version),
+			}))
+		return pluginrepo.RepoPlugin{}, false, nil
+	}
+
+	return newest, true, nil
+}
+
+func (cmd *PluginUpdate) updatePlugin(pluginName string, installed pluginconfig.PluginMetadata, candidate pluginrepo.RepoPlugin, pinned bool) error {
+	cmd.ui.Say(T("Updating plugin {{.PluginName}} to v{{.Version}} from repository {{.RepoName}}...",
+		map[string]interface{}{
+			"PluginName": pluginName,
+			"Version":    candidate.Plugin.Version,
+			"RepoName":   candidate.RepoName,
+		}))
+
+	fileDownloader := downloader.NewDownloader(os.TempDir())
+	defer func() {
+		err := fileDownloader.RemoveFile()
+		if err != nil {
+			cmd.ui.Say(T("Problem removing downloaded binary in temp directory: ") + err.Error())
+		}
+	}()
+
+	pluginDownloader := &plugininstaller.PluginDownloader{UI: cmd.ui, FileDownloader: fileDownloader}
+	pluginSourceFilepath, err := pluginDownloader.DownloadAndVerify(candidate.Plugin, cmd.checksum)
+	if err != nil {
+		return err
+	}
+
+	pluginMetadata, err := runBinaryAndObtainPluginMetadata(cmd.rpcService, pluginSourceFilepath)
+	if err != nil {
+		return err
+	}
+
+	if pluginMetadata.Name != pluginName {
+		return errors.New(T("The downloaded binary reports plugin name {{.ActualName}} instead of {{.PluginName}}; the plugin was not updated.",
+			map[string]interface{}{
+				"ActualName": pluginMetadata.Name,
+				"PluginName": pluginName,
+			}))
+	}
+
+	if pluginMetadata.Commands == nil {
+		return errors.New(T(
+			"Error getting command list from plugin {{.FilePath}}",
+			map[string]interface{}{
+				"FilePath": pluginSourceFilepath,
+			}),
+		)
+	}
+
+	otherPlugins := map[string]pluginconfig.PluginMetadata{}
+	for name, metadata := range cmd.pluginConfig.Plugins() {
+		if name != pluginName {
+			otherPlugins[name] = metadata
+		}
+	}
+
+	err = ensurePluginCommandsDoNotConflict(pluginMetadata, otherPlugins)
+	if err != nil {
+		return err
+	}
+
+	err = replacePluginBinary(pluginSourceFilepath, installed.Location)
+	if err != nil {
+		return errors.New(T(
+			"Could not replace plugin binary: \n{{.Error}}",
+			map[string]interface{}{
+				"Error": err.Error(),
+			}),
+		)
+	}
+
+	cmd.pluginConfig.SetPlugin(pluginName, pluginconfig.PluginMetadata{
+		Location: installed.Location,
+		Version:  pluginMetadata.Version,
+		Commands: pluginMetadata.Commands,
+		Pinned:   pinned,
+	})
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("Plugin {{.PluginName}} successfully updated to v{{.Version}}.",
+		map[string]interface{}{
+			"PluginName": pluginName,
+			"Version":    pluginrepo.FormatVersion(pluginMetadata.Version),
+		}))
+	cmd.ui.Say("")
+	return nil
+}
+
+// replacePluginBinary copies the new binary next to the installed one and
+// renames it into place, so the installed plugin is never left half written.
+func replacePluginBinary(sourcePath string, destinationPath string) error {
+	tempFile, err := ioutil.TempFile(filepath.Dir(destinationPath), "."+filepath.Base(destinationPath)+"-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		tempFile.Close()
+		return err
+	}
+	defer source.Close()
+
+	_, err = io.Copy(tempFile, source)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(tempFile.Name(), 0700)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), destinationPath)
+}
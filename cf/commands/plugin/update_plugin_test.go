@@ -0,0 +1,386 @@
+package plugin_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/cf/actors/pluginrepo/pluginrepofakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig"
+	"code.cloudfoundry.org/cli/cf/configuration/pluginconfig/pluginconfigfakes"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/plugin"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+	"code.cloudfoundry.org/cli/util/utilfakes"
+
+	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/web"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Update", func() {
+	var (
+		ui                  *testterm.FakeUI
+		requirementsFactory *requirementsfakes.FakeFactory
+		config              coreconfig.Repository
+		pluginConfig        *pluginconfigfakes.FakePluginConfiguration
+		fakePluginRepo      *pluginrepofakes.FakePluginRepo
+		fakeChecksum        *utilfakes.FakeSha1Checksum
+		deps                commandregistry.Dependency
+
+		testServer      *httptest.Server
+		pluginDir       string
+		installedBinary string
+		test_1          string
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = config
+		deps.PluginConfig = pluginConfig
+		deps.PluginRepo = fakePluginRepo
+		deps.ChecksumUtil = fakeChecksum
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("update-plugin").SetDependency(deps, pluginCall))
+	}
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("update-plugin", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	repoPlugin := func(name string, version string) clipr.Plugin {
+		p := clipr.Plugin{Name: name, Version: version}
+		for _, platform := range []string{"osx", "win32", "win64", "linux32", "linux64"} {
+			p.Binaries = append(p.Binaries, clipr.Binary{Platform: platform, Url: testServer.URL + "/test_1.exe"})
+		}
+		return p
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		config = testconfig.NewRepositoryWithDefaults()
+		pluginConfig = new(pluginconfigfakes.FakePluginConfiguration)
+		fakePluginRepo = new(pluginrepofakes.FakePluginRepo)
+		fakeChecksum = new(utilfakes.FakeSha1Checksum)
+		fakeChecksum.CheckSha1Returns(true)
+
+		dir, err := os.Getwd()
+		Expect(err).ToNot(HaveOccurred())
+		test_1 = filepath.Join(dir, "..", "..", "..", "fixtures", "plugins", "test_1.exe")
+		if runtime.GOOS != "windows" {
+			Expect(os.Chmod(test_1, 0700)).To(Succeed())
+		}
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.ServeFile(w, r, test_1)
+		}))
+
+		pluginDir, err = ioutil.TempDir("", "update-plugin")
+		Expect(err).ToNot(HaveOccurred())
+		installedBinary = filepath.Join(pluginDir, "test_1.exe")
+		Expect(ioutil.WriteFile(installedBinary, []byte("old binary"), 0700)).To(Succeed())
+
+		pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+			"Test1": {
+				Location: installedBinary,
+				Version:  plugin.VersionType{Major: 1, Minor: 2, Build: 0},
+				Commands: []plugin.Command{{Name: "test_1_cmd1"}},
+			},
+		})
+
+		config.SetPluginRepo(models.PluginRepo{Name: "repo1", URL: "https://repo1.example.com"})
+		config.SetPluginRepo(models.PluginRepo{Name: "repo2", URL: "https://repo2.example.com"})
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(pluginDir)
+	})
+
+	Describe("requirements", func() {
+		It("requires a plugin name or --all", func() {
+			Expect(runCommand()).To(BeFalse())
+		})
+
+		It("does not accept a plugin name with --all", func() {
+			Expect(runCommand("Test1", "--all")).To(BeFalse())
+		})
+
+		It("does not accept --version with --all", func() {
+			Expect(runCommand("--all", "--version", "1.2.4")).To(BeFalse())
+		})
+	})
+
+	Context("when the plugin is not installed", func() {
+		It("fails with an error", func() {
+			runCommand("Unknown", "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Plugin Unknown is not installed."},
+			))
+			Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the given repository is not registered", func() {
+		It("fails with an error", func() {
+			runCommand("Test1", "-r", "repo3", "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"repo3 does not exist as an available plugin repo."},
+			))
+		})
+	})
+
+	Context("when a repository is given", func() {
+		It("only searches that repository", func() {
+			runCommand("Test1", "-r", "REPO2", "-f")
+
+			Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(1))
+			Expect(fakePluginRepo.GetPluginsArgsForCall(0)).To(Equal([]models.PluginRepo{
+				{Name: "repo2", URL: "https://repo2.example.com"},
+			}))
+		})
+	})
+
+	Context("when the plugin is already up to date", func() {
+		BeforeEach(func() {
+			fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+				"repo1": {repoPlugin("Test1", "1.2.0")},
+			}, nil)
+		})
+
+		It("does not download anything", func() {
+			runCommand("Test1", "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Searching repo1, repo2 for plugin updates..."},
+				[]string{"Plugin Test1 v1.2.0 is already up to date."},
+			))
+			Expect(fakeChecksum.CheckSha1CallCount()).To(Equal(0))
+			Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the plugin is not listed by any repository", func() {
+		BeforeEach(func() {
+			fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{}, nil)
+		})
+
+		It("fails with an error", func() {
+			runCommand("Test1", "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Plugin Test1 is not available in the searched repositories."},
+			))
+		})
+	})
+
+	Context("when a newer version is available", func() {
+		BeforeEach(func() {
+			fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+				"repo1": {repoPlugin("Test1", "1.2.1")},
+				"repo2": {repoPlugin("Test1", "1.2.4")},
+			}, nil)
+		})
+
+		It("downloads the newest version and swaps it in", func() {
+			runCommand("Test1", "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Updating plugin Test1 to v1.2.4 from repository repo2..."},
+				[]string{"bytes downloaded"},
+				[]string{"OK"},
+				[]string{"Plugin Test1 successfully updated to v1.2.4."},
+			))
+
+			Expect(fakeChecksum.CheckSha1CallCount()).To(Equal(1))
+
+			expectedBinary, err := ioutil.ReadFile(test_1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ioutil.ReadFile(installedBinary)).To(Equal(expectedBinary))
+
+			files, err := ioutil.ReadDir(pluginDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+
+			Expect(pluginConfig.SetPluginCallCount()).To(Equal(1))
+			name, metadata := pluginConfig.SetPluginArgsForCall(0)
+			Expect(name).To(Equal("Test1"))
+			Expect(metadata.Location).To(Equal(installedBinary))
+			Expect(metadata.Version).To(Equal(plugin.VersionType{Major: 1, Minor: 2, Build: 4}))
+			Expect(metadata.Commands).ToNot(BeEmpty())
+			Expect(metadata.Pinned).To(BeFalse())
+		})
+
+		Context("when the checksum does not match", func() {
+			BeforeEach(func() {
+				fakeChecksum.CheckSha1Returns(false)
+			})
+
+			It("leaves the installed plugin untouched", func() {
+				runCommand("Test1", "-f")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"checksum does not match"},
+				))
+				Expect(ioutil.ReadFile(installedBinary)).To(Equal([]byte("old binary")))
+				Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the user declines the update", func() {
+			It("does not update the plugin", func() {
+				ui.Inputs = []string{"n"}
+				runCommand("Test1")
+
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Update of plugin Test1 cancelled"}))
+				Expect(fakeChecksum.CheckSha1CallCount()).To(Equal(0))
+				Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a version is pinned", func() {
+			It("installs that version and pins the plugin to it", func() {
+				runCommand("Test1", "--version", "1.2.1", "-f")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Updating plugin Test1 to v1.2.1 from repository repo1..."},
+				))
+				Expect(fakeChecksum.CheckSha1CallCount()).To(Equal(1))
+
+				Expect(pluginConfig.SetPluginCallCount()).To(Equal(1))
+				_, metadata := pluginConfig.SetPluginArgsForCall(0)
+				Expect(metadata.Pinned).To(BeTrue())
+			})
+
+			It("fails when the version is not available", func() {
+				runCommand("Test1", "--version", "1.3.0", "-f")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Plugin Test1 version 1.3.0 is not available in the searched repositories."},
+				))
+				Expect(fakeChecksum.CheckSha1CallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the downloaded binary is a different plugin", func() {
+		BeforeEach(func() {
+			pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+				"Test2": {
+					Location: installedBinary,
+					Version:  plugin.VersionType{Major: 1},
+				},
+			})
+			fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+				"repo1": {repoPlugin("Test2", "2.0.0")},
+			}, nil)
+		})
+
+		It("refuses to update", func() {
+			runCommand("Test2", "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"The downloaded binary reports plugin name Test1 instead of Test2"},
+			))
+			Expect(ioutil.ReadFile(installedBinary)).To(Equal([]byte("old binary")))
+			Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when --all is provided", func() {
+		BeforeEach(func() {
+			pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+				"Test1": {
+					Location: installedBinary,
+					Version:  plugin.VersionType{Major: 1, Minor: 2, Build: 0},
+				},
+				"Other": {Version: plugin.VersionType{Major: 3}},
+				"Local": {Version: plugin.VersionType{Major: 1}},
+			})
+			fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+				"repo1": {
+					repoPlugin("Test1", "1.2.4"),
+					repoPlugin("Other", "3.0.0"),
+				},
+			}, nil)
+		})
+
+		It("updates every outdated plugin and skips the rest", func() {
+			runCommand("--all", "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Plugin Local is not available in the searched repositories."},
+				[]string{"Plugin Other v3.0.0 is already up to date."},
+				[]string{"Plugin Test1 successfully updated to v1.2.4."},
+			))
+			Expect(pluginConfig.SetPluginCallCount()).To(Equal(1))
+		})
+
+		Context("when a plugin is pinned", func() {
+			BeforeEach(func() {
+				pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+					"Test1": {
+						Location: installedBinary,
+						Version:  plugin.VersionType{Major: 1, Minor: 2, Build: 0},
+						Pinned:   true,
+					},
+				})
+			})
+
+			It("leaves it at the pinned version", func() {
+				runCommand("--all", "-f")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Plugin Test1 is pinned to v1.2.0."},
+				))
+				Expect(fakeChecksum.CheckSha1CallCount()).To(Equal(0))
+				Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+				Expect(ioutil.ReadFile(installedBinary)).To(Equal([]byte("old binary")))
+			})
+		})
+	})
+
+	Context("when the plugin is pinned and updated by name", func() {
+		BeforeEach(func() {
+			pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+				"Test1": {
+					Location: installedBinary,
+					Version:  plugin.VersionType{Major: 1, Minor: 2, Build: 0},
+					Pinned:   true,
+				},
+			})
+			fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+				"repo1": {repoPlugin("Test1", "1.2.4")},
+			}, nil)
+		})
+
+		It("updates to the newest version and removes the pin", func() {
+			runCommand("Test1", "-f")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Plugin Test1 successfully updated to v1.2.4."},
+			))
+			Expect(pluginConfig.SetPluginCallCount()).To(Equal(1))
+			_, metadata := pluginConfig.SetPluginArgsForCall(0)
+			Expect(metadata.Pinned).To(BeFalse())
+		})
+	})
+})
@@ -41,6 +41,12 @@ type Data struct {
 	PluginRepos              []models.PluginRepo
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
+	CurrentContext           string                     `json:",omitempty"`
+	Contexts                 map[string]ContextSettings `json:",omitempty"`
+
+	// fileContext are the top level target settings read from disk, which
+	// are kept when CF_CONTEXT selects another context.
+	fileContext *ContextSettings
 }
 
 func NewData() *Data {
@@ -54,7 +60,8 @@ func NewData() *Data {
 
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = 3
-	return json.MarshalIndent(d, "", "  ")
+	file := d.fileData()
+	return json.MarshalIndent(&file, "", "  ")
 }
 
 func (d *Data) JSONUnmarshalV3(input []byte) error {
@@ -68,5 +75,6 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 		return nil
 	}
 
+	d.loadActiveContext()
 	return nil
 }
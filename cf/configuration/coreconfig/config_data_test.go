@@ -1,6 +1,8 @@
 package coreconfig_test
 
 import (
+	"os"
+
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
 
@@ -215,4 +217,104 @@ var _ = Describe("V3 Config files", func() {
 			Expect(*actualData).To(Equal(coreconfig.Data{}))
 		})
 	})
+	Describe("contexts", func() {
+		var contextsJSON = `
+		{
+			"ConfigVersion": 3,
+			"Target": "api.staging.example.com",
+			"AccessToken": "staging-access-token",
+			"OrganizationFields": {"GUID": "staging-org-guid", "Name": "staging-org"},
+			"CurrentContext": "staging",
+			"Contexts": {
+				"staging": {
+					"Target": "api.staging.example.com",
+					"AccessToken": "old-staging-access-token",
+					"OrganizationFields": {"GUID": "staging-org-guid", "Name": "staging-org"}
+				},
+				"production": {
+					"Target": "api.production.example.com",
+					"AccessToken": "production-access-token",
+					"OrganizationFields": {"GUID": "production-org-guid", "Name": "production-org"},
+					"SpaceFields": {"GUID": "production-space-guid", "Name": "production-space"}
+				}
+			}
+		}`
+
+		var data *coreconfig.Data
+
+		BeforeEach(func() {
+			data = coreconfig.NewData()
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_CONTEXT")).To(Succeed())
+		})
+
+		It("round trips the current context and the saved contexts", func() {
+			Expect(data.JSONUnmarshalV3([]byte(contextsJSON))).To(Succeed())
+			Expect(data.CurrentContext).To(Equal("staging"))
+			Expect(data.Contexts).To(HaveLen(2))
+			Expect(data.Target).To(Equal("api.staging.example.com"))
+			Expect(data.AccessToken).To(Equal("old-staging-access-token"))
+
+			data.AccessToken = "new-staging-access-token"
+			jsonData, err := data.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+
+			reloaded := coreconfig.NewData()
+			Expect(reloaded.JSONUnmarshalV3(jsonData)).To(Succeed())
+			Expect(reloaded.CurrentContext).To(Equal("staging"))
+			Expect(reloaded.AccessToken).To(Equal("new-staging-access-token"))
+			Expect(reloaded.Contexts["staging"].AccessToken).To(Equal("new-staging-access-token"))
+			Expect(reloaded.Contexts["production"]).To(Equal(data.Contexts["production"]))
+		})
+
+		It("does not write contexts when there are none", func() {
+			Expect(data.JSONUnmarshalV3([]byte(exampleV3JSON))).To(Succeed())
+			jsonData, err := data.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(jsonData).To(MatchJSON(exampleV3JSON))
+		})
+
+		Context("when CF_CONTEXT is set", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_CONTEXT", "production")).To(Succeed())
+			})
+
+			It("targets the context named by CF_CONTEXT", func() {
+				Expect(data.JSONUnmarshalV3([]byte(contextsJSON))).To(Succeed())
+				Expect(data.ActiveContext()).To(Equal("production"))
+				Expect(data.Target).To(Equal("api.production.example.com"))
+				Expect(data.AccessToken).To(Equal("production-access-token"))
+				Expect(data.OrganizationFields.Name).To(Equal("production-org"))
+				Expect(data.SpaceFields.Name).To(Equal("production-space"))
+			})
+
+			It("saves changes into that context and keeps the current context at the top level", func() {
+				Expect(data.JSONUnmarshalV3([]byte(contextsJSON))).To(Succeed())
+				data.AccessToken = "new-production-access-token"
+				jsonData, err := data.JSONMarshalV3()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(os.Unsetenv("CF_CONTEXT")).To(Succeed())
+				reloaded := coreconfig.NewData()
+				Expect(reloaded.JSONUnmarshalV3(jsonData)).To(Succeed())
+				Expect(reloaded.CurrentContext).To(Equal("staging"))
+				Expect(reloaded.Target).To(Equal("api.staging.example.com"))
+				Expect(reloaded.Contexts["production"].AccessToken).To(Equal("new-production-access-token"))
+			})
+
+			Context("when the context does not exist", func() {
+				BeforeEach(func() {
+					Expect(os.Setenv("CF_CONTEXT", "development")).To(Succeed())
+				})
+
+				It("has no target", func() {
+					Expect(data.JSONUnmarshalV3([]byte(contextsJSON))).To(Succeed())
+					Expect(data.Target).To(BeEmpty())
+					Expect(data.AccessToken).To(BeEmpty())
+				})
+			})
+		})
+	})
 })
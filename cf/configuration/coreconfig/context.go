@@ -0,0 +1,114 @@
+package coreconfig
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/models"
+)
+
+// ContextSettings are the target specific settings stored in a named
+// context. They are saved with the same keys as the top level settings, so
+// that contexts can be shared with the commands that use util/configv3.
+type ContextSettings struct {
+	Target                   string
+	APIVersion               string
+	AuthorizationEndpoint    string
+	DopplerEndPoint          string
+	UaaEndpoint              string
+	RoutingAPIEndpoint       string
+	AccessToken              string
+	SSHOAuthClient           string
+	RefreshToken             string
+	OrganizationFields       models.OrganizationFields
+	SpaceFields              models.SpaceFields
+	SSLDisabled              bool
+	MinCLIVersion            string
+	MinRecommendedCLIVersion string
+}
+
+// ActiveContext returns the name of the context in use. The CF_CONTEXT
+// environment variable takes precedence over the current context. An empty
+// name means no named context is in use.
+func (d *Data) ActiveContext() string {
+	if name := os.Getenv("CF_CONTEXT"); name != "" {
+		return name
+	}
+	return d.CurrentContext
+}
+
+// loadActiveContext replaces the top level target settings with those of the
+// active context. A context that does not exist yet starts with no target.
+func (d *Data) loadActiveContext() {
+	name := d.ActiveContext()
+	if name == "" {
+		return
+	}
+
+	fileContext := d.contextSettings()
+	d.fileContext = &fileContext
+	d.applyContextSettings(d.Contexts[name])
+}
+
+// fileData returns the Data to be written to disk. The active context is
+// saved into the list of contexts, and the top level target settings are kept
+// as those of the current context.
+func (d *Data) fileData() Data {
+	file := *d
+
+	active := d.ActiveContext()
+	if active == "" {
+		return file
+	}
+
+	file.Contexts = make(map[string]ContextSettings, len(d.Contexts)+1)
+	for name, settings := range d.Contexts {
+		file.Contexts[name] = settings
+	}
+	file.Contexts[active] = d.contextSettings()
+
+	if active != file.CurrentContext {
+		if current, ok := file.Contexts[file.CurrentContext]; ok {
+			file.applyContextSettings(current)
+		} else if d.fileContext != nil {
+			file.applyContextSettings(*d.fileContext)
+		}
+	}
+
+	return file
+}
+
+func (d *Data) contextSettings() ContextSettings {
+	return ContextSettings{
+		Target:                   d.Target,
+		APIVersion:               d.APIVersion,
+		AuthorizationEndpoint:    d.AuthorizationEndpoint,
+		DopplerEndPoint:          d.DopplerEndPoint,
+		UaaEndpoint:              d.UaaEndpoint,
+		RoutingAPIEndpoint:       d.RoutingAPIEndpoint,
+		AccessToken:              d.AccessToken,
+		SSHOAuthClient:           d.SSHOAuthClient,
+		RefreshToken:             d.RefreshToken,
+		OrganizationFields:       d.OrganizationFields,
+		SpaceFields:              d.SpaceFields,
+		SSLDisabled:              d.SSLDisabled,
+		MinCLIVersion:            d.MinCLIVersion,
+		MinRecommendedCLIVersion: d.MinRecommendedCLIVersion,
+	}
+}
+
+func (d *Data) applyContextSettings(settings ContextSettings) {
+	d.Target = settings.Target
+	d.APIVersion = settings.APIVersion
+	d.AuthorizationEndpoint = settings.AuthorizationEndpoint
+	d.DopplerEndPoint = settings.DopplerEndPoint
+	d.UaaEndpoint = settings.UaaEndpoint
+	d.RoutingAPIEndpoint = settings.RoutingAPIEndpoint
+	d.AccessToken = settings.AccessToken
+	d.SSHOAuthClient = settings.SSHOAuthClient
+	d.RefreshToken = settings.RefreshToken
+	d.OrganizationFields = settings.OrganizationFields
+	d.SpaceFields = settings.SpaceFields
+	d.SSLDisabled = settings.SSLDisabled
+	d.MinCLIVersion = settings.MinCLIVersion
+	d.MinRecommendedCLIVersion = settings.MinRecommendedCLIVersion
+}
@@ -14,6 +14,10 @@ type PluginMetadata struct {
 	Location string
 	Version  plugin.VersionType
 	Commands []plugin.Command
+
+	// Pinned is set when the plugin was updated to a specific version, which
+	// keeps 'update-plugin --all' and 'plugins --outdated' from moving it.
+	Pinned bool `json:",omitempty"`
 }
 
 func NewData() *PluginData {
@@ -160,6 +160,7 @@ func newAppPresenter() appPresenter {
 					presentCommand("disable-ssh"),
 					presentCommand("ssh-enabled"),
 					presentCommand("ssh"),
+					presentCommand("scp"),
 				},
 			},
 		}, {
@@ -395,6 +396,7 @@ func newAppPresenter() appPresenter {
 					presentCommand("plugins"),
 					presentCommand("install-plugin"),
 					presentCommand("uninstall-plugin"),
+					presentCommand("update-plugin"),
 				},
 			},
 		}, {
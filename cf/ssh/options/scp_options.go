@@ -0,0 +1,101 @@
+package options
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/cf/flags"
+)
+
+type CopyDirection int
+
+const (
+	CopyToApp CopyDirection = iota
+	CopyFromApp
+)
+
+type SCPOptions struct {
+	AppName            string
+	Index              uint
+	SkipHostValidation bool
+	Recursive          bool
+	Quiet              bool
+	Direction          CopyDirection
+	LocalPath          string
+	RemotePath         string
+}
+
+func NewSCPOptions(fc flags.FlagContext) (*SCPOptions, error) {
+	scpOptions := &SCPOptions{}
+
+	if len(fc.Args()) != 2 {
+		return scpOptions, errors.New("Requires SOURCE and TARGET as arguments")
+	}
+
+	scpOptions.Index = uint(fc.Int("i"))
+	scpOptions.SkipHostValidation = fc.Bool("k")
+	scpOptions.Recursive = fc.Bool("r")
+	scpOptions.Quiet = fc.Bool("q")
+
+	source, target := fc.Args()[0], fc.Args()[1]
+	sourceApp, sourcePath, sourceIsRemote := parseRemotePath(source)
+	targetApp, targetPath, targetIsRemote := parseRemotePath(target)
+
+	switch {
+	case sourceIsRemote && targetIsRemote:
+		return scpOptions, errors.New("Copying between two applications is not supported")
+	case sourceIsRemote:
+		scpOptions.Direction = CopyFromApp
+		scpOptions.AppName = sourceApp
+		scpOptions.RemotePath = sourcePath
+		scpOptions.LocalPath = target
+	case targetIsRemote:
+		scpOptions.Direction = CopyToApp
+		scpOptions.AppName = targetApp
+		scpOptions.RemotePath = targetPath
+		scpOptions.LocalPath = source
+	default:
+		return scpOptions, errors.New("Either SOURCE or TARGET must be of the form APP_NAME:PATH")
+	}
+
+	if scpOptions.AppName == "" {
+		return scpOptions, fmt.Errorf("Missing application name: %q", scpOptions.AppName+":"+scpOptions.RemotePath)
+	}
+
+	if scpOptions.RemotePath == "" {
+		scpOptions.RemotePath = "."
+	}
+
+	return scpOptions, nil
+}
+
+// SSHOptions returns the options used to open the SSH connection to the
+// application instance.
+func (o *SCPOptions) SSHOptions() *SSHOptions {
+	return &SSHOptions{
+		AppName:            o.AppName,
+		Index:              o.Index,
+		SkipHostValidation: o.SkipHostValidation,
+	}
+}
+
+// parseRemotePath splits APP_NAME:PATH. Like scp, an argument is local when
+// a slash appears before the first colon; Windows drive letters are local
+// too.
+func parseRemotePath(arg string) (string, string, bool) {
+	colon := strings.Index(arg, ":")
+	if colon == -1 {
+		return "", "", false
+	}
+
+	if strings.ContainsAny(arg[:colon], `/\`) {
+		return "", "", false
+	}
+
+	if colon == 1 && len(arg) > 2 && (arg[2] == '\\' || arg[2] == '/') {
+		return "", "", false
+	}
+
+	return arg[:colon], arg[colon+1:], true
+}
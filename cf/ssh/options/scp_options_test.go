@@ -0,0 +1,144 @@
+package options_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCPOptions", func() {
+	var (
+		opts       *options.SCPOptions
+		args       []string
+		parseError error
+		fc         flags.FlagContext
+	)
+
+	Describe("Parse", func() {
+		BeforeEach(func() {
+			fc = flags.New()
+			fc.NewIntFlag("app-instance-index", "i", "")
+			fc.NewBoolFlag("skip-host-validation", "k", "")
+			fc.NewBoolFlag("recursive", "r", "")
+			fc.NewBoolFlag("quiet", "q", "")
+
+			args = []string{}
+			parseError = nil
+		})
+
+		JustBeforeEach(func() {
+			err := fc.Parse(args...)
+			Expect(err).NotTo(HaveOccurred())
+
+			opts, parseError = options.NewSCPOptions(fc)
+		})
+
+		Context("when the source is in the application", func() {
+			BeforeEach(func() {
+				args = append(args, "app-1:/tmp/heap.hprof", "local-dir")
+			})
+
+			It("copies from the app", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.AppName).To(Equal("app-1"))
+				Expect(opts.Direction).To(Equal(options.CopyFromApp))
+				Expect(opts.RemotePath).To(Equal("/tmp/heap.hprof"))
+				Expect(opts.LocalPath).To(Equal("local-dir"))
+			})
+		})
+
+		Context("when the target is in the application", func() {
+			BeforeEach(func() {
+				args = append(args, "-i", "2", "-r", "./some:dir", "app-1:app")
+			})
+
+			It("copies to the app", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.AppName).To(Equal("app-1"))
+				Expect(opts.Direction).To(Equal(options.CopyToApp))
+				Expect(opts.RemotePath).To(Equal("app"))
+				Expect(opts.LocalPath).To(Equal("./some:dir"))
+				Expect(opts.Index).To(BeEquivalentTo(2))
+				Expect(opts.Recursive).To(BeTrue())
+			})
+		})
+
+		Context("when the remote path is empty", func() {
+			BeforeEach(func() {
+				args = append(args, "file.txt", "app-1:")
+			})
+
+			It("uses the home directory of the container", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.RemotePath).To(Equal("."))
+			})
+		})
+
+		Context("when the local path is a Windows path", func() {
+			BeforeEach(func() {
+				args = append(args, `C:\dumps`, "app-1:/tmp")
+			})
+
+			It("treats the drive letter as part of the local path", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.AppName).To(Equal("app-1"))
+				Expect(opts.LocalPath).To(Equal(`C:\dumps`))
+			})
+		})
+
+		Context("when -k and -q are set", func() {
+			BeforeEach(func() {
+				args = append(args, "-k", "-q", "app-1:a", "b")
+			})
+
+			It("disables host key validation and progress output", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.SkipHostValidation).To(BeTrue())
+				Expect(opts.Quiet).To(BeTrue())
+				Expect(opts.SSHOptions()).To(Equal(&options.SSHOptions{AppName: "app-1", SkipHostValidation: true}))
+			})
+		})
+
+		Context("when neither path is in an application", func() {
+			BeforeEach(func() {
+				args = append(args, "a", "b")
+			})
+
+			It("returns an error", func() {
+				Expect(parseError).To(MatchError("Either SOURCE or TARGET must be of the form APP_NAME:PATH"))
+			})
+		})
+
+		Context("when both paths are in an application", func() {
+			BeforeEach(func() {
+				args = append(args, "app-1:a", "app-2:b")
+			})
+
+			It("returns an error", func() {
+				Expect(parseError).To(MatchError("Copying between two applications is not supported"))
+			})
+		})
+
+		Context("when the application name is missing", func() {
+			BeforeEach(func() {
+				args = append(args, ":a", "b")
+			})
+
+			It("returns an error", func() {
+				Expect(parseError).To(MatchError(`Missing application name: ":a"`))
+			})
+		})
+
+		Context("when the wrong number of arguments is provided", func() {
+			BeforeEach(func() {
+				args = append(args, "app-1:a")
+			})
+
+			It("returns an error", func() {
+				Expect(parseError).To(MatchError("Requires SOURCE and TARGET as arguments"))
+			})
+		})
+	})
+})
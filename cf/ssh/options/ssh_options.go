@@ -1,6 +1,7 @@
 package options
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -29,8 +30,14 @@ type SSHOptions struct {
 	SkipRemoteExecution bool
 	TerminalRequest     TTYRequest
 	ForwardSpecs        []ForwardSpec
+	RemoteForwardSpecs  []ForwardSpec
+	DynamicForwardSpecs []string
+	AllInstances        bool
+	MaxInFlight         int
 }
 
+const DefaultMaxInFlight = 10
+
 func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 	sshOptions := &SSHOptions{}
 
@@ -42,7 +49,7 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 
 	if fc.IsSet("L") {
 		for _, arg := range fc.StringSlice("L") {
-			forwardSpec, err := sshOptions.parseLocalForwardingSpec(arg)
+			forwardSpec, err := sshOptions.parseForwardingSpec(arg, "local")
 			if err != nil {
 				return sshOptions, err
 			}
@@ -50,6 +57,26 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 		}
 	}
 
+	if fc.IsSet("R") {
+		for _, arg := range fc.StringSlice("R") {
+			forwardSpec, err := sshOptions.parseForwardingSpec(arg, "remote")
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.RemoteForwardSpecs = append(sshOptions.RemoteForwardSpecs, *forwardSpec)
+		}
+	}
+
+	if fc.IsSet("D") {
+		for _, arg := range fc.StringSlice("D") {
+			listenAddress, err := sshOptions.parseDynamicForwardingSpec(arg)
+			if err != nil {
+				return sshOptions, err
+			}
+			sshOptions.DynamicForwardSpecs = append(sshOptions.DynamicForwardSpecs, listenAddress)
+		}
+	}
+
 	if fc.IsSet("t") && fc.Bool("t") {
 		sshOptions.TerminalRequest = RequestTTYYes
 	}
@@ -62,21 +89,46 @@ func NewSSHOptions(fc flags.FlagContext) (*SSHOptions, error) {
 		sshOptions.TerminalRequest = RequestTTYNo
 	}
 
+	sshOptions.AllInstances = fc.Bool("all-instances")
+	sshOptions.MaxInFlight = DefaultMaxInFlight
+	if fc.IsSet("max-in-flight") {
+		sshOptions.MaxInFlight = fc.Int("max-in-flight")
+	}
+
+	if sshOptions.AllInstances {
+		err := sshOptions.validateAllInstances(fc)
+		if err != nil {
+			return sshOptions, err
+		}
+	}
+
 	return sshOptions, nil
 }
 
-func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error) {
-	arg = strings.TrimSpace(arg)
+func (o *SSHOptions) validateAllInstances(fc flags.FlagContext) error {
+	switch {
+	case len(o.Command) == 0:
+		return errors.New("--all-instances requires a command to be provided with -c")
+	case fc.IsSet("i"):
+		return errors.New("--all-instances cannot be used with --app-instance-index")
+	case len(o.ForwardSpecs) > 0 || len(o.RemoteForwardSpecs) > 0 || len(o.DynamicForwardSpecs) > 0:
+		return errors.New("--all-instances cannot be used with port forwarding")
+	case o.SkipRemoteExecution:
+		return errors.New("--all-instances cannot be used with --skip-remote-execution")
+	case o.TerminalRequest == RequestTTYYes || o.TerminalRequest == RequestTTYForce:
+		return errors.New("--all-instances cannot be used with pseudo-tty allocation")
+	case o.MaxInFlight < 1:
+		return errors.New("--max-in-flight must be greater than 0")
+	}
+	return nil
+}
 
-	parts := []string{}
-	for remainder := arg; remainder != ""; {
-		part, r, err := tokenizeForward(remainder)
-		if err != nil {
-			return nil, err
-		}
+func (o *SSHOptions) parseForwardingSpec(arg string, kind string) (*ForwardSpec, error) {
+	arg = strings.TrimSpace(arg)
 
-		parts = append(parts, part)
-		remainder = r
+	parts, err := splitForward(arg)
+	if err != nil {
+		return nil, err
 	}
 
 	forwardSpec := &ForwardSpec{}
@@ -91,12 +143,49 @@ func (o *SSHOptions) parseLocalForwardingSpec(arg string) (*ForwardSpec, error)
 		forwardSpec.ListenAddress = fmt.Sprintf("localhost:%s", parts[0])
 		forwardSpec.ConnectAddress = fmt.Sprintf("%s:%s", parts[1], parts[2])
 	default:
-		return nil, fmt.Errorf("Unable to parse local forwarding argument: %q", arg)
+		return nil, fmt.Errorf("Unable to parse %s forwarding argument: %q", kind, arg)
 	}
 
 	return forwardSpec, nil
 }
 
+// parseDynamicForwardingSpec parses a [bind_address:]port argument and returns
+// the local address the SOCKS proxy listens on.
+func (o *SSHOptions) parseDynamicForwardingSpec(arg string) (string, error) {
+	arg = strings.TrimSpace(arg)
+
+	parts, err := splitForward(arg)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(parts) {
+	case 2:
+		if parts[0] == "*" {
+			parts[0] = ""
+		}
+		return fmt.Sprintf("%s:%s", parts[0], parts[1]), nil
+	case 1:
+		return fmt.Sprintf("localhost:%s", parts[0]), nil
+	default:
+		return "", fmt.Errorf("Unable to parse dynamic forwarding argument: %q", arg)
+	}
+}
+
+func splitForward(arg string) ([]string, error) {
+	parts := []string{}
+	for remainder := arg; remainder != ""; {
+		part, r, err := tokenizeForward(remainder)
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part)
+		remainder = r
+	}
+	return parts, nil
+}
+
 func tokenizeForward(arg string) (string, string, error) {
 	switch arg[0] {
 	case ':':
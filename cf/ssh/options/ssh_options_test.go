@@ -20,6 +20,8 @@ var _ = Describe("SSHOptions", func() {
 		BeforeEach(func() {
 			fc = flags.New()
 			fc.NewStringSliceFlag("L", "", "")
+			fc.NewStringSliceFlag("R", "", "")
+			fc.NewStringSliceFlag("D", "", "")
 			fc.NewStringSliceFlag("command", "c", "")
 			fc.NewIntFlag("app-instance-index", "i", "")
 			fc.NewBoolFlag("skip-host-validation", "k", "")
@@ -27,6 +29,8 @@ var _ = Describe("SSHOptions", func() {
 			fc.NewBoolFlag("request-pseudo-tty", "t", "")
 			fc.NewBoolFlag("force-pseudo-tty", "tt", "")
 			fc.NewBoolFlag("disable-pseudo-tty", "T", "")
+			fc.NewBoolFlag("all-instances", "", "")
+			fc.NewIntFlag("max-in-flight", "", "")
 
 			args = []string{}
 			parseError = nil
@@ -281,6 +285,83 @@ var _ = Describe("SSHOptions", func() {
 			})
 		})
 
+		Context("when remote port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("without an explicit bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999:localhost:8888")
+				})
+
+				It("sets the remote forward spec", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(options.ForwardSpec{ListenAddress: "localhost:9999", ConnectAddress: "localhost:8888"}))
+					Expect(opts.ForwardSpecs).To(BeEmpty())
+				})
+			})
+
+			Context("with * as the bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "*:9999:localhost:8888")
+				})
+
+				It("sets the remote forward spec", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.RemoteForwardSpecs).To(ConsistOf(options.ForwardSpec{ListenAddress: ":9999", ConnectAddress: "localhost:8888"}))
+				})
+			})
+
+			Context("with an invalid specification", func() {
+				BeforeEach(func() {
+					args = append(args, "-R", "9999")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse remote forwarding argument: "9999"`))
+				})
+			})
+		})
+
+		Context("when dynamic port forwarding is requested", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name")
+			})
+
+			Context("without an explicit bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "1080")
+				})
+
+				It("listens on localhost", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardSpecs).To(ConsistOf("localhost:1080"))
+				})
+			})
+
+			Context("with an explicit ipv6 bind address", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "[::1]:1080", "-D", "*:1081")
+				})
+
+				It("sets the listen addresses", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.DynamicForwardSpecs).To(ConsistOf("[::1]:1080", ":1081"))
+				})
+			})
+
+			Context("with an invalid specification", func() {
+				BeforeEach(func() {
+					args = append(args, "-D", "a:b:1080")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError(`Unable to parse dynamic forwarding argument: "a:b:1080"`))
+				})
+			})
+		})
+
 		Context("when -N is specified", func() {
 			BeforeEach(func() {
 				args = append(args, "app-name", "-N")
@@ -292,6 +373,80 @@ var _ = Describe("SSHOptions", func() {
 				Expect(opts.AppName).To(Equal("app-name"))
 			})
 		})
+
+		Context("when --all-instances is specified", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name", "--all-instances", "-c", "ps aux")
+			})
+
+			It("runs the command on all instances with the default limit", func() {
+				Expect(parseError).NotTo(HaveOccurred())
+				Expect(opts.AllInstances).To(BeTrue())
+				Expect(opts.MaxInFlight).To(Equal(options.DefaultMaxInFlight))
+				Expect(opts.Command).To(ConsistOf("ps aux"))
+			})
+
+			Context("with --max-in-flight", func() {
+				BeforeEach(func() {
+					args = append(args, "--max-in-flight", "3")
+				})
+
+				It("sets the limit", func() {
+					Expect(parseError).NotTo(HaveOccurred())
+					Expect(opts.MaxInFlight).To(Equal(3))
+				})
+			})
+
+			Context("when --max-in-flight is not positive", func() {
+				BeforeEach(func() {
+					args = append(args, "--max-in-flight", "0")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--max-in-flight must be greater than 0"))
+				})
+			})
+
+			Context("when an instance index is provided", func() {
+				BeforeEach(func() {
+					args = append(args, "-i", "1")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances cannot be used with --app-instance-index"))
+				})
+			})
+
+			Context("when port forwarding is requested", func() {
+				BeforeEach(func() {
+					args = append(args, "-L", "9999:remote:8888")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances cannot be used with port forwarding"))
+				})
+			})
+
+			Context("when a tty is requested", func() {
+				BeforeEach(func() {
+					args = append(args, "-t")
+				})
+
+				It("returns an error", func() {
+					Expect(parseError).To(MatchError("--all-instances cannot be used with pseudo-tty allocation"))
+				})
+			})
+		})
+
+		Context("when --all-instances is specified without a command", func() {
+			BeforeEach(func() {
+				args = append(args, "app-name", "--all-instances")
+			})
+
+			It("returns an error", func() {
+				Expect(parseError).To(MatchError("--all-instances requires a command to be provided with -c"))
+			})
+		})
 	})
 
 })
@@ -0,0 +1,374 @@
+package sshCmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/formatters"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+)
+
+// Copy runs scp in the application container and speaks the scp protocol
+// over the session to copy files to or from the local disk.
+func (c *secureShell) Copy(opts *options.SCPOptions) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	_, _, stderr := c.terminalHelper.StdStreams()
+
+	var progress io.Writer = stderr
+	if opts.Quiet {
+		progress = ioutil.Discard
+	}
+
+	err = session.Start(scpCommand(opts))
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	transfer := &scpTransfer{
+		in:       inPipe,
+		out:      bufio.NewReader(outPipe),
+		progress: progress,
+	}
+
+	var copyErr error
+	if opts.Direction == options.CopyToApp {
+		copyErr = transfer.send(opts.LocalPath, opts.Recursive)
+	} else {
+		copyErr = transfer.receive(opts.LocalPath)
+	}
+	_ = inPipe.Close()
+
+	result := session.Wait()
+	wg.Wait()
+
+	if copyErr != nil {
+		return copyErr
+	}
+	return result
+}
+
+func scpCommand(opts *options.SCPOptions) string {
+	mode := "-t"
+	if opts.Direction == options.CopyFromApp {
+		mode = "-f"
+	}
+
+	recursive := ""
+	if opts.Recursive {
+		recursive = "-r "
+	}
+
+	return fmt.Sprintf("scp %s%s -- '%s'", recursive, mode, strings.Replace(opts.RemotePath, "'", `'\''`, -1))
+}
+
+// scpTransfer implements the source and sink sides of the scp protocol. The
+// remote scp reads from in and writes to out.
+type scpTransfer struct {
+	in       io.Writer
+	out      *bufio.Reader
+	progress io.Writer
+}
+
+func (t *scpTransfer) send(localPath string, recursive bool) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() && !recursive {
+		return fmt.Errorf("%s: is a directory (use -r to copy directories)", localPath)
+	}
+
+	err = t.readAck()
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() {
+		return t.sendDirectory(localPath, info)
+	}
+	return t.sendFile(localPath, info)
+}
+
+func (t *scpTransfer) sendDirectory(localPath string, info os.FileInfo) error {
+	_, err := fmt.Fprintf(t.in, "D%04o 0 %s\n", info.Mode().Perm(), info.Name())
+	if err != nil {
+		return err
+	}
+
+	err = t.readAck()
+	if err != nil {
+		return err
+	}
+
+	entries, err := ioutil.ReadDir(localPath)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(localPath, entry.Name())
+		switch {
+		case entry.IsDir():
+			err = t.sendDirectory(entryPath, entry)
+		case entry.Mode().IsRegular():
+			err = t.sendFile(entryPath, entry)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprint(t.in, "E\n")
+	if err != nil {
+		return err
+	}
+	return t.readAck()
+}
+
+func (t *scpTransfer) sendFile(localPath string, info os.FileInfo) error {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(t.in, "C%04o %d %s\n", info.Mode().Perm(), info.Size(), info.Name())
+	if err != nil {
+		return err
+	}
+
+	err = t.readAck()
+	if err != nil {
+		return err
+	}
+
+	progress := newProgressWriter(t.progress, info.Name(), info.Size())
+	_, err = io.CopyN(io.MultiWriter(t.in, progress), file, info.Size())
+	if err != nil {
+		return err
+	}
+	progress.Done()
+
+	_, err = t.in.Write([]byte{0})
+	if err != nil {
+		return err
+	}
+	return t.readAck()
+}
+
+func (t *scpTransfer) receive(localPath string) error {
+	directories := []string{}
+	received := false
+
+	for {
+		err := t.ack()
+		if err != nil {
+			return err
+		}
+
+		line, err := t.out.ReadString('\n')
+		if err == io.EOF && line == "" {
+			if !received {
+				return errors.New("scp: no files received")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return fmt.Errorf("scp: unexpected message: %q", line)
+		}
+
+		switch line[0] {
+		case 1, 2:
+			return errors.New(line[1:])
+		case 'T':
+			continue
+		case 'E':
+			if len(directories) == 0 {
+				return fmt.Errorf("scp: unexpected end of directory")
+			}
+			directories = directories[:len(directories)-1]
+			continue
+		case 'C', 'D':
+		default:
+			return fmt.Errorf("scp: unexpected message: %q", line)
+		}
+
+		mode, size, name, err := parseCopyMessage(line)
+		if err != nil {
+			return err
+		}
+
+		var target string
+		if len(directories) > 0 {
+			target = filepath.Join(directories[len(directories)-1], name)
+		} else if info, statErr := os.Stat(localPath); statErr == nil && info.IsDir() {
+			target = filepath.Join(localPath, name)
+		} else {
+			target = localPath
+		}
+		received = true
+
+		if line[0] == 'D' {
+			err = os.Mkdir(target, mode)
+			if err != nil && !os.IsExist(err) {
+				return err
+			}
+			directories = append(directories, target)
+			continue
+		}
+
+		err = t.receiveFile(target, mode, size, name)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (t *scpTransfer) receiveFile(target string, mode os.FileMode, size int64, name string) error {
+	file, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = t.ack()
+	if err != nil {
+		return err
+	}
+
+	progress := newProgressWriter(t.progress, name, size)
+	_, err = io.CopyN(io.MultiWriter(file, progress), t.out, size)
+	if err != nil {
+		return err
+	}
+	progress.Done()
+
+	return t.readAck()
+}
+
+// parseCopyMessage parses the "C0644 1024 name" and "D0755 0 name" messages
+// that announce a file or directory.
+func parseCopyMessage(line string) (os.FileMode, int64, string, error) {
+	parts := strings.SplitN(line[1:], " ", 3)
+	if len(parts) != 3 {
+		return 0, 0, "", fmt.Errorf("scp: unexpected message: %q", line)
+	}
+
+	mode, err := strconv.ParseUint(parts[0], 8, 32)
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("scp: invalid file mode: %q", parts[0])
+	}
+
+	size, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || size < 0 {
+		return 0, 0, "", fmt.Errorf("scp: invalid file size: %q", parts[1])
+	}
+
+	name := parts[2]
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return 0, 0, "", fmt.Errorf("scp: invalid file name: %q", name)
+	}
+
+	return os.FileMode(mode).Perm(), size, name, nil
+}
+
+func (t *scpTransfer) ack() error {
+	_, err := t.in.Write([]byte{0})
+	return err
+}
+
+func (t *scpTransfer) readAck() error {
+	code, err := t.out.ReadByte()
+	if err == io.EOF {
+		return errors.New("scp: connection closed by remote")
+	}
+	if err != nil {
+		return err
+	}
+
+	switch code {
+	case 0:
+		return nil
+	case 1, 2:
+		message, _ := t.out.ReadString('\n')
+		return errors.New(strings.TrimSuffix(message, "\n"))
+	default:
+		return fmt.Errorf("scp: unexpected response: %q", code)
+	}
+}
+
+type progressWriter struct {
+	out         io.Writer
+	name        string
+	total       int64
+	written     int64
+	lastPercent int64
+}
+
+func newProgressWriter(out io.Writer, name string, total int64) *progressWriter {
+	return &progressWriter{out: out, name: name, total: total, lastPercent: -1}
+}
+
+func (p *progressWriter) Write(data []byte) (int, error) {
+	p.written += int64(len(data))
+
+	percent := int64(100)
+	if p.total > 0 {
+		percent = p.written * 100 / p.total
+	}
+	if percent != p.lastPercent {
+		p.lastPercent = percent
+		fmt.Fprintf(p.out, "\r%s %3d%% %s/%s", p.name, percent, formatters.ByteSize(p.written), formatters.ByteSize(p.total))
+	}
+	return len(data), nil
+}
+
+func (p *progressWriter) Done() {
+	if p.lastPercent == -1 {
+		_, _ = p.Write(nil)
+	}
+	fmt.Fprintln(p.out)
+}
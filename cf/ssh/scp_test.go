@@ -0,0 +1,276 @@
+package sshCmd_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/terminal/terminalfakes"
+	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_ssh"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Copy", func() {
+	var (
+		fakeTerminalHelper *terminalfakes.FakeTerminalHelper
+		fakeSecureClient   *sshfakes.FakeSecureClient
+		fakeSecureDialer   *sshfakes.FakeSecureDialer
+		fakeSecureSession  *sshfakes.FakeSecureSession
+
+		secureShell sshCmd.SecureShell
+
+		stdin     *gbytes.Buffer
+		remoteOut string
+		stderr    *gbytes.Buffer
+
+		tempDir string
+		opts    *options.SCPOptions
+		copyErr error
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "scp-test")
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeTerminalHelper = new(terminalfakes.FakeTerminalHelper)
+		stderr = gbytes.NewBuffer()
+		fakeTerminalHelper.StdStreamsReturns(ioutil.NopCloser(strings.NewReader("")), gbytes.NewBuffer(), stderr)
+
+		fakeSecureClient = new(sshfakes.FakeSecureClient)
+		fakeSecureDialer = new(sshfakes.FakeSecureDialer)
+		fakeSecureSession = new(sshfakes.FakeSecureSession)
+
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeSecureClient.NewSessionReturns(fakeSecureSession, nil)
+		fakeSecureClient.ConnReturns(new(fake_ssh.FakeConn))
+
+		stdin = gbytes.NewBuffer()
+		remoteOut = ""
+		fakeSecureSession.StdinPipeReturns(stdin, nil)
+		fakeSecureSession.StderrPipeReturns(strings.NewReader(""), nil)
+
+		opts = &options.SCPOptions{AppName: "app-1"}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	JustBeforeEach(func() {
+		fakeSecureSession.StdoutPipeReturns(strings.NewReader(remoteOut), nil)
+
+		secureShell = sshCmd.NewSecureShell(
+			fakeSecureDialer,
+			fakeTerminalHelper,
+			new(sshfakes.FakeListenerFactory),
+			30*time.Second,
+			models.Application{ApplicationFields: models.ApplicationFields{GUID: "app-guid", State: "STARTED", Diego: true}},
+			"",
+			"ssh.example.com:2222",
+			"some-token",
+		)
+
+		err := secureShell.Connect(&options.SSHOptions{AppName: "app-1", SkipHostValidation: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		copyErr = secureShell.Copy(opts)
+	})
+
+	Context("when copying to the app", func() {
+		BeforeEach(func() {
+			opts.Direction = options.CopyToApp
+			opts.RemotePath = "/tmp"
+		})
+
+		Context("when the local path is a file", func() {
+			BeforeEach(func() {
+				opts.LocalPath = filepath.Join(tempDir, "hello.txt")
+				Expect(ioutil.WriteFile(opts.LocalPath, []byte("hello"), 0644)).To(Succeed())
+				Expect(os.Chmod(opts.LocalPath, 0644)).To(Succeed())
+
+				remoteOut = "\x00\x00\x00"
+			})
+
+			It("runs scp in sink mode and sends the file", func() {
+				Expect(copyErr).NotTo(HaveOccurred())
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("scp -t -- '/tmp'"))
+				Expect(string(stdin.Contents())).To(Equal("C0644 5 hello.txt\nhello\x00"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+			})
+
+			It("shows the progress", func() {
+				Expect(string(stderr.Contents())).To(ContainSubstring("hello.txt 100% 5B/5B"))
+			})
+
+			Context("when quiet is set", func() {
+				BeforeEach(func() {
+					opts.Quiet = true
+				})
+
+				It("does not show the progress", func() {
+					Expect(stderr.Contents()).To(BeEmpty())
+				})
+			})
+
+			Context("when the remote scp reports an error", func() {
+				BeforeEach(func() {
+					remoteOut = "\x00\x01scp: /tmp: Permission denied\n"
+				})
+
+				It("returns the error", func() {
+					Expect(copyErr).To(MatchError("scp: /tmp: Permission denied"))
+				})
+			})
+		})
+
+		Context("when the local path is a directory", func() {
+			BeforeEach(func() {
+				opts.LocalPath = filepath.Join(tempDir, "dir")
+				Expect(os.Mkdir(opts.LocalPath, 0755)).To(Succeed())
+				Expect(os.Chmod(opts.LocalPath, 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(opts.LocalPath, "a.txt"), []byte("a"), 0600)).To(Succeed())
+				Expect(os.Mkdir(filepath.Join(opts.LocalPath, "sub"), 0755)).To(Succeed())
+				Expect(os.Chmod(filepath.Join(opts.LocalPath, "sub"), 0755)).To(Succeed())
+
+				remoteOut = strings.Repeat("\x00", 7)
+			})
+
+			It("requires -r", func() {
+				Expect(copyErr).To(MatchError(ContainSubstring("is a directory (use -r to copy directories)")))
+				Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+				Expect(stdin.Contents()).To(BeEmpty())
+			})
+
+			Context("when recursive is set", func() {
+				BeforeEach(func() {
+					opts.Recursive = true
+				})
+
+				It("sends the directory tree", func() {
+					Expect(copyErr).NotTo(HaveOccurred())
+					Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("scp -r -t -- '/tmp'"))
+					Expect(string(stdin.Contents())).To(Equal("D0755 0 dir\nC0600 1 a.txt\na\x00D0755 0 sub\nE\nE\n"))
+				})
+			})
+		})
+	})
+
+	Context("when copying from the app", func() {
+		BeforeEach(func() {
+			opts.Direction = options.CopyFromApp
+			opts.RemotePath = "/tmp/it's here"
+			opts.LocalPath = tempDir
+		})
+
+		Context("when the remote path is a file", func() {
+			BeforeEach(func() {
+				remoteOut = "C0640 5 hello.txt\nhello\x00"
+			})
+
+			It("runs scp in source mode and writes the file into the local directory", func() {
+				Expect(copyErr).NotTo(HaveOccurred())
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal(`scp -f -- '/tmp/it'\''s here'`))
+				Expect(string(stdin.Contents())).To(Equal("\x00\x00\x00"))
+
+				contents, err := ioutil.ReadFile(filepath.Join(tempDir, "hello.txt"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("hello"))
+				Expect(string(stderr.Contents())).To(ContainSubstring("hello.txt 100% 5B/5B"))
+			})
+
+			Context("when the local path does not exist", func() {
+				BeforeEach(func() {
+					opts.LocalPath = filepath.Join(tempDir, "renamed.txt")
+				})
+
+				It("writes the file to the local path", func() {
+					Expect(copyErr).NotTo(HaveOccurred())
+
+					contents, err := ioutil.ReadFile(opts.LocalPath)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(contents)).To(Equal("hello"))
+				})
+			})
+		})
+
+		Context("when the remote path is a directory", func() {
+			BeforeEach(func() {
+				opts.Recursive = true
+				remoteOut = "D0755 0 logs\nT1500000000 0 1500000000 0\nC0644 3 a.log\nabc\x00E\n"
+			})
+
+			It("recreates the directory tree", func() {
+				Expect(copyErr).NotTo(HaveOccurred())
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(HavePrefix("scp -r -f -- "))
+
+				contents, err := ioutil.ReadFile(filepath.Join(tempDir, "logs", "a.log"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("abc"))
+			})
+		})
+
+		Context("when the remote scp reports an error", func() {
+			BeforeEach(func() {
+				remoteOut = "\x01scp: /tmp/nope: No such file or directory\n"
+			})
+
+			It("returns the error", func() {
+				Expect(copyErr).To(MatchError("scp: /tmp/nope: No such file or directory"))
+			})
+		})
+
+		Context("when the remote sends an empty line", func() {
+			BeforeEach(func() {
+				remoteOut = "\n"
+			})
+
+			It("returns an unexpected message error", func() {
+				Expect(copyErr).To(MatchError(`scp: unexpected message: ""`))
+			})
+		})
+
+		Context("when the remote sends a file name with a path", func() {
+			BeforeEach(func() {
+				remoteOut = "C0644 1 ../evil\nx\x00"
+			})
+
+			It("refuses to write it", func() {
+				Expect(copyErr).To(MatchError(`scp: invalid file name: "../evil"`))
+				Expect(filepath.Join(tempDir, "..", "evil")).NotTo(BeAnExistingFile())
+			})
+		})
+	})
+
+	Context("when the session cannot be allocated", func() {
+		BeforeEach(func() {
+			fakeSecureClient.NewSessionReturns(nil, errors.New("no session"))
+		})
+
+		It("returns an error", func() {
+			Expect(copyErr).To(MatchError("SSH session allocation failed: no session"))
+		})
+	})
+
+	Context("when the remote scp fails", func() {
+		BeforeEach(func() {
+			opts.Direction = options.CopyFromApp
+			opts.LocalPath = tempDir
+			remoteOut = "C0644 1 a\nx\x00"
+			fakeSecureSession.WaitReturns(errors.New("exit status 1"))
+		})
+
+		It("returns the session error", func() {
+			Expect(copyErr).To(MatchError("exit status 1"))
+		})
+	})
+})
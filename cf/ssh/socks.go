@@ -0,0 +1,144 @@
+package sshCmd
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+const (
+	socksVersion5 = 0x05
+
+	socksMethodNoAuth       = 0x00
+	socksMethodNoAcceptable = 0xff
+
+	socksCommandConnect = 0x01
+
+	socksAddressIPv4   = 0x01
+	socksAddressDomain = 0x03
+	socksAddressIPv6   = 0x04
+
+	socksReplySucceeded           = 0x00
+	socksReplyHostUnreachable     = 0x04
+	socksReplyCommandNotSupported = 0x07
+	socksReplyAddressNotSupported = 0x08
+)
+
+func (c *secureShell) handleSOCKSConnection(conn net.Conn) {
+	defer conn.Close()
+
+	targetAddr, err := socksHandshake(conn)
+	if err != nil {
+		c.printConnectionError("SOCKS request failed: %s\n", err.Error())
+		return
+	}
+
+	target, err := c.secureClient.Dial("tcp", targetAddr)
+	if err != nil {
+		_ = writeSOCKSReply(conn, socksReplyHostUnreachable)
+		c.printConnectionError("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	err = writeSOCKSReply(conn, socksReplySucceeded)
+	if err != nil {
+		return
+	}
+
+	proxyConnection(conn, target)
+}
+
+// socksHandshake negotiates a SOCKS5 session without authentication and
+// returns the address of a CONNECT request.
+func socksHandshake(conn io.ReadWriter) (string, error) {
+	header := make([]byte, 2)
+	_, err := io.ReadFull(conn, header)
+	if err != nil {
+		return "", err
+	}
+	if header[0] != socksVersion5 {
+		return "", fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+
+	methods := make([]byte, header[1])
+	_, err = io.ReadFull(conn, methods)
+	if err != nil {
+		return "", err
+	}
+
+	method := byte(socksMethodNoAcceptable)
+	for _, m := range methods {
+		if m == socksMethodNoAuth {
+			method = socksMethodNoAuth
+			break
+		}
+	}
+
+	_, err = conn.Write([]byte{socksVersion5, method})
+	if err != nil {
+		return "", err
+	}
+	if method == socksMethodNoAcceptable {
+		return "", errors.New("no supported SOCKS authentication method")
+	}
+
+	request := make([]byte, 4)
+	_, err = io.ReadFull(conn, request)
+	if err != nil {
+		return "", err
+	}
+	if request[0] != socksVersion5 {
+		return "", fmt.Errorf("unsupported SOCKS version %d", request[0])
+	}
+	if request[1] != socksCommandConnect {
+		_ = writeSOCKSReply(conn, socksReplyCommandNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS command %d", request[1])
+	}
+
+	var host string
+	switch request[3] {
+	case socksAddressIPv4, socksAddressIPv6:
+		size := net.IPv4len
+		if request[3] == socksAddressIPv6 {
+			size = net.IPv6len
+		}
+		ip := make([]byte, size)
+		_, err = io.ReadFull(conn, ip)
+		if err != nil {
+			return "", err
+		}
+		host = net.IP(ip).String()
+	case socksAddressDomain:
+		length := make([]byte, 1)
+		_, err = io.ReadFull(conn, length)
+		if err != nil {
+			return "", err
+		}
+		domain := make([]byte, length[0])
+		_, err = io.ReadFull(conn, domain)
+		if err != nil {
+			return "", err
+		}
+		host = string(domain)
+	default:
+		_ = writeSOCKSReply(conn, socksReplyAddressNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS address type %d", request[3])
+	}
+
+	port := make([]byte, 2)
+	_, err = io.ReadFull(conn, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+func writeSOCKSReply(conn io.Writer, reply byte) error {
+	_, err := conn.Write([]byte{socksVersion5, reply, 0x00, socksAddressIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
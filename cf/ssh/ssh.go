@@ -37,7 +37,11 @@ const (
 type SecureShell interface {
 	Connect(opts *options.SSHOptions) error
 	InteractiveSession() error
+	ExecuteCommand(stdout io.Writer, stderr io.Writer) error
 	LocalPortForward() error
+	RemotePortForward() error
+	DynamicPortForward() error
+	Copy(opts *options.SCPOptions) error
 	Wait() error
 	Close() error
 }
@@ -54,6 +58,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -90,7 +95,7 @@ type secureShell struct {
 	secureClient           SecureClient
 	opts                   *options.SSHOptions
 
-	localListeners []net.Listener
+	listeners []net.Listener
 }
 
 func NewSecureShell(
@@ -112,7 +117,7 @@ func NewSecureShell(
 		sshEndpointFingerprint: sshEndpointFingerprint,
 		sshEndpoint:            sshEndpoint,
 		token:                  token,
-		localListeners:         []net.Listener{},
+		listeners:              []net.Listener{},
 	}
 }
 
@@ -141,7 +146,7 @@ func (c *secureShell) Connect(opts *options.SSHOptions) error {
 }
 
 func (c *secureShell) Close() error {
-	for _, listener := range c.localListeners {
+	for _, listener := range c.listeners {
 		_ = listener.Close()
 	}
 	return c.secureClient.Close()
@@ -153,15 +158,54 @@ func (c *secureShell) LocalPortForward() error {
 		if err != nil {
 			return err
 		}
-		c.localListeners = append(c.localListeners, listener)
+		c.listeners = append(c.listeners, listener)
+
+		connectAddress := forwardSpec.ConnectAddress
+		go c.acceptLoop(listener, func(conn net.Conn) {
+			c.handleForwardConnection(conn, connectAddress)
+		})
+	}
+
+	return nil
+}
+
+// RemotePortForward asks the SSH server to listen on each remote forward
+// address and connects the accepted connections to the local target.
+func (c *secureShell) RemotePortForward() error {
+	for _, forwardSpec := range c.opts.RemoteForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", forwardSpec.ListenAddress)
+		if err != nil {
+			return err
+		}
+		c.listeners = append(c.listeners, listener)
+
+		connectAddress := forwardSpec.ConnectAddress
+		go c.acceptLoop(listener, func(conn net.Conn) {
+			c.handleRemoteForwardConnection(conn, connectAddress)
+		})
+	}
+
+	return nil
+}
+
+// DynamicPortForward starts a SOCKS5 proxy on each dynamic forward address.
+// Connections requested through the proxy are dialed from the application
+// container.
+func (c *secureShell) DynamicPortForward() error {
+	for _, listenAddress := range c.opts.DynamicForwardSpecs {
+		listener, err := c.listenerFactory.Listen("tcp", listenAddress)
+		if err != nil {
+			return err
+		}
+		c.listeners = append(c.listeners, listener)
 
-		go c.localForwardAcceptLoop(listener, forwardSpec.ConnectAddress)
+		go c.acceptLoop(listener, c.handleSOCKSConnection)
 	}
 
 	return nil
 }
 
-func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+func (c *secureShell) acceptLoop(listener net.Listener, handle func(net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -174,7 +218,7 @@ func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
 	}
 }
 
@@ -183,11 +227,35 @@ func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string)
 
 	target, err := c.secureClient.Dial("tcp", targetAddr)
 	if err != nil {
-		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		c.printConnectionError("connect to %s failed: %s\n", targetAddr, err.Error())
 		return
 	}
 	defer target.Close()
 
+	proxyConnection(conn, target)
+}
+
+func (c *secureShell) handleRemoteForwardConnection(conn net.Conn, targetAddr string) {
+	defer conn.Close()
+
+	target, err := net.Dial("tcp", targetAddr)
+	if err != nil {
+		c.printConnectionError("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	proxyConnection(conn, target)
+}
+
+// printConnectionError reports a failed forwarded connection on stderr, so
+// that it is not mixed with the output of the session.
+func (c *secureShell) printConnectionError(format string, args ...interface{}) {
+	_, _, stderr := c.terminalHelper.StdStreams()
+	fmt.Fprintf(stderr, format, args...)
+}
+
+func proxyConnection(conn net.Conn, target net.Conn) {
 	wg := &sync.WaitGroup{}
 	wg.Add(2)
 
@@ -313,6 +381,46 @@ func (c *secureShell) InteractiveSession() error {
 	return result
 }
 
+// ExecuteCommand runs the command from the options without a terminal or
+// standard input and copies its output to the given writers.
+func (c *secureShell) ExecuteCommand(stdout io.Writer, stderr io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(strings.Join(c.opts.Command, " "))
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	result := session.Wait()
+	wg.Wait()
+	return result
+}
+
 func (c *secureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
@@ -479,6 +587,9 @@ func (sc *secureClient) Wait() error    { return sc.client.Wait() }
 func (sc *secureClient) Dial(n, addr string) (net.Conn, error) {
 	return sc.client.Dial(n, addr)
 }
+func (sc *secureClient) Listen(n, addr string) (net.Listener, error) {
+	return sc.client.Listen(n, addr)
+}
 func (sc *secureClient) NewSession() (SecureSession, error) {
 	return sc.client.NewSession()
 }
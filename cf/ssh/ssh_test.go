@@ -7,11 +7,13 @@
 package sshCmd_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"strings"
 	"syscall"
 	"time"
 
@@ -34,6 +36,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("SSH", func() {
@@ -853,6 +856,73 @@ var _ = Describe("SSH", func() {
 		})
 	})
 
+	Describe("ExecuteCommand", func() {
+		var (
+			opts       *options.SSHOptions
+			stdout     *bytes.Buffer
+			stderr     *bytes.Buffer
+			commandErr error
+		)
+
+		BeforeEach(func() {
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				Index:   2,
+				Command: []string{"df", "-h"},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+
+			stdout = &bytes.Buffer{}
+			stderr = &bytes.Buffer{}
+
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("Filesystem Size\n"), nil)
+			fakeSecureSession.StderrPipeReturns(strings.NewReader("warning\n"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			commandErr = secureShell.ExecuteCommand(stdout, stderr)
+		})
+
+		It("runs the command without a terminal", func() {
+			Expect(commandErr).NotTo(HaveOccurred())
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("df -h"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		It("copies the command output to the writers", func() {
+			Expect(stdout.String()).To(Equal("Filesystem Size\n"))
+			Expect(stderr.String()).To(Equal("warning\n"))
+		})
+
+		Context("when the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit status 1"))
+			})
+
+			It("returns the session error", func() {
+				Expect(commandErr).To(MatchError("exit status 1"))
+			})
+		})
+
+		Context("when the session cannot be allocated", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("no session"))
+			})
+
+			It("returns an error", func() {
+				Expect(commandErr).To(MatchError("SSH session allocation failed: no session"))
+			})
+		})
+	})
+
 	Describe("LocalPortForward", func() {
 		var (
 			opts              *options.SSHOptions
@@ -1165,6 +1235,340 @@ var _ = Describe("SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			opts               *options.SSHOptions
+			remoteForwardError error
+
+			echoAddress  string
+			echoListener net.Listener
+
+			remoteListener net.Listener
+			remoteAddress  string
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			echoAddress = echoListener.Addr().String()
+
+			go func() {
+				for {
+					conn, acceptErr := echoListener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn)
+						conn.Close()
+					}()
+				}
+			}()
+
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			remoteAddress = remoteListener.Addr().String()
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				RemoteForwardSpecs: []options.ForwardSpec{{
+					ListenAddress:  "localhost:9999",
+					ConnectAddress: echoAddress,
+				}},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			remoteForwardError = secureShell.RemotePortForward()
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+			echoListener.Close()
+			remoteListener.Close()
+		})
+
+		It("asks the server to listen on the remote address", func() {
+			Expect(remoteForwardError).NotTo(HaveOccurred())
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9999"))
+		})
+
+		It("copies data between the remote connection and the local target", func() {
+			conn, err := net.Dial("tcp", remoteAddress)
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			msg := "Hello from the app\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		It("closes the remote listener when the shell is closed", func() {
+			Expect(secureShell.Close()).To(Succeed())
+
+			_, err := remoteListener.Accept()
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when the local target cannot be reached", func() {
+			var stdout, stderr *gbytes.Buffer
+
+			BeforeEach(func() {
+				closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+				Expect(err).NotTo(HaveOccurred())
+				opts.RemoteForwardSpecs[0].ConnectAddress = closedListener.Addr().String()
+				Expect(closedListener.Close()).To(Succeed())
+
+				stdout = gbytes.NewBuffer()
+				stderr = gbytes.NewBuffer()
+				fakeTerminalHelper.StdStreamsReturns(gbytes.NewBuffer(), stdout, stderr)
+				terminalHelper = fakeTerminalHelper
+			})
+
+			It("reports the failure on stderr", func() {
+				conn, err := net.Dial("tcp", remoteAddress)
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				Eventually(stderr).Should(gbytes.Say(`connect to 127\.0\.0\.1:\d+ failed: `))
+				Expect(stdout.Contents()).To(BeEmpty())
+			})
+		})
+
+		Context("when the server refuses to listen", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(remoteForwardError).To(MatchError("tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			opts                *options.SSHOptions
+			dynamicForwardError error
+
+			echoAddress  string
+			echoListener net.Listener
+
+			proxyListener net.Listener
+			proxyAddress  string
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			echoAddress = echoListener.Addr().String()
+
+			go func() {
+				for {
+					conn, acceptErr := echoListener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn)
+						conn.Close()
+					}()
+				}
+			}()
+
+			proxyListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			proxyAddress = proxyListener.Addr().String()
+			fakeListenerFactory.ListenReturns(proxyListener, nil)
+
+			fakeSecureClient.DialStub = net.Dial
+
+			opts = &options.SSHOptions{
+				AppName:             "app-1",
+				DynamicForwardSpecs: []string{"localhost:1080"},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			dynamicForwardError = secureShell.DynamicPortForward()
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+			echoListener.Close()
+			proxyListener.Close()
+		})
+
+		socksConnect := func(request []byte) (net.Conn, []byte) {
+			conn, err := net.Dial("tcp", proxyAddress)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = conn.Write([]byte{0x05, 0x01, 0x00})
+			Expect(err).NotTo(HaveOccurred())
+
+			method := make([]byte, 2)
+			_, err = io.ReadFull(conn, method)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(method).To(Equal([]byte{0x05, 0x00}))
+
+			_, err = conn.Write(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			reply := make([]byte, 10)
+			_, err = io.ReadFull(conn, reply)
+			Expect(err).NotTo(HaveOccurred())
+
+			return conn, reply
+		}
+
+		connectRequest := func(addressType byte, host []byte, port int) []byte {
+			request := []byte{0x05, 0x01, 0x00, addressType}
+			request = append(request, host...)
+			return append(request, byte(port>>8), byte(port))
+		}
+
+		echoPort := func() int {
+			return echoListener.Addr().(*net.TCPAddr).Port
+		}
+
+		It("listens on the dynamic forward address", func() {
+			Expect(dynamicForwardError).NotTo(HaveOccurred())
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("dials the requested IPv4 address through the secure client", func() {
+			conn, reply := socksConnect(connectRequest(0x01, []byte{127, 0, 0, 1}, echoPort()))
+			defer conn.Close()
+			Expect(reply[1]).To(Equal(byte(0x00)))
+
+			Eventually(fakeSecureClient.DialCallCount).Should(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal(echoAddress))
+
+			msg := "Hello through the proxy\n"
+			_, err := conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		It("dials the requested domain name through the secure client", func() {
+			domain := "localhost"
+			conn, reply := socksConnect(connectRequest(0x03, append([]byte{byte(len(domain))}, domain...), echoPort()))
+			defer conn.Close()
+			Expect(reply[1]).To(Equal(byte(0x00)))
+
+			_, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(addr).To(Equal(fmt.Sprintf("localhost:%d", echoPort())))
+		})
+
+		Context("when the secure client cannot dial the target", func() {
+			var stdout, stderr *gbytes.Buffer
+
+			BeforeEach(func() {
+				fakeSecureClient.DialReturns(nil, errors.New("connection refused"))
+				fakeSecureClient.DialStub = nil
+
+				stdout = gbytes.NewBuffer()
+				stderr = gbytes.NewBuffer()
+				fakeTerminalHelper.StdStreamsReturns(gbytes.NewBuffer(), stdout, stderr)
+				terminalHelper = fakeTerminalHelper
+			})
+
+			It("replies that the host is unreachable", func() {
+				conn, reply := socksConnect(connectRequest(0x01, []byte{10, 0, 0, 1}, 5432))
+				defer conn.Close()
+				Expect(reply[1]).To(Equal(byte(0x04)))
+			})
+
+			It("reports the failure on stderr", func() {
+				conn, _ := socksConnect(connectRequest(0x01, []byte{10, 0, 0, 1}, 5432))
+				defer conn.Close()
+
+				Eventually(stderr).Should(gbytes.Say("connect to 10.0.0.1:5432 failed: connection refused"))
+				Expect(stdout.Contents()).To(BeEmpty())
+			})
+		})
+
+		Context("when the SOCKS request is invalid", func() {
+			var stdout, stderr *gbytes.Buffer
+
+			BeforeEach(func() {
+				stdout = gbytes.NewBuffer()
+				stderr = gbytes.NewBuffer()
+				fakeTerminalHelper.StdStreamsReturns(gbytes.NewBuffer(), stdout, stderr)
+				terminalHelper = fakeTerminalHelper
+			})
+
+			It("reports the failure on stderr", func() {
+				conn, err := net.Dial("tcp", proxyAddress)
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				_, err = conn.Write([]byte{0x04, 0x01, 0x00})
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(stderr).Should(gbytes.Say("SOCKS request failed: unsupported SOCKS version 4"))
+				Expect(stdout.Contents()).To(BeEmpty())
+				Expect(fakeSecureClient.DialCallCount()).To(Equal(0))
+			})
+		})
+
+		It("rejects commands other than CONNECT", func() {
+			request := connectRequest(0x01, []byte{127, 0, 0, 1}, echoPort())
+			request[1] = 0x02
+
+			conn, reply := socksConnect(request)
+			defer conn.Close()
+			Expect(reply[1]).To(Equal(byte(0x07)))
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(0))
+		})
+
+		Context("when listen fails", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenReturns(nil, errors.New("failure is an option"))
+			})
+
+			It("returns the error", func() {
+				Expect(dynamicForwardError).To(MatchError("failure is an option"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var opts *options.SSHOptions
 		var waitErr error
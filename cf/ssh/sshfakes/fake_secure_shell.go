@@ -2,6 +2,7 @@
 package sshfakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/cf/ssh"
@@ -41,6 +42,35 @@ type FakeSecureShell struct {
 	closeReturns     struct {
 		result1 error
 	}
+	CopyStub        func(opts *options.SCPOptions) error
+	copyMutex       sync.RWMutex
+	copyArgsForCall []struct {
+		opts *options.SCPOptions
+	}
+	copyReturns struct {
+		result1 error
+	}
+	RemotePortForwardStub        func() error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct{}
+	remotePortForwardReturns     struct {
+		result1 error
+	}
+	DynamicPortForwardStub        func() error
+	dynamicPortForwardMutex       sync.RWMutex
+	dynamicPortForwardArgsForCall []struct{}
+	dynamicPortForwardReturns     struct {
+		result1 error
+	}
+	ExecuteCommandStub        func(stdout io.Writer, stderr io.Writer) error
+	executeCommandMutex       sync.RWMutex
+	executeCommandArgsForCall []struct {
+		stdout io.Writer
+		stderr io.Writer
+	}
+	executeCommandReturns struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -178,6 +208,123 @@ func (fake *FakeSecureShell) CloseReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) Copy(opts *options.SCPOptions) error {
+	fake.copyMutex.Lock()
+	fake.copyArgsForCall = append(fake.copyArgsForCall, struct {
+		opts *options.SCPOptions
+	}{opts})
+	fake.recordInvocation("Copy", []interface{}{opts})
+	fake.copyMutex.Unlock()
+	if fake.CopyStub != nil {
+		return fake.CopyStub(opts)
+	} else {
+		return fake.copyReturns.result1
+	}
+}
+
+func (fake *FakeSecureShell) CopyCallCount() int {
+	fake.copyMutex.RLock()
+	defer fake.copyMutex.RUnlock()
+	return len(fake.copyArgsForCall)
+}
+
+func (fake *FakeSecureShell) CopyArgsForCall(i int) *options.SCPOptions {
+	fake.copyMutex.RLock()
+	defer fake.copyMutex.RUnlock()
+	return fake.copyArgsForCall[i].opts
+}
+
+func (fake *FakeSecureShell) CopyReturns(result1 error) {
+	fake.CopyStub = nil
+	fake.copyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) RemotePortForward() error {
+	fake.remotePortForwardMutex.Lock()
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct{}{})
+	fake.recordInvocation("RemotePortForward", []interface{}{})
+	fake.remotePortForwardMutex.Unlock()
+	if fake.RemotePortForwardStub != nil {
+		return fake.RemotePortForwardStub()
+	} else {
+		return fake.remotePortForwardReturns.result1
+	}
+}
+
+func (fake *FakeSecureShell) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShell) RemotePortForwardReturns(result1 error) {
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) DynamicPortForward() error {
+	fake.dynamicPortForwardMutex.Lock()
+	fake.dynamicPortForwardArgsForCall = append(fake.dynamicPortForwardArgsForCall, struct{}{})
+	fake.recordInvocation("DynamicPortForward", []interface{}{})
+	fake.dynamicPortForwardMutex.Unlock()
+	if fake.DynamicPortForwardStub != nil {
+		return fake.DynamicPortForwardStub()
+	} else {
+		return fake.dynamicPortForwardReturns.result1
+	}
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardCallCount() int {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return len(fake.dynamicPortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardReturns(result1 error) {
+	fake.DynamicPortForwardStub = nil
+	fake.dynamicPortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) ExecuteCommand(stdout io.Writer, stderr io.Writer) error {
+	fake.executeCommandMutex.Lock()
+	fake.executeCommandArgsForCall = append(fake.executeCommandArgsForCall, struct {
+		stdout io.Writer
+		stderr io.Writer
+	}{stdout, stderr})
+	fake.recordInvocation("ExecuteCommand", []interface{}{stdout, stderr})
+	fake.executeCommandMutex.Unlock()
+	if fake.ExecuteCommandStub != nil {
+		return fake.ExecuteCommandStub(stdout, stderr)
+	} else {
+		return fake.executeCommandReturns.result1
+	}
+}
+
+func (fake *FakeSecureShell) ExecuteCommandCallCount() int {
+	fake.executeCommandMutex.RLock()
+	defer fake.executeCommandMutex.RUnlock()
+	return len(fake.executeCommandArgsForCall)
+}
+
+func (fake *FakeSecureShell) ExecuteCommandArgsForCall(i int) (io.Writer, io.Writer) {
+	fake.executeCommandMutex.RLock()
+	defer fake.executeCommandMutex.RUnlock()
+	return fake.executeCommandArgsForCall[i].stdout, fake.executeCommandArgsForCall[i].stderr
+}
+
+func (fake *FakeSecureShell) ExecuteCommandReturns(result1 error) {
+	fake.ExecuteCommandStub = nil
+	fake.executeCommandReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -191,6 +338,14 @@ func (fake *FakeSecureShell) Invocations() map[string][][]interface{} {
 	defer fake.waitMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
+	fake.copyMutex.RLock()
+	defer fake.copyMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	fake.executeCommandMutex.RLock()
+	defer fake.executeCommandMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -19,6 +19,15 @@ type FakeConfig struct {
 	accessTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ActiveContextStub        func() string
+	activeContextMutex       sync.RWMutex
+	activeContextArgsForCall []struct{}
+	activeContextReturns     struct {
+		result1 string
+	}
+	activeContextReturnsOnCall map[int]struct {
+		result1 string
+	}
 	APIVersionStub        func() string
 	aPIVersionMutex       sync.RWMutex
 	aPIVersionArgsForCall []struct{}
@@ -55,6 +64,28 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	ContextStub        func(name string) (configv3.ContextSettings, error)
+	contextMutex       sync.RWMutex
+	contextArgsForCall []struct {
+		name string
+	}
+	contextReturns struct {
+		result1 configv3.ContextSettings
+		result2 error
+	}
+	contextReturnsOnCall map[int]struct {
+		result1 configv3.ContextSettings
+		result2 error
+	}
+	ContextNamesStub        func() []string
+	contextNamesMutex       sync.RWMutex
+	contextNamesArgsForCall []struct{}
+	contextNamesReturns     struct {
+		result1 []string
+	}
+	contextNamesReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct{}
@@ -156,6 +187,29 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RetryMaxStub        func() int
+	retryMaxMutex       sync.RWMutex
+	retryMaxArgsForCall []struct{}
+	retryMaxReturns     struct {
+		result1 int
+	}
+	retryMaxReturnsOnCall map[int]struct {
+		result1 int
+	}
+	RetryMaxDelayStub        func() time.Duration
+	retryMaxDelayMutex       sync.RWMutex
+	retryMaxDelayArgsForCall []struct{}
+	retryMaxDelayReturns     struct {
+		result1 time.Duration
+	}
+	retryMaxDelayReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	SaveContextStub        func(name string)
+	saveContextMutex       sync.RWMutex
+	saveContextArgsForCall []struct {
+		name string
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
@@ -276,10 +330,21 @@ type FakeConfig struct {
 	UnsetSpaceInformationStub               func()
 	unsetSpaceInformationMutex              sync.RWMutex
 	unsetSpaceInformationArgsForCall        []struct{}
-	VerboseStub                             func() (bool, []string)
-	verboseMutex                            sync.RWMutex
-	verboseArgsForCall                      []struct{}
-	verboseReturns                          struct {
+	UseContextStub                          func(name string) error
+	useContextMutex                         sync.RWMutex
+	useContextArgsForCall                   []struct {
+		name string
+	}
+	useContextReturns struct {
+		result1 error
+	}
+	useContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VerboseStub        func() (bool, []string)
+	verboseMutex       sync.RWMutex
+	verboseArgsForCall []struct{}
+	verboseReturns     struct {
 		result1 bool
 		result2 []string
 	}
@@ -331,6 +396,46 @@ func (fake *FakeConfig) AccessTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ActiveContext() string {
+	fake.activeContextMutex.Lock()
+	ret, specificReturn := fake.activeContextReturnsOnCall[len(fake.activeContextArgsForCall)]
+	fake.activeContextArgsForCall = append(fake.activeContextArgsForCall, struct{}{})
+	fake.recordInvocation("ActiveContext", []interface{}{})
+	fake.activeContextMutex.Unlock()
+	if fake.ActiveContextStub != nil {
+		return fake.ActiveContextStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.activeContextReturns.result1
+}
+
+func (fake *FakeConfig) ActiveContextCallCount() int {
+	fake.activeContextMutex.RLock()
+	defer fake.activeContextMutex.RUnlock()
+	return len(fake.activeContextArgsForCall)
+}
+
+func (fake *FakeConfig) ActiveContextReturns(result1 string) {
+	fake.ActiveContextStub = nil
+	fake.activeContextReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ActiveContextReturnsOnCall(i int, result1 string) {
+	fake.ActiveContextStub = nil
+	if fake.activeContextReturnsOnCall == nil {
+		fake.activeContextReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.activeContextReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) APIVersion() string {
 	fake.aPIVersionMutex.Lock()
 	ret, specificReturn := fake.aPIVersionReturnsOnCall[len(fake.aPIVersionArgsForCall)]
@@ -491,6 +596,97 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) Context(name string) (configv3.ContextSettings, error) {
+	fake.contextMutex.Lock()
+	ret, specificReturn := fake.contextReturnsOnCall[len(fake.contextArgsForCall)]
+	fake.contextArgsForCall = append(fake.contextArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("Context", []interface{}{name})
+	fake.contextMutex.Unlock()
+	if fake.ContextStub != nil {
+		return fake.ContextStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.contextReturns.result1, fake.contextReturns.result2
+}
+
+func (fake *FakeConfig) ContextCallCount() int {
+	fake.contextMutex.RLock()
+	defer fake.contextMutex.RUnlock()
+	return len(fake.contextArgsForCall)
+}
+
+func (fake *FakeConfig) ContextArgsForCall(i int) string {
+	fake.contextMutex.RLock()
+	defer fake.contextMutex.RUnlock()
+	return fake.contextArgsForCall[i].name
+}
+
+func (fake *FakeConfig) ContextReturns(result1 configv3.ContextSettings, result2 error) {
+	fake.ContextStub = nil
+	fake.contextReturns = struct {
+		result1 configv3.ContextSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) ContextReturnsOnCall(i int, result1 configv3.ContextSettings, result2 error) {
+	fake.ContextStub = nil
+	if fake.contextReturnsOnCall == nil {
+		fake.contextReturnsOnCall = make(map[int]struct {
+			result1 configv3.ContextSettings
+			result2 error
+		})
+	}
+	fake.contextReturnsOnCall[i] = struct {
+		result1 configv3.ContextSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) ContextNames() []string {
+	fake.contextNamesMutex.Lock()
+	ret, specificReturn := fake.contextNamesReturnsOnCall[len(fake.contextNamesArgsForCall)]
+	fake.contextNamesArgsForCall = append(fake.contextNamesArgsForCall, struct{}{})
+	fake.recordInvocation("ContextNames", []interface{}{})
+	fake.contextNamesMutex.Unlock()
+	if fake.ContextNamesStub != nil {
+		return fake.ContextNamesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.contextNamesReturns.result1
+}
+
+func (fake *FakeConfig) ContextNamesCallCount() int {
+	fake.contextNamesMutex.RLock()
+	defer fake.contextNamesMutex.RUnlock()
+	return len(fake.contextNamesArgsForCall)
+}
+
+func (fake *FakeConfig) ContextNamesReturns(result1 []string) {
+	fake.ContextNamesStub = nil
+	fake.contextNamesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) ContextNamesReturnsOnCall(i int, result1 []string) {
+	fake.ContextNamesStub = nil
+	if fake.contextNamesReturnsOnCall == nil {
+		fake.contextNamesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.contextNamesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -934,6 +1130,110 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RetryMax() int {
+	fake.retryMaxMutex.Lock()
+	ret, specificReturn := fake.retryMaxReturnsOnCall[len(fake.retryMaxArgsForCall)]
+	fake.retryMaxArgsForCall = append(fake.retryMaxArgsForCall, struct{}{})
+	fake.recordInvocation("RetryMax", []interface{}{})
+	fake.retryMaxMutex.Unlock()
+	if fake.RetryMaxStub != nil {
+		return fake.RetryMaxStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.retryMaxReturns.result1
+}
+
+func (fake *FakeConfig) RetryMaxCallCount() int {
+	fake.retryMaxMutex.RLock()
+	defer fake.retryMaxMutex.RUnlock()
+	return len(fake.retryMaxArgsForCall)
+}
+
+func (fake *FakeConfig) RetryMaxReturns(result1 int) {
+	fake.RetryMaxStub = nil
+	fake.retryMaxReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) RetryMaxReturnsOnCall(i int, result1 int) {
+	fake.RetryMaxStub = nil
+	if fake.retryMaxReturnsOnCall == nil {
+		fake.retryMaxReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.retryMaxReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) RetryMaxDelay() time.Duration {
+	fake.retryMaxDelayMutex.Lock()
+	ret, specificReturn := fake.retryMaxDelayReturnsOnCall[len(fake.retryMaxDelayArgsForCall)]
+	fake.retryMaxDelayArgsForCall = append(fake.retryMaxDelayArgsForCall, struct{}{})
+	fake.recordInvocation("RetryMaxDelay", []interface{}{})
+	fake.retryMaxDelayMutex.Unlock()
+	if fake.RetryMaxDelayStub != nil {
+		return fake.RetryMaxDelayStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.retryMaxDelayReturns.result1
+}
+
+func (fake *FakeConfig) RetryMaxDelayCallCount() int {
+	fake.retryMaxDelayMutex.RLock()
+	defer fake.retryMaxDelayMutex.RUnlock()
+	return len(fake.retryMaxDelayArgsForCall)
+}
+
+func (fake *FakeConfig) RetryMaxDelayReturns(result1 time.Duration) {
+	fake.RetryMaxDelayStub = nil
+	fake.retryMaxDelayReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) RetryMaxDelayReturnsOnCall(i int, result1 time.Duration) {
+	fake.RetryMaxDelayStub = nil
+	if fake.retryMaxDelayReturnsOnCall == nil {
+		fake.retryMaxDelayReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.retryMaxDelayReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) SaveContext(name string) {
+	fake.saveContextMutex.Lock()
+	fake.saveContextArgsForCall = append(fake.saveContextArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("SaveContext", []interface{}{name})
+	fake.saveContextMutex.Unlock()
+	if fake.SaveContextStub != nil {
+		fake.SaveContextStub(name)
+	}
+}
+
+func (fake *FakeConfig) SaveContextCallCount() int {
+	fake.saveContextMutex.RLock()
+	defer fake.saveContextMutex.RUnlock()
+	return len(fake.saveContextArgsForCall)
+}
+
+func (fake *FakeConfig) SaveContextArgsForCall(i int) string {
+	fake.saveContextMutex.RLock()
+	defer fake.saveContextMutex.RUnlock()
+	return fake.saveContextArgsForCall[i].name
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -1442,6 +1742,54 @@ func (fake *FakeConfig) UnsetSpaceInformationCallCount() int {
 	return len(fake.unsetSpaceInformationArgsForCall)
 }
 
+func (fake *FakeConfig) UseContext(name string) error {
+	fake.useContextMutex.Lock()
+	ret, specificReturn := fake.useContextReturnsOnCall[len(fake.useContextArgsForCall)]
+	fake.useContextArgsForCall = append(fake.useContextArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("UseContext", []interface{}{name})
+	fake.useContextMutex.Unlock()
+	if fake.UseContextStub != nil {
+		return fake.UseContextStub(name)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.useContextReturns.result1
+}
+
+func (fake *FakeConfig) UseContextCallCount() int {
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
+	return len(fake.useContextArgsForCall)
+}
+
+func (fake *FakeConfig) UseContextArgsForCall(i int) string {
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
+	return fake.useContextArgsForCall[i].name
+}
+
+func (fake *FakeConfig) UseContextReturns(result1 error) {
+	fake.UseContextStub = nil
+	fake.useContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) UseContextReturnsOnCall(i int, result1 error) {
+	fake.UseContextStub = nil
+	if fake.useContextReturnsOnCall == nil {
+		fake.useContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.useContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -1490,6 +1838,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
 	defer fake.accessTokenMutex.RUnlock()
+	fake.activeContextMutex.RLock()
+	defer fake.activeContextMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
@@ -1498,6 +1848,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryVersionMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.contextMutex.RLock()
+	defer fake.contextMutex.RUnlock()
+	fake.contextNamesMutex.RLock()
+	defer fake.contextNamesMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
@@ -1520,6 +1874,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pollingIntervalMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.retryMaxMutex.RLock()
+	defer fake.retryMaxMutex.RUnlock()
+	fake.retryMaxDelayMutex.RLock()
+	defer fake.retryMaxDelayMutex.RUnlock()
+	fake.saveContextMutex.RLock()
+	defer fake.saveContextMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
@@ -1552,6 +1912,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.unsetOrganizationInformationMutex.RUnlock()
 	fake.unsetSpaceInformationMutex.RLock()
 	defer fake.unsetSpaceInformationMutex.RUnlock()
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	return fake.invocations
@@ -300,9 +300,12 @@ func (cmd HelpCommand) displayCommand() error {
 func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 	return [][]string{
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
+		{"CF_CONTEXT=name", cmd.UI.TranslateText("Use the named context in the current shell")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_RETRY_MAX=2", cmd.UI.TranslateText("Max number of times a failed Cloud Controller request is retried")},
+		{"CF_RETRY_MAX_DELAY=30", cmd.UI.TranslateText("Max wait time between retries of a failed Cloud Controller request, in seconds")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable HTTP proxying for API requests")},
@@ -312,6 +315,7 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
+		{"--output", cmd.UI.TranslateText("Display the output as 'json' or 'yaml' (apps, buildpacks, contexts, isolation-segments, org, orgs, routes, space, stacks, task, tasks)")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 	}
 }
@@ -580,9 +580,12 @@ var _ = Describe("help Command", func() {
 
 				Expect(testUI.Out).To(Say("ENVIRONMENT VARIABLES:"))
 				Expect(testUI.Out).To(Say("   CF_COLOR=false                     Do not colorize output"))
+				Expect(testUI.Out).To(Say("   CF_CONTEXT=name                    Use the named context in the current shell"))
 				Expect(testUI.Out).To(Say("   CF_DIAL_TIMEOUT=5                  Max wait time to establish a connection, including name resolution, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_HOME=path/to/dir/               Override path to default config directory"))
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
+				Expect(testUI.Out).To(Say("   CF_RETRY_MAX=2                     Max number of times a failed Cloud Controller request is retried"))
+				Expect(testUI.Out).To(Say("   CF_RETRY_MAX_DELAY=30              Max wait time between retries of a failed Cloud Controller request, in seconds"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable HTTP proxying for API requests"))
@@ -6,6 +6,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"contexts", "save-context", "use-context"},
 		},
 	},
 	{
@@ -14,12 +15,12 @@ var HelpCategoryList = []HelpCategory{
 			{"apps", "app"},
 			{"push", "scale", "delete", "rename"},
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
-			{"run-task", "tasks", "terminate-task"},
+			{"run-task", "task", "tasks", "terminate-task", "run-scheduled-tasks"},
 			{"events", "files", "logs"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"copy-source", "create-app-manifest", "export-space-manifest"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "scp"},
 		},
 	},
 	{
@@ -43,7 +44,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "SPACES:",
 		CommandList: [][]string{
-			{"spaces", "space"},
+			{"spaces", "space", "diff-spaces"},
 			{"create-space", "delete-space", "rename-space"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
 		},
@@ -141,7 +142,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
-			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"plugins", "install-plugin", "uninstall-plugin", "update-plugin"},
 		},
 	},
 }
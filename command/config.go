@@ -11,10 +11,13 @@ import (
 // Config a way of getting basic CF configuration
 type Config interface {
 	AccessToken() string
+	ActiveContext() string
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
 	ColorEnabled() configv3.ColorSetting
+	Context(name string) (configv3.ContextSettings, error)
+	ContextNames() []string
 	CurrentUser() (configv3.User, error)
 	DialTimeout() time.Duration
 	Experimental() bool
@@ -26,6 +29,9 @@ type Config interface {
 	Plugins() map[string]configv3.Plugin
 	PollingInterval() time.Duration
 	RefreshToken() string
+	RetryMax() int
+	RetryMaxDelay() time.Duration
+	SaveContext(name string)
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
@@ -42,5 +48,6 @@ type Config interface {
 	UAAOAuthClientSecret() string
 	UnsetOrganizationInformation()
 	UnsetSpaceInformation()
+	UseContext(name string) error
 	Verbose() (bool, []string)
 }
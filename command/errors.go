@@ -173,6 +173,52 @@ func (e RequiredArgumentError) Translate(translate func(string, ...interface{})
 	})
 }
 
+type ArgumentCombinationError struct {
+	Arg1 string
+	Arg2 string
+}
+
+func (e ArgumentCombinationError) Error() string {
+	return "Incorrect Usage: '{{.Arg1}}' and '{{.Arg2}}' cannot be used together."
+}
+
+func (e ArgumentCombinationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Arg1": e.Arg1,
+		"Arg2": e.Arg2,
+	})
+}
+
+type FlagRequiresFlagError struct {
+	Flag         string
+	RequiredFlag string
+}
+
+func (e FlagRequiresFlagError) Error() string {
+	return "Incorrect Usage: '{{.Flag}}' can only be used with '{{.RequiredFlag}}'."
+}
+
+func (e FlagRequiresFlagError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Flag":         e.Flag,
+		"RequiredFlag": e.RequiredFlag,
+	})
+}
+
+type StructuredOutputNotSupportedError struct {
+	Command string
+}
+
+func (e StructuredOutputNotSupportedError) Error() string {
+	return "Incorrect Usage: '--output' is not supported by the '{{.Command}}' command."
+}
+
+func (e StructuredOutputNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command": e.Command,
+	})
+}
+
 type MinimumAPIVersionNotMetError struct {
 	CurrentVersion string
 	MinimumVersion string
@@ -188,3 +234,21 @@ func (e MinimumAPIVersionNotMetError) Translate(translate func(string, ...interf
 		"MinimumVersion": e.MinimumVersion,
 	})
 }
+
+type FlagMinimumAPIVersionNotMetError struct {
+	Flag           string
+	CurrentVersion string
+	MinimumVersion string
+}
+
+func (e FlagMinimumAPIVersionNotMetError) Error() string {
+	return "Option '{{.Flag}}' requires CF API version {{.MinimumVersion}} or higher. Your target is {{.CurrentVersion}}."
+}
+
+func (e FlagMinimumAPIVersionNotMetError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Flag":           e.Flag,
+		"CurrentVersion": e.CurrentVersion,
+		"MinimumVersion": e.MinimumVersion,
+	})
+}
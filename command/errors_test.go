@@ -50,6 +50,10 @@ var _ = Describe("Translatable Errors", func() {
 
 		// Parse errors.
 		Entry("ParseArgumentError", ParseArgumentError{}),
+		Entry("ArgumentCombinationError", ArgumentCombinationError{}),
+		Entry("FlagRequiresFlagError", FlagRequiresFlagError{}),
+		Entry("StructuredOutputNotSupportedError", StructuredOutputNotSupportedError{}),
+		Entry("FlagMinimumAPIVersionNotMetError", FlagMinimumAPIVersionNotMetError{}),
 
 		// Version errors.
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type AppPorts []int
+
+func (p *AppPorts) UnmarshalFlag(val string) error {
+	var ports AppPorts
+	for _, rawPort := range strings.Split(val, ",") {
+		port, err := strconv.Atoi(strings.TrimSpace(rawPort))
+		if err != nil || port <= 0 {
+			return &flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `--app-ports must be a comma delimited list of port numbers`,
+			}
+		}
+		ports = append(ports, port)
+	}
+
+	*p = ports
+	return nil
+}
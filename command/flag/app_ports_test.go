@@ -0,0 +1,47 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AppPorts", func() {
+	var ports AppPorts
+
+	BeforeEach(func() {
+		ports = nil
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("sets a single port", func() {
+			err := ports.UnmarshalFlag("8080")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ports).To(Equal(AppPorts{8080}))
+		})
+
+		It("sets a comma delimited list of ports", func() {
+			err := ports.UnmarshalFlag("8080, 9090,1234")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ports).To(Equal(AppPorts{8080, 9090, 1234}))
+		})
+
+		DescribeTable("returns an error for invalid ports",
+			func(value string) {
+				err := ports.UnmarshalFlag(value)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `--app-ports must be a comma delimited list of port numbers`,
+				}))
+				Expect(ports).To(BeNil())
+			},
+			Entry("empty", ""),
+			Entry("not a number", "8080,banana"),
+			Entry("zero", "0"),
+			Entry("negative number", "-1"),
+			Entry("trailing comma", "8080,"),
+		)
+	})
+})
@@ -4,6 +4,10 @@ type AppName struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
 
+type AppNames struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
 type Buildpack struct {
 	Buildpack string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 }
@@ -12,6 +16,10 @@ type CommandName struct {
 	CommandName string `positional-arg-name:"COMMAND_NAME" description:"The command name"`
 }
 
+type ContextName struct {
+	ContextName string `positional-arg-name:"CONTEXT_NAME" required:"true" description:"The context name"`
+}
+
 type Domain struct {
 	Domain string `positional-arg-name:"DOMAIN" required:"true" description:"The domain"`
 }
@@ -279,11 +287,20 @@ type InstallPluginArgs struct {
 	URL       string `positional-arg-name:"URL" description:"The URL to the plugin, if the plugin exists online"`
 }
 
+type UpdatePluginArgs struct {
+	PluginName string `positional-arg-name:"PLUGIN_NAME" description:"The plugin name, unless --all is provided"`
+}
+
 type RunTaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Command string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
 }
 
+type TaskArgs struct {
+	AppName    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	SequenceID string `positional-arg-name:"TASK_ID" required:"true" description:"The task's unique sequence ID"`
+}
+
 type TerminateTaskArgs struct {
 	AppName    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	SequenceID string `positional-arg-name:"TASK_ID" required:"true" description:"The task's unique sequence ID"`
@@ -302,3 +319,17 @@ type SpaceIsolationArgs struct {
 	SpaceName            string `positional-arg-name:"SPACE_NAME" required:"true" description:"The space name"`
 	IsolationSegmentName string `positional-arg-name:"SEGMENT_NAME" required:"true" description:"The isolation segment name"`
 }
+
+type OptionalAppName struct {
+	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
+}
+
+type SCPArgs struct {
+	Source string `positional-arg-name:"SOURCE" required:"true" description:"The path to copy from, either LOCAL_PATH or APP_NAME:REMOTE_PATH"`
+	Target string `positional-arg-name:"TARGET" required:"true" description:"The path to copy to, either LOCAL_PATH or APP_NAME:REMOTE_PATH"`
+}
+
+type DiffSpacesArgs struct {
+	Source OrgSpacePath `positional-arg-name:"SOURCE_ORG/SPACE" required:"true" description:"The space to compare from"`
+	Target OrgSpacePath `positional-arg-name:"TARGET_ORG/SPACE" required:"true" description:"The space to compare to"`
+}
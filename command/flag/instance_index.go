@@ -0,0 +1,26 @@
+package flag
+
+import (
+	"strconv"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type InstanceIndex struct {
+	Index int
+	IsSet bool
+}
+
+func (i *InstanceIndex) UnmarshalFlag(val string) error {
+	index, err := strconv.Atoi(val)
+	if err != nil || index < 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `INDEX must be a non-negative integer`,
+		}
+	}
+
+	i.Index = index
+	i.IsSet = true
+	return nil
+}
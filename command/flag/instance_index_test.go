@@ -0,0 +1,38 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstanceIndex", func() {
+	var index InstanceIndex
+
+	BeforeEach(func() {
+		index = InstanceIndex{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("sets the index", func() {
+			err := index.UnmarshalFlag("2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(index).To(Equal(InstanceIndex{Index: 2, IsSet: true}))
+		})
+
+		DescribeTable("returns an error for invalid indexes",
+			func(value string) {
+				err := index.UnmarshalFlag(value)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `INDEX must be a non-negative integer`,
+				}))
+				Expect(index.IsSet).To(BeFalse())
+			},
+			Entry("negative number", "-1"),
+			Entry("not a number", "banana"),
+		)
+	})
+})
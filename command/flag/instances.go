@@ -0,0 +1,26 @@
+package flag
+
+import (
+	"strconv"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Instances struct {
+	Value int
+	IsSet bool
+}
+
+func (i *Instances) UnmarshalFlag(val string) error {
+	instances, err := strconv.Atoi(val)
+	if err != nil || instances < 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `INSTANCES must be a non-negative integer`,
+		}
+	}
+
+	i.Value = instances
+	i.IsSet = true
+	return nil
+}
@@ -0,0 +1,44 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Instances", func() {
+	var instances Instances
+
+	BeforeEach(func() {
+		instances = Instances{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("sets the number of instances", func() {
+			err := instances.UnmarshalFlag("3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(Equal(Instances{Value: 3, IsSet: true}))
+		})
+
+		It("allows scaling to zero instances", func() {
+			err := instances.UnmarshalFlag("0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(Equal(Instances{Value: 0, IsSet: true}))
+		})
+
+		DescribeTable("returns an error for invalid instance counts",
+			func(value string) {
+				err := instances.UnmarshalFlag(value)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `INSTANCES must be a non-negative integer`,
+				}))
+				Expect(instances.IsSet).To(BeFalse())
+			},
+			Entry("negative number", "-1"),
+			Entry("not a number", "banana"),
+		)
+	})
+})
@@ -0,0 +1,27 @@
+package flag
+
+import (
+	"strconv"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// MaxLimit is the largest page of results the Cloud Controller returns.
+const MaxLimit = 5000
+
+type Limit struct {
+	Value int
+}
+
+func (l *Limit) UnmarshalFlag(val string) error {
+	limit, err := strconv.Atoi(val)
+	if err != nil || limit < 1 || limit > MaxLimit {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `LIMIT must be an integer between 1 and 5000`,
+		}
+	}
+
+	l.Value = limit
+	return nil
+}
@@ -0,0 +1,46 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Limit", func() {
+	var limit Limit
+
+	BeforeEach(func() {
+		limit = Limit{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("sets the value", func() {
+			err := limit.UnmarshalFlag("25")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(limit.Value).To(Equal(25))
+		})
+
+		It("accepts the largest page size", func() {
+			err := limit.UnmarshalFlag("5000")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(limit.Value).To(Equal(MaxLimit))
+		})
+
+		DescribeTable("returns an error when the limit is not between 1 and 5000",
+			func(val string) {
+				err := limit.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `LIMIT must be an integer between 1 and 5000`,
+				}))
+				Expect(limit.Value).To(BeZero())
+			},
+			Entry("zero", "0"),
+			Entry("negative", "-3"),
+			Entry("above the largest page size", "5001"),
+			Entry("not a number", "banana"),
+		)
+	})
+})
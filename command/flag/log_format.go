@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogFormat struct {
+	Format string
+}
+
+func (_ LogFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"json", "text"}, prefix, false)
+}
+
+func (f *LogFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "json", "text":
+		f.Format = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `FORMAT must be "json" or "text"`,
+		}
+	}
+	return nil
+}
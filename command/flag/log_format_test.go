@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogFormat", func() {
+	var format LogFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := format.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("completes to 'json' when passed 'j'", "j",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("completes to 'text' when passed 'T'", "T",
+				[]flags.Completion{{Item: "text"}}),
+			Entry("completes to 'json' and 'text' when passed nothing", "",
+				[]flags.Completion{{Item: "json"}, {Item: "text"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			format = LogFormat{}
+		})
+
+		DescribeTable("downcases and sets format",
+			func(value string, expected string) {
+				err := format.UnmarshalFlag(value)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(format.Format).To(Equal(expected))
+			},
+			Entry("sets 'json' when passed 'JSON'", "JSON", "json"),
+			Entry("sets 'text' when passed 'text'", "text", "text"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := format.UnmarshalFlag("xml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `FORMAT must be "json" or "text"`,
+				}))
+				Expect(format.Format).To(BeEmpty())
+			})
+		})
+	})
+})
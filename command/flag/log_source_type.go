@@ -0,0 +1,32 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+var logSourceTypes = []string{"API", "APP", "CELL", "LGR", "RTR", "SSH", "STG"}
+
+type LogSourceType struct {
+	SourceType string
+}
+
+func (_ LogSourceType) Complete(prefix string) []flags.Completion {
+	return completions(logSourceTypes, prefix, false)
+}
+
+func (s *LogSourceType) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	for _, sourceType := range logSourceTypes {
+		if valUpper == sourceType {
+			s.SourceType = valUpper
+			return nil
+		}
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: `SOURCE must be one of: API, APP, CELL, LGR, RTR, SSH, STG`,
+	}
+}
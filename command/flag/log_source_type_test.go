@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSourceType", func() {
+	var sourceType LogSourceType
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := sourceType.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("completes to 'API' and 'APP' when passed 'a'", "a",
+				[]flags.Completion{{Item: "API"}, {Item: "APP"}}),
+			Entry("completes to 'RTR' when passed 'R'", "R",
+				[]flags.Completion{{Item: "RTR"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			sourceType = LogSourceType{}
+		})
+
+		DescribeTable("upcases and sets the source type",
+			func(value string, expected string) {
+				err := sourceType.UnmarshalFlag(value)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sourceType.SourceType).To(Equal(expected))
+			},
+			Entry("sets 'APP' when passed 'app'", "app", "APP"),
+			Entry("sets 'RTR' when passed 'RTR'", "RTR", "RTR"),
+			Entry("sets 'STG' when passed 'Stg'", "Stg", "STG"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := sourceType.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `SOURCE must be one of: API, APP, CELL, LGR, RTR, SSH, STG`,
+				}))
+				Expect(sourceType.SourceType).To(BeEmpty())
+			})
+		})
+	})
+})
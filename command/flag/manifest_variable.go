@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// ManifestVariable is a NAME=VALUE pair used to fill in a ((NAME))
+// placeholder in a manifest. The value is kept exactly as given, so values
+// such as 0123 or 1.10 are not turned into numbers.
+type ManifestVariable struct {
+	Name  string
+	Value string
+}
+
+func (v *ManifestVariable) UnmarshalFlag(val string) error {
+	pieces := strings.SplitN(val, "=", 2)
+	if len(pieces) != 2 || pieces[0] == "" {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `--var must be in the format NAME=VALUE`,
+		}
+	}
+
+	v.Name = pieces[0]
+	v.Value = pieces[1]
+	return nil
+}
@@ -0,0 +1,48 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ManifestVariable", func() {
+	var variable ManifestVariable
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			variable = ManifestVariable{}
+		})
+
+		DescribeTable("sets the name and the value as given",
+			func(input string, expectedName string, expectedValue string) {
+				err := variable.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(variable.Name).To(Equal(expectedName))
+				Expect(variable.Value).To(Equal(expectedValue))
+			},
+			Entry("string value", "stage=prod", "stage", "prod"),
+			Entry("integer value", "instances=3", "instances", "3"),
+			Entry("value with a leading zero", "PASSWORD=0123", "PASSWORD", "0123"),
+			Entry("version-like value", "VERSION=1.10", "VERSION", "1.10"),
+			Entry("boolean-like value", "FLAG=yes", "FLAG", "yes"),
+			Entry("quoted value", `instances="3"`, "instances", `"3"`),
+			Entry("value containing '='", "args=a=b", "args", "a=b"),
+			Entry("empty value", "stage=", "stage", ""),
+		)
+
+		DescribeTable("returns an error when not in the format NAME=VALUE",
+			func(input string) {
+				err := variable.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `--var must be in the format NAME=VALUE`,
+				}))
+			},
+			Entry("no '='", "stage"),
+			Entry("no name", "=prod"),
+		)
+	})
+})
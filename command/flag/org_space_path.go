@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type OrgSpacePath struct {
+	Org   string
+	Space string
+}
+
+func (o *OrgSpacePath) UnmarshalFlag(val string) error {
+	parts := strings.SplitN(val, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `Spaces must be given as ORG/SPACE`,
+		}
+	}
+
+	o.Org = parts[0]
+	o.Space = parts[1]
+	return nil
+}
+
+func (o OrgSpacePath) String() string {
+	return o.Org + "/" + o.Space
+}
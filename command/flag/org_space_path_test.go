@@ -0,0 +1,40 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OrgSpacePath", func() {
+	var orgSpacePath OrgSpacePath
+
+	BeforeEach(func() {
+		orgSpacePath = OrgSpacePath{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("sets the org and space", func() {
+			err := orgSpacePath.UnmarshalFlag("some-org/some/space")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orgSpacePath).To(Equal(OrgSpacePath{Org: "some-org", Space: "some/space"}))
+			Expect(orgSpacePath.String()).To(Equal("some-org/some/space"))
+		})
+
+		DescribeTable("returns an error when the value is not ORG/SPACE",
+			func(val string) {
+				err := orgSpacePath.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `Spaces must be given as ORG/SPACE`,
+				}))
+				Expect(orgSpacePath).To(Equal(OrgSpacePath{}))
+			},
+			Entry("no slash", "some-space"),
+			Entry("no org", "/some-space"),
+			Entry("no space", "some-org/"),
+		)
+	})
+})
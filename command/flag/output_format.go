@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type OutputFormat struct {
+	Format string
+}
+
+func (_ OutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{"json", "yaml"}, prefix, false)
+}
+
+func (o *OutputFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "json", "yaml":
+		o.Format = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `OUTPUT must be "json" or "yaml"`,
+		}
+	}
+	return nil
+}
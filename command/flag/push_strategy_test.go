@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PushStrategy", func() {
+	var strategy PushStrategy
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := strategy.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("completes to 'blue-green' when passed 'b'", "b",
+				[]flags.Completion{{Item: "blue-green"}}),
+			Entry("completes to 'blue-green' when passed 'BL'", "BL",
+				[]flags.Completion{{Item: "blue-green"}}),
+			Entry("completes to 'blue-green' when passed nothing", "",
+				[]flags.Completion{{Item: "blue-green"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			strategy = PushStrategy{}
+		})
+
+		DescribeTable("downcases and sets strategy",
+			func(settingStrategy string, expectedStrategy string) {
+				err := strategy.UnmarshalFlag(settingStrategy)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(strategy.Strategy).To(Equal(expectedStrategy))
+			},
+			Entry("sets 'blue-green' when passed 'blue-green'", "blue-green", "blue-green"),
+			Entry("sets 'blue-green' when passed 'Blue-Green'", "Blue-Green", "blue-green"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := strategy.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `STRATEGY must be "blue-green"`,
+				}))
+				Expect(strategy.Strategy).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,24 @@
+package flag
+
+import (
+	"regexp"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Regexp struct {
+	Regexp *regexp.Regexp
+}
+
+func (r *Regexp) UnmarshalFlag(val string) error {
+	expression, err := regexp.Compile(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "REGEX is not a valid regular expression: " + err.Error(),
+		}
+	}
+
+	r.Regexp = expression
+	return nil
+}
@@ -0,0 +1,35 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Regexp", func() {
+	var expression Regexp
+
+	BeforeEach(func() {
+		expression = Regexp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("compiles the expression", func() {
+			err := expression.UnmarshalFlag("^GET /health")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(expression.Regexp.MatchString("GET /health 200")).To(BeTrue())
+		})
+
+		Context("when the expression is invalid", func() {
+			It("returns an error", func() {
+				err := expression.UnmarshalFlag("(unclosed")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "REGEX is not a valid regular expression: error parsing regexp: missing closing ): `(unclosed`",
+				}))
+				Expect(expression.Regexp).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskState", func() {
+	var taskState TaskState
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := taskState.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'PENDING' when passed 'p'", "p",
+				[]flags.Completion{{Item: "PENDING"}}),
+			Entry("returns 'SUCCEEDED' when passed 'SU'", "SU",
+				[]flags.Completion{{Item: "SUCCEEDED"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			taskState = TaskState{}
+		})
+
+		DescribeTable("upcases and sets state",
+			func(settingState string, expectedState string) {
+				err := taskState.UnmarshalFlag(settingState)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(taskState.State).To(Equal(expectedState))
+			},
+			Entry("sets 'PENDING' when passed 'pending'", "pending", "PENDING"),
+			Entry("sets 'RUNNING' when passed 'Running'", "Running", "RUNNING"),
+			Entry("sets 'SUCCEEDED' when passed 'SUCCEEDED'", "SUCCEEDED", "SUCCEEDED"),
+			Entry("sets 'FAILED' when passed 'failed'", "failed", "FAILED"),
+			Entry("sets 'CANCELING' when passed 'canceling'", "canceling", "CANCELING"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := taskState.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `STATE must be "PENDING", "RUNNING", "SUCCEEDED", "FAILED" or "CANCELING"`,
+				}))
+				Expect(taskState.State).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Timestamp is a point in time given either as an RFC3339 timestamp or as a
+// duration (e.g. 90m, 2h) before now.
+type Timestamp struct {
+	Time time.Time
+}
+
+func (t *Timestamp) UnmarshalFlag(val string) error {
+	if timestamp, err := time.Parse(time.RFC3339, val); err == nil {
+		t.Time = timestamp
+		return nil
+	}
+
+	if duration, err := time.ParseDuration(val); err == nil && duration >= 0 {
+		t.Time = time.Now().Add(-duration)
+		return nil
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: `TIME must be an RFC3339 timestamp (e.g. 2017-06-01T15:04:05Z) or a duration (e.g. 30m, 2h)`,
+	}
+}
+
+// IsSet returns true if a time was provided.
+func (t Timestamp) IsSet() bool {
+	return !t.Time.IsZero()
+}
@@ -0,0 +1,47 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timestamp", func() {
+	var timestamp Timestamp
+
+	BeforeEach(func() {
+		timestamp = Timestamp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("parses RFC3339 timestamps", func() {
+			err := timestamp.UnmarshalFlag("2017-06-01T15:04:05Z")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(timestamp.Time).To(Equal(time.Date(2017, 6, 1, 15, 4, 5, 0, time.UTC)))
+			Expect(timestamp.IsSet()).To(BeTrue())
+		})
+
+		It("treats durations as the time that long ago", func() {
+			err := timestamp.UnmarshalFlag("90m")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(timestamp.Time).To(BeTemporally("~", time.Now().Add(-90*time.Minute), time.Second))
+		})
+
+		DescribeTable("returns an error for invalid times",
+			func(value string) {
+				err := timestamp.UnmarshalFlag(value)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `TIME must be an RFC3339 timestamp (e.g. 2017-06-01T15:04:05Z) or a duration (e.g. 30m, 2h)`,
+				}))
+				Expect(timestamp.IsSet()).To(BeFalse())
+			},
+			Entry("garbage", "yesterday"),
+			Entry("negative duration", "-5m"),
+		)
+	})
+})
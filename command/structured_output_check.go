@@ -0,0 +1,23 @@
+package command
+
+import "code.cloudfoundry.org/cli/util/configv3"
+
+// StructuredOutputCommander is implemented by commands that can display their
+// output as a JSON or YAML document when the global '--output' flag is set.
+type StructuredOutputCommander interface {
+	SupportsStructuredOutput() bool
+}
+
+// StructuredOutputCheck returns a StructuredOutputNotSupportedError when
+// structured output is requested for a command that cannot display it.
+func StructuredOutputCheck(cmd interface{}, commandName string, outputFormat configv3.OutputFormat) error {
+	if outputFormat == configv3.OutputDefault {
+		return nil
+	}
+
+	if structuredCmd, ok := cmd.(StructuredOutputCommander); ok && structuredCmd.SupportsStructuredOutput() {
+		return nil
+	}
+
+	return StructuredOutputNotSupportedError{Command: commandName}
+}
@@ -0,0 +1,36 @@
+package command_test
+
+import (
+	. "code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type structuredCommand struct{}
+
+func (structuredCommand) SupportsStructuredOutput() bool { return true }
+
+type textCommand struct{}
+
+var _ = Describe("Structured Output Check", func() {
+	Context("when no output format is requested", func() {
+		It("does not return an error", func() {
+			Expect(StructuredOutputCheck(textCommand{}, "create-space", configv3.OutputDefault)).To(Succeed())
+		})
+	})
+
+	Context("when the command supports structured output", func() {
+		It("does not return an error", func() {
+			Expect(StructuredOutputCheck(structuredCommand{}, "orgs", configv3.OutputJSON)).To(Succeed())
+		})
+	})
+
+	Context("when the command does not support structured output", func() {
+		It("returns a StructuredOutputNotSupportedError", func() {
+			err := StructuredOutputCheck(textCommand{}, "create-space", configv3.OutputYAML)
+			Expect(err).To(MatchError(StructuredOutputNotSupportedError{Command: "create-space"}))
+		})
+	})
+})
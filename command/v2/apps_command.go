@@ -1,22 +1,100 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . AppsActor
+
+type AppsActor interface {
+	GetApplicationRoutes(applicationGUID string, query []ccv2.Query) ([]v2action.Route, v2action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+}
+
+// appDocument is the structured output of a single app in the apps command.
+type appDocument struct {
+	Name           string   `json:"name"`
+	GUID           string   `json:"guid"`
+	RequestedState string   `json:"requested_state"`
+	Instances      int      `json:"instances"`
+	Memory         int      `json:"memory_in_mb"`
+	DiskQuota      int      `json:"disk_in_mb"`
+	URLs           []string `json:"urls"`
+}
+
 type AppsCommand struct {
 	usage           interface{} `usage:"CF_NAME apps"`
 	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppsActor
 }
 
-func (_ AppsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *AppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewStructuredOutputClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ AppsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
-	return nil
+func (cmd AppsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd AppsCommand) Execute(args []string) error {
+	if shared.RunLegacyCommand(cmd.UI, oldCmd.Main) {
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	apps, warnings, err := cmd.Actor.GetApplicationsBySpace(cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	documents := []appDocument{}
+	for _, app := range apps {
+		routes, warnings, err := cmd.Actor.GetApplicationRoutes(app.GUID, nil)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		urls := []string{}
+		for _, route := range routes {
+			urls = append(urls, route.String())
+		}
+
+		documents = append(documents, appDocument{
+			Name:           app.Name,
+			GUID:           app.GUID,
+			RequestedState: string(app.State),
+			Instances:      app.Instances,
+			Memory:         app.Memory,
+			DiskQuota:      app.DiskQuota,
+			URLs:           urls,
+		})
+	}
+
+	return cmd.UI.DisplayStructuredData(documents)
 }
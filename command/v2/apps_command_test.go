@@ -0,0 +1,130 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apps Command", func() {
+	var (
+		cmd             AppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		testUI.OutputFormat = configv3.OutputJSON
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppsActor)
+
+		cmd = AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when structured output is requested", func() {
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		Context("when the space has apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsBySpaceReturns([]v2action.Application{
+					{GUID: "app-guid-1", Name: "app-1", State: ccv2.ApplicationStarted, Instances: 2, Memory: 256, DiskQuota: 1024},
+					{GUID: "app-guid-2", Name: "app-2", State: ccv2.ApplicationStopped, Instances: 1, Memory: 128, DiskQuota: 512},
+				}, v2action.Warnings{"apps-warning"}, nil)
+
+				fakeActor.GetApplicationRoutesStub = func(appGUID string, _ []ccv2.Query) ([]v2action.Route, v2action.Warnings, error) {
+					if appGUID == "app-guid-1" {
+						return []v2action.Route{{Host: "app-1", Domain: "some-domain.com"}}, v2action.Warnings{"routes-warning"}, nil
+					}
+					return nil, nil, nil
+				}
+			})
+
+			It("displays the apps and their routes as a document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+					{
+						"name": "app-1",
+						"guid": "app-guid-1",
+						"requested_state": "STARTED",
+						"instances": 2,
+						"memory_in_mb": 256,
+						"disk_in_mb": 1024,
+						"urls": ["app-1.some-domain.com"]
+					},
+					{
+						"name": "app-2",
+						"guid": "app-guid-2",
+						"requested_state": "STOPPED",
+						"instances": 1,
+						"memory_in_mb": 128,
+						"disk_in_mb": 512,
+						"urls": []
+					}
+				]`))
+
+				Expect(testUI.Err).To(Say("apps-warning"))
+				Expect(testUI.Err).To(Say("routes-warning"))
+			})
+		})
+
+		Context("when the space has no apps", func() {
+			It("displays an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[]`))
+			})
+		})
+
+		Context("when getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsBySpaceReturns(nil, v2action.Warnings{"apps-warning"}, errors.New("apps-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("apps-error"))
+				Expect(testUI.Err).To(Say("apps-warning"))
+				Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+			})
+		})
+	})
+})
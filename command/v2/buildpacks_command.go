@@ -1,22 +1,86 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . BuildpacksActor
+
+type BuildpacksActor interface {
+	GetBuildpacks() ([]v2action.Buildpack, v2action.Warnings, error)
+}
+
+// buildpackDocument is the structured output of a single buildpack in the
+// buildpacks command.
+type buildpackDocument struct {
+	Name     string `json:"name"`
+	GUID     string `json:"guid"`
+	Position int    `json:"position"`
+	Enabled  bool   `json:"enabled"`
+	Locked   bool   `json:"locked"`
+	Filename string `json:"filename"`
+}
+
 type BuildpacksCommand struct {
 	usage           interface{} `usage:"CF_NAME buildpacks"`
 	relatedCommands interface{} `related_commands:"push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       BuildpacksActor
 }
 
-func (_ BuildpacksCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *BuildpacksCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewStructuredOutputClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ BuildpacksCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
-	return nil
+func (cmd BuildpacksCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd BuildpacksCommand) Execute(args []string) error {
+	if shared.RunLegacyCommand(cmd.UI, oldCmd.Main) {
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	buildpacks, warnings, err := cmd.Actor.GetBuildpacks()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	documents := []buildpackDocument{}
+	for _, buildpack := range buildpacks {
+		documents = append(documents, buildpackDocument{
+			Name:     buildpack.Name,
+			GUID:     buildpack.GUID,
+			Position: buildpack.Position,
+			Enabled:  buildpack.Enabled,
+			Locked:   buildpack.Locked,
+			Filename: buildpack.Filename,
+		})
+	}
+
+	return cmd.UI.DisplayStructuredData(documents)
 }
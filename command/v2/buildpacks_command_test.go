@@ -0,0 +1,105 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("buildpacks Command", func() {
+	var (
+		cmd             BuildpacksCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeBuildpacksActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		testUI.OutputFormat = configv3.OutputJSON
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeBuildpacksActor)
+
+		cmd = BuildpacksCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("supports structured output", func() {
+		Expect(cmd.SupportsStructuredOutput()).To(BeTrue())
+	})
+
+	Context("when structured output is requested", func() {
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeFalse())
+				Expect(checkTargetedSpace).To(BeFalse())
+			})
+		})
+
+		Context("when there are buildpacks", func() {
+			BeforeEach(func() {
+				fakeActor.GetBuildpacksReturns([]v2action.Buildpack{
+					{GUID: "buildpack-guid-1", Name: "ruby_buildpack", Position: 1, Enabled: true, Filename: "ruby_buildpack.zip"},
+					{GUID: "buildpack-guid-2", Name: "go_buildpack", Position: 2, Locked: true, Filename: "go_buildpack.zip"},
+				}, v2action.Warnings{"buildpacks-warning"}, nil)
+			})
+
+			It("displays the buildpacks as a document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+					{"name": "ruby_buildpack", "guid": "buildpack-guid-1", "position": 1, "enabled": true, "locked": false, "filename": "ruby_buildpack.zip"},
+					{"name": "go_buildpack", "guid": "buildpack-guid-2", "position": 2, "enabled": false, "locked": true, "filename": "go_buildpack.zip"}
+				]`))
+				Expect(testUI.Err).To(Say("buildpacks-warning"))
+			})
+		})
+
+		Context("when there are no buildpacks", func() {
+			It("displays an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[]`))
+			})
+		})
+
+		Context("when getting the buildpacks fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetBuildpacksReturns(nil, v2action.Warnings{"buildpacks-warning"}, errors.New("buildpacks-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("buildpacks-error"))
+				Expect(testUI.Err).To(Say("buildpacks-warning"))
+				Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,96 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+// contextDocument is the structured output of a single context.
+type contextDocument struct {
+	Name    string `json:"name"`
+	Current bool   `json:"current"`
+	API     string `json:"api"`
+	Org     string `json:"org"`
+	Space   string `json:"space"`
+}
+
+type ContextsCommand struct {
+	usage           interface{} `usage:"CF_NAME contexts"`
+	relatedCommands interface{} `related_commands:"save-context, use-context"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *ContextsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd ContextsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd ContextsCommand) Execute(args []string) error {
+	active := cmd.Config.ActiveContext()
+
+	documents := []contextDocument{}
+	for _, name := range cmd.Config.ContextNames() {
+		settings, err := cmd.Config.Context(name)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		documents = append(documents, contextDocument{
+			Name:    name,
+			Current: name == active,
+			API:     settings.Target,
+			Org:     settings.TargetedOrganization.Name,
+			Space:   settings.TargetedSpace.Name,
+		})
+	}
+
+	if cmd.UI.HasStructuredOutput() {
+		return cmd.UI.DisplayStructuredData(documents)
+	}
+
+	cmd.UI.DisplayText("Getting contexts...")
+	cmd.UI.DisplayNewline()
+
+	if len(documents) == 0 {
+		cmd.UI.DisplayText("No contexts found. Use '{{.Command}}' to save the current target as a context.", map[string]interface{}{
+			"Command": cmd.Config.BinaryName() + " save-context",
+		})
+		return nil
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+
+	for _, document := range documents {
+		current := ""
+		if document.Current {
+			current = "*"
+		}
+
+		table = append(table, []string{
+			current,
+			document.Name,
+			document.API,
+			document.Org,
+			document.Space,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("contexts Command", func() {
+	var (
+		cmd        ContextsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		cmd = ContextsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when there are no contexts", func() {
+		It("displays a tip to save a context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting contexts..."))
+			Expect(testUI.Out).To(Say("No contexts found. Use 'faceman save-context' to save the current target as a context."))
+		})
+	})
+
+	Context("when there are contexts", func() {
+		BeforeEach(func() {
+			fakeConfig.ActiveContextReturns("prod")
+			fakeConfig.ContextNamesReturns([]string{"dev", "prod"})
+			fakeConfig.ContextStub = func(name string) (configv3.ContextSettings, error) {
+				return configv3.ContextSettings{
+					Target:               "https://api." + name + ".com",
+					TargetedOrganization: configv3.Organization{Name: name + "-org"},
+					TargetedSpace:        configv3.Space{Name: name + "-space"},
+				}, nil
+			}
+		})
+
+		It("displays the contexts and marks the active one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting contexts..."))
+			Expect(testUI.Out).To(Say(`\s+name\s+api endpoint\s+org\s+space`))
+			Expect(testUI.Out).To(Say(`\s+dev\s+https://api.dev.com\s+dev-org\s+dev-space`))
+			Expect(testUI.Out).To(Say(`\*\s+prod\s+https://api.prod.com\s+prod-org\s+prod-space`))
+
+			Expect(fakeConfig.ContextCallCount()).To(Equal(2))
+			Expect(fakeConfig.ContextArgsForCall(0)).To(Equal("dev"))
+			Expect(fakeConfig.ContextArgsForCall(1)).To(Equal("prod"))
+		})
+
+		Context("when structured output is requested", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputJSON
+			})
+
+			It("displays the contexts as structured data", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting contexts..."))
+				Expect(testUI.Out).To(Say(`"name": "dev",\s+"current": false,\s+"api": "https://api.dev.com",\s+"org": "dev-org",\s+"space": "dev-space"`))
+				Expect(testUI.Out).To(Say(`"name": "prod",\s+"current": true`))
+			})
+		})
+	})
+})
@@ -0,0 +1,107 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+//go:generate counterfeiter . DiffSpacesActor
+
+type DiffSpacesActor interface {
+	GetSpaceConfigurationByOrganizationAndSpaceName(orgName string, spaceName string) (v2action.SpaceConfiguration, v2action.Warnings, error)
+}
+
+type DiffSpacesCommand struct {
+	RequiredArgs    flag.DiffSpacesArgs `positional-args:"yes"`
+	usage           interface{}         `usage:"CF_NAME diff-spaces SOURCE_ORG/SPACE TARGET_ORG/SPACE\n\nCompares the apps, routes, services, space quota and security groups of two spaces, and the instances, memory, routes, service bindings and environment variables of the apps in both. Environment variable values are never displayed. Exits with a non-zero status when the spaces differ.\n\nEXAMPLES:\n   CF_NAME diff-spaces my-org/staging my-org/production"`
+	relatedCommands interface{}         `related_commands:"apps, space, spaces"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DiffSpacesActor
+}
+
+func (cmd *DiffSpacesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, nil)
+
+	return nil
+}
+
+func (cmd DiffSpacesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	source := cmd.RequiredArgs.Source
+	target := cmd.RequiredArgs.Target
+
+	cmd.UI.DisplayTextWithFlavor("Comparing space {{.SourceSpace}} in org {{.SourceOrg}} with space {{.TargetSpace}} in org {{.TargetOrg}} as {{.CurrentUser}}...", map[string]interface{}{
+		"SourceSpace": source.Space,
+		"SourceOrg":   source.Org,
+		"TargetSpace": target.Space,
+		"TargetOrg":   target.Org,
+		"CurrentUser": user.Name,
+	})
+
+	sourceConfiguration, warnings, err := cmd.Actor.GetSpaceConfigurationByOrganizationAndSpaceName(source.Org, source.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	targetConfiguration, warnings, err := cmd.Actor.GetSpaceConfigurationByOrganizationAndSpaceName(target.Org, target.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+
+	differences := v2action.DiffSpaceConfigurations(sourceConfiguration, targetConfiguration)
+	if len(differences) == 0 {
+		cmd.UI.DisplayText("No differences found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("property"),
+			source.String(),
+			target.String(),
+		},
+	}
+	for _, difference := range differences {
+		table = append(table, []string{
+			difference.App,
+			cmd.UI.TranslateText(difference.Property),
+			difference.Source,
+			difference.Target,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	cmd.UI.DisplayNewline()
+
+	return shared.SpacesDifferError{
+		Source: source.String(),
+		Target: target.String(),
+	}
+}
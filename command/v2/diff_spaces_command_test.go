@@ -0,0 +1,150 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff-spaces Command", func() {
+	var (
+		cmd             DiffSpacesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeDiffSpacesActor
+		binaryName      string
+		executeErr      error
+
+		sourceConfiguration v2action.SpaceConfiguration
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeDiffSpacesActor)
+
+		cmd = DiffSpacesCommand{
+			RequiredArgs: flag.DiffSpacesArgs{
+				Source: flag.OrgSpacePath{Org: "some-org", Space: "staging"},
+				Target: flag.OrgSpacePath{Org: "other-org", Space: "production"},
+			},
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		sourceConfiguration = v2action.SpaceConfiguration{
+			SpaceSummary: v2action.SpaceSummary{
+				AppNames: []string{"some-app"},
+			},
+			Applications: []v2action.ApplicationConfiguration{
+				{
+					Name:                 "some-app",
+					Instances:            2,
+					EnvironmentVariables: map[string]string{"SECRET": "some-secret"},
+				},
+			},
+		}
+		fakeActor.GetSpaceConfigurationByOrganizationAndSpaceNameReturns(sourceConfiguration, v2action.Warnings{"get-space-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns a wrapped error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when a space cannot be found", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceConfigurationByOrganizationAndSpaceNameReturnsOnCall(1,
+				v2action.SpaceConfiguration{},
+				v2action.Warnings{"get-space-warning"},
+				v2action.SpaceNotFoundError{Name: "production"})
+		})
+
+		It("returns a translatable error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(shared.SpaceNotFoundError{Name: "production"}))
+			Expect(testUI.Err).To(Say("get-space-warning"))
+		})
+	})
+
+	Context("when the spaces are the same", func() {
+		It("displays that there are no differences", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Comparing space staging in org some-org with space production in org other-org as some-user..."))
+			Expect(testUI.Out).To(Say("No differences found."))
+			Expect(testUI.Err).To(Say("get-space-warning"))
+
+			Expect(fakeActor.GetSpaceConfigurationByOrganizationAndSpaceNameCallCount()).To(Equal(2))
+			orgName, spaceName := fakeActor.GetSpaceConfigurationByOrganizationAndSpaceNameArgsForCall(0)
+			Expect(orgName).To(Equal("some-org"))
+			Expect(spaceName).To(Equal("staging"))
+			orgName, spaceName = fakeActor.GetSpaceConfigurationByOrganizationAndSpaceNameArgsForCall(1)
+			Expect(orgName).To(Equal("other-org"))
+			Expect(spaceName).To(Equal("production"))
+		})
+	})
+
+	Context("when the spaces differ", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceConfigurationByOrganizationAndSpaceNameReturnsOnCall(1,
+				v2action.SpaceConfiguration{
+					SpaceSummary: v2action.SpaceSummary{
+						AppNames: []string{"some-app"},
+					},
+					Applications: []v2action.ApplicationConfiguration{
+						{
+							Name:                 "some-app",
+							Instances:            4,
+							EnvironmentVariables: map[string]string{"SECRET": "other-secret"},
+						},
+					},
+				},
+				nil,
+				nil)
+		})
+
+		It("displays the differences without environment variable values and returns a SpacesDifferError", func() {
+			Expect(executeErr).To(MatchError(shared.SpacesDifferError{
+				Source: "some-org/staging",
+				Target: "other-org/production",
+			}))
+
+			Expect(testUI.Out).To(Say(`app\s+property\s+some-org/staging\s+other-org/production`))
+			Expect(testUI.Out).To(Say(`some-app\s+instances\s+2\s+4`))
+			Expect(testUI.Out).To(Say(`some-app\s+env SECRET\s+\[hidden\]\s+\[hidden\]`))
+			Expect(testUI.Out).ToNot(Say("secret"))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package v2
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+//go:generate counterfeiter . ExportSpaceManifestActor
+
+type ExportSpaceManifestActor interface {
+	GetSpaceManifestApplications(spaceGUID string) ([]manifest.Application, v2action.Warnings, error)
+}
+
+type ExportSpaceManifestCommand struct {
+	FilePath        flag.Path   `short:"p" description:"Specify a path for file creation. If path not specified, manifest file is created in current working directory."`
+	usage           interface{} `usage:"CF_NAME export-space-manifest [-p /path/to/<space-name>_manifest.yml]\n\nCreates one manifest for every app in the targeted space, with their routes, services, environment variables, health checks, buildpacks and stacks, so that the space can be recreated with push. The manifest holds the values of environment variables and is only readable by the current user.\n\nEXAMPLES:\n   CF_NAME export-space-manifest -p backup/production_manifest.yml"`
+	relatedCommands interface{} `related_commands:"apps, create-app-manifest, push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportSpaceManifestActor
+}
+
+func (cmd *ExportSpaceManifestCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, _, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, nil)
+
+	return nil
+}
+
+func (cmd ExportSpaceManifestCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	cmd.UI.DisplayTextWithFlavor("Creating a manifest for space {{.SpaceName}} in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"SpaceName":   space.Name,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"CurrentUser": user.Name,
+	})
+
+	apps, warnings, err := cmd.Actor.GetSpaceManifestApplications(space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(apps) == 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("No apps found.")
+		return nil
+	}
+
+	filePath := string(cmd.FilePath)
+	if filePath == "" {
+		filePath = fmt.Sprintf("%s_manifest.yml", space.Name)
+	}
+
+	err = manifest.WriteApplicationManifest(filePath, apps)
+	if err != nil {
+		return shared.ManifestFileCreationError{Path: filePath, Message: err.Error()}
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Manifest file created successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": filePath,
+	})
+
+	return nil
+}
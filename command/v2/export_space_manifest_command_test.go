@@ -0,0 +1,152 @@
+package v2_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-space-manifest Command", func() {
+	var (
+		cmd             ExportSpaceManifestCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeExportSpaceManifestActor
+		binaryName      string
+		executeErr      error
+
+		tmpDir       string
+		manifestPath string
+		apps         []manifest.Application
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeExportSpaceManifestActor)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "export-space-manifest-test")
+		Expect(err).ToNot(HaveOccurred())
+		manifestPath = filepath.Join(tmpDir, "manifest.yml")
+
+		cmd = ExportSpaceManifestCommand{
+			FilePath:    flag.Path(manifestPath),
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		instances := 2
+		apps = []manifest.Application{
+			{
+				Name:                 "web",
+				Instances:            &instances,
+				Routes:               []string{"web.example.com"},
+				Services:             []string{"some-db"},
+				EnvironmentVariables: map[string]string{"SOME_VAR": "some-value"},
+			},
+			{
+				Name:    "worker",
+				NoRoute: true,
+			},
+		}
+		fakeActor.GetSpaceManifestApplicationsReturns(apps, v2action.Warnings{"get-apps-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NoTargetedSpaceError{BinaryName: binaryName})
+		})
+
+		It("returns a wrapped error", func() {
+			Expect(executeErr).To(MatchError(command.NoTargetedSpaceError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("writes a manifest of every app in the space", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Creating a manifest for space some-space in org some-org as some-user..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("Manifest file created successfully at %s", manifestPath))
+		Expect(testUI.Err).To(Say("get-apps-warning"))
+
+		Expect(fakeActor.GetSpaceManifestApplicationsCallCount()).To(Equal(1))
+		Expect(fakeActor.GetSpaceManifestApplicationsArgsForCall(0)).To(Equal("some-space-guid"))
+
+		writtenApps, err := manifest.ReadAndMergeManifests(manifestPath, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writtenApps).To(Equal(apps))
+	})
+
+	Context("when the space has no apps", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceManifestApplicationsReturns(nil, nil, nil)
+		})
+
+		It("does not write a manifest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No apps found."))
+			Expect(manifestPath).ToNot(BeAnExistingFile())
+		})
+	})
+
+	Context("when getting the apps fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSpaceManifestApplicationsReturns(nil, v2action.Warnings{"get-apps-warning"}, v2action.StackNotFoundError{GUID: "stack-guid"})
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError(v2action.StackNotFoundError{GUID: "stack-guid"}))
+			Expect(testUI.Err).To(Say("get-apps-warning"))
+		})
+	})
+
+	Context("when the manifest cannot be written", func() {
+		BeforeEach(func() {
+			cmd.FilePath = flag.Path(filepath.Join(tmpDir, "missing-dir", "manifest.yml"))
+		})
+
+		It("returns a ManifestFileCreationError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(shared.ManifestFileCreationError{}))
+			Expect(executeErr.(shared.ManifestFileCreationError).Path).To(Equal(string(cmd.FilePath)))
+		})
+	})
+})
@@ -1,6 +1,9 @@
 package v2
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/cloudfoundry/noaa/consumer"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -13,15 +16,26 @@ import (
 //go:generate counterfeiter . LogsActor
 
 type LogsActor interface {
-	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
-	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
+	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetStreamingLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetStreamingLogsForSpace(spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
 }
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	OptionalArgs    flag.AppNames        `positional-args:"yes"`
+	AllAppsInSpace  bool                 `long:"all-apps-in-space" description:"Stream logs from all apps in the targeted space"`
+	Recent          bool                 `long:"recent" description:"Dump recent logs instead of tailing"`
+	Sources         []flag.LogSourceType `long:"source" description:"Only show logs from this source type (API, APP, CELL, LGR, RTR, SSH, STG); may be repeated"`
+	ExcludeSources  []flag.LogSourceType `long:"exclude-source" description:"Hide logs from this source type; may be repeated"`
+	Instance        flag.InstanceIndex   `long:"instance" description:"Only show logs from the app instance with this index"`
+	Match           flag.Regexp          `long:"match" description:"Only show log messages matching this regular expression"`
+	ExcludeMatch    flag.Regexp          `long:"exclude-match" description:"Hide log messages matching this regular expression"`
+	Format          flag.LogFormat       `long:"format" description:"Display log messages as 'text' (default) or as newline-delimited 'json'"`
+	Since           flag.Timestamp       `long:"since" description:"Only show recent log messages after this time, as an RFC3339 timestamp or a duration ago (e.g. 30m)"`
+	Until           flag.Timestamp       `long:"until" description:"Only show recent log messages before this time, as an RFC3339 timestamp or a duration ago (e.g. 30m)"`
+	usage           interface{}          `usage:"CF_NAME logs APP_NAME... [--recent [--since TIME] [--until TIME]] [--format FORMAT] [--source SOURCE]... [--exclude-source SOURCE]... [--instance INDEX] [--match REGEX] [--exclude-match REGEX]\n   CF_NAME logs --all-apps-in-space [--format FORMAT] [--source SOURCE]... [--exclude-source SOURCE]... [--instance INDEX] [--match REGEX] [--exclude-match REGEX]\n\nEXAMPLES:\n   CF_NAME logs my-app --source APP --instance 0\n   CF_NAME logs my-app --recent --exclude-source RTR --match \"(?i)error\"\n   CF_NAME logs my-app --recent --since 2h --format json\n   CF_NAME logs frontend backend --source APP"`
+	relatedCommands interface{}          `related_commands:"app, apps, ssh"`
 
 	UI          command.UI
 	Config      command.Config
@@ -47,6 +61,27 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
+	appNames := cmd.OptionalArgs.AppNames
+	switch {
+	case cmd.AllAppsInSpace && len(appNames) > 0:
+		return command.ArgumentCombinationError{Arg1: "APP_NAME", Arg2: "--all-apps-in-space"}
+	case !cmd.AllAppsInSpace && len(appNames) == 0:
+		return command.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case cmd.Recent && cmd.AllAppsInSpace:
+		return command.ArgumentCombinationError{Arg1: "--recent", Arg2: "--all-apps-in-space"}
+	case cmd.Recent && len(appNames) > 1:
+		return command.ArgumentCombinationError{Arg1: "--recent", Arg2: "multiple APP_NAME arguments"}
+	}
+
+	if !cmd.Recent {
+		if cmd.Since.IsSet() {
+			return command.FlagRequiresFlagError{Flag: "--since", RequiredFlag: "--recent"}
+		}
+		if cmd.Until.IsSet() {
+			return command.FlagRequiresFlagError{Flag: "--until", RequiredFlag: "--recent"}
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -57,14 +92,9 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	if !cmd.jsonFormat() {
+		cmd.displayFlavorText(user.Name)
+	}
 
 	if cmd.Recent {
 		return cmd.displayRecentLogs()
@@ -73,16 +103,40 @@ func (cmd LogsCommand) Execute(args []string) error {
 	return cmd.streamLogs()
 }
 
+func (cmd LogsCommand) displayFlavorText(username string) {
+	values := map[string]interface{}{
+		"AppNames":  strings.Join(cmd.OptionalArgs.AppNames, ", "),
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	}
+
+	switch {
+	case cmd.AllAppsInSpace:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", values)
+	case len(cmd.OptionalArgs.AppNames) > 1:
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", values)
+	default:
+		values["AppName"] = cmd.OptionalArgs.AppNames[0]
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", values)
+	}
+	cmd.UI.DisplayNewline()
+}
+
 func (cmd LogsCommand) displayRecentLogs() error {
 	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
+		cmd.OptionalArgs.AppNames[0],
 		cmd.Config.TargetedSpace().GUID,
+		cmd.logFilter(),
 		cmd.NOAAClient,
 		cmd.Config,
 	)
 
 	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		displayErr := cmd.displayLogMessage(message)
+		if displayErr != nil {
+			return displayErr
+		}
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -90,13 +144,23 @@ func (cmd LogsCommand) displayRecentLogs() error {
 }
 
 func (cmd LogsCommand) streamLogs() error {
-	messages, logErrs, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.NOAAClient,
-		cmd.Config,
+	var (
+		messages <-chan *v2action.LogMessage
+		logErrs  <-chan error
+		warnings v2action.Warnings
+		err      error
 	)
 
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	switch {
+	case cmd.AllAppsInSpace:
+		messages, logErrs, warnings, err = cmd.Actor.GetStreamingLogsForSpace(spaceGUID, cmd.logFilter(), cmd.NOAAClient, cmd.Config)
+	case len(cmd.OptionalArgs.AppNames) > 1:
+		messages, logErrs, warnings, err = cmd.Actor.GetStreamingLogsForApplicationsByNameAndSpace(cmd.OptionalArgs.AppNames, spaceGUID, cmd.logFilter(), cmd.NOAAClient, cmd.Config)
+	default:
+		messages, logErrs, warnings, err = cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(cmd.OptionalArgs.AppNames[0], spaceGUID, cmd.logFilter(), cmd.NOAAClient, cmd.Config)
+	}
+
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -111,7 +175,11 @@ func (cmd LogsCommand) streamLogs() error {
 				break
 			}
 
-			cmd.UI.DisplayLogMessage(message, true)
+			err = cmd.displayLogMessage(*message)
+			if err != nil {
+				cmd.NOAAClient.Close()
+				return err
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
@@ -129,3 +197,42 @@ func (cmd LogsCommand) streamLogs() error {
 
 	return nil
 }
+
+func (cmd LogsCommand) logFilter() v2action.LogFilter {
+	filter := v2action.LogFilter{
+		Match:        cmd.Match.Regexp,
+		ExcludeMatch: cmd.ExcludeMatch.Regexp,
+	}
+
+	for _, source := range cmd.Sources {
+		filter.SourceTypes = append(filter.SourceTypes, source.SourceType)
+	}
+
+	for _, source := range cmd.ExcludeSources {
+		filter.ExcludeSourceTypes = append(filter.ExcludeSourceTypes, source.SourceType)
+	}
+
+	if cmd.Instance.IsSet {
+		filter.SourceInstance = strconv.Itoa(cmd.Instance.Index)
+	}
+
+	if cmd.Recent {
+		filter.Since = cmd.Since.Time
+		filter.Until = cmd.Until.Time
+	}
+
+	return filter
+}
+
+func (cmd LogsCommand) jsonFormat() bool {
+	return cmd.Format.Format == "json"
+}
+
+func (cmd LogsCommand) displayLogMessage(message v2action.LogMessage) error {
+	if cmd.jsonFormat() {
+		return cmd.UI.DisplayJSONLogMessage(message)
+	}
+
+	cmd.UI.DisplayLogMessage(message, true)
+	return nil
+}
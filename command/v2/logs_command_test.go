@@ -2,12 +2,14 @@ package v2_test
 
 import (
 	"errors"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -47,7 +49,7 @@ var _ = Describe("logs command", func() {
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
-		cmd.RequiredArgs.AppName = "some-app"
+		cmd.OptionalArgs.AppNames = []string{"some-app"}
 		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 	})
 
@@ -55,6 +57,50 @@ var _ = Describe("logs command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when no app name is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppNames = nil
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when app names and --all-apps-in-space are provided", func() {
+		BeforeEach(func() {
+			cmd.AllAppsInSpace = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "APP_NAME", Arg2: "--all-apps-in-space"}))
+		})
+	})
+
+	Context("when --recent and --all-apps-in-space are provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppNames = nil
+			cmd.AllAppsInSpace = true
+			cmd.Recent = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--recent", Arg2: "--all-apps-in-space"}))
+		})
+	})
+
+	Context("when --recent and multiple app names are provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppNames = []string{"app-1", "app-2"}
+			cmd.Recent = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--recent", Arg2: "multiple APP_NAME arguments"}))
+		})
+	})
+
 	Context("when the checkTarget fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(
@@ -139,14 +185,78 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("i am message 2"))
 
 					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client, config := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					appName, spaceGUID, filter, client, config := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(filter).To(Equal(v2action.LogFilter{}))
 					Expect(client).To(Equal(noaaClient))
 					Expect(config).To(Equal(fakeConfig))
 				})
 			})
+
+			Context("when --format json is provided", func() {
+				BeforeEach(func() {
+					cmd.Format = flag.LogFormat{Format: "json"}
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+						[]v2action.LogMessage{
+							*v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "1"),
+						},
+						v2action.Warnings{"some-warning-1"},
+						nil)
+				})
+
+				It("displays the log messages as JSON lines without flavor text", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("Retrieving logs"))
+					Expect(testUI.Out).To(Say(`\{"timestamp":"[^"]+","source_type":"APP/PROC/WEB","source_instance":"1","stream":"OUT","message":"i am message 1"\}`))
+					Expect(testUI.Err).To(Say("some-warning-1"))
+				})
+			})
+
+			Context("when --since and --until are provided", func() {
+				var since, until time.Time
+
+				BeforeEach(func() {
+					since = time.Unix(100, 0)
+					until = time.Unix(200, 0)
+					cmd.Since = flag.Timestamp{Time: since}
+					cmd.Until = flag.Timestamp{Time: until}
+				})
+
+				It("passes the time window to the actor", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					_, _, filter, _, _ := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(filter.Since).To(Equal(since))
+					Expect(filter.Until).To(Equal(until))
+				})
+			})
+
+			Context("when filter flags are provided", func() {
+				BeforeEach(func() {
+					cmd.Sources = []flag.LogSourceType{{SourceType: "APP"}, {SourceType: "STG"}}
+					cmd.ExcludeSources = []flag.LogSourceType{{SourceType: "RTR"}}
+					cmd.Instance = flag.InstanceIndex{Index: 0, IsSet: true}
+					cmd.Match = flag.Regexp{Regexp: regexp.MustCompile("error")}
+					cmd.ExcludeMatch = flag.Regexp{Regexp: regexp.MustCompile("health")}
+				})
+
+				It("passes the filter to the actor", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					_, _, filter, _, _ := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(filter).To(Equal(v2action.LogFilter{
+						SourceTypes:        []string{"APP", "STG"},
+						ExcludeSourceTypes: []string{"RTR"},
+						SourceInstance:     "0",
+						Match:              regexp.MustCompile("error"),
+						ExcludeMatch:       regexp.MustCompile("health"),
+					}))
+				})
+			})
 		})
 
 		Context("when the --recent flag is not provided", func() {
@@ -175,7 +285,7 @@ var _ = Describe("logs command", func() {
 				BeforeEach(func() {
 					expectedErr = errors.New("some-error")
 
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.LogFilter, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
 						messages := make(chan *v2action.LogMessage)
 						logErrs := make(chan error)
 
@@ -198,7 +308,7 @@ var _ = Describe("logs command", func() {
 
 			Context("when the logs actor returns logs", func() {
 				BeforeEach(func() {
-					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.LogFilter, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
 						messages := make(chan *v2action.LogMessage)
 						logErrs := make(chan error)
 						message1 := v2action.NewLogMessage(
@@ -240,14 +350,158 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("i am message 2"))
 
 					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client, config := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+					appName, spaceGUID, filter, client, config := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(filter).To(Equal(v2action.LogFilter{}))
 					Expect(client).To(Equal(noaaClient))
 					Expect(config).To(Equal(fakeConfig))
 				})
 			})
+
+			Context("when --since is provided", func() {
+				BeforeEach(func() {
+					cmd.Since = flag.Timestamp{Time: time.Unix(100, 0)}
+				})
+
+				It("returns a FlagRequiresFlagError", func() {
+					Expect(executeErr).To(MatchError(command.FlagRequiresFlagError{Flag: "--since", RequiredFlag: "--recent"}))
+					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when --until is provided", func() {
+				BeforeEach(func() {
+					cmd.Until = flag.Timestamp{Time: time.Unix(100, 0)}
+				})
+
+				It("returns a FlagRequiresFlagError", func() {
+					Expect(executeErr).To(MatchError(command.FlagRequiresFlagError{Flag: "--until", RequiredFlag: "--recent"}))
+				})
+			})
+
+			Context("when --format json is provided", func() {
+				BeforeEach(func() {
+					cmd.Format = flag.LogFormat{Format: "json"}
+
+					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.LogFilter, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+
+						go func() {
+							messages <- v2action.NewLogMessage("i am message 1", 2, time.Unix(0, 0), "RTR", "0")
+							close(messages)
+							close(logErrs)
+						}()
+
+						return messages, logErrs, nil, nil
+					}
+				})
+
+				It("streams the log messages as JSON lines", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("Retrieving logs"))
+					Expect(testUI.Out).To(Say(`\{"timestamp":"[^"]+","source_type":"RTR","source_instance":"0","stream":"ERR","message":"i am message 1"\}`))
+				})
+			})
+
+			Context("when filter flags are provided", func() {
+				BeforeEach(func() {
+					cmd.Sources = []flag.LogSourceType{{SourceType: "APP"}}
+					cmd.Instance = flag.InstanceIndex{Index: 2, IsSet: true}
+
+					fakeActor.GetStreamingLogsForApplicationByNameAndSpaceStub = func(_ string, _ string, _ v2action.LogFilter, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+						close(messages)
+						close(logErrs)
+						return messages, logErrs, nil, nil
+					}
+				})
+
+				It("passes the filter to the actor", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					_, _, filter, _, _ := fakeActor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(filter).To(Equal(v2action.LogFilter{
+						SourceTypes:    []string{"APP"},
+						SourceInstance: "2",
+					}))
+				})
+			})
+		})
+
+		Context("when multiple app names are provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.AppNames = []string{"app-1", "app-2"}
+
+				fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceStub = func(_ []string, _ string, _ v2action.LogFilter, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+					messages := make(chan *v2action.LogMessage)
+					logErrs := make(chan error)
+
+					go func() {
+						messages <- v2action.NewAppLogMessage("app-1", "i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "0")
+						messages <- v2action.NewAppLogMessage("app-2", "i am message 2", 1, time.Unix(1, 0), "APP/PROC/WEB", "0")
+						close(messages)
+						close(logErrs)
+					}()
+
+					return messages, logErrs, v2action.Warnings{"some-warning"}, nil
+				}
+			})
+
+			It("streams the merged logs of all the apps prefixed with the app name", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Retrieving logs for apps app-1, app-2 in org some-org-name / space some-space-name as some-user..."))
+				Expect(testUI.Out).To(Say(`app-1 \| .*i am message 1`))
+				Expect(testUI.Out).To(Say(`app-2 \| .*i am message 2`))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceCallCount()).To(Equal(1))
+				appNames, spaceGUID, _, client, config := fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceArgsForCall(0)
+				Expect(appNames).To(Equal([]string{"app-1", "app-2"}))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(client).To(Equal(noaaClient))
+				Expect(config).To(Equal(fakeConfig))
+
+				Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --all-apps-in-space is provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.AppNames = nil
+				cmd.AllAppsInSpace = true
+				cmd.Sources = []flag.LogSourceType{{SourceType: "APP"}}
+
+				fakeActor.GetStreamingLogsForSpaceStub = func(_ string, _ v2action.LogFilter, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+					messages := make(chan *v2action.LogMessage)
+					logErrs := make(chan error)
+
+					go func() {
+						messages <- v2action.NewAppLogMessage("app-1", "i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "0")
+						close(messages)
+						close(logErrs)
+					}()
+
+					return messages, logErrs, nil, nil
+				}
+			})
+
+			It("streams the logs of all apps in the space", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Retrieving logs for all apps in org some-org-name / space some-space-name as some-user..."))
+				Expect(testUI.Out).To(Say(`app-1 \| .*i am message 1`))
+
+				Expect(fakeActor.GetStreamingLogsForSpaceCallCount()).To(Equal(1))
+				spaceGUID, filter, _, _ := fakeActor.GetStreamingLogsForSpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(filter.SourceTypes).To(Equal([]string{"APP"}))
+			})
 		})
 	})
 })
@@ -27,6 +27,12 @@ type OrgActorV3 interface {
 	CloudControllerAPIVersion() string
 }
 
+// orgDocument is the structured output of the org command.
+type orgDocument struct {
+	v2action.OrganizationSummary
+	IsolationSegmentNames []string `json:"isolation_segments,omitempty"`
+}
+
 type OrgCommand struct {
 	RequiredArgs    flag.Organization `positional-args:"yes"`
 	GUID            bool              `long:"guid" description:"Retrieve and display the given org's guid.  All other output for the org is suppressed."`
@@ -64,6 +70,10 @@ func (cmd *OrgCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd OrgCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd OrgCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
 	if err != nil {
@@ -95,13 +105,15 @@ func (cmd OrgCommand) displayOrgSummary() error {
 		return shared.HandleError(err)
 	}
 
-	cmd.UI.DisplayTextWithFlavor(
-		"Getting info for org {{.OrgName}} as {{.Username}}...",
-		map[string]interface{}{
-			"OrgName":  cmd.RequiredArgs.Organization,
-			"Username": user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.HasStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor(
+			"Getting info for org {{.OrgName}} as {{.Username}}...",
+			map[string]interface{}{
+				"OrgName":  cmd.RequiredArgs.Organization,
+				"Username": user.Name,
+			})
+		cmd.UI.DisplayNewline()
+	}
 
 	orgSummary, warnings, err := cmd.Actor.GetOrganizationSummaryByName(cmd.RequiredArgs.Organization)
 	cmd.UI.DisplayWarnings(warnings)
@@ -116,6 +128,7 @@ func (cmd OrgCommand) displayOrgSummary() error {
 		{cmd.UI.TranslateText("spaces:"), strings.Join(orgSummary.SpaceNames, ", ")},
 	}
 
+	var isolationSegmentNames []string
 	if cmd.ActorV3 != nil {
 		apiCheck := command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), "3.11.0")
 		if apiCheck == nil {
@@ -125,7 +138,7 @@ func (cmd OrgCommand) displayOrgSummary() error {
 				return shared.HandleError(err)
 			}
 
-			isolationSegmentNames := []string{}
+			isolationSegmentNames = []string{}
 			for _, iso := range isolationSegments {
 				isolationSegmentNames = append(isolationSegmentNames, iso.Name)
 			}
@@ -135,6 +148,13 @@ func (cmd OrgCommand) displayOrgSummary() error {
 		}
 	}
 
+	if cmd.UI.HasStructuredOutput() {
+		return cmd.UI.DisplayStructuredData(orgDocument{
+			OrganizationSummary:   orgSummary,
+			IsolationSegmentNames: isolationSegmentNames,
+		})
+	}
+
 	cmd.UI.DisplayKeyValueTable("", table, 3)
 
 	return nil
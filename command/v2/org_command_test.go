@@ -223,6 +223,28 @@ var _ = Describe("org Command", func() {
 					orgGuid := fakeActorV3.GetIsolationSegmentsByOrganizationArgsForCall(0)
 					Expect(orgGuid).To(Equal("some-org-guid"))
 				})
+
+				Context("when structured output is requested", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputJSON
+					})
+
+					It("displays the org summary and isolation segments as a document", func() {
+						Expect(executeErr).To(BeNil())
+
+						Expect(testUI.Out).ToNot(Say("Getting info for org"))
+						Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+							"name": "some-org",
+							"guid": "some-org-guid",
+							"quota": "some-quota",
+							"domains": ["a-shared.com", "b-private.com", "c-shared.com", "d-private.com"],
+							"spaces": ["space1", "space2"],
+							"isolation_segments": ["isolation-segment-1", "isolation-segment-2"]
+						}`))
+						Expect(testUI.Err).To(Say("warning-1"))
+						Expect(testUI.Err).To(Say("warning-4"))
+					})
+				})
 			})
 
 			Context("when api version is below 3.11.0", func() {
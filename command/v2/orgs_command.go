@@ -1,21 +1,77 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . OrgsActor
+
+type OrgsActor interface {
+	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
+}
+
+// orgListDocument is the structured output of a single org in the orgs
+// command.
+type orgListDocument struct {
+	Name string `json:"name"`
+	GUID string `json:"guid"`
+}
+
 type OrgsCommand struct {
 	usage interface{} `usage:"CF_NAME orgs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       OrgsActor
 }
 
-func (_ OrgsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *OrgsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewStructuredOutputClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ OrgsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
-	return nil
+func (cmd OrgsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd OrgsCommand) Execute(args []string) error {
+	if shared.RunLegacyCommand(cmd.UI, oldCmd.Main) {
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	orgs, warnings, err := cmd.Actor.GetOrganizations()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	documents := []orgListDocument{}
+	for _, org := range orgs {
+		documents = append(documents, orgListDocument{
+			Name: org.Name,
+			GUID: org.GUID,
+		})
+	}
+
+	return cmd.UI.DisplayStructuredData(documents)
 }
@@ -0,0 +1,105 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("orgs Command", func() {
+	var (
+		cmd             OrgsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeOrgsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		testUI.OutputFormat = configv3.OutputJSON
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeOrgsActor)
+
+		cmd = OrgsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("supports structured output", func() {
+		Expect(cmd.SupportsStructuredOutput()).To(BeTrue())
+	})
+
+	Context("when structured output is requested", func() {
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeFalse())
+				Expect(checkTargetedSpace).To(BeFalse())
+			})
+		})
+
+		Context("when there are orgs", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsReturns([]v2action.Organization{
+					{GUID: "org-guid-1", Name: "org-1"},
+					{GUID: "org-guid-2", Name: "org-2"},
+				}, v2action.Warnings{"orgs-warning"}, nil)
+			})
+
+			It("displays the orgs as a document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+					{"name": "org-1", "guid": "org-guid-1"},
+					{"name": "org-2", "guid": "org-guid-2"}
+				]`))
+				Expect(testUI.Err).To(Say("orgs-warning"))
+			})
+		})
+
+		Context("when there are no orgs", func() {
+			It("displays an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[]`))
+			})
+		})
+
+		Context("when getting the orgs fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsReturns(nil, v2action.Warnings{"orgs-warning"}, errors.New("orgs-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("orgs-error"))
+				Expect(testUI.Err).To(Say("orgs-warning"))
+				Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+			})
+		})
+	})
+})
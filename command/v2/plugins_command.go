@@ -9,8 +9,10 @@ import (
 
 type PluginsCommand struct {
 	Checksum        bool        `long:"checksum" description:"Compute and show the sha1 value of the plugin binary file"`
-	usage           interface{} `usage:"CF_NAME plugins [--checksum]"`
-	relatedCommands interface{} `related_commands:"install-plugin, repo-plugins, uninstall-plugin"`
+	Outdated        bool        `long:"outdated" description:"Search the plugin repositories for new versions of installed plugins"`
+	Export          string      `long:"export" description:"Write the name, version, source repository and checksums of installed plugins to a lockfile"`
+	usage           interface{} `usage:"CF_NAME plugins [--checksum | --outdated | --export LOCKFILE]\n\nEXAMPLES:\n   CF_NAME plugins --export plugins.lock"`
+	relatedCommands interface{} `related_commands:"install-plugin, repo-plugins, uninstall-plugin, update-plugin"`
 }
 
 func (_ PluginsCommand) Setup(config command.Config, ui command.UI) error {
@@ -3,43 +3,424 @@ package v2
 import (
 	"os"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"github.com/cloudfoundry/noaa/consumer"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
 )
 
+// minVersionAppPorts is the first CF API version that accepts the ports an
+// application listens on.
+const minVersionAppPorts = "2.51.0"
+
+//go:generate counterfeiter . PushActor
+
+type PushActor interface {
+	BindServices(config v2action.ApplicationConfig) (v2action.ApplicationConfig, v2action.Warnings, error)
+	CloudControllerAPIVersion() string
+	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, settings v2action.PushSettings, apps []manifest.Application) ([]v2action.ApplicationConfig, v2action.Warnings, error)
+	CreateOrUpdateApplication(config v2action.ApplicationConfig) (v2action.ApplicationConfig, v2action.Warnings, error)
+	GetApplicationSummaryByNameAndSpace(name string, spaceGUID string) (v2action.ApplicationSummary, v2action.Warnings, error)
+	MapRoutes(config v2action.ApplicationConfig) (v2action.ApplicationConfig, v2action.Warnings, error)
+	RollbackBlueGreenApplications(oldConfig v2action.ApplicationConfig, newConfig v2action.ApplicationConfig, stage v2action.BlueGreenStage) (v2action.Warnings, error)
+	StartApplication(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+	StartApplicationAndWaitForAllInstances(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+	SwapBlueGreenApplications(oldConfig v2action.ApplicationConfig, newConfig v2action.ApplicationConfig, keepOldApp bool) (v2action.BlueGreenStage, v2action.Warnings, error)
+	UploadApplication(appGUID string, path string) (v2action.Warnings, error)
+}
+
 type PushCommand struct {
-	AppPorts             string                      `long:"app-ports" description:"Comma delimited list of ports the application may listen on" hidden:"true"` //TODO: Custom AppPorts flag
-	BuildpackName        string                      `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
-	StartupCommand       string                      `short:"c" description:"Startup command, set to null to reset to default start command"`
-	Domain               string                      `short:"d" description:"Domain (e.g. example.com)"`
-	DockerImage          string                      `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
-	PathToManifest       flag.PathWithExistenceCheck `short:"f" description:"Path to manifest"`
-	HealthCheckType      flag.HealthCheckType        `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
-	Hostname             string                      `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
-	NumInstances         int                         `short:"i" description:"Number of instances"`
-	DiskLimit            string                      `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	MemoryLimit          string                      `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	NoHostname           bool                        `long:"no-hostname" description:"Map the root domain to this app"`
-	NoManifest           bool                        `long:"no-manifest" description:"Ignore manifest file"`
-	NoRoute              bool                        `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
-	NoStart              bool                        `long:"no-start" description:"Do not start an app after pushing"`
-	DirectoryPath        flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	RandomRoute          bool                        `long:"random-route" description:"Create a random route for this app"`
-	RoutePath            string                      `long:"route-path" description:"Path for the route"`
-	Stack                string                      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
-	ApplicationStartTime int                         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
-	usage                interface{}                 `usage:"Push a single app (with or without a manifest):\n   CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-d DOMAIN] [-f MANIFEST_PATH] [--docker-image DOCKER_IMAGE]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [--hostname HOST] [-p PATH] [-s STACK] [-t TIMEOUT] [-u (process | port | http)] [--route-path ROUTE_PATH]\n   [--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route]\n\n   Push multiple apps with a manifest:\n   cf push [-f MANIFEST_PATH]"`
-	envCFStagingTimeout  interface{}                 `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
-	envCFStartupTimeout  interface{}                 `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
-	relatedCommands      interface{}                 `related_commands:"apps, create-app-manifest, logs, ssh, start"`
-}
-
-func (_ PushCommand) Setup(config command.Config, ui command.UI) error {
+	OptionalArgs         flag.OptionalAppName          `positional-args:"yes"`
+	AppPorts             flag.AppPorts                 `long:"app-ports" description:"Comma delimited list of ports the application may listen on" hidden:"true"`
+	BuildpackName        string                        `short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
+	StartupCommand       string                        `short:"c" description:"Startup command, set to null to reset to default start command"`
+	Domain               string                        `short:"d" description:"Domain (e.g. example.com)"`
+	DockerImage          string                        `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
+	PathToManifest       flag.PathWithExistenceCheck   `short:"f" description:"Path to manifest"`
+	HealthCheckType      flag.HealthCheckType          `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
+	Hostname             string                        `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
+	KeepOldApp           bool                          `long:"keep-old-app" description:"Stop and rename the previous app to APP_NAME-old instead of deleting it, replacing any existing APP_NAME-old, used with '--strategy blue-green'"`
+	NumInstances         int                           `short:"i" description:"Number of instances"`
+	DiskLimit            flag.Megabytes                `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	MemoryLimit          flag.Megabytes                `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NoHostname           bool                          `long:"no-hostname" description:"Map the root domain to this app"`
+	NoManifest           bool                          `long:"no-manifest" description:"Ignore manifest file"`
+	NoRoute              bool                          `long:"no-route" description:"Do not map a route to this app and remove routes from previous pushes of this app"`
+	NoStart              bool                          `long:"no-start" description:"Do not start an app after pushing"`
+	DirectoryPath        flag.PathWithExistenceCheck   `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
+	RandomRoute          bool                          `long:"random-route" description:"Create a random route for this app"`
+	RoutePath            string                        `long:"route-path" description:"Path for the route"`
+	Stack                string                        `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	Strategy             flag.PushStrategy             `long:"strategy" description:"Deployment strategy. 'blue-green' pushes a temporary app and only moves the routes to it once all of its instances are running"`
+	ApplicationStartTime int                           `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	Vars                 []flag.ManifestVariable       `long:"var" description:"Variable key value pair for variable substitution in the manifest, (e.g., name=app1); can specify multiple times"`
+	VarsFiles            []flag.PathWithExistenceCheck `long:"vars-file" description:"Path to a variable substitution file for the manifest; can specify multiple times"`
+	usage                interface{}                   `usage:"Push a single app (with or without a manifest):\n   CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-d DOMAIN] [-f MANIFEST_PATH] [--docker-image DOCKER_IMAGE]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [--hostname HOST] [-p PATH] [-s STACK] [-t TIMEOUT] [-u (process | port | http)] [--route-path ROUTE_PATH]\n   [--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route]\n   [--strategy blue-green [--keep-old-app]]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]\n\n   Push multiple apps with a manifest:\n   cf push [-f MANIFEST_PATH] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]"`
+	envCFStagingTimeout  interface{}                   `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
+	envCFStartupTimeout  interface{}                   `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+	relatedCommands      interface{}                   `related_commands:"apps, create-app-manifest, logs, ssh, start"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       PushActor
+	NOAAClient  *consumer.Consumer
+}
+
+func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
 	return nil
 }
 
-func (_ PushCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd PushCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.Strategy.Strategy != "" && cmd.NoStart {
+		return command.ArgumentCombinationError{Arg1: "--strategy", Arg2: "--no-start"}
+	}
+
+	if cmd.KeepOldApp && cmd.Strategy.Strategy == "" {
+		return command.FlagRequiresFlagError{Flag: "--keep-old-app", RequiredFlag: "--strategy blue-green"}
+	}
+
+	if len(cmd.AppPorts) > 0 {
+		apiVersion := cmd.Actor.CloudControllerAPIVersion()
+		if command.MinimumAPIVersionCheck(apiVersion, minVersionAppPorts) != nil {
+			return command.FlagMinimumAPIVersionNotMetError{
+				Flag:           "--app-ports",
+				CurrentVersion: apiVersion,
+				MinimumVersion: minVersionAppPorts,
+			}
+		}
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	manifestApps, err := cmd.readManifest()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	configs, warnings, err := cmd.Actor.ConvertToApplicationConfigs(
+		cmd.Config.TargetedOrganization().GUID,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.pushSettings(),
+		manifestApps,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	for _, config := range configs {
+		err = cmd.pushApplication(config, user)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+func (cmd PushCommand) pushApplication(config v2action.ApplicationConfig, user configv3.User) error {
+	appName := config.DesiredApplication.Name
+	templateValues := map[string]interface{}{
+		"AppName":     appName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": user.Name,
+	}
+
+	if cmd.Strategy.Strategy == "blue-green" && config.Exists() {
+		return cmd.pushBlueGreen(config, templateValues)
+	}
+
+	if config.Exists() {
+		cmd.UI.DisplayTextWithFlavor("Updating app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", templateValues)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", templateValues)
+	}
+
+	config, warnings, err := cmd.Actor.CreateOrUpdateApplication(config)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if config.NoRoute || len(config.DesiredRoutes) > 0 {
+		if config.NoRoute {
+			cmd.UI.DisplayText("Unmapping routes from app {{.AppName}}...", templateValues)
+		} else {
+			cmd.UI.DisplayText("Mapping routes to app {{.AppName}}...", templateValues)
+		}
+
+		config, warnings, err = cmd.Actor.MapRoutes(config)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayNewline()
+	}
+
+	if len(config.UnboundServices()) > 0 {
+		cmd.UI.DisplayText("Binding services to app {{.AppName}}...", templateValues)
+
+		config, warnings, err = cmd.Actor.BindServices(config)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayNewline()
+	}
+
+	if config.Path != "" {
+		cmd.UI.DisplayText("Uploading app files from: {{.Path}}", map[string]interface{}{
+			"Path": config.Path,
+		})
+
+		warnings, err = cmd.Actor.UploadApplication(config.CurrentApplication.GUID, config.Path)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayNewline()
+	}
+
+	if cmd.NoStart {
+		return nil
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Starting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", templateValues)
+
+	messages, logErrs, appStarting, apiWarnings, errs := cmd.Actor.StartApplication(config.CurrentApplication, cmd.NOAAClient, cmd.Config)
+	cmd.UI.DisplayNewline()
+	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appStarting, apiWarnings, errs)
+	if err != nil {
+		return err
+	}
+
+	return cmd.displayAppSummary(appName)
+}
+
+// pushBlueGreen pushes the new version of an existing application as a
+// temporary application. Once all of its instances are running, the routes
+// are moved over and the previous application is removed. A failure before
+// the previous application is removed rolls back to it.
+func (cmd PushCommand) pushBlueGreen(config v2action.ApplicationConfig, templateValues map[string]interface{}) error {
+	newConfig := config.BlueGreenConfig()
+	templateValues["TemporaryAppName"] = newConfig.DesiredApplication.Name
+
+	cmd.UI.DisplayTextWithFlavor("Pushing app {{.AppName}} with blue-green strategy in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", templateValues)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Creating temporary app {{.TemporaryAppName}}...", templateValues)
+	newConfig, warnings, err := cmd.Actor.CreateOrUpdateApplication(newConfig)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if newConfig.Path != "" {
+		cmd.UI.DisplayText("Uploading app files from: {{.Path}}", map[string]interface{}{
+			"Path": newConfig.Path,
+		})
+
+		warnings, err = cmd.Actor.UploadApplication(newConfig.CurrentApplication.GUID, newConfig.Path)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return cmd.rollbackBlueGreen(config, newConfig, v2action.BlueGreenNotSwapped, shared.HandleError(err))
+		}
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayNewline()
+	}
+
+	if len(newConfig.UnboundServices()) > 0 {
+		cmd.UI.DisplayText("Binding services to app {{.AppName}}...", map[string]interface{}{
+			"AppName": newConfig.DesiredApplication.Name,
+		})
+
+		newConfig, warnings, err = cmd.Actor.BindServices(newConfig)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return cmd.rollbackBlueGreen(config, newConfig, v2action.BlueGreenNotSwapped, shared.HandleError(err))
+		}
+		cmd.UI.DisplayOK()
+		cmd.UI.DisplayNewline()
+	}
+
+	cmd.UI.DisplayText("Starting temporary app {{.TemporaryAppName}} and waiting for all instances...", templateValues)
+
+	messages, logErrs, appStarting, apiWarnings, errs := cmd.Actor.StartApplicationAndWaitForAllInstances(newConfig.CurrentApplication, cmd.NOAAClient, cmd.Config)
+	cmd.UI.DisplayNewline()
+	err = shared.PollStart(cmd.UI, cmd.Config, messages, logErrs, appStarting, apiWarnings, errs)
+	if err != nil {
+		return cmd.rollbackBlueGreen(config, newConfig, v2action.BlueGreenNotSwapped, err)
+	}
+
+	cmd.UI.DisplayNewline()
+	if cmd.KeepOldApp {
+		cmd.UI.DisplayText("Moving routes to {{.TemporaryAppName}} and renaming previous app to {{.AppName}}-old...", templateValues)
+	} else {
+		cmd.UI.DisplayText("Moving routes to {{.TemporaryAppName}} and deleting previous app...", templateValues)
+	}
+
+	stage, warnings, err := cmd.Actor.SwapBlueGreenApplications(config, newConfig, cmd.KeepOldApp)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return cmd.rollbackBlueGreen(config, newConfig, stage, shared.HandleError(err))
+	}
+	cmd.UI.DisplayOK()
+
+	return cmd.displayAppSummary(config.CurrentApplication.Name)
+}
+
+// rollbackBlueGreen undoes a failed blue-green push up to the provided stage.
+// Once the previous application has been removed, the temporary application
+// is kept since it is the only one serving the routes.
+func (cmd PushCommand) rollbackBlueGreen(oldConfig v2action.ApplicationConfig, newConfig v2action.ApplicationConfig, stage v2action.BlueGreenStage, pushErr error) error {
+	cmd.UI.DisplayNewline()
+	if stage >= v2action.BlueGreenOldAppRemoved {
+		cmd.UI.DisplayWarning("The previous app has been removed, so {{.TemporaryAppName}} is kept and serves the routes of {{.AppName}}. Rename it to {{.AppName}} once the error is resolved.", map[string]interface{}{
+			"AppName":          oldConfig.CurrentApplication.Name,
+			"TemporaryAppName": newConfig.DesiredApplication.Name,
+		})
+		return pushErr
+	}
+
+	cmd.UI.DisplayText("Rolling back to app {{.AppName}}...", map[string]interface{}{
+		"AppName": oldConfig.CurrentApplication.Name,
+	})
+
+	warnings, err := cmd.Actor.RollbackBlueGreenApplications(oldConfig, newConfig, stage)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to roll back: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	} else {
+		cmd.UI.DisplayOK()
+	}
+
+	return pushErr
+}
+
+func (cmd PushCommand) displayAppSummary(appName string) error {
+	cmd.UI.DisplayNewline()
+
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
+
+	return nil
+}
+
+// readManifest returns the applications in the provided manifest, or in the
+// manifest of the current directory if one exists.
+func (cmd PushCommand) readManifest() ([]manifest.Application, error) {
+	if cmd.NoManifest {
+		return nil, nil
+	}
+
+	manifestPath := string(cmd.PathToManifest)
+	if manifestPath == "" {
+		pwd, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+		manifestPath = pwd
+	}
+
+	vars, err := cmd.manifestVariables()
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := manifest.ReadAndMergeManifests(manifestPath, vars)
+	if _, ok := err.(manifest.ManifestNotFoundError); ok && cmd.PathToManifest == "" {
+		return nil, nil
+	}
+
+	return apps, err
+}
+
+// manifestVariables returns the variables for the manifest. Variables files
+// are applied in order and --var values take precedence over all of them.
+func (cmd PushCommand) manifestVariables() (manifest.Variables, error) {
+	vars := manifest.Variables{}
+	for _, path := range cmd.VarsFiles {
+		fileVars, err := manifest.ReadVariablesFile(string(path))
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range fileVars {
+			vars[name] = value
+		}
+	}
+
+	for _, variable := range cmd.Vars {
+		vars[variable.Name] = variable.Value
+	}
+
+	return vars, nil
+}
+
+func (cmd PushCommand) pushSettings() v2action.PushSettings {
+	healthCheckType := cmd.HealthCheckType.Type
+	if healthCheckType == "none" {
+		healthCheckType = "process"
+	}
+
+	return v2action.PushSettings{
+		Name:               cmd.OptionalArgs.AppName,
+		AppPorts:           cmd.AppPorts,
+		Buildpack:          cmd.BuildpackName,
+		Command:            cmd.StartupCommand,
+		DiskQuota:          cmd.DiskLimit.Size,
+		DockerImage:        cmd.DockerImage,
+		Domain:             cmd.Domain,
+		HealthCheckTimeout: cmd.ApplicationStartTime,
+		HealthCheckType:    healthCheckType,
+		Hostname:           cmd.Hostname,
+		Instances:          cmd.NumInstances,
+		Memory:             cmd.MemoryLimit.Size,
+		NoHostname:         cmd.NoHostname,
+		NoRoute:            cmd.NoRoute,
+		Path:               string(cmd.DirectoryPath),
+		RandomRoute:        cmd.RandomRoute,
+		RoutePath:          cmd.RoutePath,
+		StackName:          cmd.Stack,
+	}
+}
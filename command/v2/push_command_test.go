@@ -0,0 +1,620 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Push Command", func() {
+	var (
+		cmd             PushCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakePushActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakePushActor)
+
+		cmd = PushCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.OptionalArgs.AppName = "some-app"
+		cmd.NoManifest = true
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		fakeActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			appStart := make(chan bool)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			go func() {
+				close(messages)
+				close(logErrs)
+				close(appStart)
+				close(warnings)
+				close(errs)
+			}()
+
+			return messages, logErrs, appStart, warnings, errs
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when getting the current user returns an error", func() {
+			BeforeEach(func() {
+				fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("current-user-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("current-user-error"))
+			})
+		})
+
+		Context("when command line settings are provided", func() {
+			BeforeEach(func() {
+				cmd.BuildpackName = "some-buildpack"
+				cmd.DiskLimit = flag.Megabytes{Size: 512}
+				cmd.HealthCheckType = flag.HealthCheckType{Type: "none"}
+				cmd.MemoryLimit = flag.Megabytes{Size: 256}
+				cmd.NumInstances = 3
+				cmd.DirectoryPath = "/some/path"
+				cmd.RoutePath = "/some-route-path"
+				cmd.Stack = "some-stack"
+			})
+
+			It("passes them to the actor", func() {
+				Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(1))
+				orgGUID, spaceGUID, settings, apps := fakeActor.ConvertToApplicationConfigsArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(apps).To(BeEmpty())
+				Expect(settings).To(Equal(v2action.PushSettings{
+					Name:            "some-app",
+					Buildpack:       "some-buildpack",
+					DiskQuota:       512,
+					HealthCheckType: "process",
+					Instances:       3,
+					Memory:          256,
+					Path:            "/some/path",
+					RoutePath:       "/some-route-path",
+					StackName:       "some-stack",
+				}))
+			})
+		})
+
+		Context("when --app-ports is provided", func() {
+			BeforeEach(func() {
+				cmd.AppPorts = flag.AppPorts{8080, 9090}
+				fakeActor.CloudControllerAPIVersionReturns("2.51.0")
+			})
+
+			It("passes the ports to the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(1))
+				_, _, settings, _ := fakeActor.ConvertToApplicationConfigsArgsForCall(0)
+				Expect(settings.AppPorts).To(Equal([]int{8080, 9090}))
+			})
+
+			Context("when the API is below the minimum version", func() {
+				BeforeEach(func() {
+					fakeActor.CloudControllerAPIVersionReturns("2.50.0")
+				})
+
+				It("returns a FlagMinimumAPIVersionNotMetError", func() {
+					Expect(executeErr).To(MatchError(command.FlagMinimumAPIVersionNotMetError{
+						Flag:           "--app-ports",
+						CurrentVersion: "2.50.0",
+						MinimumVersion: "2.51.0",
+					}))
+					Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when a manifest is provided", func() {
+			var tmpDir string
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "push-command")
+				Expect(err).ToNot(HaveOccurred())
+
+				manifestPath := filepath.Join(tmpDir, "manifest.yml")
+				Expect(ioutil.WriteFile(manifestPath, []byte("applications:\n- name: manifest-app\n  instances: 2\n"), 0644)).To(Succeed())
+
+				cmd.NoManifest = false
+				cmd.PathToManifest = flag.PathWithExistenceCheck(manifestPath)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(tmpDir)).To(Succeed())
+			})
+
+			It("passes the manifest applications to the actor", func() {
+				Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(1))
+				_, _, _, apps := fakeActor.ConvertToApplicationConfigsArgsForCall(0)
+				instances := 2
+				Expect(apps).To(Equal([]manifest.Application{{Name: "manifest-app", Instances: &instances}}))
+			})
+
+			Context("when the manifest contains variables", func() {
+				BeforeEach(func() {
+					manifestPath := filepath.Join(tmpDir, "manifest.yml")
+					Expect(ioutil.WriteFile(manifestPath, []byte("applications:\n- name: ((name))\n  instances: ((instances))\n  stack: ((stack))\n"), 0644)).To(Succeed())
+
+					varsFile1 := filepath.Join(tmpDir, "vars-1.yml")
+					Expect(ioutil.WriteFile(varsFile1, []byte("name: file-1-app\ninstances: 1\n"), 0644)).To(Succeed())
+					varsFile2 := filepath.Join(tmpDir, "vars-2.yml")
+					Expect(ioutil.WriteFile(varsFile2, []byte("instances: 4\n"), 0644)).To(Succeed())
+
+					cmd.VarsFiles = []flag.PathWithExistenceCheck{
+						flag.PathWithExistenceCheck(varsFile1),
+						flag.PathWithExistenceCheck(varsFile2),
+					}
+					cmd.Vars = []flag.ManifestVariable{{Name: "stack", Value: "some-stack"}}
+				})
+
+				It("fills them in from the vars files and --var, later values taking precedence", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, _, _, apps := fakeActor.ConvertToApplicationConfigsArgsForCall(0)
+					instances := 4
+					Expect(apps).To(Equal([]manifest.Application{{Name: "file-1-app", Instances: &instances, StackName: "some-stack"}}))
+				})
+
+				Context("when a variable is not provided", func() {
+					BeforeEach(func() {
+						cmd.Vars = nil
+					})
+
+					It("returns an UnresolvedVariablesError", func() {
+						Expect(executeErr).To(MatchError(manifest.UnresolvedVariablesError{
+							Path:  filepath.Join(tmpDir, "manifest.yml"),
+							Names: []string{"stack"},
+						}))
+						Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+
+		Context("when converting the settings fails", func() {
+			BeforeEach(func() {
+				fakeActor.ConvertToApplicationConfigsReturns(nil, v2action.Warnings{"convert-warning"}, v2action.StackNotFoundError{Name: "some-stack"})
+			})
+
+			It("displays the warnings and returns the translated error", func() {
+				Expect(executeErr).To(MatchError(shared.StackNotFoundError{Name: "some-stack"}))
+				Expect(testUI.Err).To(Say("convert-warning"))
+			})
+		})
+
+		Context("when the application is new", func() {
+			BeforeEach(func() {
+				config := v2action.ApplicationConfig{
+					DesiredApplication: v2action.Application{Name: "some-app"},
+					DesiredRoutes:      []v2action.Route{{Host: "some-app", Domain: "some-domain.com"}},
+					Path:               "/some/path",
+				}
+				fakeActor.ConvertToApplicationConfigsReturns([]v2action.ApplicationConfig{config}, v2action.Warnings{"convert-warning"}, nil)
+
+				createdConfig := config
+				createdConfig.CurrentApplication = v2action.Application{GUID: "some-app-guid", Name: "some-app"}
+				fakeActor.CreateOrUpdateApplicationReturns(createdConfig, v2action.Warnings{"create-warning"}, nil)
+				fakeActor.MapRoutesReturns(createdConfig, v2action.Warnings{"map-routes-warning"}, nil)
+				fakeActor.UploadApplicationReturns(v2action.Warnings{"upload-warning"}, nil)
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v2action.ApplicationSummary{
+					Application: v2action.Application{Name: "some-app"},
+				}, v2action.Warnings{"summary-warning"}, nil)
+			})
+
+			It("creates the app, maps routes, uploads the bits and starts it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Creating app some-app in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Mapping routes to app some-app..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Uploading app files from: /some/path"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Starting app some-app in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("name:\\s+some-app"))
+
+				Expect(testUI.Err).To(Say("convert-warning"))
+				Expect(testUI.Err).To(Say("create-warning"))
+				Expect(testUI.Err).To(Say("map-routes-warning"))
+				Expect(testUI.Err).To(Say("upload-warning"))
+				Expect(testUI.Err).To(Say("summary-warning"))
+
+				Expect(fakeActor.UploadApplicationCallCount()).To(Equal(1))
+				appGUID, path := fakeActor.UploadApplicationArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(path).To(Equal("/some/path"))
+
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+				app, _, _ := fakeActor.StartApplicationArgsForCall(0)
+				Expect(app.GUID).To(Equal("some-app-guid"))
+
+				Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID := fakeActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+
+			Context("when --no-start is provided", func() {
+				BeforeEach(func() {
+					cmd.NoStart = true
+				})
+
+				It("does not start the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.UploadApplicationCallCount()).To(Equal(1))
+					Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+					Expect(testUI.Out).ToNot(Say("Starting app"))
+				})
+			})
+
+			Context("when the app has services to bind", func() {
+				BeforeEach(func() {
+					config := v2action.ApplicationConfig{
+						CurrentApplication: v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+						DesiredApplication: v2action.Application{Name: "some-app"},
+						DesiredServices:    []string{"some-db"},
+						NoRoute:            true,
+						Path:               "/some/path",
+					}
+					fakeActor.CreateOrUpdateApplicationReturns(config, nil, nil)
+					fakeActor.MapRoutesReturns(config, nil, nil)
+
+					boundConfig := config
+					boundConfig.CurrentServices = []string{"some-db"}
+					fakeActor.BindServicesReturns(boundConfig, v2action.Warnings{"bind-warning"}, nil)
+				})
+
+				It("binds them before uploading and starting the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Binding services to app some-app..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Uploading app files from: /some/path"))
+					Expect(testUI.Out).To(Say("Starting app some-app"))
+					Expect(testUI.Err).To(Say("bind-warning"))
+
+					Expect(fakeActor.BindServicesCallCount()).To(Equal(1))
+					Expect(fakeActor.BindServicesArgsForCall(0).DesiredServices).To(Equal([]string{"some-db"}))
+				})
+
+				Context("when binding fails", func() {
+					BeforeEach(func() {
+						fakeActor.BindServicesReturns(v2action.ApplicationConfig{}, v2action.Warnings{"bind-warning"}, v2action.ServiceInstanceNotFoundError{Name: "some-db"})
+					})
+
+					It("returns the translated error without starting the app", func() {
+						Expect(executeErr).To(MatchError(command.ServiceInstanceNotFoundError{Name: "some-db"}))
+						Expect(testUI.Err).To(Say("bind-warning"))
+						Expect(fakeActor.UploadApplicationCallCount()).To(Equal(0))
+						Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			It("does not bind services when there are none to bind", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.BindServicesCallCount()).To(Equal(0))
+				Expect(testUI.Out).ToNot(Say("Binding services"))
+			})
+
+			Context("when the upload fails", func() {
+				BeforeEach(func() {
+					fakeActor.UploadApplicationReturns(v2action.Warnings{"upload-warning"}, errors.New("upload-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("upload-error"))
+					Expect(testUI.Err).To(Say("upload-warning"))
+					Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the application already exists and has no route changes", func() {
+			BeforeEach(func() {
+				config := v2action.ApplicationConfig{
+					CurrentApplication: v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+					DesiredApplication: v2action.Application{Name: "some-app"},
+					Path:               "/some/path",
+				}
+				fakeActor.ConvertToApplicationConfigsReturns([]v2action.ApplicationConfig{config}, nil, nil)
+				fakeActor.CreateOrUpdateApplicationReturns(config, nil, nil)
+				cmd.NoStart = true
+			})
+
+			It("updates the app without mapping routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Updating app some-app in org some-org / space some-space as some-user..."))
+				Expect(fakeActor.MapRoutesCallCount()).To(Equal(0))
+				Expect(fakeActor.UploadApplicationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when --keep-old-app is provided without --strategy", func() {
+			BeforeEach(func() {
+				cmd.KeepOldApp = true
+			})
+
+			It("returns a FlagRequiresFlagError", func() {
+				Expect(executeErr).To(MatchError(command.FlagRequiresFlagError{Flag: "--keep-old-app", RequiredFlag: "--strategy blue-green"}))
+				Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when --strategy blue-green is provided", func() {
+			var (
+				oldConfig v2action.ApplicationConfig
+				newConfig v2action.ApplicationConfig
+			)
+
+			BeforeEach(func() {
+				cmd.Strategy = flag.PushStrategy{Strategy: "blue-green"}
+
+				oldConfig = v2action.ApplicationConfig{
+					CurrentApplication: v2action.Application{GUID: "old-app-guid", Name: "some-app", Instances: 2},
+					DesiredApplication: v2action.Application{Name: "some-app"},
+					CurrentRoutes:      []v2action.Route{{GUID: "some-route-guid"}},
+					DesiredRoutes:      []v2action.Route{{GUID: "some-route-guid"}},
+					Path:               "/some/path",
+				}
+				fakeActor.ConvertToApplicationConfigsReturns([]v2action.ApplicationConfig{oldConfig}, nil, nil)
+
+				newConfig = oldConfig.BlueGreenConfig()
+				newConfig.CurrentApplication = v2action.Application{GUID: "new-app-guid", Name: "some-app-new"}
+				fakeActor.CreateOrUpdateApplicationReturns(newConfig, v2action.Warnings{"create-warning"}, nil)
+				fakeActor.UploadApplicationReturns(v2action.Warnings{"upload-warning"}, nil)
+				fakeActor.StartApplicationAndWaitForAllInstancesStub = fakeActor.StartApplicationStub
+				fakeActor.SwapBlueGreenApplicationsReturns(v2action.BlueGreenSwapped, v2action.Warnings{"swap-warning"}, nil)
+				fakeActor.RollbackBlueGreenApplicationsReturns(v2action.Warnings{"rollback-warning"}, nil)
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v2action.ApplicationSummary{
+					Application: v2action.Application{Name: "some-app"},
+				}, nil, nil)
+			})
+
+			It("pushes a temporary app and swaps it with the existing app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Pushing app some-app with blue-green strategy in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("Creating temporary app some-app-new..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Uploading app files from: /some/path"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Starting temporary app some-app-new and waiting for all instances..."))
+				Expect(testUI.Out).To(Say("Moving routes to some-app-new and deleting previous app..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("name:\\s+some-app"))
+
+				Expect(testUI.Err).To(Say("create-warning"))
+				Expect(testUI.Err).To(Say("upload-warning"))
+				Expect(testUI.Err).To(Say("swap-warning"))
+
+				Expect(fakeActor.CreateOrUpdateApplicationCallCount()).To(Equal(1))
+				Expect(fakeActor.CreateOrUpdateApplicationArgsForCall(0)).To(Equal(oldConfig.BlueGreenConfig()))
+
+				Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+				Expect(fakeActor.StartApplicationAndWaitForAllInstancesCallCount()).To(Equal(1))
+				app, _, _ := fakeActor.StartApplicationAndWaitForAllInstancesArgsForCall(0)
+				Expect(app.GUID).To(Equal("new-app-guid"))
+
+				Expect(fakeActor.SwapBlueGreenApplicationsCallCount()).To(Equal(1))
+				passedOldConfig, passedNewConfig, keepOldApp := fakeActor.SwapBlueGreenApplicationsArgsForCall(0)
+				Expect(passedOldConfig).To(Equal(oldConfig))
+				Expect(passedNewConfig).To(Equal(newConfig))
+				Expect(keepOldApp).To(BeFalse())
+
+				Expect(fakeActor.RollbackBlueGreenApplicationsCallCount()).To(Equal(0))
+
+				appName, _ := fakeActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+			})
+
+			Context("when --keep-old-app is provided", func() {
+				BeforeEach(func() {
+					cmd.KeepOldApp = true
+				})
+
+				It("keeps the previous app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Moving routes to some-app-new and renaming previous app to some-app-old..."))
+
+					_, _, keepOldApp := fakeActor.SwapBlueGreenApplicationsArgsForCall(0)
+					Expect(keepOldApp).To(BeTrue())
+				})
+			})
+
+			Context("when the temporary app fails to start", func() {
+				BeforeEach(func() {
+					fakeActor.StartApplicationAndWaitForAllInstancesStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+						appStart := make(chan bool)
+						warnings := make(chan string)
+						errs := make(chan error)
+
+						go func() {
+							errs <- v2action.ApplicationInstanceCrashedError{Name: "some-app-new"}
+							close(messages)
+							close(logErrs)
+							close(appStart)
+							close(warnings)
+							close(errs)
+						}()
+
+						return messages, logErrs, appStart, warnings, errs
+					}
+				})
+
+				It("rolls back and returns the error", func() {
+					Expect(executeErr).To(MatchError(shared.UnsuccessfulStartError{AppName: "some-app-new", BinaryName: "faceman"}))
+
+					Expect(testUI.Out).To(Say("Rolling back to app some-app..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Err).To(Say("rollback-warning"))
+
+					Expect(fakeActor.SwapBlueGreenApplicationsCallCount()).To(Equal(0))
+					Expect(fakeActor.RollbackBlueGreenApplicationsCallCount()).To(Equal(1))
+					passedOldConfig, passedNewConfig, stage := fakeActor.RollbackBlueGreenApplicationsArgsForCall(0)
+					Expect(passedOldConfig).To(Equal(oldConfig))
+					Expect(passedNewConfig).To(Equal(newConfig))
+					Expect(stage).To(Equal(v2action.BlueGreenNotSwapped))
+				})
+			})
+
+			Context("when swapping the apps fails", func() {
+				BeforeEach(func() {
+					fakeActor.SwapBlueGreenApplicationsReturns(v2action.BlueGreenRoutesMoved, v2action.Warnings{"swap-warning"}, errors.New("swap-error"))
+					fakeActor.RollbackBlueGreenApplicationsReturns(nil, errors.New("rollback-error"))
+				})
+
+				It("rolls back the completed steps and returns the original error", func() {
+					Expect(executeErr).To(MatchError("swap-error"))
+					Expect(testUI.Out).To(Say("Rolling back to app some-app..."))
+					Expect(testUI.Err).To(Say("swap-warning"))
+					Expect(testUI.Err).To(Say("Unable to roll back: rollback-error"))
+					Expect(fakeActor.RollbackBlueGreenApplicationsCallCount()).To(Equal(1))
+					_, _, stage := fakeActor.RollbackBlueGreenApplicationsArgsForCall(0)
+					Expect(stage).To(Equal(v2action.BlueGreenRoutesMoved))
+				})
+			})
+
+			Context("when renaming the temporary app fails after the previous app was removed", func() {
+				BeforeEach(func() {
+					fakeActor.SwapBlueGreenApplicationsReturns(v2action.BlueGreenOldAppRemoved, v2action.Warnings{"swap-warning"}, errors.New("swap-error"))
+				})
+
+				It("keeps the temporary app and returns the error", func() {
+					Expect(executeErr).To(MatchError("swap-error"))
+					Expect(testUI.Out).ToNot(Say("Rolling back"))
+					Expect(testUI.Err).To(Say("The previous app has been removed, so some-app-new is kept and serves the routes of some-app. Rename it to some-app once the error is resolved."))
+					Expect(fakeActor.RollbackBlueGreenApplicationsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the previous app has services bound", func() {
+				BeforeEach(func() {
+					newConfig.DesiredServices = []string{"some-db"}
+					fakeActor.CreateOrUpdateApplicationReturns(newConfig, nil, nil)
+					boundConfig := newConfig
+					boundConfig.CurrentServices = []string{"some-db"}
+					fakeActor.BindServicesReturns(boundConfig, v2action.Warnings{"bind-warning"}, nil)
+				})
+
+				It("binds them to the temporary app before starting it", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Binding services to app some-app-new..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say("Starting temporary app some-app-new"))
+					Expect(testUI.Err).To(Say("bind-warning"))
+
+					Expect(fakeActor.BindServicesCallCount()).To(Equal(1))
+					Expect(fakeActor.BindServicesArgsForCall(0).CurrentApplication.GUID).To(Equal("new-app-guid"))
+				})
+
+				Context("when binding fails", func() {
+					BeforeEach(func() {
+						fakeActor.BindServicesReturns(newConfig, v2action.Warnings{"bind-warning"}, errors.New("bind-error"))
+					})
+
+					It("rolls back without starting the temporary app", func() {
+						Expect(executeErr).To(MatchError("bind-error"))
+						Expect(fakeActor.StartApplicationAndWaitForAllInstancesCallCount()).To(Equal(0))
+						Expect(fakeActor.RollbackBlueGreenApplicationsCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when --no-start is also provided", func() {
+				BeforeEach(func() {
+					cmd.NoStart = true
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--strategy", Arg2: "--no-start"}))
+					Expect(fakeActor.ConvertToApplicationConfigsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the application does not exist yet", func() {
+				BeforeEach(func() {
+					config := v2action.ApplicationConfig{
+						DesiredApplication: v2action.Application{Name: "some-app"},
+						Path:               "/some/path",
+					}
+					fakeActor.ConvertToApplicationConfigsReturns([]v2action.ApplicationConfig{config}, nil, nil)
+					createdConfig := config
+					createdConfig.CurrentApplication = v2action.Application{GUID: "some-app-guid", Name: "some-app"}
+					fakeActor.CreateOrUpdateApplicationReturns(createdConfig, nil, nil)
+				})
+
+				It("pushes the app normally", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Creating app some-app in org some-org / space some-space as some-user..."))
+					Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
+					Expect(fakeActor.SwapBlueGreenApplicationsCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
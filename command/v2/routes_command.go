@@ -1,23 +1,110 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . RoutesActor
+
+type RoutesActor interface {
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetRouteApplications(routeGUID string, query []ccv2.Query) ([]v2action.Application, v2action.Warnings, error)
+	GetSpaceRoutes(spaceGUID string, query []ccv2.Query) ([]v2action.Route, v2action.Warnings, error)
+}
+
+// routeDocument is the structured output of a single route in the routes
+// command.
+type routeDocument struct {
+	v2action.Route
+	SpaceName string   `json:"space"`
+	AppNames  []string `json:"apps"`
+}
+
 type RoutesCommand struct {
 	OrgLevel        bool        `long:"orglevel" description:"List all the routes for all spaces of current organization"`
 	usage           interface{} `usage:"CF_NAME routes [--orglevel]"`
 	relatedCommands interface{} `related_commands:"check-route, domains, map-route, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RoutesActor
 }
 
-func (_ RoutesCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RoutesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewStructuredOutputClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ RoutesCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
-	return nil
+func (cmd RoutesCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd RoutesCommand) Execute(args []string) error {
+	if shared.RunLegacyCommand(cmd.UI, oldCmd.Main) {
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, !cmd.OrgLevel)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	var spaces []v2action.Space
+	if cmd.OrgLevel {
+		var warnings v2action.Warnings
+		spaces, warnings, err = cmd.Actor.GetOrganizationSpaces(cmd.Config.TargetedOrganization().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	} else {
+		targetedSpace := cmd.Config.TargetedSpace()
+		spaces = []v2action.Space{{GUID: targetedSpace.GUID, Name: targetedSpace.Name}}
+	}
+
+	documents := []routeDocument{}
+	for _, space := range spaces {
+		routes, warnings, err := cmd.Actor.GetSpaceRoutes(space.GUID, nil)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		for _, route := range routes {
+			apps, warnings, err := cmd.Actor.GetRouteApplications(route.GUID, nil)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return shared.HandleError(err)
+			}
+
+			appNames := []string{}
+			for _, app := range apps {
+				appNames = append(appNames, app.Name)
+			}
+
+			documents = append(documents, routeDocument{
+				Route:     route,
+				SpaceName: space.Name,
+				AppNames:  appNames,
+			})
+		}
+	}
+
+	return cmd.UI.DisplayStructuredData(documents)
 }
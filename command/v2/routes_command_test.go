@@ -0,0 +1,132 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("routes Command", func() {
+	var (
+		cmd             RoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRoutesActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		testUI.OutputFormat = configv3.OutputJSON
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRoutesActor)
+
+		cmd = RoutesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+
+		fakeActor.GetSpaceRoutesReturns([]v2action.Route{
+			{GUID: "route-guid", Host: "host", Domain: "some-domain.com", DomainGUID: "domain-guid", SpaceGUID: "some-space-guid"},
+		}, v2action.Warnings{"routes-warning"}, nil)
+		fakeActor.GetRouteApplicationsReturns([]v2action.Application{{Name: "app-1"}}, v2action.Warnings{"route-apps-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when structured output is requested", func() {
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+			})
+		})
+
+		It("displays the routes in the targeted space and their apps as a document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			spaceGUID, _ := fakeActor.GetSpaceRoutesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			routeGUID, _ := fakeActor.GetRouteApplicationsArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+				{
+					"guid": "route-guid",
+					"host": "host",
+					"domain": "some-domain.com",
+					"domain_guid": "domain-guid",
+					"path": "",
+					"space_guid": "some-space-guid",
+					"space": "some-space",
+					"apps": ["app-1"]
+				}
+			]`))
+			Expect(testUI.Err).To(Say("routes-warning"))
+			Expect(testUI.Err).To(Say("route-apps-warning"))
+		})
+
+		Context("when --orglevel is provided", func() {
+			BeforeEach(func() {
+				cmd.OrgLevel = true
+				fakeActor.GetOrganizationSpacesReturns([]v2action.Space{
+					{GUID: "space-guid-1", Name: "space-1"},
+					{GUID: "space-guid-2", Name: "space-2"},
+				}, v2action.Warnings{"spaces-warning"}, nil)
+			})
+
+			It("displays the routes of every space in the org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedSpace).To(BeFalse())
+
+				Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeActor.GetSpaceRoutesCallCount()).To(Equal(2))
+				spaceGUID, _ := fakeActor.GetSpaceRoutesArgsForCall(1)
+				Expect(spaceGUID).To(Equal("space-guid-2"))
+
+				Expect(testUI.Out).To(Say(`"space": "space-1"`))
+				Expect(testUI.Out).To(Say(`"space": "space-2"`))
+				Expect(testUI.Err).To(Say("spaces-warning"))
+			})
+		})
+
+		Context("when getting the route apps fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouteApplicationsReturns(nil, v2action.Warnings{"route-apps-warning"}, errors.New("route-apps-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("route-apps-error"))
+				Expect(testUI.Err).To(Say("route-apps-warning"))
+			})
+		})
+	})
+})
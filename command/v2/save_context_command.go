@@ -0,0 +1,33 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SaveContextCommand struct {
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME save-context CONTEXT_NAME\n\nEXAMPLES:\n   CF_NAME api https://api.example.com\n   CF_NAME login\n   CF_NAME save-context production"`
+	relatedCommands interface{}      `related_commands:"contexts, use-context"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *SaveContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd SaveContextCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Saving current target as context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": cmd.RequiredArgs.ContextName,
+	})
+
+	cmd.Config.SaveContext(cmd.RequiredArgs.ContextName)
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
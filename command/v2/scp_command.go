@@ -0,0 +1,28 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SCPCommand struct {
+	RequiredArgs       flag.SCPArgs `positional-args:"yes"`
+	AppInstanceIndex   int          `long:"app-instance-index" short:"i" description:"Application instance index"`
+	Recursive          bool         `long:"recursive" short:"r" description:"Recursively copy directories"`
+	Quiet              bool         `long:"quiet" short:"q" description:"Do not show transfer progress"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	usage              interface{}  `usage:"CF_NAME scp [-i app-instance-index] [-r] [-q] [--skip-host-validation] APP_NAME:REMOTE_PATH LOCAL_PATH\n   CF_NAME scp [-i app-instance-index] [-r] [-q] [--skip-host-validation] LOCAL_PATH APP_NAME:REMOTE_PATH\n\nEXAMPLES:\n   CF_NAME scp my-app:/home/vcap/app/heap.hprof .\n   CF_NAME scp -i 1 -r ./config my-app:/tmp"`
+	relatedCommands    interface{}  `related_commands:"allow-space-ssh, enable-ssh, ssh"`
+}
+
+func (_ SCPCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ SCPCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
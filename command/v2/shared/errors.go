@@ -187,3 +187,101 @@ func (e StartupTimeoutError) Translate(translate func(string, ...interface{}) st
 		"BinaryName": e.BinaryName,
 	})
 }
+
+type StackNotFoundError struct {
+	Name string
+}
+
+func (e StackNotFoundError) Error() string {
+	return "Stack '{{.Name}}' not found."
+}
+
+func (e StackNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type DomainNotFoundError struct {
+	Name string
+}
+
+func (e DomainNotFoundError) Error() string {
+	return "Domain '{{.Name}}' not found."
+}
+
+func (e DomainNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type AppNotFoundInManifestError struct {
+	Name string
+}
+
+func (e AppNotFoundInManifestError) Error() string {
+	return "Could not find app named '{{.AppName}}' in manifest"
+}
+
+func (e AppNotFoundInManifestError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.Name,
+	})
+}
+
+type CommandLineOptionsWithMultipleAppsError struct{}
+
+func (e CommandLineOptionsWithMultipleAppsError) Error() string {
+	return "Incorrect Usage: Command line flags (except -f) cannot be applied when pushing multiple apps from a manifest file."
+}
+
+func (e CommandLineOptionsWithMultipleAppsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+type ContextNotFoundError struct {
+	Name string
+}
+
+func (e ContextNotFoundError) Error() string {
+	return "Context '{{.Name}}' not found."
+}
+
+func (e ContextNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type SpacesDifferError struct {
+	Source string
+	Target string
+}
+
+func (e SpacesDifferError) Error() string {
+	return "Space {{.Source}} differs from space {{.Target}}."
+}
+
+func (e SpacesDifferError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Source": e.Source,
+		"Target": e.Target,
+	})
+}
+
+type ManifestFileCreationError struct {
+	Path    string
+	Message string
+}
+
+func (e ManifestFileCreationError) Error() string {
+	return "Error creating manifest file {{.Path}}: {{.Message}}"
+}
+
+func (e ManifestFileCreationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
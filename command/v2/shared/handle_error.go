@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 func HandleError(err error) error {
@@ -47,6 +48,23 @@ func HandleError(err error) error {
 		return SpaceNotFoundError{Name: e.Name}
 	case v2action.HTTPHealthCheckInvalidError:
 		return HTTPHealthCheckInvalidError{}
+	case v2action.StackNotFoundError:
+		if e.Name != "" {
+			return StackNotFoundError{Name: e.Name}
+		}
+	case v2action.DomainNotFoundError:
+		if e.Name != "" {
+			return DomainNotFoundError{Name: e.Name}
+		}
+	case v2action.AppNotFoundInManifestError:
+		return AppNotFoundInManifestError{Name: e.Name}
+	case v2action.CommandLineOptionsWithMultipleAppsError:
+		return CommandLineOptionsWithMultipleAppsError{}
+	case v2action.MissingNameError:
+		return command.RequiredArgumentError{ArgumentName: "APP_NAME"}
+
+	case configv3.ContextNotFoundError:
+		return ContextNotFoundError{Name: e.Name}
 	}
 
 	return err
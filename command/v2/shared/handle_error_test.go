@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -85,6 +86,36 @@ var _ = Describe("HandleError", func() {
 			HTTPHealthCheckInvalidError{},
 		),
 
+		Entry("v2action.StackNotFoundError -> StackNotFoundError",
+			v2action.StackNotFoundError{Name: "some-stack"},
+			StackNotFoundError{Name: "some-stack"},
+		),
+
+		Entry("v2action.DomainNotFoundError -> DomainNotFoundError",
+			v2action.DomainNotFoundError{Name: "some-domain"},
+			DomainNotFoundError{Name: "some-domain"},
+		),
+
+		Entry("v2action.AppNotFoundInManifestError -> AppNotFoundInManifestError",
+			v2action.AppNotFoundInManifestError{Name: "some-app"},
+			AppNotFoundInManifestError{Name: "some-app"},
+		),
+
+		Entry("v2action.CommandLineOptionsWithMultipleAppsError -> CommandLineOptionsWithMultipleAppsError",
+			v2action.CommandLineOptionsWithMultipleAppsError{},
+			CommandLineOptionsWithMultipleAppsError{},
+		),
+
+		Entry("v2action.MissingNameError -> RequiredArgumentError",
+			v2action.MissingNameError{},
+			command.RequiredArgumentError{ArgumentName: "APP_NAME"},
+		),
+
+		Entry("configv3.ContextNotFoundError -> ContextNotFoundError",
+			configv3.ContextNotFoundError{Name: "some-context"},
+			ContextNotFoundError{Name: "some-context"},
+		),
+
 		Entry("uaa.InvalidAuthTokenError -> InvalidRefreshTokenError",
 			uaa.InvalidAuthTokenError{},
 			InvalidRefreshTokenError{},
@@ -15,18 +15,23 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 
 	verbose, location := config.Verbose()
 
+	var retryOutputs []ccWrapper.RequestLoggerOutput
 	if verbose {
-		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+		display := ui.RequestLoggerTerminalDisplay()
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(display))
+		retryOutputs = append(retryOutputs, display)
 	}
 
 	if location != nil {
-		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+		writer := ui.RequestLoggerFileWriter(location)
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(writer))
+		retryOutputs = append(retryOutputs, writer)
 	}
 
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequestWithPolicy(ccWrapper.NewRetryPolicy(config), retryOutputs...))
 
 	ccClient := ccv2.NewClient(ccv2.Config{
 		AppName:            config.BinaryName(),
@@ -0,0 +1,34 @@
+package shared
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// NewStructuredOutputClients creates the clients of a command that only
+// displays structured output itself and leaves the table to the legacy
+// command, which it runs through RunLegacyCommand. The clients are nil when
+// structured output was not requested, since the legacy command creates its
+// own.
+func NewStructuredOutputClients(config command.Config, ui command.UI) (*ccv2.Client, *uaa.Client, error) {
+	if !ui.HasStructuredOutput() {
+		return nil, nil, nil
+	}
+
+	return NewClients(config, ui, true)
+}
+
+// RunLegacyCommand runs legacyMain when structured output was not requested
+// and returns true if it did. The legacy entry point is passed in because the
+// legacy commands import this package.
+func RunLegacyCommand(ui command.UI, legacyMain func(traceEnv string, args []string)) bool {
+	if ui.HasStructuredOutput() {
+		return false
+	}
+
+	legacyMain(os.Getenv("CF_TRACE"), os.Args)
+	return true
+}
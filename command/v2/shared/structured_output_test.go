@@ -0,0 +1,63 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Structured Output", func() {
+	var (
+		fakeConfig  *commandfakes.FakeConfig
+		testUI      *ui.UI
+		legacyCalls int
+	)
+
+	legacyMain := func(traceEnv string, args []string) {
+		legacyCalls++
+	}
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		legacyCalls = 0
+	})
+
+	Context("when structured output is not requested", func() {
+		It("does not create any clients", func() {
+			ccClient, uaaClient, err := NewStructuredOutputClients(fakeConfig, testUI)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ccClient).To(BeNil())
+			Expect(uaaClient).To(BeNil())
+			Expect(fakeConfig.TargetCallCount()).To(Equal(0))
+		})
+
+		It("runs the legacy command", func() {
+			Expect(RunLegacyCommand(testUI, legacyMain)).To(BeTrue())
+			Expect(legacyCalls).To(Equal(1))
+		})
+	})
+
+	Context("when structured output is requested", func() {
+		BeforeEach(func() {
+			testUI.OutputFormat = configv3.OutputJSON
+		})
+
+		It("creates the clients", func() {
+			_, _, err := NewStructuredOutputClients(fakeConfig, testUI)
+			Expect(err).To(MatchError(command.NoAPISetError{BinaryName: "faceman"}))
+		})
+
+		It("does not run the legacy command", func() {
+			Expect(RunLegacyCommand(testUI, legacyMain)).To(BeFalse())
+			Expect(legacyCalls).To(Equal(0))
+		})
+	})
+})
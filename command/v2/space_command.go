@@ -28,6 +28,12 @@ type SpaceActorV3 interface {
 	GetIsolationSegmentBySpace(spaceGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
 }
 
+// spaceDocument is the structured output of the space command.
+type spaceDocument struct {
+	v2action.SpaceSummary
+	IsolationSegmentName string `json:"isolation_segment,omitempty"`
+}
+
 type SpaceCommand struct {
 	RequiredArgs       flag.Space  `positional-args:"yes"`
 	GUID               bool        `long:"guid" description:"Retrieve and display the given space's guid.  All other output for the space is suppressed."`
@@ -67,6 +73,10 @@ func (cmd *SpaceCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd SpaceCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd SpaceCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
 
@@ -99,12 +109,14 @@ func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) erro
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting info for space {{.TargetSpace}} in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"TargetSpace": cmd.RequiredArgs.Space,
-		"OrgName":     cmd.Config.TargetedOrganization().Name,
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.UI.HasStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting info for space {{.TargetSpace}} in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"TargetSpace": cmd.RequiredArgs.Space,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "2.74.0")
 	includeStagingSecurityGroupsRules := err == nil
@@ -124,6 +136,7 @@ func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) erro
 		{cmd.UI.TranslateText("security groups:"), strings.Join(spaceSummary.SecurityGroupNames, ", ")},
 	}
 
+	var isolationSegmentName string
 	if cmd.ActorV3 != nil {
 		apiCheck := command.MinimumAPIVersionCheck(cmd.ActorV3.CloudControllerAPIVersion(), "3.11.0")
 		if apiCheck == nil {
@@ -132,6 +145,7 @@ func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) erro
 			if err != nil {
 				return sharedV3.HandleError(err)
 			}
+			isolationSegmentName = isolationSegment.Name
 
 			table = append(table[:4], append([][]string{
 				{cmd.UI.TranslateText("isolation segment:"), isolationSegment.Name},
@@ -139,6 +153,16 @@ func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) erro
 		}
 	}
 
+	if cmd.UI.HasStructuredOutput() {
+		if !displaySecurityGroupRules {
+			spaceSummary.SecurityGroupRules = nil
+		}
+		return cmd.UI.DisplayStructuredData(spaceDocument{
+			SpaceSummary:         spaceSummary,
+			IsolationSegmentName: isolationSegmentName,
+		})
+	}
+
 	cmd.UI.DisplayKeyValueTable("", table, 3)
 
 	if displaySecurityGroupRules {
@@ -222,6 +222,30 @@ var _ = Describe("space Command", func() {
 					Expect(fakeActorV3.GetIsolationSegmentBySpaceCallCount()).To(Equal(1))
 					Expect(fakeActorV3.GetIsolationSegmentBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
 				})
+
+				Context("when structured output is requested", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputJSON
+					})
+
+					It("displays the space summary and isolation segment as a document", func() {
+						Expect(executeErr).To(BeNil())
+
+						Expect(testUI.Out).ToNot(Say("Getting info for space"))
+						Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+							"name": "some-space",
+							"guid": "some-space-guid",
+							"org": "some-org",
+							"apps": ["app1", "app2", "app3"],
+							"services": ["service1", "service2", "service3"],
+							"space_quota": "some-space-quota",
+							"security_groups": ["public_networks", "dns", "load_balancer"],
+							"isolation_segment": "some-isolation-segment"
+						}`))
+						Expect(testUI.Err).To(Say("warning-1"))
+						Expect(testUI.Err).To(Say("v3-warning-2"))
+					})
+				})
 			})
 
 			Context("when v3 api version is below 3.11.0 and the v2 api version is no less than 2.74.0", func() {
@@ -416,5 +440,23 @@ var _ = Describe("space Command", func() {
 			Eventually(testUI.Out).Should(Say("#1\\s+more_public_networks\\s+11.0.0.0-169.253.255.255\\s+54321\\s+udp\\s+staging\\s+More public networks"))
 			Eventually(testUI.Out).Should(Say("(?m)\\s+more_public_networks\\s+11.0.0.0-169.253.255.255\\s+54321\\s+udp\\s+running\\s+More public networks"))
 		})
+
+		Context("when structured output is requested", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputYAML
+			})
+
+			It("includes the security group rules in the document", func() {
+				Expect(executeErr).To(BeNil())
+				Expect(testUI.Out).To(Say(`security_group_rules:
+- security_group: public_networks
+  description: Public networks
+  destination: 0.0.0.0-9.255.255.255
+  lifecycle: staging
+  ports: "12345"
+  protocol: tcp
+`))
+			})
+		})
 	})
 })
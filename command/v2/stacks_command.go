@@ -1,22 +1,80 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . StacksActor
+
+type StacksActor interface {
+	GetStacks() ([]v2action.Stack, v2action.Warnings, error)
+}
+
+// stackDocument is the structured output of a single stack in the stacks
+// command.
+type stackDocument struct {
+	Name        string `json:"name"`
+	GUID        string `json:"guid"`
+	Description string `json:"description"`
+}
+
 type StacksCommand struct {
 	usage           interface{} `usage:"CF_NAME stacks"`
 	relatedCommands interface{} `related_commands:"app, push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       StacksActor
 }
 
-func (_ StacksCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *StacksCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewStructuredOutputClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ StacksCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
-	return nil
+func (cmd StacksCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd StacksCommand) Execute(args []string) error {
+	if shared.RunLegacyCommand(cmd.UI, oldCmd.Main) {
+		return nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	stacks, warnings, err := cmd.Actor.GetStacks()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	documents := []stackDocument{}
+	for _, stack := range stacks {
+		documents = append(documents, stackDocument{
+			Name:        stack.Name,
+			GUID:        stack.GUID,
+			Description: stack.Description,
+		})
+	}
+
+	return cmd.UI.DisplayStructuredData(documents)
 }
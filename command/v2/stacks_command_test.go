@@ -0,0 +1,105 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("stacks Command", func() {
+	var (
+		cmd             StacksCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeStacksActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		testUI.OutputFormat = configv3.OutputJSON
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeStacksActor)
+
+		cmd = StacksCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("supports structured output", func() {
+		Expect(cmd.SupportsStructuredOutput()).To(BeTrue())
+	})
+
+	Context("when structured output is requested", func() {
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeFalse())
+				Expect(checkTargetedSpace).To(BeFalse())
+			})
+		})
+
+		Context("when there are stacks", func() {
+			BeforeEach(func() {
+				fakeActor.GetStacksReturns([]v2action.Stack{
+					{GUID: "stack-guid-1", Name: "cflinuxfs2", Description: "Cloud Foundry Linux-based filesystem"},
+					{GUID: "stack-guid-2", Name: "windows2012R2", Description: "Windows Server 2012 R2"},
+				}, v2action.Warnings{"stacks-warning"}, nil)
+			})
+
+			It("displays the stacks as a document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+					{"name": "cflinuxfs2", "guid": "stack-guid-1", "description": "Cloud Foundry Linux-based filesystem"},
+					{"name": "windows2012R2", "guid": "stack-guid-2", "description": "Windows Server 2012 R2"}
+				]`))
+				Expect(testUI.Err).To(Say("stacks-warning"))
+			})
+		})
+
+		Context("when there are no stacks", func() {
+			It("displays an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[]`))
+			})
+		})
+
+		Context("when getting the stacks fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetStacksReturns(nil, v2action.Warnings{"stacks-warning"}, errors.New("stacks-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("stacks-error"))
+				Expect(testUI.Err).To(Say("stacks-warning"))
+				Expect(testUI.Out.(*Buffer).Contents()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,28 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type UpdatePluginCommand struct {
+	OptionalArgs         flag.UpdatePluginArgs `positional-args:"yes"`
+	All                  bool                  `long:"all" description:"Update every installed plugin that has a newer version available"`
+	RegisteredRepository string                `short:"r" description:"Name of a registered repository to search for the new version"`
+	Version              string                `long:"version" description:"Install the given version instead of the latest one"`
+	Force                bool                  `short:"f" description:"Force update of plugin without confirmation"`
+	usage                interface{}           `usage:"CF_NAME update-plugin (PLUGIN_NAME | --all) [-r REPO_NAME] [--version VERSION] [-f]\n\n   Prompts for confirmation unless '-f' is provided.\n\nEXAMPLES:\n   CF_NAME update-plugin plugin-echo\n   CF_NAME update-plugin plugin-echo -r My-Repo --version 1.2.0\n   CF_NAME update-plugin --all -f"`
+	relatedCommands      interface{}           `related_commands:"install-plugin, plugins, repo-plugins"`
+}
+
+func (_ UpdatePluginCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ UpdatePluginCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
@@ -0,0 +1,43 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+type UseContextCommand struct {
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME use-context CONTEXT_NAME"`
+	relatedCommands interface{}      `related_commands:"contexts, save-context, target"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *UseContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd UseContextCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Switching to context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": cmd.RequiredArgs.ContextName,
+	})
+
+	err := cmd.Config.UseContext(cmd.RequiredArgs.ContextName)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+
+	if active := cmd.Config.ActiveContext(); active != cmd.RequiredArgs.ContextName {
+		cmd.UI.DisplayWarning("CF_CONTEXT is set; this shell will continue to use context {{.ActiveContext}}.", map[string]interface{}{
+			"ActiveContext": active,
+		})
+	}
+
+	return nil
+}
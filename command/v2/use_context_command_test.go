@@ -0,0 +1,75 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("use-context Command", func() {
+	var (
+		cmd        UseContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = UseContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.ContextName = "dev"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the context exists", func() {
+		BeforeEach(func() {
+			fakeConfig.ActiveContextReturns("dev")
+		})
+
+		It("switches to the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Switching to context dev..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).ToNot(Say("CF_CONTEXT"))
+
+			Expect(fakeConfig.UseContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.UseContextArgsForCall(0)).To(Equal("dev"))
+		})
+	})
+
+	Context("when CF_CONTEXT overrides the context", func() {
+		BeforeEach(func() {
+			fakeConfig.ActiveContextReturns("prod")
+		})
+
+		It("warns that the shell keeps using the overriding context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("CF_CONTEXT is set; this shell will continue to use context prod."))
+		})
+	})
+
+	Context("when the context does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.UseContextReturns(configv3.ContextNotFoundError{Name: "dev"})
+		})
+
+		It("returns a ContextNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.ContextNotFoundError{Name: "dev"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeDiffSpacesActor struct {
+	GetSpaceConfigurationByOrganizationAndSpaceNameStub        func(orgName string, spaceName string) (v2action.SpaceConfiguration, v2action.Warnings, error)
+	getSpaceConfigurationByOrganizationAndSpaceNameMutex       sync.RWMutex
+	getSpaceConfigurationByOrganizationAndSpaceNameArgsForCall []struct {
+		orgName   string
+		spaceName string
+	}
+	getSpaceConfigurationByOrganizationAndSpaceNameReturns struct {
+		result1 v2action.SpaceConfiguration
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceConfigurationByOrganizationAndSpaceNameReturnsOnCall map[int]struct {
+		result1 v2action.SpaceConfiguration
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDiffSpacesActor) GetSpaceConfigurationByOrganizationAndSpaceName(orgName string, spaceName string) (v2action.SpaceConfiguration, v2action.Warnings, error) {
+	fake.getSpaceConfigurationByOrganizationAndSpaceNameMutex.Lock()
+	ret, specificReturn := fake.getSpaceConfigurationByOrganizationAndSpaceNameReturnsOnCall[len(fake.getSpaceConfigurationByOrganizationAndSpaceNameArgsForCall)]
+	fake.getSpaceConfigurationByOrganizationAndSpaceNameArgsForCall = append(fake.getSpaceConfigurationByOrganizationAndSpaceNameArgsForCall, struct {
+		orgName   string
+		spaceName string
+	}{orgName, spaceName})
+	fake.recordInvocation("GetSpaceConfigurationByOrganizationAndSpaceName", []interface{}{orgName, spaceName})
+	fake.getSpaceConfigurationByOrganizationAndSpaceNameMutex.Unlock()
+	if fake.GetSpaceConfigurationByOrganizationAndSpaceNameStub != nil {
+		return fake.GetSpaceConfigurationByOrganizationAndSpaceNameStub(orgName, spaceName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceConfigurationByOrganizationAndSpaceNameReturns.result1, fake.getSpaceConfigurationByOrganizationAndSpaceNameReturns.result2, fake.getSpaceConfigurationByOrganizationAndSpaceNameReturns.result3
+}
+
+func (fake *FakeDiffSpacesActor) GetSpaceConfigurationByOrganizationAndSpaceNameCallCount() int {
+	fake.getSpaceConfigurationByOrganizationAndSpaceNameMutex.RLock()
+	defer fake.getSpaceConfigurationByOrganizationAndSpaceNameMutex.RUnlock()
+	return len(fake.getSpaceConfigurationByOrganizationAndSpaceNameArgsForCall)
+}
+
+func (fake *FakeDiffSpacesActor) GetSpaceConfigurationByOrganizationAndSpaceNameArgsForCall(i int) (string, string) {
+	fake.getSpaceConfigurationByOrganizationAndSpaceNameMutex.RLock()
+	defer fake.getSpaceConfigurationByOrganizationAndSpaceNameMutex.RUnlock()
+	return fake.getSpaceConfigurationByOrganizationAndSpaceNameArgsForCall[i].orgName, fake.getSpaceConfigurationByOrganizationAndSpaceNameArgsForCall[i].spaceName
+}
+
+func (fake *FakeDiffSpacesActor) GetSpaceConfigurationByOrganizationAndSpaceNameReturns(result1 v2action.SpaceConfiguration, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceConfigurationByOrganizationAndSpaceNameStub = nil
+	fake.getSpaceConfigurationByOrganizationAndSpaceNameReturns = struct {
+		result1 v2action.SpaceConfiguration
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDiffSpacesActor) GetSpaceConfigurationByOrganizationAndSpaceNameReturnsOnCall(i int, result1 v2action.SpaceConfiguration, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceConfigurationByOrganizationAndSpaceNameStub = nil
+	if fake.getSpaceConfigurationByOrganizationAndSpaceNameReturnsOnCall == nil {
+		fake.getSpaceConfigurationByOrganizationAndSpaceNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.SpaceConfiguration
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceConfigurationByOrganizationAndSpaceNameReturnsOnCall[i] = struct {
+		result1 v2action.SpaceConfiguration
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDiffSpacesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getSpaceConfigurationByOrganizationAndSpaceNameMutex.RLock()
+	defer fake.getSpaceConfigurationByOrganizationAndSpaceNameMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDiffSpacesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.DiffSpacesActor = new(FakeDiffSpacesActor)
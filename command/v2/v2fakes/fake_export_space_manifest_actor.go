@@ -0,0 +1,106 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+type FakeExportSpaceManifestActor struct {
+	GetSpaceManifestApplicationsStub        func(spaceGUID string) ([]manifest.Application, v2action.Warnings, error)
+	getSpaceManifestApplicationsMutex       sync.RWMutex
+	getSpaceManifestApplicationsArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceManifestApplicationsReturns struct {
+		result1 []manifest.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceManifestApplicationsReturnsOnCall map[int]struct {
+		result1 []manifest.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeExportSpaceManifestActor) GetSpaceManifestApplications(spaceGUID string) ([]manifest.Application, v2action.Warnings, error) {
+	fake.getSpaceManifestApplicationsMutex.Lock()
+	ret, specificReturn := fake.getSpaceManifestApplicationsReturnsOnCall[len(fake.getSpaceManifestApplicationsArgsForCall)]
+	fake.getSpaceManifestApplicationsArgsForCall = append(fake.getSpaceManifestApplicationsArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceManifestApplications", []interface{}{spaceGUID})
+	fake.getSpaceManifestApplicationsMutex.Unlock()
+	if fake.GetSpaceManifestApplicationsStub != nil {
+		return fake.GetSpaceManifestApplicationsStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceManifestApplicationsReturns.result1, fake.getSpaceManifestApplicationsReturns.result2, fake.getSpaceManifestApplicationsReturns.result3
+}
+
+func (fake *FakeExportSpaceManifestActor) GetSpaceManifestApplicationsCallCount() int {
+	fake.getSpaceManifestApplicationsMutex.RLock()
+	defer fake.getSpaceManifestApplicationsMutex.RUnlock()
+	return len(fake.getSpaceManifestApplicationsArgsForCall)
+}
+
+func (fake *FakeExportSpaceManifestActor) GetSpaceManifestApplicationsArgsForCall(i int) string {
+	fake.getSpaceManifestApplicationsMutex.RLock()
+	defer fake.getSpaceManifestApplicationsMutex.RUnlock()
+	return fake.getSpaceManifestApplicationsArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeExportSpaceManifestActor) GetSpaceManifestApplicationsReturns(result1 []manifest.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceManifestApplicationsStub = nil
+	fake.getSpaceManifestApplicationsReturns = struct {
+		result1 []manifest.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportSpaceManifestActor) GetSpaceManifestApplicationsReturnsOnCall(i int, result1 []manifest.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceManifestApplicationsStub = nil
+	if fake.getSpaceManifestApplicationsReturnsOnCall == nil {
+		fake.getSpaceManifestApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []manifest.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceManifestApplicationsReturnsOnCall[i] = struct {
+		result1 []manifest.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeExportSpaceManifestActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getSpaceManifestApplicationsMutex.RLock()
+	defer fake.getSpaceManifestApplicationsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeExportSpaceManifestActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.ExportSpaceManifestActor = new(FakeExportSpaceManifestActor)
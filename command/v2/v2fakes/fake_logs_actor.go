@@ -9,11 +9,12 @@ import (
 )
 
 type FakeLogsActor struct {
-	GetRecentLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
+	GetRecentLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
 	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
 		appName   string
 		spaceGUID string
+		filter    v2action.LogFilter
 		client    v2action.NOAAClient
 		config    v2action.Config
 	}
@@ -27,11 +28,12 @@ type FakeLogsActor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
-	GetStreamingLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetStreamingLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
 	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
 		appName   string
 		spaceGUID string
+		filter    v2action.LogFilter
 		client    v2action.NOAAClient
 		config    v2action.Config
 	}
@@ -47,23 +49,65 @@ type FakeLogsActor struct {
 		result3 v2action.Warnings
 		result4 error
 	}
+	GetStreamingLogsForApplicationsByNameAndSpaceStub        func(appNames []string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	getStreamingLogsForApplicationsByNameAndSpaceMutex       sync.RWMutex
+	getStreamingLogsForApplicationsByNameAndSpaceArgsForCall []struct {
+		appNames  []string
+		spaceGUID string
+		filter    v2action.LogFilter
+		client    v2action.NOAAClient
+		config    v2action.Config
+	}
+	getStreamingLogsForApplicationsByNameAndSpaceReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	GetStreamingLogsForSpaceStub        func(spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	getStreamingLogsForSpaceMutex       sync.RWMutex
+	getStreamingLogsForSpaceArgsForCall []struct {
+		spaceGUID string
+		filter    v2action.LogFilter
+		client    v2action.NOAAClient
+		config    v2action.Config
+	}
+	getStreamingLogsForSpaceReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	getStreamingLogsForSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error) {
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)]
 	fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
 		appName   string
 		spaceGUID string
+		filter    v2action.LogFilter
 		client    v2action.NOAAClient
 		config    v2action.Config
-	}{appName, spaceGUID, client, config})
-	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{appName, spaceGUID, client, config})
+	}{appName, spaceGUID, filter, client, config})
+	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{appName, spaceGUID, filter, client, config})
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
 	if fake.GetRecentLogsForApplicationByNameAndSpaceStub != nil {
-		return fake.GetRecentLogsForApplicationByNameAndSpaceStub(appName, spaceGUID, client, config)
+		return fake.GetRecentLogsForApplicationByNameAndSpaceStub(appName, spaceGUID, filter, client, config)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -77,10 +121,10 @@ func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceCallCount()
 	return len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.NOAAClient, v2action.Config) {
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.LogFilter, v2action.NOAAClient, v2action.Config) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
-	return fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].appName, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].spaceGUID, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].client, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].config
+	return fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].appName, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].spaceGUID, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].filter, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].client, fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i].config
 }
 
 func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceReturns(result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
@@ -108,19 +152,20 @@ func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCal
 	}{result1, result2, result3}
 }
 
-func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
 	fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall, struct {
 		appName   string
 		spaceGUID string
+		filter    v2action.LogFilter
 		client    v2action.NOAAClient
 		config    v2action.Config
-	}{appName, spaceGUID, client, config})
-	fake.recordInvocation("GetStreamingLogsForApplicationByNameAndSpace", []interface{}{appName, spaceGUID, client, config})
+	}{appName, spaceGUID, filter, client, config})
+	fake.recordInvocation("GetStreamingLogsForApplicationByNameAndSpace", []interface{}{appName, spaceGUID, filter, client, config})
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
 	if fake.GetStreamingLogsForApplicationByNameAndSpaceStub != nil {
-		return fake.GetStreamingLogsForApplicationByNameAndSpaceStub(appName, spaceGUID, client, config)
+		return fake.GetStreamingLogsForApplicationByNameAndSpaceStub(appName, spaceGUID, filter, client, config)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3, ret.result4
@@ -134,10 +179,10 @@ func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceCallCount
 	return len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.NOAAClient, v2action.Config) {
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.LogFilter, v2action.NOAAClient, v2action.Config) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
-	return fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].appName, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].spaceGUID, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].client, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].config
+	return fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].appName, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].spaceGUID, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].filter, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].client, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].config
 }
 
 func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
@@ -168,6 +213,132 @@ func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOn
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+	var appNamesCopy []string
+	if appNames != nil {
+		appNamesCopy = make([]string, len(appNames))
+		copy(appNamesCopy, appNames)
+	}
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall)]
+	fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall = append(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall, struct {
+		appNames  []string
+		spaceGUID string
+		filter    v2action.LogFilter
+		client    v2action.NOAAClient
+		config    v2action.Config
+	}{appNamesCopy, spaceGUID, filter, client, config})
+	fake.recordInvocation("GetStreamingLogsForApplicationsByNameAndSpace", []interface{}{appNamesCopy, spaceGUID, filter, client, config})
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Unlock()
+	if fake.GetStreamingLogsForApplicationsByNameAndSpaceStub != nil {
+		return fake.GetStreamingLogsForApplicationsByNameAndSpaceStub(appNames, spaceGUID, filter, client, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result1, fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result2, fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result3, fake.getStreamingLogsForApplicationsByNameAndSpaceReturns.result4
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceCallCount() int {
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceArgsForCall(i int) ([]string, string, v2action.LogFilter, v2action.NOAAClient, v2action.Config) {
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	return fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].appNames, fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].spaceGUID, fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].filter, fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].client, fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i].config
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForApplicationsByNameAndSpaceStub = nil
+	fake.getStreamingLogsForApplicationsByNameAndSpaceReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForApplicationsByNameAndSpaceStub = nil
+	if fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpace(spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+	fake.getStreamingLogsForSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForSpaceReturnsOnCall[len(fake.getStreamingLogsForSpaceArgsForCall)]
+	fake.getStreamingLogsForSpaceArgsForCall = append(fake.getStreamingLogsForSpaceArgsForCall, struct {
+		spaceGUID string
+		filter    v2action.LogFilter
+		client    v2action.NOAAClient
+		config    v2action.Config
+	}{spaceGUID, filter, client, config})
+	fake.recordInvocation("GetStreamingLogsForSpace", []interface{}{spaceGUID, filter, client, config})
+	fake.getStreamingLogsForSpaceMutex.Unlock()
+	if fake.GetStreamingLogsForSpaceStub != nil {
+		return fake.GetStreamingLogsForSpaceStub(spaceGUID, filter, client, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.getStreamingLogsForSpaceReturns.result1, fake.getStreamingLogsForSpaceReturns.result2, fake.getStreamingLogsForSpaceReturns.result3, fake.getStreamingLogsForSpaceReturns.result4
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceCallCount() int {
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceArgsForCall(i int) (string, v2action.LogFilter, v2action.NOAAClient, v2action.Config) {
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
+	return fake.getStreamingLogsForSpaceArgsForCall[i].spaceGUID, fake.getStreamingLogsForSpaceArgsForCall[i].filter, fake.getStreamingLogsForSpaceArgsForCall[i].client, fake.getStreamingLogsForSpaceArgsForCall[i].config
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForSpaceStub = nil
+	fake.getStreamingLogsForSpaceReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForSpaceReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForSpaceStub = nil
+	if fake.getStreamingLogsForSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeLogsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -175,6 +346,10 @@ func (fake *FakeLogsActor) Invocations() map[string][][]interface{} {
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForSpaceMutex.RLock()
+	defer fake.getStreamingLogsForSpaceMutex.RUnlock()
 	return fake.invocations
 }
 
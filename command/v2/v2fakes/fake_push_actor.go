@@ -0,0 +1,837 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+type FakePushActor struct {
+	BindServicesStub        func(config v2action.ApplicationConfig) (v2action.ApplicationConfig, v2action.Warnings, error)
+	bindServicesMutex       sync.RWMutex
+	bindServicesArgsForCall []struct {
+		config v2action.ApplicationConfig
+	}
+	bindServicesReturns struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}
+	bindServicesReturnsOnCall map[int]struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	cloudControllerAPIVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ConvertToApplicationConfigsStub        func(orgGUID string, spaceGUID string, settings v2action.PushSettings, apps []manifest.Application) ([]v2action.ApplicationConfig, v2action.Warnings, error)
+	convertToApplicationConfigsMutex       sync.RWMutex
+	convertToApplicationConfigsArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+		settings  v2action.PushSettings
+		apps      []manifest.Application
+	}
+	convertToApplicationConfigsReturns struct {
+		result1 []v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}
+	convertToApplicationConfigsReturnsOnCall map[int]struct {
+		result1 []v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}
+	CreateOrUpdateApplicationStub        func(config v2action.ApplicationConfig) (v2action.ApplicationConfig, v2action.Warnings, error)
+	createOrUpdateApplicationMutex       sync.RWMutex
+	createOrUpdateApplicationArgsForCall []struct {
+		config v2action.ApplicationConfig
+	}
+	createOrUpdateApplicationReturns struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}
+	createOrUpdateApplicationReturnsOnCall map[int]struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationSummaryByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.ApplicationSummary, v2action.Warnings, error)
+	getApplicationSummaryByNameAndSpaceMutex       sync.RWMutex
+	getApplicationSummaryByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationSummaryByNameAndSpaceReturns struct {
+		result1 v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationSummaryByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	MapRoutesStub        func(config v2action.ApplicationConfig) (v2action.ApplicationConfig, v2action.Warnings, error)
+	mapRoutesMutex       sync.RWMutex
+	mapRoutesArgsForCall []struct {
+		config v2action.ApplicationConfig
+	}
+	mapRoutesReturns struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}
+	mapRoutesReturnsOnCall map[int]struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}
+	RollbackBlueGreenApplicationsStub        func(oldConfig v2action.ApplicationConfig, newConfig v2action.ApplicationConfig, stage v2action.BlueGreenStage) (v2action.Warnings, error)
+	rollbackBlueGreenApplicationsMutex       sync.RWMutex
+	rollbackBlueGreenApplicationsArgsForCall []struct {
+		oldConfig v2action.ApplicationConfig
+		newConfig v2action.ApplicationConfig
+		stage     v2action.BlueGreenStage
+	}
+	rollbackBlueGreenApplicationsReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	rollbackBlueGreenApplicationsReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	StartApplicationStub        func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+	startApplicationMutex       sync.RWMutex
+	startApplicationArgsForCall []struct {
+		app    v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}
+	startApplicationReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}
+	startApplicationReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}
+	StartApplicationAndWaitForAllInstancesStub        func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error)
+	startApplicationAndWaitForAllInstancesMutex       sync.RWMutex
+	startApplicationAndWaitForAllInstancesArgsForCall []struct {
+		app    v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}
+	startApplicationAndWaitForAllInstancesReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}
+	startApplicationAndWaitForAllInstancesReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}
+	SwapBlueGreenApplicationsStub        func(oldConfig v2action.ApplicationConfig, newConfig v2action.ApplicationConfig, keepOldApp bool) (v2action.BlueGreenStage, v2action.Warnings, error)
+	swapBlueGreenApplicationsMutex       sync.RWMutex
+	swapBlueGreenApplicationsArgsForCall []struct {
+		oldConfig  v2action.ApplicationConfig
+		newConfig  v2action.ApplicationConfig
+		keepOldApp bool
+	}
+	swapBlueGreenApplicationsReturns struct {
+		result1 v2action.BlueGreenStage
+		result2 v2action.Warnings
+		result3 error
+	}
+	swapBlueGreenApplicationsReturnsOnCall map[int]struct {
+		result1 v2action.BlueGreenStage
+		result2 v2action.Warnings
+		result3 error
+	}
+	UploadApplicationStub        func(appGUID string, path string) (v2action.Warnings, error)
+	uploadApplicationMutex       sync.RWMutex
+	uploadApplicationArgsForCall []struct {
+		appGUID string
+		path    string
+	}
+	uploadApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	uploadApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePushActor) BindServices(config v2action.ApplicationConfig) (v2action.ApplicationConfig, v2action.Warnings, error) {
+	fake.bindServicesMutex.Lock()
+	ret, specificReturn := fake.bindServicesReturnsOnCall[len(fake.bindServicesArgsForCall)]
+	fake.bindServicesArgsForCall = append(fake.bindServicesArgsForCall, struct {
+		config v2action.ApplicationConfig
+	}{config})
+	fake.recordInvocation("BindServices", []interface{}{config})
+	fake.bindServicesMutex.Unlock()
+	if fake.BindServicesStub != nil {
+		return fake.BindServicesStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.bindServicesReturns.result1, fake.bindServicesReturns.result2, fake.bindServicesReturns.result3
+}
+
+func (fake *FakePushActor) BindServicesCallCount() int {
+	fake.bindServicesMutex.RLock()
+	defer fake.bindServicesMutex.RUnlock()
+	return len(fake.bindServicesArgsForCall)
+}
+
+func (fake *FakePushActor) BindServicesArgsForCall(i int) v2action.ApplicationConfig {
+	fake.bindServicesMutex.RLock()
+	defer fake.bindServicesMutex.RUnlock()
+	return fake.bindServicesArgsForCall[i].config
+}
+
+func (fake *FakePushActor) BindServicesReturns(result1 v2action.ApplicationConfig, result2 v2action.Warnings, result3 error) {
+	fake.BindServicesStub = nil
+	fake.bindServicesReturns = struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) BindServicesReturnsOnCall(i int, result1 v2action.ApplicationConfig, result2 v2action.Warnings, result3 error) {
+	fake.BindServicesStub = nil
+	if fake.bindServicesReturnsOnCall == nil {
+		fake.bindServicesReturnsOnCall = make(map[int]struct {
+			result1 v2action.ApplicationConfig
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.bindServicesReturnsOnCall[i] = struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cloudControllerAPIVersionReturns.result1
+}
+
+func (fake *FakePushActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakePushActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePushActor) CloudControllerAPIVersionReturnsOnCall(i int, result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	if fake.cloudControllerAPIVersionReturnsOnCall == nil {
+		fake.cloudControllerAPIVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.cloudControllerAPIVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePushActor) ConvertToApplicationConfigs(orgGUID string, spaceGUID string, settings v2action.PushSettings, apps []manifest.Application) ([]v2action.ApplicationConfig, v2action.Warnings, error) {
+	var appsCopy []manifest.Application
+	if apps != nil {
+		appsCopy = make([]manifest.Application, len(apps))
+		copy(appsCopy, apps)
+	}
+	fake.convertToApplicationConfigsMutex.Lock()
+	ret, specificReturn := fake.convertToApplicationConfigsReturnsOnCall[len(fake.convertToApplicationConfigsArgsForCall)]
+	fake.convertToApplicationConfigsArgsForCall = append(fake.convertToApplicationConfigsArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+		settings  v2action.PushSettings
+		apps      []manifest.Application
+	}{orgGUID, spaceGUID, settings, appsCopy})
+	fake.recordInvocation("ConvertToApplicationConfigs", []interface{}{orgGUID, spaceGUID, settings, appsCopy})
+	fake.convertToApplicationConfigsMutex.Unlock()
+	if fake.ConvertToApplicationConfigsStub != nil {
+		return fake.ConvertToApplicationConfigsStub(orgGUID, spaceGUID, settings, apps)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.convertToApplicationConfigsReturns.result1, fake.convertToApplicationConfigsReturns.result2, fake.convertToApplicationConfigsReturns.result3
+}
+
+func (fake *FakePushActor) ConvertToApplicationConfigsCallCount() int {
+	fake.convertToApplicationConfigsMutex.RLock()
+	defer fake.convertToApplicationConfigsMutex.RUnlock()
+	return len(fake.convertToApplicationConfigsArgsForCall)
+}
+
+func (fake *FakePushActor) ConvertToApplicationConfigsArgsForCall(i int) (string, string, v2action.PushSettings, []manifest.Application) {
+	fake.convertToApplicationConfigsMutex.RLock()
+	defer fake.convertToApplicationConfigsMutex.RUnlock()
+	return fake.convertToApplicationConfigsArgsForCall[i].orgGUID, fake.convertToApplicationConfigsArgsForCall[i].spaceGUID, fake.convertToApplicationConfigsArgsForCall[i].settings, fake.convertToApplicationConfigsArgsForCall[i].apps
+}
+
+func (fake *FakePushActor) ConvertToApplicationConfigsReturns(result1 []v2action.ApplicationConfig, result2 v2action.Warnings, result3 error) {
+	fake.ConvertToApplicationConfigsStub = nil
+	fake.convertToApplicationConfigsReturns = struct {
+		result1 []v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) ConvertToApplicationConfigsReturnsOnCall(i int, result1 []v2action.ApplicationConfig, result2 v2action.Warnings, result3 error) {
+	fake.ConvertToApplicationConfigsStub = nil
+	if fake.convertToApplicationConfigsReturnsOnCall == nil {
+		fake.convertToApplicationConfigsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ApplicationConfig
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.convertToApplicationConfigsReturnsOnCall[i] = struct {
+		result1 []v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) CreateOrUpdateApplication(config v2action.ApplicationConfig) (v2action.ApplicationConfig, v2action.Warnings, error) {
+	fake.createOrUpdateApplicationMutex.Lock()
+	ret, specificReturn := fake.createOrUpdateApplicationReturnsOnCall[len(fake.createOrUpdateApplicationArgsForCall)]
+	fake.createOrUpdateApplicationArgsForCall = append(fake.createOrUpdateApplicationArgsForCall, struct {
+		config v2action.ApplicationConfig
+	}{config})
+	fake.recordInvocation("CreateOrUpdateApplication", []interface{}{config})
+	fake.createOrUpdateApplicationMutex.Unlock()
+	if fake.CreateOrUpdateApplicationStub != nil {
+		return fake.CreateOrUpdateApplicationStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.createOrUpdateApplicationReturns.result1, fake.createOrUpdateApplicationReturns.result2, fake.createOrUpdateApplicationReturns.result3
+}
+
+func (fake *FakePushActor) CreateOrUpdateApplicationCallCount() int {
+	fake.createOrUpdateApplicationMutex.RLock()
+	defer fake.createOrUpdateApplicationMutex.RUnlock()
+	return len(fake.createOrUpdateApplicationArgsForCall)
+}
+
+func (fake *FakePushActor) CreateOrUpdateApplicationArgsForCall(i int) v2action.ApplicationConfig {
+	fake.createOrUpdateApplicationMutex.RLock()
+	defer fake.createOrUpdateApplicationMutex.RUnlock()
+	return fake.createOrUpdateApplicationArgsForCall[i].config
+}
+
+func (fake *FakePushActor) CreateOrUpdateApplicationReturns(result1 v2action.ApplicationConfig, result2 v2action.Warnings, result3 error) {
+	fake.CreateOrUpdateApplicationStub = nil
+	fake.createOrUpdateApplicationReturns = struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) CreateOrUpdateApplicationReturnsOnCall(i int, result1 v2action.ApplicationConfig, result2 v2action.Warnings, result3 error) {
+	fake.CreateOrUpdateApplicationStub = nil
+	if fake.createOrUpdateApplicationReturnsOnCall == nil {
+		fake.createOrUpdateApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.ApplicationConfig
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.createOrUpdateApplicationReturnsOnCall[i] = struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) GetApplicationSummaryByNameAndSpace(name string, spaceGUID string) (v2action.ApplicationSummary, v2action.Warnings, error) {
+	fake.getApplicationSummaryByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationSummaryByNameAndSpaceReturnsOnCall[len(fake.getApplicationSummaryByNameAndSpaceArgsForCall)]
+	fake.getApplicationSummaryByNameAndSpaceArgsForCall = append(fake.getApplicationSummaryByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationSummaryByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationSummaryByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationSummaryByNameAndSpaceStub != nil {
+		return fake.GetApplicationSummaryByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationSummaryByNameAndSpaceReturns.result1, fake.getApplicationSummaryByNameAndSpaceReturns.result2, fake.getApplicationSummaryByNameAndSpaceReturns.result3
+}
+
+func (fake *FakePushActor) GetApplicationSummaryByNameAndSpaceCallCount() int {
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationSummaryByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakePushActor) GetApplicationSummaryByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationSummaryByNameAndSpaceArgsForCall[i].name, fake.getApplicationSummaryByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakePushActor) GetApplicationSummaryByNameAndSpaceReturns(result1 v2action.ApplicationSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationSummaryByNameAndSpaceStub = nil
+	fake.getApplicationSummaryByNameAndSpaceReturns = struct {
+		result1 v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) GetApplicationSummaryByNameAndSpaceReturnsOnCall(i int, result1 v2action.ApplicationSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationSummaryByNameAndSpaceStub = nil
+	if fake.getApplicationSummaryByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationSummaryByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ApplicationSummary
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSummaryByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) MapRoutes(config v2action.ApplicationConfig) (v2action.ApplicationConfig, v2action.Warnings, error) {
+	fake.mapRoutesMutex.Lock()
+	ret, specificReturn := fake.mapRoutesReturnsOnCall[len(fake.mapRoutesArgsForCall)]
+	fake.mapRoutesArgsForCall = append(fake.mapRoutesArgsForCall, struct {
+		config v2action.ApplicationConfig
+	}{config})
+	fake.recordInvocation("MapRoutes", []interface{}{config})
+	fake.mapRoutesMutex.Unlock()
+	if fake.MapRoutesStub != nil {
+		return fake.MapRoutesStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.mapRoutesReturns.result1, fake.mapRoutesReturns.result2, fake.mapRoutesReturns.result3
+}
+
+func (fake *FakePushActor) MapRoutesCallCount() int {
+	fake.mapRoutesMutex.RLock()
+	defer fake.mapRoutesMutex.RUnlock()
+	return len(fake.mapRoutesArgsForCall)
+}
+
+func (fake *FakePushActor) MapRoutesArgsForCall(i int) v2action.ApplicationConfig {
+	fake.mapRoutesMutex.RLock()
+	defer fake.mapRoutesMutex.RUnlock()
+	return fake.mapRoutesArgsForCall[i].config
+}
+
+func (fake *FakePushActor) MapRoutesReturns(result1 v2action.ApplicationConfig, result2 v2action.Warnings, result3 error) {
+	fake.MapRoutesStub = nil
+	fake.mapRoutesReturns = struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) MapRoutesReturnsOnCall(i int, result1 v2action.ApplicationConfig, result2 v2action.Warnings, result3 error) {
+	fake.MapRoutesStub = nil
+	if fake.mapRoutesReturnsOnCall == nil {
+		fake.mapRoutesReturnsOnCall = make(map[int]struct {
+			result1 v2action.ApplicationConfig
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.mapRoutesReturnsOnCall[i] = struct {
+		result1 v2action.ApplicationConfig
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) RollbackBlueGreenApplications(oldConfig v2action.ApplicationConfig, newConfig v2action.ApplicationConfig, stage v2action.BlueGreenStage) (v2action.Warnings, error) {
+	fake.rollbackBlueGreenApplicationsMutex.Lock()
+	ret, specificReturn := fake.rollbackBlueGreenApplicationsReturnsOnCall[len(fake.rollbackBlueGreenApplicationsArgsForCall)]
+	fake.rollbackBlueGreenApplicationsArgsForCall = append(fake.rollbackBlueGreenApplicationsArgsForCall, struct {
+		oldConfig v2action.ApplicationConfig
+		newConfig v2action.ApplicationConfig
+		stage     v2action.BlueGreenStage
+	}{oldConfig, newConfig, stage})
+	fake.recordInvocation("RollbackBlueGreenApplications", []interface{}{oldConfig, newConfig, stage})
+	fake.rollbackBlueGreenApplicationsMutex.Unlock()
+	if fake.RollbackBlueGreenApplicationsStub != nil {
+		return fake.RollbackBlueGreenApplicationsStub(oldConfig, newConfig, stage)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.rollbackBlueGreenApplicationsReturns.result1, fake.rollbackBlueGreenApplicationsReturns.result2
+}
+
+func (fake *FakePushActor) RollbackBlueGreenApplicationsCallCount() int {
+	fake.rollbackBlueGreenApplicationsMutex.RLock()
+	defer fake.rollbackBlueGreenApplicationsMutex.RUnlock()
+	return len(fake.rollbackBlueGreenApplicationsArgsForCall)
+}
+
+func (fake *FakePushActor) RollbackBlueGreenApplicationsArgsForCall(i int) (v2action.ApplicationConfig, v2action.ApplicationConfig, v2action.BlueGreenStage) {
+	fake.rollbackBlueGreenApplicationsMutex.RLock()
+	defer fake.rollbackBlueGreenApplicationsMutex.RUnlock()
+	return fake.rollbackBlueGreenApplicationsArgsForCall[i].oldConfig, fake.rollbackBlueGreenApplicationsArgsForCall[i].newConfig, fake.rollbackBlueGreenApplicationsArgsForCall[i].stage
+}
+
+func (fake *FakePushActor) RollbackBlueGreenApplicationsReturns(result1 v2action.Warnings, result2 error) {
+	fake.RollbackBlueGreenApplicationsStub = nil
+	fake.rollbackBlueGreenApplicationsReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushActor) RollbackBlueGreenApplicationsReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.RollbackBlueGreenApplicationsStub = nil
+	if fake.rollbackBlueGreenApplicationsReturnsOnCall == nil {
+		fake.rollbackBlueGreenApplicationsReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.rollbackBlueGreenApplicationsReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushActor) StartApplication(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+	fake.startApplicationMutex.Lock()
+	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
+	fake.startApplicationArgsForCall = append(fake.startApplicationArgsForCall, struct {
+		app    v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}{app, client, config})
+	fake.recordInvocation("StartApplication", []interface{}{app, client, config})
+	fake.startApplicationMutex.Unlock()
+	if fake.StartApplicationStub != nil {
+		return fake.StartApplicationStub(app, client, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	return fake.startApplicationReturns.result1, fake.startApplicationReturns.result2, fake.startApplicationReturns.result3, fake.startApplicationReturns.result4, fake.startApplicationReturns.result5
+}
+
+func (fake *FakePushActor) StartApplicationCallCount() int {
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	return len(fake.startApplicationArgsForCall)
+}
+
+func (fake *FakePushActor) StartApplicationArgsForCall(i int) (v2action.Application, v2action.NOAAClient, v2action.Config) {
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	return fake.startApplicationArgsForCall[i].app, fake.startApplicationArgsForCall[i].client, fake.startApplicationArgsForCall[i].config
+}
+
+func (fake *FakePushActor) StartApplicationReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan bool, result4 <-chan string, result5 <-chan error) {
+	fake.StartApplicationStub = nil
+	fake.startApplicationReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakePushActor) StartApplicationReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan bool, result4 <-chan string, result5 <-chan error) {
+	fake.StartApplicationStub = nil
+	if fake.startApplicationReturnsOnCall == nil {
+		fake.startApplicationReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 <-chan bool
+			result4 <-chan string
+			result5 <-chan error
+		})
+	}
+	fake.startApplicationReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakePushActor) StartApplicationAndWaitForAllInstances(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan bool, <-chan string, <-chan error) {
+	fake.startApplicationAndWaitForAllInstancesMutex.Lock()
+	ret, specificReturn := fake.startApplicationAndWaitForAllInstancesReturnsOnCall[len(fake.startApplicationAndWaitForAllInstancesArgsForCall)]
+	fake.startApplicationAndWaitForAllInstancesArgsForCall = append(fake.startApplicationAndWaitForAllInstancesArgsForCall, struct {
+		app    v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}{app, client, config})
+	fake.recordInvocation("StartApplicationAndWaitForAllInstances", []interface{}{app, client, config})
+	fake.startApplicationAndWaitForAllInstancesMutex.Unlock()
+	if fake.StartApplicationAndWaitForAllInstancesStub != nil {
+		return fake.StartApplicationAndWaitForAllInstancesStub(app, client, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	return fake.startApplicationAndWaitForAllInstancesReturns.result1, fake.startApplicationAndWaitForAllInstancesReturns.result2, fake.startApplicationAndWaitForAllInstancesReturns.result3, fake.startApplicationAndWaitForAllInstancesReturns.result4, fake.startApplicationAndWaitForAllInstancesReturns.result5
+}
+
+func (fake *FakePushActor) StartApplicationAndWaitForAllInstancesCallCount() int {
+	fake.startApplicationAndWaitForAllInstancesMutex.RLock()
+	defer fake.startApplicationAndWaitForAllInstancesMutex.RUnlock()
+	return len(fake.startApplicationAndWaitForAllInstancesArgsForCall)
+}
+
+func (fake *FakePushActor) StartApplicationAndWaitForAllInstancesArgsForCall(i int) (v2action.Application, v2action.NOAAClient, v2action.Config) {
+	fake.startApplicationAndWaitForAllInstancesMutex.RLock()
+	defer fake.startApplicationAndWaitForAllInstancesMutex.RUnlock()
+	return fake.startApplicationAndWaitForAllInstancesArgsForCall[i].app, fake.startApplicationAndWaitForAllInstancesArgsForCall[i].client, fake.startApplicationAndWaitForAllInstancesArgsForCall[i].config
+}
+
+func (fake *FakePushActor) StartApplicationAndWaitForAllInstancesReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan bool, result4 <-chan string, result5 <-chan error) {
+	fake.StartApplicationAndWaitForAllInstancesStub = nil
+	fake.startApplicationAndWaitForAllInstancesReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakePushActor) StartApplicationAndWaitForAllInstancesReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 <-chan bool, result4 <-chan string, result5 <-chan error) {
+	fake.StartApplicationAndWaitForAllInstancesStub = nil
+	if fake.startApplicationAndWaitForAllInstancesReturnsOnCall == nil {
+		fake.startApplicationAndWaitForAllInstancesReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 <-chan bool
+			result4 <-chan string
+			result5 <-chan error
+		})
+	}
+	fake.startApplicationAndWaitForAllInstancesReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 <-chan bool
+		result4 <-chan string
+		result5 <-chan error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakePushActor) SwapBlueGreenApplications(oldConfig v2action.ApplicationConfig, newConfig v2action.ApplicationConfig, keepOldApp bool) (v2action.BlueGreenStage, v2action.Warnings, error) {
+	fake.swapBlueGreenApplicationsMutex.Lock()
+	ret, specificReturn := fake.swapBlueGreenApplicationsReturnsOnCall[len(fake.swapBlueGreenApplicationsArgsForCall)]
+	fake.swapBlueGreenApplicationsArgsForCall = append(fake.swapBlueGreenApplicationsArgsForCall, struct {
+		oldConfig  v2action.ApplicationConfig
+		newConfig  v2action.ApplicationConfig
+		keepOldApp bool
+	}{oldConfig, newConfig, keepOldApp})
+	fake.recordInvocation("SwapBlueGreenApplications", []interface{}{oldConfig, newConfig, keepOldApp})
+	fake.swapBlueGreenApplicationsMutex.Unlock()
+	if fake.SwapBlueGreenApplicationsStub != nil {
+		return fake.SwapBlueGreenApplicationsStub(oldConfig, newConfig, keepOldApp)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.swapBlueGreenApplicationsReturns.result1, fake.swapBlueGreenApplicationsReturns.result2, fake.swapBlueGreenApplicationsReturns.result3
+}
+
+func (fake *FakePushActor) SwapBlueGreenApplicationsCallCount() int {
+	fake.swapBlueGreenApplicationsMutex.RLock()
+	defer fake.swapBlueGreenApplicationsMutex.RUnlock()
+	return len(fake.swapBlueGreenApplicationsArgsForCall)
+}
+
+func (fake *FakePushActor) SwapBlueGreenApplicationsArgsForCall(i int) (v2action.ApplicationConfig, v2action.ApplicationConfig, bool) {
+	fake.swapBlueGreenApplicationsMutex.RLock()
+	defer fake.swapBlueGreenApplicationsMutex.RUnlock()
+	return fake.swapBlueGreenApplicationsArgsForCall[i].oldConfig, fake.swapBlueGreenApplicationsArgsForCall[i].newConfig, fake.swapBlueGreenApplicationsArgsForCall[i].keepOldApp
+}
+
+func (fake *FakePushActor) SwapBlueGreenApplicationsReturns(result1 v2action.BlueGreenStage, result2 v2action.Warnings, result3 error) {
+	fake.SwapBlueGreenApplicationsStub = nil
+	fake.swapBlueGreenApplicationsReturns = struct {
+		result1 v2action.BlueGreenStage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) SwapBlueGreenApplicationsReturnsOnCall(i int, result1 v2action.BlueGreenStage, result2 v2action.Warnings, result3 error) {
+	fake.SwapBlueGreenApplicationsStub = nil
+	if fake.swapBlueGreenApplicationsReturnsOnCall == nil {
+		fake.swapBlueGreenApplicationsReturnsOnCall = make(map[int]struct {
+			result1 v2action.BlueGreenStage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.swapBlueGreenApplicationsReturnsOnCall[i] = struct {
+		result1 v2action.BlueGreenStage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) UploadApplication(appGUID string, path string) (v2action.Warnings, error) {
+	fake.uploadApplicationMutex.Lock()
+	ret, specificReturn := fake.uploadApplicationReturnsOnCall[len(fake.uploadApplicationArgsForCall)]
+	fake.uploadApplicationArgsForCall = append(fake.uploadApplicationArgsForCall, struct {
+		appGUID string
+		path    string
+	}{appGUID, path})
+	fake.recordInvocation("UploadApplication", []interface{}{appGUID, path})
+	fake.uploadApplicationMutex.Unlock()
+	if fake.UploadApplicationStub != nil {
+		return fake.UploadApplicationStub(appGUID, path)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.uploadApplicationReturns.result1, fake.uploadApplicationReturns.result2
+}
+
+func (fake *FakePushActor) UploadApplicationCallCount() int {
+	fake.uploadApplicationMutex.RLock()
+	defer fake.uploadApplicationMutex.RUnlock()
+	return len(fake.uploadApplicationArgsForCall)
+}
+
+func (fake *FakePushActor) UploadApplicationArgsForCall(i int) (string, string) {
+	fake.uploadApplicationMutex.RLock()
+	defer fake.uploadApplicationMutex.RUnlock()
+	return fake.uploadApplicationArgsForCall[i].appGUID, fake.uploadApplicationArgsForCall[i].path
+}
+
+func (fake *FakePushActor) UploadApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.UploadApplicationStub = nil
+	fake.uploadApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushActor) UploadApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.UploadApplicationStub = nil
+	if fake.uploadApplicationReturnsOnCall == nil {
+		fake.uploadApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.uploadApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.bindServicesMutex.RLock()
+	defer fake.bindServicesMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.convertToApplicationConfigsMutex.RLock()
+	defer fake.convertToApplicationConfigsMutex.RUnlock()
+	fake.createOrUpdateApplicationMutex.RLock()
+	defer fake.createOrUpdateApplicationMutex.RUnlock()
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	fake.mapRoutesMutex.RLock()
+	defer fake.mapRoutesMutex.RUnlock()
+	fake.rollbackBlueGreenApplicationsMutex.RLock()
+	defer fake.rollbackBlueGreenApplicationsMutex.RUnlock()
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	fake.startApplicationAndWaitForAllInstancesMutex.RLock()
+	defer fake.startApplicationAndWaitForAllInstancesMutex.RUnlock()
+	fake.swapBlueGreenApplicationsMutex.RLock()
+	defer fake.swapBlueGreenApplicationsMutex.RUnlock()
+	fake.uploadApplicationMutex.RLock()
+	defer fake.uploadApplicationMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePushActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.PushActor = new(FakePushActor)
@@ -0,0 +1,262 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeRoutesActor struct {
+	GetOrganizationSpacesStub        func(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	getOrganizationSpacesMutex       sync.RWMutex
+	getOrganizationSpacesArgsForCall []struct {
+		orgGUID string
+	}
+	getOrganizationSpacesReturns struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationSpacesReturnsOnCall map[int]struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRouteApplicationsStub        func(routeGUID string, query []ccv2.Query) ([]v2action.Application, v2action.Warnings, error)
+	getRouteApplicationsMutex       sync.RWMutex
+	getRouteApplicationsArgsForCall []struct {
+		routeGUID string
+		query     []ccv2.Query
+	}
+	getRouteApplicationsReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getRouteApplicationsReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceRoutesStub        func(spaceGUID string, query []ccv2.Query) ([]v2action.Route, v2action.Warnings, error)
+	getSpaceRoutesMutex       sync.RWMutex
+	getSpaceRoutesArgsForCall []struct {
+		spaceGUID string
+		query     []ccv2.Query
+	}
+	getSpaceRoutesReturns struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceRoutesReturnsOnCall map[int]struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRoutesActor) GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpacesReturnsOnCall[len(fake.getOrganizationSpacesArgsForCall)]
+	fake.getOrganizationSpacesArgsForCall = append(fake.getOrganizationSpacesArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationSpaces", []interface{}{orgGUID})
+	fake.getOrganizationSpacesMutex.Unlock()
+	if fake.GetOrganizationSpacesStub != nil {
+		return fake.GetOrganizationSpacesStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationSpacesReturns.result1, fake.getOrganizationSpacesReturns.result2, fake.getOrganizationSpacesReturns.result3
+}
+
+func (fake *FakeRoutesActor) GetOrganizationSpacesCallCount() int {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	return len(fake.getOrganizationSpacesArgsForCall)
+}
+
+func (fake *FakeRoutesActor) GetOrganizationSpacesArgsForCall(i int) string {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	return fake.getOrganizationSpacesArgsForCall[i].orgGUID
+}
+
+func (fake *FakeRoutesActor) GetOrganizationSpacesReturns(result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationSpacesStub = nil
+	fake.getOrganizationSpacesReturns = struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetOrganizationSpacesReturnsOnCall(i int, result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationSpacesStub = nil
+	if fake.getOrganizationSpacesReturnsOnCall == nil {
+		fake.getOrganizationSpacesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationSpacesReturnsOnCall[i] = struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetRouteApplications(routeGUID string, query []ccv2.Query) ([]v2action.Application, v2action.Warnings, error) {
+	var queryCopy []ccv2.Query
+	if query != nil {
+		queryCopy = make([]ccv2.Query, len(query))
+		copy(queryCopy, query)
+	}
+	fake.getRouteApplicationsMutex.Lock()
+	ret, specificReturn := fake.getRouteApplicationsReturnsOnCall[len(fake.getRouteApplicationsArgsForCall)]
+	fake.getRouteApplicationsArgsForCall = append(fake.getRouteApplicationsArgsForCall, struct {
+		routeGUID string
+		query     []ccv2.Query
+	}{routeGUID, queryCopy})
+	fake.recordInvocation("GetRouteApplications", []interface{}{routeGUID, queryCopy})
+	fake.getRouteApplicationsMutex.Unlock()
+	if fake.GetRouteApplicationsStub != nil {
+		return fake.GetRouteApplicationsStub(routeGUID, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteApplicationsReturns.result1, fake.getRouteApplicationsReturns.result2, fake.getRouteApplicationsReturns.result3
+}
+
+func (fake *FakeRoutesActor) GetRouteApplicationsCallCount() int {
+	fake.getRouteApplicationsMutex.RLock()
+	defer fake.getRouteApplicationsMutex.RUnlock()
+	return len(fake.getRouteApplicationsArgsForCall)
+}
+
+func (fake *FakeRoutesActor) GetRouteApplicationsArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getRouteApplicationsMutex.RLock()
+	defer fake.getRouteApplicationsMutex.RUnlock()
+	return fake.getRouteApplicationsArgsForCall[i].routeGUID, fake.getRouteApplicationsArgsForCall[i].query
+}
+
+func (fake *FakeRoutesActor) GetRouteApplicationsReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetRouteApplicationsStub = nil
+	fake.getRouteApplicationsReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetRouteApplicationsReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetRouteApplicationsStub = nil
+	if fake.getRouteApplicationsReturnsOnCall == nil {
+		fake.getRouteApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteApplicationsReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetSpaceRoutes(spaceGUID string, query []ccv2.Query) ([]v2action.Route, v2action.Warnings, error) {
+	var queryCopy []ccv2.Query
+	if query != nil {
+		queryCopy = make([]ccv2.Query, len(query))
+		copy(queryCopy, query)
+	}
+	fake.getSpaceRoutesMutex.Lock()
+	ret, specificReturn := fake.getSpaceRoutesReturnsOnCall[len(fake.getSpaceRoutesArgsForCall)]
+	fake.getSpaceRoutesArgsForCall = append(fake.getSpaceRoutesArgsForCall, struct {
+		spaceGUID string
+		query     []ccv2.Query
+	}{spaceGUID, queryCopy})
+	fake.recordInvocation("GetSpaceRoutes", []interface{}{spaceGUID, queryCopy})
+	fake.getSpaceRoutesMutex.Unlock()
+	if fake.GetSpaceRoutesStub != nil {
+		return fake.GetSpaceRoutesStub(spaceGUID, query)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceRoutesReturns.result1, fake.getSpaceRoutesReturns.result2, fake.getSpaceRoutesReturns.result3
+}
+
+func (fake *FakeRoutesActor) GetSpaceRoutesCallCount() int {
+	fake.getSpaceRoutesMutex.RLock()
+	defer fake.getSpaceRoutesMutex.RUnlock()
+	return len(fake.getSpaceRoutesArgsForCall)
+}
+
+func (fake *FakeRoutesActor) GetSpaceRoutesArgsForCall(i int) (string, []ccv2.Query) {
+	fake.getSpaceRoutesMutex.RLock()
+	defer fake.getSpaceRoutesMutex.RUnlock()
+	return fake.getSpaceRoutesArgsForCall[i].spaceGUID, fake.getSpaceRoutesArgsForCall[i].query
+}
+
+func (fake *FakeRoutesActor) GetSpaceRoutesReturns(result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceRoutesStub = nil
+	fake.getSpaceRoutesReturns = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetSpaceRoutesReturnsOnCall(i int, result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceRoutesStub = nil
+	if fake.getSpaceRoutesReturnsOnCall == nil {
+		fake.getSpaceRoutesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Route
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRoutesReturnsOnCall[i] = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	fake.getRouteApplicationsMutex.RLock()
+	defer fake.getRouteApplicationsMutex.RUnlock()
+	fake.getSpaceRoutesMutex.RLock()
+	defer fake.getSpaceRoutesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRoutesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.RoutesActor = new(FakeRoutesActor)
@@ -0,0 +1,95 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeStacksActor struct {
+	GetStacksStub        func() ([]v2action.Stack, v2action.Warnings, error)
+	getStacksMutex       sync.RWMutex
+	getStacksArgsForCall []struct{}
+	getStacksReturns     struct {
+		result1 []v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	getStacksReturnsOnCall map[int]struct {
+		result1 []v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStacksActor) GetStacks() ([]v2action.Stack, v2action.Warnings, error) {
+	fake.getStacksMutex.Lock()
+	ret, specificReturn := fake.getStacksReturnsOnCall[len(fake.getStacksArgsForCall)]
+	fake.getStacksArgsForCall = append(fake.getStacksArgsForCall, struct{}{})
+	fake.recordInvocation("GetStacks", []interface{}{})
+	fake.getStacksMutex.Unlock()
+	if fake.GetStacksStub != nil {
+		return fake.GetStacksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStacksReturns.result1, fake.getStacksReturns.result2, fake.getStacksReturns.result3
+}
+
+func (fake *FakeStacksActor) GetStacksCallCount() int {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return len(fake.getStacksArgsForCall)
+}
+
+func (fake *FakeStacksActor) GetStacksReturns(result1 []v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	fake.getStacksReturns = struct {
+		result1 []v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStacksActor) GetStacksReturnsOnCall(i int, result1 []v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStacksStub = nil
+	if fake.getStacksReturnsOnCall == nil {
+		fake.getStacksReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Stack
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getStacksReturnsOnCall[i] = struct {
+		result1 []v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStacksActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeStacksActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.StacksActor = new(FakeStacksActor)
@@ -40,6 +40,10 @@ func (cmd *IsolationSegmentsCommand) Setup(config command.Config, ui command.UI)
 	return nil
 }
 
+func (cmd IsolationSegmentsCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.11.0")
 	if err != nil {
@@ -56,15 +60,22 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting isolation segments as {{.CurrentUser}}...", map[string]interface{}{
-		"CurrentUser": user.Name,
-	})
+	if !cmd.UI.HasStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting isolation segments as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": user.Name,
+		})
+	}
 
 	summaries, warnings, err := cmd.Actor.GetIsolationSegmentSummaries()
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
 	}
+
+	if cmd.UI.HasStructuredOutput() {
+		return cmd.UI.DisplayStructuredData(summaries)
+	}
+
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
@@ -120,6 +120,26 @@ var _ = Describe("isolation-segments Command", func() {
 
 					Expect(fakeActor.GetIsolationSegmentSummariesCallCount()).To(Equal(1))
 				})
+
+				Context("when structured output is requested", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputJSON
+					})
+
+					It("displays the isolation segment summaries as a document", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("Getting isolation segments"))
+						Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+							{"name": "some-iso-1", "orgs": []},
+							{"name": "some-iso-2", "orgs": ["some-org-1"]},
+							{"name": "some-iso-3", "orgs": ["some-org-1", "some-org-2"]}
+						]`))
+
+						Expect(testUI.Err).To(Say("warning-1"))
+						Expect(testUI.Err).To(Say("warning-2"))
+					})
+				})
 			})
 
 			Context("when there are no isolation segments", func() {
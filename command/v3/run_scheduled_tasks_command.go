@@ -0,0 +1,256 @@
+package v3
+
+import (
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/taskschedule"
+)
+
+//go:generate counterfeiter . RunScheduledTasksActor
+
+type RunScheduledTasksActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (v3action.Task, v3action.Warnings, error)
+	RunTask(appGUID string, command string, name string, memory uint64, disk uint64) (v3action.Task, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type RunScheduledTasksCommand struct {
+	File            flag.PathWithExistenceCheck `long:"file" short:"f" required:"true" description:"Path to a YAML file of task definitions"`
+	HistoryFile     flag.Path                   `long:"history-file" description:"Path to the file recording every scheduled run (default: scheduled_tasks_history.json in the CF configuration directory)"`
+	usage           interface{}                 `usage:"CF_NAME run-scheduled-tasks -f TASKS_FILE [--history-file HISTORY_FILE]\n\nRuns until interrupted, starting each task in the targeted space when its schedule is due. A run is skipped while the task started by its previous run is still running.\n\nTASKS_FILE:\n   tasks:\n   - name: nightly-report\n     app: my-app\n     command: bundle exec rake report\n     memory: 512M\n     disk: 1G\n     schedule: \"0 2 * * *\"\n\nEXAMPLES:\n   CF_NAME run-scheduled-tasks -f tasks.yml"`
+	relatedCommands interface{}                 `related_commands:"run-task, task, tasks"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RunScheduledTasksActor
+
+	// Now and After are the clock the schedules are evaluated against.
+	Now   func() time.Time
+	After func(time.Duration) <-chan time.Time
+	// Interrupt stops the command when it receives a signal.
+	Interrupt <-chan os.Signal
+}
+
+func (cmd *RunScheduledTasksCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	cmd.Now = time.Now
+	cmd.After = time.After
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	cmd.Interrupt = interrupt
+
+	return nil
+}
+
+func (cmd RunScheduledTasksCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	definitions, err := taskschedule.ReadDefinitions(string(cmd.File))
+	if err != nil {
+		return err
+	}
+
+	historyPath := string(cmd.HistoryFile)
+	if historyPath == "" {
+		historyPath = filepath.Join(filepath.Dir(configv3.ConfigFilePath()), "scheduled_tasks_history.json")
+	}
+	history := taskschedule.NewHistory(historyPath)
+
+	lastStarted, err := history.LastStarted()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Running scheduled tasks from {{.File}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"File":        cmd.File,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	now := cmd.Now()
+	nextRuns := make([]time.Time, len(definitions))
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("schedule"),
+			cmd.UI.TranslateText("next run"),
+		},
+	}
+	for i, definition := range definitions {
+		nextRuns[i] = definition.Schedule.Next(now)
+		table = append(table, []string{
+			definition.Name,
+			definition.App,
+			definition.Schedule.String(),
+			cmd.formatTime(nextRuns[i]),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Recording runs in {{.HistoryFile}}. Press Ctrl+C to stop.", map[string]interface{}{
+		"HistoryFile": history.Path(),
+	})
+
+	for {
+		due := earliest(nextRuns)
+		if due.IsZero() {
+			return nil
+		}
+
+		select {
+		case <-cmd.After(due.Sub(cmd.Now())):
+		case <-cmd.Interrupt:
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayText("Stopped running scheduled tasks.")
+			return nil
+		}
+
+		for i, definition := range definitions {
+			if nextRuns[i].IsZero() || nextRuns[i].After(due) {
+				continue
+			}
+
+			run := cmd.runScheduledTask(definition, space.GUID, due, lastStarted[definition.Name])
+			if run.Started() {
+				lastStarted[definition.Name] = run
+			}
+
+			err = history.Append(run)
+			if err != nil {
+				cmd.UI.DisplayWarning("Unable to record the run in {{.HistoryFile}}: {{.Error}}", map[string]interface{}{
+					"HistoryFile": history.Path(),
+					"Error":       err.Error(),
+				})
+			}
+
+			nextRuns[i] = definition.Schedule.Next(due)
+		}
+	}
+}
+
+// runScheduledTask starts the task of a definition unless the task started
+// by its previous run is still in progress. Failures are displayed rather
+// than returned, so one broken definition does not stop the others.
+func (cmd RunScheduledTasksCommand) runScheduledTask(definition taskschedule.Definition, spaceGUID string, scheduledAt time.Time, previous taskschedule.Run) taskschedule.Run {
+	run := taskschedule.Run{
+		Name:        definition.Name,
+		App:         definition.App,
+		ScheduledAt: scheduledAt,
+	}
+
+	fail := func(err error) taskschedule.Run {
+		run.Error = err.Error()
+		cmd.UI.DisplayWarning("{{.Time}} Failed to run task {{.TaskName}} on app {{.AppName}}: {{.Error}}", map[string]interface{}{
+			"Time":     cmd.formatTime(scheduledAt),
+			"TaskName": definition.Name,
+			"AppName":  definition.App,
+			"Error":    run.Error,
+		})
+		return run
+	}
+
+	if previous.Started() {
+		task, warnings, err := cmd.Actor.GetTaskBySequenceIDAndApplication(previous.SequenceID, previous.AppGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err == nil && isTaskInProgress(task) {
+			run.Skipped = true
+			cmd.UI.DisplayText("{{.Time}} Skipped task {{.TaskName}} on app {{.AppName}}: task {{.SequenceID}} from the previous run is {{.State}}", map[string]interface{}{
+				"Time":       cmd.formatTime(scheduledAt),
+				"TaskName":   definition.Name,
+				"AppName":    definition.App,
+				"SequenceID": task.SequenceID,
+				"State":      cmd.UI.TranslateText(task.State),
+			})
+			return run
+		}
+	}
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(definition.App, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return fail(err)
+	}
+
+	task, warnings, err := cmd.Actor.RunTask(application.GUID, definition.Command, definition.Name, definition.Memory, definition.Disk)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return fail(err)
+	}
+
+	run.AppGUID = application.GUID
+	run.SequenceID = task.SequenceID
+	cmd.UI.DisplayText("{{.Time}} Started task {{.TaskName}} ({{.SequenceID}}) on app {{.AppName}}", map[string]interface{}{
+		"Time":       cmd.formatTime(scheduledAt),
+		"TaskName":   definition.Name,
+		"SequenceID": task.SequenceID,
+		"AppName":    definition.App,
+	})
+	return run
+}
+
+func (cmd RunScheduledTasksCommand) formatTime(t time.Time) string {
+	if t.IsZero() {
+		return cmd.UI.TranslateText("never")
+	}
+	return t.Format(time.RFC3339)
+}
+
+func isTaskInProgress(task v3action.Task) bool {
+	switch task.State {
+	case pendingState, runningState, cancelingState:
+		return true
+	}
+	return false
+}
+
+// earliest returns the earliest non-zero time, or the zero time if there is
+// none.
+func earliest(times []time.Time) time.Time {
+	var first time.Time
+	for _, t := range times {
+		if !t.IsZero() && (first.IsZero() || t.Before(first)) {
+			first = t
+		}
+	}
+	return first
+}
@@ -0,0 +1,274 @@
+package v3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/taskschedule"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("run-scheduled-tasks Command", func() {
+	var (
+		cmd             v3.RunScheduledTasksCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeRunScheduledTasksActor
+		binaryName      string
+		executeErr      error
+
+		tmpDir      string
+		historyPath string
+		now         time.Time
+		fires       int
+		maxFires    int
+		interrupt   chan os.Signal
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeRunScheduledTasksActor)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "run-scheduled-tasks-test")
+		Expect(err).ToNot(HaveOccurred())
+		historyPath = filepath.Join(tmpDir, "history.json")
+
+		definitionsPath := filepath.Join(tmpDir, "tasks.yml")
+		Expect(ioutil.WriteFile(definitionsPath, []byte(`---
+tasks:
+- name: every-minute
+  app: some-app
+  command: ./poll
+  schedule: "* * * * *"
+- name: nightly
+  app: some-app
+  command: ./report
+  memory: 512M
+  disk: 1G
+  schedule: "0 2 * * *"
+`), 0644)).To(Succeed())
+
+		// The clock jumps to the next due time whenever the command waits,
+		// and the command is interrupted once maxFires runs are due.
+		now = time.Date(2017, 6, 1, 1, 58, 30, 0, time.UTC)
+		fires = 0
+		maxFires = 3
+		interrupt = make(chan os.Signal, 1)
+
+		cmd = v3.RunScheduledTasksCommand{
+			File:        flag.PathWithExistenceCheck(definitionsPath),
+			HistoryFile: flag.Path(historyPath),
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Now: func() time.Time {
+				return now
+			},
+			After: func(d time.Duration) <-chan time.Time {
+				if fires == maxFires {
+					interrupt <- os.Interrupt
+					return nil
+				}
+				fires++
+				now = now.Add(d)
+
+				fired := make(chan time.Time, 1)
+				fired <- now
+				return fired
+			},
+			Interrupt: interrupt,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v3action.Application{GUID: "some-app-guid"},
+			v3action.Warnings{"get-application-warning"},
+			nil)
+		fakeActor.RunTaskStub = func(_ string, _ string, name string, _ uint64, _ uint64) (v3action.Task, v3action.Warnings, error) {
+			return v3action.Task{Name: name, SequenceID: fakeActor.RunTaskCallCount()}, nil, nil
+		}
+		fakeActor.GetTaskBySequenceIDAndApplicationStub = func(sequenceID int, _ string) (v3action.Task, v3action.Warnings, error) {
+			return v3action.Task{SequenceID: sequenceID, State: "SUCCEEDED"}, nil, nil
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	readHistory := func() []string {
+		contents, err := ioutil.ReadFile(historyPath)
+		Expect(err).ToNot(HaveOccurred())
+		return strings.Split(strings.TrimSpace(string(contents)), "\n")
+	}
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the task definitions are invalid", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(string(cmd.File), []byte("tasks: []\n"), 0644)).To(Succeed())
+		})
+
+		It("returns an InvalidDefinitionsError", func() {
+			Expect(executeErr).To(MatchError(taskschedule.InvalidDefinitionsError{Path: string(cmd.File), Message: "no tasks defined"}))
+			Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+		})
+	})
+
+	It("starts each task when its schedule is due until interrupted", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Running scheduled tasks from .*tasks.yml in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say(`name\s+app\s+schedule\s+next run`))
+		Expect(testUI.Out).To(Say(`every-minute\s+some-app\s+\* \* \* \* \*\s+2017-06-01T01:59:00Z`))
+		Expect(testUI.Out).To(Say(`nightly\s+some-app\s+0 2 \* \* \*\s+2017-06-01T02:00:00Z`))
+		Expect(testUI.Out).To(Say("Recording runs in .*history.json. Press Ctrl\\+C to stop."))
+		Expect(testUI.Out).To(Say(`2017-06-01T01:59:00Z Started task every-minute \(1\) on app some-app`))
+		Expect(testUI.Out).To(Say(`2017-06-01T02:00:00Z Started task every-minute \(2\) on app some-app`))
+		Expect(testUI.Out).To(Say(`2017-06-01T02:00:00Z Started task nightly \(3\) on app some-app`))
+		Expect(testUI.Out).To(Say(`2017-06-01T02:01:00Z Started task every-minute \(4\) on app some-app`))
+		Expect(testUI.Out).To(Say("Stopped running scheduled tasks."))
+		Expect(testUI.Err).To(Say("get-application-warning"))
+
+		Expect(fakeActor.RunTaskCallCount()).To(Equal(4))
+		appGUID, taskCommand, name, memory, disk := fakeActor.RunTaskArgsForCall(2)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(taskCommand).To(Equal("./report"))
+		Expect(name).To(Equal("nightly"))
+		Expect(memory).To(BeEquivalentTo(512))
+		Expect(disk).To(BeEquivalentTo(1024))
+
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeActor.GetTaskBySequenceIDAndApplicationCallCount()).To(Equal(2))
+		sequenceID, appGUID := fakeActor.GetTaskBySequenceIDAndApplicationArgsForCall(0)
+		Expect(sequenceID).To(Equal(1))
+		Expect(appGUID).To(Equal("some-app-guid"))
+
+		history := readHistory()
+		Expect(history).To(HaveLen(4))
+		Expect(history[2]).To(Equal(`{"name":"nightly","app":"some-app","scheduled_at":"2017-06-01T02:00:00Z","app_guid":"some-app-guid","sequence_id":3}`))
+	})
+
+	Context("when the task of the previous run is still in progress", func() {
+		BeforeEach(func() {
+			fakeActor.GetTaskBySequenceIDAndApplicationStub = func(sequenceID int, _ string) (v3action.Task, v3action.Warnings, error) {
+				return v3action.Task{SequenceID: sequenceID, State: "RUNNING"}, v3action.Warnings{"get-task-warning"}, nil
+			}
+		})
+
+		It("skips the run and records it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`2017-06-01T01:59:00Z Started task every-minute \(1\) on app some-app`))
+			Expect(testUI.Out).To(Say(`2017-06-01T02:00:00Z Skipped task every-minute on app some-app: task 1 from the previous run is RUNNING`))
+			Expect(testUI.Out).To(Say(`2017-06-01T02:00:00Z Started task nightly \(2\) on app some-app`))
+			Expect(testUI.Out).To(Say(`2017-06-01T02:01:00Z Skipped task every-minute on app some-app: task 1 from the previous run is RUNNING`))
+			Expect(testUI.Err).To(Say("get-task-warning"))
+
+			Expect(fakeActor.RunTaskCallCount()).To(Equal(2))
+
+			history := readHistory()
+			Expect(history).To(HaveLen(4))
+			Expect(history[1]).To(Equal(`{"name":"every-minute","app":"some-app","scheduled_at":"2017-06-01T02:00:00Z","skipped":true}`))
+		})
+	})
+
+	Context("when the history records a run from before the command started", func() {
+		BeforeEach(func() {
+			maxFires = 1
+			Expect(taskschedule.NewHistory(historyPath).Append(taskschedule.Run{
+				Name:       "every-minute",
+				App:        "some-app",
+				AppGUID:    "old-app-guid",
+				SequenceID: 7,
+			})).To(Succeed())
+		})
+
+		It("checks the task of that run before starting a new one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetTaskBySequenceIDAndApplicationCallCount()).To(Equal(1))
+			sequenceID, appGUID := fakeActor.GetTaskBySequenceIDAndApplicationArgsForCall(0)
+			Expect(sequenceID).To(Equal(7))
+			Expect(appGUID).To(Equal("old-app-guid"))
+			Expect(fakeActor.RunTaskCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when a task cannot be started", func() {
+		BeforeEach(func() {
+			maxFires = 2
+			fakeActor.GetApplicationByNameAndSpaceReturnsOnCall(0,
+				v3action.Application{},
+				nil,
+				v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("displays the failure, records it and keeps running", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Err).To(Say(`2017-06-01T01:59:00Z Failed to run task every-minute on app some-app: Application 'some-app' not found.`))
+			Expect(testUI.Out).To(Say(`2017-06-01T02:00:00Z Started task every-minute \(1\) on app some-app`))
+
+			history := readHistory()
+			Expect(history[0]).To(Equal(`{"name":"every-minute","app":"some-app","scheduled_at":"2017-06-01T01:59:00Z","error":"Application 'some-app' not found."}`))
+		})
+	})
+})
@@ -2,11 +2,14 @@ package v3
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 )
 
@@ -16,6 +19,13 @@ type RunTaskActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	RunTask(appGUID string, command string, name string, memory uint64, disk uint64) (v3action.Task, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
+	WaitForTaskToComplete(taskGUID string) (v3action.Task, v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . RunTaskActorV2
+
+type RunTaskActorV2 interface {
+	GetStreamingLogsForTask(appGUID string, taskName string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error)
 }
 
 type RunTaskCommand struct {
@@ -23,13 +33,16 @@ type RunTaskCommand struct {
 	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	Name            string           `long:"name" description:"Name to give the task (generated if omitted)"`
-	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate"`
+	Wait            bool             `long:"wait" description:"Wait for the task to complete while displaying its logs, and fail if the task fails"`
+	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME] [--wait]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate --wait"`
 	relatedCommands interface{}      `related_commands:"logs, tasks, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       RunTaskActor
+	ActorV2     RunTaskActorV2
+	NOAAClient  v2action.NOAAClient
 }
 
 func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
@@ -43,6 +56,18 @@ func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	cmd.Actor = v3action.NewActor(client, config)
 
+	// The v2 clients are only used to display the logs of the task.
+	if !cmd.Wait {
+		return nil
+	}
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.ActorV2 = v2action.NewActor(ccClientV2, uaaClientV2)
+	cmd.NOAAClient = sharedV2.NewNOAAClient(ccClientV2.DopplerEndpoint(), config, uaaClientV2, ui)
+
 	return nil
 }
 
@@ -83,6 +108,16 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
+	// The log stream is opened as soon as the task exists so that the first
+	// lines it logs are not missed.
+	var (
+		messages <-chan *v2action.LogMessage
+		logErrs  <-chan error
+	)
+	if cmd.Wait {
+		messages, logErrs = cmd.ActorV2.GetStreamingLogsForTask(application.GUID, task.Name, cmd.NOAAClient, cmd.Config)
+	}
+
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 	cmd.UI.DisplayText("Task has been submitted successfully for execution.")
@@ -91,5 +126,70 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
 	}, 3)
 
+	if !cmd.Wait {
+		return nil
+	}
+
+	return cmd.waitForTask(task, messages, logErrs)
+}
+
+// waitForTask displays the logs of the task until it completes. Logs that
+// arrive after the task completes are displayed for one polling interval.
+func (cmd RunTaskCommand) waitForTask(task v3action.Task, messages <-chan *v2action.LogMessage, logErrs <-chan error) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for task {{.TaskName}} to complete...", map[string]interface{}{
+		"TaskName": task.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	logsDone := make(chan struct{})
+	go func() {
+		defer close(logsDone)
+
+		for messages != nil || logErrs != nil {
+			select {
+			case message, ok := <-messages:
+				if !ok {
+					messages = nil
+					break
+				}
+
+				cmd.UI.DisplayLogMessage(message, false)
+			case logErr, ok := <-logErrs:
+				if !ok {
+					logErrs = nil
+					break
+				}
+
+				switch logErr.(type) {
+				case v2action.NOAATimeoutError:
+					cmd.UI.DisplayWarning("timeout connecting to log server, no log will be shown")
+				default:
+					cmd.UI.DisplayWarning(logErr.Error())
+				}
+			}
+		}
+	}()
+
+	completedTask, warnings, err := cmd.Actor.WaitForTaskToComplete(task.GUID)
+
+	select {
+	case <-logsDone:
+	case <-time.After(cmd.Config.PollingInterval()):
+	}
+	_ = cmd.NOAAClient.Close()
+	<-logsDone
+
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Task {{.TaskName}} ({{.SequenceID}}) succeeded.", map[string]interface{}{
+		"TaskName":   completedTask.Name,
+		"SequenceID": completedTask.SequenceID,
+	})
+
 	return nil
 }
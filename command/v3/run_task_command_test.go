@@ -2,14 +2,18 @@ package v3_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -25,6 +29,8 @@ var _ = Describe("run-task Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v3fakes.FakeRunTaskActor
+		fakeActorV2     *v3fakes.FakeRunTaskActorV2
+		fakeNOAAClient  *v2actionfakes.FakeNOAAClient
 		binaryName      string
 		executeErr      error
 	)
@@ -34,12 +40,16 @@ var _ = Describe("run-task Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeRunTaskActor)
+		fakeActorV2 = new(v3fakes.FakeRunTaskActorV2)
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
 
 		cmd = v3.RunTaskCommand{
 			UI:          testUI,
 			Config:      fakeConfig,
 			SharedActor: fakeSharedActor,
 			Actor:       fakeActor,
+			ActorV2:     fakeActorV2,
+			NOAAClient:  fakeNOAAClient,
 		}
 
 		cmd.RequiredArgs.AppName = "some-app-name"
@@ -153,6 +163,8 @@ var _ = Describe("run-task Command", func() {
 						Expect(memory).To(BeEquivalentTo(0))
 						Expect(disk).To(BeEquivalentTo(0))
 
+						Expect(fakeActorV2.GetStreamingLogsForTaskCallCount()).To(Equal(0))
+
 						Expect(testUI.Out).To(Say(`Creating task for app some-app-name in org some-org / space some-space as some-user...
 OK
 
@@ -205,6 +217,128 @@ get-application-warning-3`))
 					})
 				})
 
+				Context("when waiting for the task", func() {
+					BeforeEach(func() {
+						cmd.Name = "some-task-name"
+						cmd.Wait = true
+						fakeActor.RunTaskReturns(
+							v3action.Task{
+								GUID:       "some-task-guid",
+								Name:       "some-task-name",
+								SequenceID: 3,
+							},
+							v3action.Warnings{"get-application-warning-3"},
+							nil)
+
+						fakeActorV2.GetStreamingLogsForTaskStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error) {
+							messages := make(chan *v2action.LogMessage)
+							errs := make(chan error)
+
+							go func() {
+								messages <- v2action.NewLogMessage("migrating", 1, time.Now(), "APP/TASK/some-task-name", "0")
+								errs <- errors.New("some-log-error")
+								close(messages)
+								close(errs)
+							}()
+
+							return messages, errs
+						}
+					})
+
+					Context("when the task succeeds", func() {
+						BeforeEach(func() {
+							fakeActor.WaitForTaskToCompleteReturns(
+								v3action.Task{Name: "some-task-name", SequenceID: 3, State: "SUCCEEDED"},
+								v3action.Warnings{"wait-warning"},
+								nil)
+						})
+
+						It("streams the task logs and reports the task succeeded", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActorV2.GetStreamingLogsForTaskCallCount()).To(Equal(1))
+							appGUID, taskName, noaaClient, _ := fakeActorV2.GetStreamingLogsForTaskArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(taskName).To(Equal("some-task-name"))
+							Expect(noaaClient).To(Equal(fakeNOAAClient))
+
+							Expect(fakeActor.WaitForTaskToCompleteCallCount()).To(Equal(1))
+							Expect(fakeActor.WaitForTaskToCompleteArgsForCall(0)).To(Equal("some-task-guid"))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+
+							Expect(testUI.Out).To(Say("Waiting for task some-task-name to complete..."))
+							Expect(testUI.Out).To(Say("migrating"))
+							Expect(testUI.Out).To(Say(`Task some-task-name \(3\) succeeded\.`))
+							Expect(testUI.Err).To(Say("some-log-error"))
+							Expect(testUI.Err).To(Say("wait-warning"))
+						})
+					})
+
+					Context("when the task logs after it completes", func() {
+						BeforeEach(func() {
+							fakeConfig.PollingIntervalReturns(100 * time.Millisecond)
+
+							completed := make(chan struct{})
+							closed := make(chan struct{})
+							fakeActor.WaitForTaskToCompleteStub = func(_ string) (v3action.Task, v3action.Warnings, error) {
+								Expect(fakeActorV2.GetStreamingLogsForTaskCallCount()).To(Equal(1))
+								close(completed)
+								return v3action.Task{Name: "some-task-name", SequenceID: 3, State: "SUCCEEDED"}, nil, nil
+							}
+							fakeNOAAClient.CloseStub = func() error {
+								close(closed)
+								return nil
+							}
+
+							fakeActorV2.GetStreamingLogsForTaskStub = func(_ string, _ string, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error) {
+								messages := make(chan *v2action.LogMessage)
+								errs := make(chan error)
+
+								go func() {
+									defer close(messages)
+									defer close(errs)
+
+									<-completed
+									time.Sleep(10 * time.Millisecond)
+									select {
+									case messages <- v2action.NewLogMessage("late message", 1, time.Now(), "APP/TASK/some-task-name", "0"):
+									case <-closed:
+										return
+									}
+									<-closed
+								}()
+
+								return messages, errs
+							}
+						})
+
+						It("displays the logs that arrive before the grace period ends", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say("late message"))
+							Expect(testUI.Out).To(Say(`Task some-task-name \(3\) succeeded\.`))
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.WaitForTaskToCompleteReturns(
+								v3action.Task{},
+								v3action.Warnings{"wait-warning"},
+								v3action.TaskFailedError{Name: "some-task-name", SequenceID: 3, Reason: "Exited with status 1"})
+						})
+
+						It("returns a translatable error and displays all warnings", func() {
+							Expect(executeErr).To(MatchError(shared.TaskFailedError{Name: "some-task-name", SequenceID: 3, Reason: "Exited with status 1"}))
+
+							Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+							Expect(testUI.Out).NotTo(Say("succeeded"))
+							Expect(testUI.Err).To(Say("wait-warning"))
+						})
+					})
+				})
+
 				Context("when task disk space is provided", func() {
 					BeforeEach(func() {
 						cmd.Name = "some-task-name"
@@ -0,0 +1,72 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// DisplayAppSummary displays the application's requested state followed by
+// the instances of each of its processes.
+func DisplayAppSummary(ui command.UI, appSummary v3action.ApplicationSummary) {
+	var processes []string
+	for _, process := range appSummary.ProcessSummaries {
+		processes = append(processes, fmt.Sprintf("%s:%d/%d", process.Type, process.HealthyInstanceCount(), process.Instances))
+	}
+
+	ui.DisplayKeyValueTable("", [][]string{
+		{ui.TranslateText("name:"), appSummary.Name},
+		{ui.TranslateText("requested state:"), strings.ToLower(string(appSummary.State))},
+		{ui.TranslateText("processes:"), strings.Join(processes, ", ")},
+	}, 3)
+
+	for _, process := range appSummary.ProcessSummaries {
+		ui.DisplayNewline()
+		DisplayProcessSummary(ui, process)
+	}
+}
+
+// DisplayProcessSummary displays the scale of the process and the stats of
+// each of its instances.
+func DisplayProcessSummary(ui command.UI, process v3action.ProcessSummary) {
+	ui.DisplayKeyValueTable("", [][]string{
+		{ui.TranslateText("type:"), process.Type},
+		{ui.TranslateText("instances:"), fmt.Sprintf("%d/%d", process.HealthyInstanceCount(), process.Instances)},
+		{ui.TranslateText("memory usage:"), fmt.Sprintf("%dM", process.MemoryInMB)},
+		{ui.TranslateText("disk usage:"), fmt.Sprintf("%dM", process.DiskInMB)},
+	}, 3)
+	ui.DisplayNewline()
+
+	if len(process.InstanceDetails) == 0 {
+		ui.DisplayText("There are no running instances of this process.")
+		return
+	}
+
+	table := [][]string{
+		{
+			"",
+			ui.TranslateText("state"),
+			ui.TranslateText("since"),
+			ui.TranslateText("cpu"),
+			ui.TranslateText("memory"),
+			ui.TranslateText("disk"),
+		},
+	}
+
+	for _, instance := range process.InstanceDetails {
+		table = append(table, []string{
+			fmt.Sprintf("#%d", instance.Index),
+			ui.TranslateText(strings.ToLower(string(instance.State))),
+			instance.StartTime().UTC().Format(time.RFC3339),
+			fmt.Sprintf("%.1f%%", instance.CPU*100),
+			fmt.Sprintf("%s of %s", bytefmt.ByteSize(instance.MemoryUsage), bytefmt.ByteSize(instance.MemoryQuota)),
+			fmt.Sprintf("%s of %s", bytefmt.ByteSize(instance.DiskUsage), bytefmt.ByteSize(instance.DiskQuota)),
+		})
+	}
+
+	ui.DisplayTableWithHeader("", table, 3)
+}
@@ -1,5 +1,7 @@
 package shared
 
+import "time"
+
 type RunTaskError struct {
 	Message string
 }
@@ -55,3 +57,97 @@ func (e OrganizationNotFoundError) Translate(translate func(string, ...interface
 		"Name": e.Name,
 	})
 }
+
+type StagingFailedError struct {
+	Message string
+}
+
+func (e StagingFailedError) Error() string {
+	return "Error staging application: {{.Message}}"
+}
+
+func (e StagingFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message": e.Message,
+	})
+}
+
+type StagingTimeoutError struct {
+	AppName string
+	Timeout time.Duration
+}
+
+func (e StagingTimeoutError) Error() string {
+	return "{{.AppName}} failed to stage within {{.Timeout}} minutes"
+}
+
+func (e StagingTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+		"Timeout": e.Timeout.Minutes(),
+	})
+}
+
+type UnsuccessfulStartError struct {
+	AppName    string
+	BinaryName string
+}
+
+func (e UnsuccessfulStartError) Error() string {
+	return "Start unsuccessful\n\nTIP: use '{{.BinaryName}} logs {{.AppName}} --recent' for more information"
+}
+
+func (e UnsuccessfulStartError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"BinaryName": e.BinaryName,
+	})
+}
+
+type StartupTimeoutError struct {
+	AppName    string
+	BinaryName string
+}
+
+func (e StartupTimeoutError) Error() string {
+	return "Start app timeout\n\nTIP: Application must be listening on the right port. Instead of hard coding the port, use the $PORT environment variable.\n\nUse '{{.BinaryName}} logs {{.AppName}} --recent' for more information"
+}
+
+func (e StartupTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"BinaryName": e.BinaryName,
+	})
+}
+
+type ProcessNotFoundError struct {
+	ProcessType string
+}
+
+func (e ProcessNotFoundError) Error() string {
+	return "Process {{.ProcessType}} not found"
+}
+
+func (e ProcessNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ProcessType": e.ProcessType,
+	})
+}
+
+type TaskFailedError struct {
+	Name       string
+	SequenceID int
+	Reason     string
+}
+
+func (e TaskFailedError) Error() string {
+	return "Task {{.TaskName}} ({{.SequenceID}}) failed: {{.Reason}}"
+}
+
+func (e TaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskName":   e.Name,
+		"SequenceID": e.SequenceID,
+		"Reason":     e.Reason,
+	})
+}
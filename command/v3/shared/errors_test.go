@@ -41,5 +41,10 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("ClientTargetError", ClientTargetError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
+		Entry("StagingFailedError", StagingFailedError{}),
+		Entry("StagingTimeoutError", StagingTimeoutError{}),
+		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
+		Entry("StartupTimeoutError", StartupTimeoutError{}),
+		Entry("ProcessNotFoundError", ProcessNotFoundError{}),
 	)
 })
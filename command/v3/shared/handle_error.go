@@ -40,6 +40,14 @@ func HandleError(err error) error {
 		return OrganizationNotFoundError{Name: e.Name}
 	case v3action.IsolationSegmentNotFoundError:
 		return IsolationSegmentNotFoundError{Name: e.Name}
+	case v3action.ProcessNotFoundError:
+		return ProcessNotFoundError{ProcessType: e.ProcessType}
+	case v3action.StagingFailedError:
+		return StagingFailedError{Message: e.Reason}
+	case v3action.StagingTimeoutError:
+		return StagingTimeoutError{AppName: e.AppName, Timeout: e.Timeout}
+	case v3action.TaskFailedError:
+		return TaskFailedError{Name: e.Name, SequenceID: e.SequenceID, Reason: e.Reason}
 	}
 
 	return err
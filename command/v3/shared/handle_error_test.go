@@ -2,6 +2,7 @@ package shared_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
@@ -76,6 +77,22 @@ var _ = Describe("HandleError", func() {
 			v3action.OrganizationNotFoundError{Name: "some-org"},
 			OrganizationNotFoundError{Name: "some-org"}),
 
+		Entry("v3action.ProcessNotFoundError -> ProcessNotFoundError",
+			v3action.ProcessNotFoundError{ProcessType: "worker"},
+			ProcessNotFoundError{ProcessType: "worker"}),
+
+		Entry("v3action.StagingFailedError -> StagingFailedError",
+			v3action.StagingFailedError{Reason: "some staging error"},
+			StagingFailedError{Message: "some staging error"}),
+
+		Entry("v3action.StagingTimeoutError -> StagingTimeoutError",
+			v3action.StagingTimeoutError{AppName: "some-app", Timeout: time.Minute},
+			StagingTimeoutError{AppName: "some-app", Timeout: time.Minute}),
+
+		Entry("v3action.TaskFailedError -> TaskFailedError",
+			v3action.TaskFailedError{Name: "some-task", SequenceID: 3, Reason: "Exited with status 1"},
+			TaskFailedError{Name: "some-task", SequenceID: 3, Reason: "Exited with status 1"}),
+
 		Entry("default case -> original error",
 			err,
 			err),
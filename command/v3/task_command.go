@@ -0,0 +1,129 @@
+package v3
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+//go:generate counterfeiter . TaskActor
+
+type TaskActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (v3action.Task, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type TaskCommand struct {
+	RequiredArgs    flag.TaskArgs `positional-args:"yes"`
+	usage           interface{}   `usage:"CF_NAME task APP_NAME TASK_ID\n\nEXAMPLES:\n   CF_NAME task my-app 3"`
+	relatedCommands interface{}   `related_commands:"logs, run-task, tasks, terminate-task"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TaskActor
+}
+
+func (cmd *TaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd TaskCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
+func (cmd TaskCommand) Execute(args []string) error {
+	sequenceID, err := flag.ParseStringToInt(cmd.RequiredArgs.SequenceID)
+	if err != nil {
+		return command.ParseArgumentError{
+			ArgumentName: "TASK_ID",
+			ExpectedType: "integer",
+		}
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.UI.HasStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting task {{.TaskSequenceID}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"TaskSequenceID": sequenceID,
+			"AppName":        cmd.RequiredArgs.AppName,
+			"OrgName":        cmd.Config.TargetedOrganization().Name,
+			"SpaceName":      space.Name,
+			"CurrentUser":    user.Name,
+		})
+	}
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	task, warnings, err := cmd.Actor.GetTaskBySequenceIDAndApplication(sequenceID, application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.UI.HasStructuredOutput() {
+		return cmd.UI.DisplayStructuredData(task)
+	}
+
+	startTime, err := time.Parse(time.RFC3339, task.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	if task.Command == "" {
+		task.Command = "[hidden]"
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("name:"), task.Name},
+		{cmd.UI.TranslateText("id:"), strconv.Itoa(task.SequenceID)},
+		{cmd.UI.TranslateText("state:"), cmd.UI.TranslateText(task.State)},
+		{cmd.UI.TranslateText("start time:"), startTime.Format(time.RFC1123)},
+		{cmd.UI.TranslateText("command:"), task.Command},
+		{cmd.UI.TranslateText("memory:"), bytefmt.ByteSize(task.MemoryInMB * bytefmt.MEGABYTE)},
+		{cmd.UI.TranslateText("disk:"), bytefmt.ByteSize(task.DiskInMB * bytefmt.MEGABYTE)},
+		{cmd.UI.TranslateText("droplet:"), task.DropletGUID},
+		{cmd.UI.TranslateText("failure reason:"), task.FailureReason},
+	}, 3)
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("task Command", func() {
+	var (
+		cmd             v3.TaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeTaskActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeTaskActor)
+
+		cmd = v3.TaskCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app-name"
+		cmd.RequiredArgs.SequenceID = "3"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	Context("when the task id argument is not an integer", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.SequenceID = "not-an-integer"
+		})
+
+		It("returns an ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "TASK_ID",
+				ExpectedType: "integer",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and a space and org are targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+				GUID: "some-org-guid",
+				Name: "some-org",
+			})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{
+				GUID: "some-space-guid",
+				Name: "some-space",
+			})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		})
+
+		Context("when provided a valid application name and task sequence ID", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v3action.Application{GUID: "some-app-guid"},
+					v3action.Warnings{"get-application-warning"},
+					nil)
+				fakeActor.GetTaskBySequenceIDAndApplicationReturns(
+					v3action.Task{
+						GUID:          "some-task-guid",
+						SequenceID:    3,
+						Name:          "some-task",
+						Command:       "some-command",
+						State:         "FAILED",
+						CreatedAt:     "2016-11-08T22:26:02Z",
+						MemoryInMB:    256,
+						DiskInMB:      1024,
+						DropletGUID:   "some-droplet-guid",
+						FailureReason: "Exited with status 1",
+					},
+					v3action.Warnings{"get-task-warning"},
+					nil)
+			})
+
+			It("displays the task details and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app-name"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				sequenceID, appGUID := fakeActor.GetTaskBySequenceIDAndApplicationArgsForCall(0)
+				Expect(sequenceID).To(Equal(3))
+				Expect(appGUID).To(Equal("some-app-guid"))
+
+				Expect(testUI.Out).To(Say("Getting task 3 of app some-app-name in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`name:\s+some-task`))
+				Expect(testUI.Out).To(Say(`id:\s+3`))
+				Expect(testUI.Out).To(Say(`state:\s+FAILED`))
+				Expect(testUI.Out).To(Say(`start time:\s+Tue, 08 Nov 2016 22:26:02 UTC`))
+				Expect(testUI.Out).To(Say(`command:\s+some-command`))
+				Expect(testUI.Out).To(Say(`memory:\s+256M`))
+				Expect(testUI.Out).To(Say(`disk:\s+1G`))
+				Expect(testUI.Out).To(Say(`droplet:\s+some-droplet-guid`))
+				Expect(testUI.Out).To(Say(`failure reason:\s+Exited with status 1`))
+
+				Expect(testUI.Err).To(Say("get-application-warning"))
+				Expect(testUI.Err).To(Say("get-task-warning"))
+			})
+
+			Context("when structured output is requested", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = configv3.OutputYAML
+				})
+
+				It("outputs the task as a document", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Getting task"))
+					Expect(testUI.Out).To(Say("guid: some-task-guid"))
+					Expect(testUI.Out).To(Say("droplet_guid: some-droplet-guid"))
+				})
+			})
+		})
+
+		Context("when getting the app returns a translatable error", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v3action.Application{},
+					v3action.Warnings{"get-application-warning"},
+					v3action.ApplicationNotFoundError{Name: "some-app-name"})
+			})
+
+			It("returns a translatable error and all warnings", func() {
+				Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app-name"}))
+				Expect(testUI.Err).To(Say("get-application-warning"))
+				Expect(fakeActor.GetTaskBySequenceIDAndApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when getting the task returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("request-error")
+				fakeActor.GetTaskBySequenceIDAndApplicationReturns(
+					v3action.Task{},
+					v3action.Warnings{"get-task-warning"},
+					cloudcontroller.RequestError{Err: expectedErr})
+			})
+
+			It("returns a translatable error and all warnings", func() {
+				Expect(executeErr).To(MatchError(command.APIRequestError{Err: expectedErr}))
+				Expect(testUI.Err).To(Say("get-task-warning"))
+			})
+		})
+	})
+})
@@ -19,18 +19,26 @@ const (
 	succeededState = "SUCCEEDED"
 )
 
+// minVersionTaskSinceFilter is the first CF API version that filters tasks by
+// creation time.
+const minVersionTaskSinceFilter = "3.76.0"
+
 //go:generate counterfeiter . TasksActor
 
 type TasksActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
-	GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	GetFilteredApplicationTasks(appGUID string, filter v3action.TaskFilter, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
 }
 
 type TasksCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME tasks APP_NAME"`
-	relatedCommands interface{}  `related_commands:"apps, logs, run-task, terminate-task"`
+	RequiredArgs    flag.AppName     `positional-args:"yes"`
+	States          []flag.TaskState `long:"state" description:"Only show tasks in this state; can be repeated"`
+	Name            string           `long:"name" description:"Only show tasks with this name"`
+	Limit           flag.Limit       `long:"limit" description:"Only show this many of the most recently created tasks"`
+	Since           flag.Timestamp   `long:"since" description:"Only show tasks created after this time, as an RFC3339 timestamp or a duration ago (e.g. 30m)"`
+	usage           interface{}      `usage:"CF_NAME tasks APP_NAME [--state STATE]... [--name TASK_NAME] [--limit LIMIT] [--since TIME]\n\nEXAMPLES:\n   CF_NAME tasks my-app --state FAILED --since 24h\n   CF_NAME tasks my-app --name migrate --limit 5"`
+	relatedCommands interface{}      `related_commands:"apps, logs, run-task, task, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
@@ -52,12 +60,27 @@ func (cmd *TasksCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd TasksCommand) SupportsStructuredOutput() bool {
+	return true
+}
+
 func (cmd TasksCommand) Execute(args []string) error {
 	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
 	if err != nil {
 		return err
 	}
 
+	if !cmd.Since.Time.IsZero() {
+		apiVersion := cmd.Actor.CloudControllerAPIVersion()
+		if command.MinimumAPIVersionCheck(apiVersion, minVersionTaskSinceFilter) != nil {
+			return command.FlagMinimumAPIVersionNotMetError{
+				Flag:           "--since",
+				CurrentVersion: apiVersion,
+				MinimumVersion: minVersionTaskSinceFilter,
+			}
+		}
+	}
+
 	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -76,19 +99,25 @@ func (cmd TasksCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting tasks for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"AppName":     cmd.RequiredArgs.AppName,
-		"OrgName":     cmd.Config.TargetedOrganization().Name,
-		"SpaceName":   space.Name,
-		"CurrentUser": user.Name,
-	})
+	if !cmd.UI.HasStructuredOutput() {
+		cmd.UI.DisplayTextWithFlavor("Getting tasks for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   space.Name,
+			"CurrentUser": user.Name,
+		})
+	}
 
-	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, v3action.Descending)
+	tasks, warnings, err := cmd.Actor.GetFilteredApplicationTasks(application.GUID, cmd.taskFilter(), v3action.Descending)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
 	}
 
+	if cmd.UI.HasStructuredOutput() {
+		return cmd.UI.DisplayStructuredData(tasks)
+	}
+
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
@@ -124,3 +153,15 @@ func (cmd TasksCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd TasksCommand) taskFilter() v3action.TaskFilter {
+	filter := v3action.TaskFilter{
+		Name:  cmd.Name,
+		Since: cmd.Since.Time,
+		Limit: cmd.Limit.Value,
+	}
+	for _, state := range cmd.States {
+		filter.States = append(filter.States, state.State)
+	}
+	return filter
+}
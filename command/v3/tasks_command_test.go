@@ -2,12 +2,14 @@ package v3_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v3"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -122,7 +124,7 @@ var _ = Describe("tasks Command", func() {
 						v3action.Application{GUID: "some-app-guid"},
 						v3action.Warnings{"get-application-warning-1", "get-application-warning-2"},
 						nil)
-					fakeActor.GetApplicationTasksReturns(
+					fakeActor.GetFilteredApplicationTasksReturns(
 						[]v3action.Task{
 							{
 								GUID:       "task-3-guid",
@@ -133,12 +135,13 @@ var _ = Describe("tasks Command", func() {
 								Command:    "some-command",
 							},
 							{
-								GUID:       "task-2-guid",
-								SequenceID: 2,
-								Name:       "task-2",
-								State:      "FAILED",
-								CreatedAt:  "2016-11-08T22:26:02Z",
-								Command:    "some-command",
+								GUID:          "task-2-guid",
+								SequenceID:    2,
+								Name:          "task-2",
+								State:         "FAILED",
+								CreatedAt:     "2016-11-08T22:26:02Z",
+								Command:       "some-command",
+								FailureReason: "Exited with status 1",
 							},
 							{
 								GUID:       "task-1-guid",
@@ -161,9 +164,10 @@ var _ = Describe("tasks Command", func() {
 					Expect(appName).To(Equal("some-app-name"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 
-					Expect(fakeActor.GetApplicationTasksCallCount()).To(Equal(1))
-					guid, order := fakeActor.GetApplicationTasksArgsForCall(0)
+					Expect(fakeActor.GetFilteredApplicationTasksCallCount()).To(Equal(1))
+					guid, filter, order := fakeActor.GetFilteredApplicationTasksArgsForCall(0)
 					Expect(guid).To(Equal("some-app-guid"))
+					Expect(filter).To(Equal(v3action.TaskFilter{}))
 					Expect(order).To(Equal(v3action.Descending))
 
 					Expect(testUI.Out).To(Say(`Getting tasks for app some-app-name in org some-org / space some-space as some-user...
@@ -179,9 +183,50 @@ get-application-warning-2
 get-tasks-warning-1`))
 				})
 
+				Context("when filters are provided", func() {
+					var since time.Time
+
+					BeforeEach(func() {
+						since = time.Date(2017, 6, 1, 15, 4, 5, 0, time.UTC)
+						cmd.States = []flag.TaskState{{State: "FAILED"}, {State: "RUNNING"}}
+						cmd.Name = "task-2"
+						cmd.Limit = flag.Limit{Value: 5}
+						cmd.Since = flag.Timestamp{Time: since}
+						fakeActor.CloudControllerAPIVersionReturns("3.76.0")
+					})
+
+					It("passes the filters to the actor", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetFilteredApplicationTasksCallCount()).To(Equal(1))
+						_, filter, _ := fakeActor.GetFilteredApplicationTasksArgsForCall(0)
+						Expect(filter).To(Equal(v3action.TaskFilter{
+							States: []string{"FAILED", "RUNNING"},
+							Name:   "task-2",
+							Since:  since,
+							Limit:  5,
+						}))
+					})
+
+					Context("when the API version does not support filtering by creation time", func() {
+						BeforeEach(func() {
+							fakeActor.CloudControllerAPIVersionReturns("3.75.0")
+						})
+
+						It("returns a FlagMinimumAPIVersionNotMetError", func() {
+							Expect(executeErr).To(MatchError(command.FlagMinimumAPIVersionNotMetError{
+								Flag:           "--since",
+								CurrentVersion: "3.75.0",
+								MinimumVersion: "3.76.0",
+							}))
+							Expect(fakeActor.GetFilteredApplicationTasksCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				Context("when the tasks' command fields are returned as empty strings", func() {
 					BeforeEach(func() {
-						fakeActor.GetApplicationTasksReturns(
+						fakeActor.GetFilteredApplicationTasksReturns(
 							[]v3action.Task{
 								{
 									GUID:       "task-2-guid",
@@ -214,9 +259,37 @@ get-tasks-warning-1`))
 					})
 				})
 
+				Context("when structured output is requested", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputYAML
+					})
+
+					It("outputs the tasks as a document", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("Getting tasks"))
+						Expect(testUI.Out).To(Say(`- guid: task-3-guid
+  sequence_id: 3
+  name: task-3
+  command: some-command
+  state: RUNNING
+  created_at: 2016-11-08T22:26:02Z
+- guid: task-2-guid
+  sequence_id: 2
+  name: task-2
+  command: some-command
+  state: FAILED
+  created_at: 2016-11-08T22:26:02Z
+  failure_reason: Exited with status 1
+- guid: task-1-guid
+`))
+						Expect(testUI.Err).To(Say("get-tasks-warning-1"))
+					})
+				})
+
 				Context("when there are no tasks associated with the application", func() {
 					BeforeEach(func() {
-						fakeActor.GetApplicationTasksReturns([]v3action.Task{}, nil, nil)
+						fakeActor.GetFilteredApplicationTasksReturns([]v3action.Task{}, nil, nil)
 					})
 
 					It("outputs an empty table", func() {
@@ -262,7 +335,7 @@ id   name   state   start time   command
 								v3action.Application{GUID: "some-app-guid"},
 								nil,
 								nil)
-							fakeActor.GetApplicationTasksReturns(
+							fakeActor.GetFilteredApplicationTasksReturns(
 								[]v3action.Task{},
 								nil,
 								returnedErr)
@@ -303,7 +376,7 @@ id   name   state   start time   command
 								v3action.Application{GUID: "some-app-guid"},
 								v3action.Warnings{"get-application-warning-1", "get-application-warning-2"},
 								nil)
-							fakeActor.GetApplicationTasksReturns(
+							fakeActor.GetFilteredApplicationTasksReturns(
 								nil,
 								v3action.Warnings{"get-tasks-warning-1", "get-tasks-warning-2"},
 								expectedErr)
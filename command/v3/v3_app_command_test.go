@@ -0,0 +1,159 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-app Command", func() {
+	var (
+		cmd             v3.V3AppCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3AppActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3AppActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = v3.V3AppCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		})
+
+		Context("when the app summary can be retrieved", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+					v3action.ApplicationSummary{
+						Application: v3action.Application{Name: "some-app", State: ccv3.ApplicationStateStarted},
+						ProcessSummaries: v3action.ProcessSummaries{
+							{
+								Process: v3action.Process{Type: "web", Instances: 2, MemoryInMB: 32, DiskInMB: 1024},
+								InstanceDetails: []v3action.ProcessInstance{
+									{
+										Index:       0,
+										State:       ccv3.ProcessInstanceStateRunning,
+										CPU:         0.015,
+										MemoryUsage: 1048576,
+										MemoryQuota: 33554432,
+										DiskUsage:   2097152,
+										DiskQuota:   1073741824,
+									},
+									{Index: 1, State: ccv3.ProcessInstanceStateStarting},
+								},
+							},
+							{
+								Process: v3action.Process{Type: "worker", Instances: 0, MemoryInMB: 64, DiskInMB: 512},
+							},
+						},
+					},
+					v3action.Warnings{"summary-warning"},
+					nil,
+				)
+			})
+
+			It("displays the app and the instances of each process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Showing health and status for app some-app in org some-org / space some-space as banana..."))
+				Expect(testUI.Out).To(Say(`name:\s+some-app`))
+				Expect(testUI.Out).To(Say(`requested state:\s+started`))
+				Expect(testUI.Out).To(Say(`processes:\s+web:1/2, worker:0/0`))
+
+				Expect(testUI.Out).To(Say(`type:\s+web`))
+				Expect(testUI.Out).To(Say(`instances:\s+1/2`))
+				Expect(testUI.Out).To(Say(`memory usage:\s+32M`))
+				Expect(testUI.Out).To(Say(`disk usage:\s+1024M`))
+				Expect(testUI.Out).To(Say(`state\s+since\s+cpu\s+memory\s+disk`))
+				Expect(testUI.Out).To(Say(`#0\s+running\s+\S+\s+1\.5\x25\s+1M of 32M\s+2M of 1G`))
+				Expect(testUI.Out).To(Say(`#1\s+starting`))
+
+				Expect(testUI.Out).To(Say(`type:\s+worker`))
+				Expect(testUI.Out).To(Say(`instances:\s+0/0`))
+				Expect(testUI.Out).To(Say("There are no running instances of this process."))
+
+				Expect(testUI.Err).To(Say("summary-warning"))
+
+				appName, spaceGUID := fakeActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{}, v3action.Warnings{"summary-warning"}, v3action.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(testUI.Err).To(Say("summary-warning"))
+			})
+		})
+
+		Context("when getting the summary fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("summary error")
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{}, nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
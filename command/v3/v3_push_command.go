@@ -0,0 +1,292 @@
+package v3
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . V3PushActor
+
+type V3PushActor interface {
+	CreateAndUploadPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
+	CreateApplicationByNameAndSpace(name string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	SetApplicationDroplet(appGUID string, dropletGUID string) (v3action.Warnings, error)
+	StagePackage(packageGUID string, appName string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error)
+	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
+	StopApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
+	WaitForApplicationToStart(app v3action.Application) (v3action.Warnings, error)
+}
+
+//go:generate counterfeiter . V3PushActorV2
+
+type V3PushActorV2 interface {
+	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+}
+
+type V3PushCommand struct {
+	RequiredArgs flag.AppName                `positional-args:"yes"`
+	AppPath      flag.PathWithExistenceCheck `short:"p" description:"Path to app directory to upload (defaults to the current directory)"`
+	usage        interface{}                 `usage:"CF_NAME v3-push APP_NAME [-p APP_PATH]"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3PushActor
+	ActorV2     V3PushActorV2
+	NOAAClient  v2action.NOAAClient
+}
+
+func (cmd *V3PushCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.ActorV2 = v2action.NewActor(ccClientV2, uaaClientV2)
+	cmd.NOAAClient = sharedV2.NewNOAAClient(ccClientV2.DopplerEndpoint(), config, uaaClientV2, ui)
+
+	return nil
+}
+
+func (cmd V3PushCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	app, err := cmd.createOrGetApplication(user.Name)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if app.Started() {
+		err = cmd.stopApplication(app)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+	}
+
+	pkg, err := cmd.uploadPackage()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	droplet, err := cmd.stagePackage(pkg)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	err = cmd.setApplicationDroplet(app, droplet)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return cmd.startApplication(app)
+}
+
+func (cmd V3PushCommand) createOrGetApplication(username string) (v3action.Application, error) {
+	cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  username,
+	})
+
+	app, warnings, err := cmd.Actor.CreateApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(v3action.ApplicationAlreadyExistsError); ok {
+		cmd.UI.DisplayWarning("App {{.AppName}} already exists.", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+		})
+
+		app, warnings, err = cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+	}
+	if err != nil {
+		return v3action.Application{}, err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return app, nil
+}
+
+func (cmd V3PushCommand) stopApplication(app v3action.Application) error {
+	cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}}...", map[string]interface{}{
+		"AppName": app.Name,
+	})
+
+	_, warnings, err := cmd.Actor.StopApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd V3PushCommand) uploadPackage() (v3action.Package, error) {
+	cmd.UI.DisplayTextWithFlavor("Uploading app {{.AppName}}...", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	appPath := string(cmd.AppPath)
+	if appPath == "" {
+		pwd, err := os.Getwd()
+		if err != nil {
+			return v3action.Package{}, err
+		}
+		appPath = pwd
+	}
+
+	pkg, warnings, err := cmd.Actor.CreateAndUploadPackageByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, appPath)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v3action.Package{}, err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return pkg, nil
+}
+
+func (cmd V3PushCommand) stagePackage(pkg v3action.Package) (v3action.Droplet, error) {
+	cmd.UI.DisplayTextWithFlavor("Staging package for app {{.AppName}}...", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+
+	messages, logErrs, logWarnings, err := cmd.ActorV2.GetStreamingLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, v2action.LogFilter{}, cmd.NOAAClient, cmd.Config)
+	cmd.UI.DisplayWarnings(logWarnings)
+	if err != nil {
+		return v3action.Droplet{}, err
+	}
+	defer cmd.NOAAClient.Close()
+
+	var droplet v3action.Droplet
+	dropletStream, warningsStream, errStream := cmd.Actor.StagePackage(pkg.GUID, cmd.RequiredArgs.AppName)
+	for dropletStream != nil || warningsStream != nil || errStream != nil {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil
+				break
+			}
+
+			if message.Staging() {
+				cmd.UI.DisplayLogMessage(message, false)
+			}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				break
+			}
+
+			switch logErr.(type) {
+			case v2action.NOAATimeoutError:
+				cmd.UI.DisplayWarning("timeout connecting to log server, no log will be shown")
+			default:
+				cmd.UI.DisplayWarning(logErr.Error())
+			}
+		case stagedDroplet, ok := <-dropletStream:
+			if !ok {
+				dropletStream = nil
+				break
+			}
+
+			droplet = stagedDroplet
+		case warnings, ok := <-warningsStream:
+			if !ok {
+				warningsStream = nil
+				break
+			}
+
+			cmd.UI.DisplayWarnings(warnings)
+		case stageErr, ok := <-errStream:
+			if !ok {
+				errStream = nil
+				break
+			}
+
+			return v3action.Droplet{}, stageErr
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return droplet, nil
+}
+
+func (cmd V3PushCommand) setApplicationDroplet(app v3action.Application, droplet v3action.Droplet) error {
+	cmd.UI.DisplayTextWithFlavor("Setting app {{.AppName}} to droplet {{.DropletGUID}}...", map[string]interface{}{
+		"AppName":     app.Name,
+		"DropletGUID": droplet.GUID,
+	})
+
+	warnings, err := cmd.Actor.SetApplicationDroplet(app.GUID, droplet.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd V3PushCommand) startApplication(app v3action.Application) error {
+	cmd.UI.DisplayTextWithFlavor("Starting app {{.AppName}}...", map[string]interface{}{
+		"AppName": app.Name,
+	})
+
+	_, warnings, err := cmd.Actor.StartApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for app to start...")
+
+	warnings, err = cmd.Actor.WaitForApplicationToStart(app)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+	case v3action.ApplicationInstanceCrashedError:
+		return shared.UnsuccessfulStartError{AppName: app.Name, BinaryName: cmd.Config.BinaryName()}
+	case v3action.StartupTimeoutError:
+		return shared.StartupTimeoutError{AppName: app.Name, BinaryName: cmd.Config.BinaryName()}
+	default:
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,283 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-push Command", func() {
+	var (
+		cmd             v3.V3PushCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3PushActor
+		fakeActorV2     *v3fakes.FakeV3PushActorV2
+		fakeNOAAClient  *v2actionfakes.FakeNOAAClient
+		binaryName      string
+		executeErr      error
+		app             string
+	)
+
+	stageWith := func(droplet v3action.Droplet, err error) func(string, string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+		return func(string, string) (<-chan v3action.Droplet, <-chan v3action.Warnings, <-chan error) {
+			dropletStream := make(chan v3action.Droplet)
+			warningsStream := make(chan v3action.Warnings)
+			errStream := make(chan error)
+
+			go func() {
+				defer close(dropletStream)
+				defer close(warningsStream)
+				defer close(errStream)
+
+				warningsStream <- v3action.Warnings{"stage-warning"}
+				if err != nil {
+					errStream <- err
+					return
+				}
+				dropletStream <- droplet
+			}()
+
+			return dropletStream, warningsStream, errStream
+		}
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3PushActor)
+		fakeActorV2 = new(v3fakes.FakeV3PushActorV2)
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		app = "some-app"
+
+		cmd = v3.V3PushCommand{
+			RequiredArgs: flag.AppName{AppName: app},
+			AppPath:      flag.PathWithExistenceCheck("/some/app/path"),
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			ActorV2:     fakeActorV2,
+			NOAAClient:  fakeNOAAClient,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Out).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+
+			fakeActor.CreateApplicationByNameAndSpaceReturns(
+				v3action.Application{Name: app, GUID: "some-app-guid"},
+				v3action.Warnings{"create-app-warning"},
+				nil,
+			)
+			fakeActor.CreateAndUploadPackageByApplicationNameAndSpaceReturns(
+				v3action.Package{GUID: "some-package-guid"},
+				v3action.Warnings{"upload-warning"},
+				nil,
+			)
+			fakeActorV2.GetStreamingLogsForApplicationByNameAndSpaceStub = func(appName string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+				messages := make(chan *v2action.LogMessage, 2)
+				messages <- v2action.NewLogMessage("staging log", 1, time.Now(), "STG", "0")
+				messages <- v2action.NewLogMessage("app log", 1, time.Now(), "APP", "0")
+				return messages, make(chan error), v2action.Warnings{"logs-warning"}, nil
+			}
+			fakeActor.StagePackageStub = stageWith(v3action.Droplet{GUID: "some-droplet-guid"}, nil)
+			fakeActor.SetApplicationDropletReturns(v3action.Warnings{"set-droplet-warning"}, nil)
+			fakeActor.StartApplicationReturns(v3action.Application{}, v3action.Warnings{"start-warning"}, nil)
+			fakeActor.WaitForApplicationToStartReturns(v3action.Warnings{"wait-warning"}, nil)
+		})
+
+		Context("when every step succeeds", func() {
+			It("creates, uploads, stages and starts the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Creating app some-app in org some-org / space some-space as banana..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Uploading app some-app..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Staging package for app some-app..."))
+				Expect(testUI.Out).To(Say("staging log"))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Setting app some-app to droplet some-droplet-guid..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say("Starting app some-app..."))
+				Expect(testUI.Out).To(Say("Waiting for app to start..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).ToNot(Say("app log"))
+
+				Expect(testUI.Err).To(Say("create-app-warning"))
+				Expect(testUI.Err).To(Say("upload-warning"))
+				Expect(testUI.Err).To(Say("logs-warning"))
+				Expect(testUI.Err).To(Say("stage-warning"))
+				Expect(testUI.Err).To(Say("set-droplet-warning"))
+				Expect(testUI.Err).To(Say("start-warning"))
+				Expect(testUI.Err).To(Say("wait-warning"))
+
+				appName, spaceGUID := fakeActor.CreateApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal(app))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				appName, spaceGUID, bitsPath := fakeActor.CreateAndUploadPackageByApplicationNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal(app))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(bitsPath).To(Equal("/some/app/path"))
+
+				appName, spaceGUID, _, noaaClient, _ := fakeActorV2.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal(app))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(noaaClient).To(Equal(fakeNOAAClient))
+				Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+
+				packageGUID, appName := fakeActor.StagePackageArgsForCall(0)
+				Expect(packageGUID).To(Equal("some-package-guid"))
+				Expect(appName).To(Equal(app))
+
+				appGUID, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(dropletGUID).To(Equal("some-droplet-guid"))
+
+				Expect(fakeActor.StartApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeActor.WaitForApplicationToStartArgsForCall(0)).To(Equal(v3action.Application{Name: app, GUID: "some-app-guid"}))
+				Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app already exists", func() {
+			BeforeEach(func() {
+				fakeActor.CreateApplicationByNameAndSpaceReturns(v3action.Application{}, nil, v3action.ApplicationAlreadyExistsError{Name: app})
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v3action.Application{Name: app, GUID: "some-app-guid", State: ccv3.ApplicationStateStarted},
+					v3action.Warnings{"get-app-warning"},
+					nil,
+				)
+				fakeActor.StopApplicationReturns(v3action.Application{}, v3action.Warnings{"stop-warning"}, nil)
+			})
+
+			It("stops the existing app before pushing the new droplet", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("App some-app already exists."))
+				Expect(testUI.Err).To(Say("get-app-warning"))
+				Expect(testUI.Out).To(Say("Stopping app some-app..."))
+				Expect(testUI.Err).To(Say("stop-warning"))
+
+				Expect(fakeActor.StopApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+				appGUID, _ := fakeActor.SetApplicationDropletArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when staging fails", func() {
+			BeforeEach(func() {
+				fakeActor.StagePackageStub = stageWith(v3action.Droplet{}, v3action.StagingFailedError{Reason: "some staging error"})
+			})
+
+			It("returns the staging error and closes the log stream", func() {
+				Expect(executeErr).To(MatchError(shared.StagingFailedError{Message: "some staging error"}))
+				Expect(testUI.Err).To(Say("stage-warning"))
+				Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+				Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when streaming the logs fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("logs error")
+				fakeActorV2.GetStreamingLogsForApplicationByNameAndSpaceStub = nil
+				fakeActorV2.GetStreamingLogsForApplicationByNameAndSpaceReturns(nil, nil, v2action.Warnings{"logs-warning"}, expectedErr)
+			})
+
+			It("returns the error without staging", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("logs-warning"))
+				Expect(fakeActor.StagePackageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when uploading the package fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("upload error")
+				fakeActor.CreateAndUploadPackageByApplicationNameAndSpaceReturns(v3action.Package{}, v3action.Warnings{"upload-warning"}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("upload-warning"))
+				Expect(fakeActor.StagePackageCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an instance crashes", func() {
+			BeforeEach(func() {
+				fakeActor.WaitForApplicationToStartReturns(v3action.Warnings{"wait-warning"}, v3action.ApplicationInstanceCrashedError{Name: app})
+			})
+
+			It("returns an UnsuccessfulStartError", func() {
+				Expect(executeErr).To(MatchError(shared.UnsuccessfulStartError{AppName: app, BinaryName: binaryName}))
+				Expect(testUI.Err).To(Say("wait-warning"))
+			})
+		})
+
+		Context("when the app times out starting", func() {
+			BeforeEach(func() {
+				fakeActor.WaitForApplicationToStartReturns(nil, v3action.StartupTimeoutError{Name: app})
+			})
+
+			It("returns a StartupTimeoutError", func() {
+				Expect(executeErr).To(MatchError(shared.StartupTimeoutError{AppName: app, BinaryName: binaryName}))
+			})
+		})
+	})
+})
@@ -0,0 +1,201 @@
+package v3
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . V3ScaleActor
+
+type V3ScaleActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetProcessSummariesByApplication(appGUID string) (v3action.ProcessSummaries, v3action.Warnings, error)
+	ScaleProcessByApplication(appGUID string, processType string, scale v3action.ProcessScaleOptions) (v3action.Warnings, error)
+	StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
+	StopApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
+	WaitForApplicationToStart(app v3action.Application) (v3action.Warnings, error)
+}
+
+type V3ScaleCommand struct {
+	RequiredArgs flag.AppName   `positional-args:"yes"`
+	ProcessType  string         `long:"process" default:"web" description:"App process to scale"`
+	Instances    flag.Instances `short:"i" description:"Number of instances"`
+	DiskLimit    flag.Megabytes `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	MemoryLimit  flag.Megabytes `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	ForceRestart bool           `short:"f" description:"Force restart of app without prompt"`
+	usage        interface{}    `usage:"CF_NAME v3-scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY] [-f]"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3ScaleActor
+}
+
+func (cmd *V3ScaleCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client, config)
+
+	return nil
+}
+
+func (cmd V3ScaleCommand) Execute(args []string) error {
+	cmd.UI.DisplayText(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.Instances.IsSet && cmd.DiskLimit.Size == 0 && cmd.MemoryLimit.Size == 0 {
+		cmd.UI.DisplayTextWithFlavor("Showing current scale of process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", cmd.flavorValues(user.Name))
+		cmd.UI.DisplayNewline()
+		return cmd.displayProcessSummary(app)
+	}
+
+	// A stopped app picks up the new memory and disk when it is next started,
+	// so it is only restarted when it is running.
+	restartRequired := (cmd.DiskLimit.Size != 0 || cmd.MemoryLimit.Size != 0) && app.Started()
+	if restartRequired && !cmd.ForceRestart {
+		confirmed, promptErr := cmd.UI.DisplayBoolPrompt(false, "This will cause the app to restart. Are you sure you want to scale {{.AppName}}?", map[string]interface{}{
+			"AppName": app.Name,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !confirmed {
+			cmd.UI.DisplayText("Scaling cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Scaling process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", cmd.flavorValues(user.Name))
+
+	warnings, err = cmd.Actor.ScaleProcessByApplication(app.GUID, cmd.ProcessType, cmd.scaleOptions())
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if restartRequired {
+		err = cmd.restartApplication(app)
+		if err != nil {
+			return err
+		}
+	}
+
+	return cmd.displayProcessSummary(app)
+}
+
+func (cmd V3ScaleCommand) flavorValues(username string) map[string]interface{} {
+	return map[string]interface{}{
+		"ProcessType": cmd.ProcessType,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    username,
+	}
+}
+
+func (cmd V3ScaleCommand) scaleOptions() v3action.ProcessScaleOptions {
+	var scale v3action.ProcessScaleOptions
+
+	if cmd.Instances.IsSet {
+		instances := cmd.Instances.Value
+		scale.Instances = &instances
+	}
+
+	if cmd.DiskLimit.Size != 0 {
+		disk := cmd.DiskLimit.Size
+		scale.DiskInMB = &disk
+	}
+
+	if cmd.MemoryLimit.Size != 0 {
+		memory := cmd.MemoryLimit.Size
+		scale.MemoryInMB = &memory
+	}
+
+	return scale
+}
+
+func (cmd V3ScaleCommand) restartApplication(app v3action.Application) error {
+	cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}}...", map[string]interface{}{
+		"AppName": app.Name,
+	})
+
+	_, warnings, err := cmd.Actor.StopApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Starting app {{.AppName}}...", map[string]interface{}{
+		"AppName": app.Name,
+	})
+
+	_, warnings, err = cmd.Actor.StartApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayText("Waiting for app to start...")
+
+	warnings, err = cmd.Actor.WaitForApplicationToStart(app)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+	case v3action.ApplicationInstanceCrashedError:
+		return shared.UnsuccessfulStartError{AppName: app.Name, BinaryName: cmd.Config.BinaryName()}
+	case v3action.StartupTimeoutError:
+		return shared.StartupTimeoutError{AppName: app.Name, BinaryName: cmd.Config.BinaryName()}
+	default:
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
+func (cmd V3ScaleCommand) displayProcessSummary(app v3action.Application) error {
+	summaries, warnings, err := cmd.Actor.GetProcessSummariesByApplication(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	for _, summary := range summaries {
+		if summary.Type == cmd.ProcessType {
+			shared.DisplayProcessSummary(cmd.UI, summary)
+			return nil
+		}
+	}
+
+	return shared.ProcessNotFoundError{ProcessType: cmd.ProcessType}
+}
@@ -0,0 +1,278 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-scale Command", func() {
+	var (
+		cmd             v3.V3ScaleCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3ScaleActor
+		binaryName      string
+		executeErr      error
+		app             v3action.Application
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3ScaleActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		app = v3action.Application{Name: "some-app", GUID: "some-app-guid", State: ccv3.ApplicationStateStarted}
+
+		cmd = v3.V3ScaleCommand{
+			RequiredArgs: flag.AppName{AppName: "some-app"},
+			ProcessType:  "web",
+			UI:           testUI,
+			Config:       fakeConfig,
+			SharedActor:  fakeSharedActor,
+			Actor:        fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	Context("when the user is logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "banana"}, nil)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+
+			fakeActor.GetApplicationByNameAndSpaceReturns(app, v3action.Warnings{"get-app-warning"}, nil)
+			fakeActor.GetProcessSummariesByApplicationReturns(
+				v3action.ProcessSummaries{
+					{
+						Process:         v3action.Process{Type: "web", Instances: 1, MemoryInMB: 32, DiskInMB: 1024},
+						InstanceDetails: []v3action.ProcessInstance{{Index: 0, State: ccv3.ProcessInstanceStateRunning}},
+					},
+					{
+						Process:         v3action.Process{Type: "worker", Instances: 3, MemoryInMB: 64, DiskInMB: 512},
+						InstanceDetails: []v3action.ProcessInstance{{Index: 0, State: ccv3.ProcessInstanceStateRunning}},
+					},
+				},
+				v3action.Warnings{"get-processes-warning"},
+				nil,
+			)
+			fakeActor.ScaleProcessByApplicationReturns(v3action.Warnings{"scale-warning"}, nil)
+		})
+
+		Context("when no scale flags are provided", func() {
+			It("displays the current scale of the web process", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Showing current scale of process web of app some-app in org some-org / space some-space as banana..."))
+				Expect(testUI.Out).To(Say(`type:\s+web`))
+				Expect(testUI.Out).To(Say(`instances:\s+1/1`))
+				Expect(testUI.Err).To(Say("get-app-warning"))
+				Expect(testUI.Err).To(Say("get-processes-warning"))
+
+				Expect(fakeActor.ScaleProcessByApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the process does not exist", func() {
+			BeforeEach(func() {
+				cmd.ProcessType = "console"
+			})
+
+			It("returns a ProcessNotFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.ProcessNotFoundError{ProcessType: "console"}))
+			})
+		})
+
+		Context("when only the instances are scaled", func() {
+			BeforeEach(func() {
+				cmd.ProcessType = "worker"
+				cmd.Instances = flag.Instances{Value: 3, IsSet: true}
+			})
+
+			It("scales the process without restarting the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Scaling process worker of app some-app in org some-org / space some-space as banana..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`type:\s+worker`))
+				Expect(testUI.Out).To(Say(`instances:\s+1/3`))
+				Expect(testUI.Err).To(Say("scale-warning"))
+
+				appGUID, processType, scale := fakeActor.ScaleProcessByApplicationArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(processType).To(Equal("worker"))
+				Expect(*scale.Instances).To(Equal(3))
+				Expect(scale.MemoryInMB).To(BeNil())
+				Expect(scale.DiskInMB).To(BeNil())
+
+				Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the memory is scaled", func() {
+			BeforeEach(func() {
+				cmd.MemoryLimit = flag.Megabytes{Size: 64}
+			})
+
+			Context("when the user confirms the restart", func() {
+				BeforeEach(func() {
+					input.Write([]byte("y\n"))
+					fakeActor.StopApplicationReturns(v3action.Application{}, v3action.Warnings{"stop-warning"}, nil)
+					fakeActor.StartApplicationReturns(v3action.Application{}, v3action.Warnings{"start-warning"}, nil)
+					fakeActor.WaitForApplicationToStartReturns(v3action.Warnings{"wait-warning"}, nil)
+				})
+
+				It("scales the process and restarts the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`This will cause the app to restart. Are you sure you want to scale some-app\?`))
+					Expect(testUI.Out).To(Say("Scaling process web of app some-app"))
+					Expect(testUI.Out).To(Say("Stopping app some-app..."))
+					Expect(testUI.Out).To(Say("Starting app some-app..."))
+					Expect(testUI.Out).To(Say("Waiting for app to start..."))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say(`type:\s+web`))
+
+					Expect(testUI.Err).To(Say("stop-warning"))
+					Expect(testUI.Err).To(Say("start-warning"))
+					Expect(testUI.Err).To(Say("wait-warning"))
+
+					_, _, scale := fakeActor.ScaleProcessByApplicationArgsForCall(0)
+					Expect(scale.Instances).To(BeNil())
+					Expect(*scale.MemoryInMB).To(BeEquivalentTo(64))
+
+					Expect(fakeActor.StopApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+					Expect(fakeActor.StartApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+					Expect(fakeActor.WaitForApplicationToStartArgsForCall(0)).To(Equal(app))
+				})
+			})
+
+			Context("when the user cancels", func() {
+				BeforeEach(func() {
+					input.Write([]byte("n\n"))
+				})
+
+				It("does not scale the process", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Scaling cancelled"))
+					Expect(fakeActor.ScaleProcessByApplicationCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when --force is provided", func() {
+				BeforeEach(func() {
+					cmd.ForceRestart = true
+				})
+
+				It("does not prompt", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("Are you sure"))
+					Expect(fakeActor.ScaleProcessByApplicationCallCount()).To(Equal(1))
+					Expect(fakeActor.StopApplicationCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the app is stopped", func() {
+				BeforeEach(func() {
+					app.State = ccv3.ApplicationStateStopped
+					fakeActor.GetApplicationByNameAndSpaceReturns(app, nil, nil)
+				})
+
+				It("scales the process without prompting or starting the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Are you sure"))
+					Expect(testUI.Out).To(Say("Scaling process web of app some-app"))
+					Expect(testUI.Out).To(Say("OK"))
+					Expect(testUI.Out).To(Say(`type:\s+web`))
+					Expect(testUI.Out).ToNot(Say("Stopping app"))
+
+					_, _, scale := fakeActor.ScaleProcessByApplicationArgsForCall(0)
+					Expect(*scale.MemoryInMB).To(BeEquivalentTo(64))
+
+					Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+					Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+					Expect(fakeActor.WaitForApplicationToStartCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the app crashes after restarting", func() {
+				BeforeEach(func() {
+					cmd.ForceRestart = true
+					fakeActor.WaitForApplicationToStartReturns(nil, v3action.ApplicationInstanceCrashedError{Name: "some-app"})
+				})
+
+				It("returns an UnsuccessfulStartError", func() {
+					Expect(executeErr).To(MatchError(shared.UnsuccessfulStartError{AppName: "some-app", BinaryName: binaryName}))
+				})
+			})
+		})
+
+		Context("when scaling fails", func() {
+			BeforeEach(func() {
+				cmd.Instances = flag.Instances{Value: 2, IsSet: true}
+				fakeActor.ScaleProcessByApplicationReturns(v3action.Warnings{"scale-warning"}, v3action.ProcessNotFoundError{ProcessType: "web"})
+			})
+
+			It("returns the translated error", func() {
+				Expect(executeErr).To(MatchError(shared.ProcessNotFoundError{ProcessType: "web"}))
+				Expect(testUI.Err).To(Say("scale-warning"))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, nil, v3action.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			})
+		})
+
+		Context("when getting the process summaries fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("summaries error")
+				fakeActor.GetProcessSummariesByApplicationReturns(nil, nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
@@ -53,6 +53,21 @@ type FakeRunTaskActor struct {
 	cloudControllerAPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	WaitForTaskToCompleteStub        func(taskGUID string) (v3action.Task, v3action.Warnings, error)
+	waitForTaskToCompleteMutex       sync.RWMutex
+	waitForTaskToCompleteArgsForCall []struct {
+		taskGUID string
+	}
+	waitForTaskToCompleteReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	waitForTaskToCompleteReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -210,6 +225,60 @@ func (fake *FakeRunTaskActor) CloudControllerAPIVersionReturnsOnCall(i int, resu
 	}{result1}
 }
 
+func (fake *FakeRunTaskActor) WaitForTaskToComplete(taskGUID string) (v3action.Task, v3action.Warnings, error) {
+	fake.waitForTaskToCompleteMutex.Lock()
+	ret, specificReturn := fake.waitForTaskToCompleteReturnsOnCall[len(fake.waitForTaskToCompleteArgsForCall)]
+	fake.waitForTaskToCompleteArgsForCall = append(fake.waitForTaskToCompleteArgsForCall, struct {
+		taskGUID string
+	}{taskGUID})
+	fake.recordInvocation("WaitForTaskToComplete", []interface{}{taskGUID})
+	fake.waitForTaskToCompleteMutex.Unlock()
+	if fake.WaitForTaskToCompleteStub != nil {
+		return fake.WaitForTaskToCompleteStub(taskGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.waitForTaskToCompleteReturns.result1, fake.waitForTaskToCompleteReturns.result2, fake.waitForTaskToCompleteReturns.result3
+}
+
+func (fake *FakeRunTaskActor) WaitForTaskToCompleteCallCount() int {
+	fake.waitForTaskToCompleteMutex.RLock()
+	defer fake.waitForTaskToCompleteMutex.RUnlock()
+	return len(fake.waitForTaskToCompleteArgsForCall)
+}
+
+func (fake *FakeRunTaskActor) WaitForTaskToCompleteArgsForCall(i int) string {
+	fake.waitForTaskToCompleteMutex.RLock()
+	defer fake.waitForTaskToCompleteMutex.RUnlock()
+	return fake.waitForTaskToCompleteArgsForCall[i].taskGUID
+}
+
+func (fake *FakeRunTaskActor) WaitForTaskToCompleteReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.WaitForTaskToCompleteStub = nil
+	fake.waitForTaskToCompleteReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRunTaskActor) WaitForTaskToCompleteReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.WaitForTaskToCompleteStub = nil
+	if fake.waitForTaskToCompleteReturnsOnCall == nil {
+		fake.waitForTaskToCompleteReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.waitForTaskToCompleteReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeRunTaskActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -219,6 +288,8 @@ func (fake *FakeRunTaskActor) Invocations() map[string][][]interface{} {
 	defer fake.runTaskMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.waitForTaskToCompleteMutex.RLock()
+	defer fake.waitForTaskToCompleteMutex.RUnlock()
 	return fake.invocations
 }
 
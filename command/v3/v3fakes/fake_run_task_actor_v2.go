@@ -0,0 +1,106 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeRunTaskActorV2 struct {
+	GetStreamingLogsForTaskStub        func(appGUID string, taskName string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error)
+	getStreamingLogsForTaskMutex       sync.RWMutex
+	getStreamingLogsForTaskArgsForCall []struct {
+		appGUID  string
+		taskName string
+		client   v2action.NOAAClient
+		config   v2action.Config
+	}
+	getStreamingLogsForTaskReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}
+	getStreamingLogsForTaskReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRunTaskActorV2) GetStreamingLogsForTask(appGUID string, taskName string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForTaskReturnsOnCall[len(fake.getStreamingLogsForTaskArgsForCall)]
+	fake.getStreamingLogsForTaskArgsForCall = append(fake.getStreamingLogsForTaskArgsForCall, struct {
+		appGUID  string
+		taskName string
+		client   v2action.NOAAClient
+		config   v2action.Config
+	}{appGUID, taskName, client, config})
+	fake.recordInvocation("GetStreamingLogsForTask", []interface{}{appGUID, taskName, client, config})
+	fake.getStreamingLogsForTaskMutex.Unlock()
+	if fake.GetStreamingLogsForTaskStub != nil {
+		return fake.GetStreamingLogsForTaskStub(appGUID, taskName, client, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getStreamingLogsForTaskReturns.result1, fake.getStreamingLogsForTaskReturns.result2
+}
+
+func (fake *FakeRunTaskActorV2) GetStreamingLogsForTaskCallCount() int {
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	return len(fake.getStreamingLogsForTaskArgsForCall)
+}
+
+func (fake *FakeRunTaskActorV2) GetStreamingLogsForTaskArgsForCall(i int) (string, string, v2action.NOAAClient, v2action.Config) {
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	return fake.getStreamingLogsForTaskArgsForCall[i].appGUID, fake.getStreamingLogsForTaskArgsForCall[i].taskName, fake.getStreamingLogsForTaskArgsForCall[i].client, fake.getStreamingLogsForTaskArgsForCall[i].config
+}
+
+func (fake *FakeRunTaskActorV2) GetStreamingLogsForTaskReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsForTaskStub = nil
+	fake.getStreamingLogsForTaskReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeRunTaskActorV2) GetStreamingLogsForTaskReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsForTaskStub = nil
+	if fake.getStreamingLogsForTaskReturnsOnCall == nil {
+		fake.getStreamingLogsForTaskReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+		})
+	}
+	fake.getStreamingLogsForTaskReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeRunTaskActorV2) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeRunTaskActorV2) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.RunTaskActorV2 = new(FakeRunTaskActorV2)
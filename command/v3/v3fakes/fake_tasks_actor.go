@@ -25,18 +25,19 @@ type FakeTasksActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
-	GetApplicationTasksStub        func(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
-	getApplicationTasksMutex       sync.RWMutex
-	getApplicationTasksArgsForCall []struct {
+	GetFilteredApplicationTasksStub        func(appGUID string, filter v3action.TaskFilter, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	getFilteredApplicationTasksMutex       sync.RWMutex
+	getFilteredApplicationTasksArgsForCall []struct {
 		appGUID   string
+		filter    v3action.TaskFilter
 		sortOrder v3action.SortOrder
 	}
-	getApplicationTasksReturns struct {
+	getFilteredApplicationTasksReturns struct {
 		result1 []v3action.Task
 		result2 v3action.Warnings
 		result3 error
 	}
-	getApplicationTasksReturnsOnCall map[int]struct {
+	getFilteredApplicationTasksReturnsOnCall map[int]struct {
 		result1 []v3action.Task
 		result2 v3action.Warnings
 		result3 error
@@ -109,55 +110,56 @@ func (fake *FakeTasksActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTasksActor) GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error) {
-	fake.getApplicationTasksMutex.Lock()
-	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
-	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
+func (fake *FakeTasksActor) GetFilteredApplicationTasks(appGUID string, filter v3action.TaskFilter, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error) {
+	fake.getFilteredApplicationTasksMutex.Lock()
+	ret, specificReturn := fake.getFilteredApplicationTasksReturnsOnCall[len(fake.getFilteredApplicationTasksArgsForCall)]
+	fake.getFilteredApplicationTasksArgsForCall = append(fake.getFilteredApplicationTasksArgsForCall, struct {
 		appGUID   string
+		filter    v3action.TaskFilter
 		sortOrder v3action.SortOrder
-	}{appGUID, sortOrder})
-	fake.recordInvocation("GetApplicationTasks", []interface{}{appGUID, sortOrder})
-	fake.getApplicationTasksMutex.Unlock()
-	if fake.GetApplicationTasksStub != nil {
-		return fake.GetApplicationTasksStub(appGUID, sortOrder)
+	}{appGUID, filter, sortOrder})
+	fake.recordInvocation("GetFilteredApplicationTasks", []interface{}{appGUID, filter, sortOrder})
+	fake.getFilteredApplicationTasksMutex.Unlock()
+	if fake.GetFilteredApplicationTasksStub != nil {
+		return fake.GetFilteredApplicationTasksStub(appGUID, filter, sortOrder)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationTasksReturns.result1, fake.getApplicationTasksReturns.result2, fake.getApplicationTasksReturns.result3
+	return fake.getFilteredApplicationTasksReturns.result1, fake.getFilteredApplicationTasksReturns.result2, fake.getFilteredApplicationTasksReturns.result3
 }
 
-func (fake *FakeTasksActor) GetApplicationTasksCallCount() int {
-	fake.getApplicationTasksMutex.RLock()
-	defer fake.getApplicationTasksMutex.RUnlock()
-	return len(fake.getApplicationTasksArgsForCall)
+func (fake *FakeTasksActor) GetFilteredApplicationTasksCallCount() int {
+	fake.getFilteredApplicationTasksMutex.RLock()
+	defer fake.getFilteredApplicationTasksMutex.RUnlock()
+	return len(fake.getFilteredApplicationTasksArgsForCall)
 }
 
-func (fake *FakeTasksActor) GetApplicationTasksArgsForCall(i int) (string, v3action.SortOrder) {
-	fake.getApplicationTasksMutex.RLock()
-	defer fake.getApplicationTasksMutex.RUnlock()
-	return fake.getApplicationTasksArgsForCall[i].appGUID, fake.getApplicationTasksArgsForCall[i].sortOrder
+func (fake *FakeTasksActor) GetFilteredApplicationTasksArgsForCall(i int) (string, v3action.TaskFilter, v3action.SortOrder) {
+	fake.getFilteredApplicationTasksMutex.RLock()
+	defer fake.getFilteredApplicationTasksMutex.RUnlock()
+	return fake.getFilteredApplicationTasksArgsForCall[i].appGUID, fake.getFilteredApplicationTasksArgsForCall[i].filter, fake.getFilteredApplicationTasksArgsForCall[i].sortOrder
 }
 
-func (fake *FakeTasksActor) GetApplicationTasksReturns(result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
-	fake.GetApplicationTasksStub = nil
-	fake.getApplicationTasksReturns = struct {
+func (fake *FakeTasksActor) GetFilteredApplicationTasksReturns(result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetFilteredApplicationTasksStub = nil
+	fake.getFilteredApplicationTasksReturns = struct {
 		result1 []v3action.Task
 		result2 v3action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTasksActor) GetApplicationTasksReturnsOnCall(i int, result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
-	fake.GetApplicationTasksStub = nil
-	if fake.getApplicationTasksReturnsOnCall == nil {
-		fake.getApplicationTasksReturnsOnCall = make(map[int]struct {
+func (fake *FakeTasksActor) GetFilteredApplicationTasksReturnsOnCall(i int, result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetFilteredApplicationTasksStub = nil
+	if fake.getFilteredApplicationTasksReturnsOnCall == nil {
+		fake.getFilteredApplicationTasksReturnsOnCall = make(map[int]struct {
 			result1 []v3action.Task
 			result2 v3action.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationTasksReturnsOnCall[i] = struct {
+	fake.getFilteredApplicationTasksReturnsOnCall[i] = struct {
 		result1 []v3action.Task
 		result2 v3action.Warnings
 		result3 error
@@ -209,8 +211,8 @@ func (fake *FakeTasksActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
-	fake.getApplicationTasksMutex.RLock()
-	defer fake.getApplicationTasksMutex.RUnlock()
+	fake.getFilteredApplicationTasksMutex.RLock()
+	defer fake.getFilteredApplicationTasksMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	return fake.invocations
@@ -0,0 +1,118 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeV3PushActorV2 struct {
+	GetStreamingLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+		filter    v2action.LogFilter
+		client    v2action.NOAAClient
+		config    v2action.Config
+	}
+	getStreamingLogsForApplicationByNameAndSpaceReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3PushActorV2) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, filter v2action.LogFilter, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+		filter    v2action.LogFilter
+		client    v2action.NOAAClient
+		config    v2action.Config
+	}{appName, spaceGUID, filter, client, config})
+	fake.recordInvocation("GetStreamingLogsForApplicationByNameAndSpace", []interface{}{appName, spaceGUID, filter, client, config})
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetStreamingLogsForApplicationByNameAndSpaceStub != nil {
+		return fake.GetStreamingLogsForApplicationByNameAndSpaceStub(appName, spaceGUID, filter, client, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.getStreamingLogsForApplicationByNameAndSpaceReturns.result1, fake.getStreamingLogsForApplicationByNameAndSpaceReturns.result2, fake.getStreamingLogsForApplicationByNameAndSpaceReturns.result3, fake.getStreamingLogsForApplicationByNameAndSpaceReturns.result4
+}
+
+func (fake *FakeV3PushActorV2) GetStreamingLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3PushActorV2) GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.LogFilter, v2action.NOAAClient, v2action.Config) {
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].appName, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].spaceGUID, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].filter, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].client, fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall[i].config
+}
+
+func (fake *FakeV3PushActorV2) GetStreamingLogsForApplicationByNameAndSpaceReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = nil
+	fake.getStreamingLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeV3PushActorV2) GetStreamingLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.GetStreamingLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeV3PushActorV2) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeV3PushActorV2) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.V3PushActorV2 = new(FakeV3PushActorV2)
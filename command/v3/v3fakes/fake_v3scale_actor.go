@@ -0,0 +1,456 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeV3ScaleActor struct {
+	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetProcessSummariesByApplicationStub        func(appGUID string) (v3action.ProcessSummaries, v3action.Warnings, error)
+	getProcessSummariesByApplicationMutex       sync.RWMutex
+	getProcessSummariesByApplicationArgsForCall []struct {
+		appGUID string
+	}
+	getProcessSummariesByApplicationReturns struct {
+		result1 v3action.ProcessSummaries
+		result2 v3action.Warnings
+		result3 error
+	}
+	getProcessSummariesByApplicationReturnsOnCall map[int]struct {
+		result1 v3action.ProcessSummaries
+		result2 v3action.Warnings
+		result3 error
+	}
+	ScaleProcessByApplicationStub        func(appGUID string, processType string, scale v3action.ProcessScaleOptions) (v3action.Warnings, error)
+	scaleProcessByApplicationMutex       sync.RWMutex
+	scaleProcessByApplicationArgsForCall []struct {
+		appGUID     string
+		processType string
+		scale       v3action.ProcessScaleOptions
+	}
+	scaleProcessByApplicationReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	scaleProcessByApplicationReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	StartApplicationStub        func(appGUID string) (v3action.Application, v3action.Warnings, error)
+	startApplicationMutex       sync.RWMutex
+	startApplicationArgsForCall []struct {
+		appGUID string
+	}
+	startApplicationReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	startApplicationReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	StopApplicationStub        func(appGUID string) (v3action.Application, v3action.Warnings, error)
+	stopApplicationMutex       sync.RWMutex
+	stopApplicationArgsForCall []struct {
+		appGUID string
+	}
+	stopApplicationReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	stopApplicationReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	WaitForApplicationToStartStub        func(app v3action.Application) (v3action.Warnings, error)
+	waitForApplicationToStartMutex       sync.RWMutex
+	waitForApplicationToStartArgsForCall []struct {
+		app v3action.Application
+	}
+	waitForApplicationToStartReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	waitForApplicationToStartReturnsOnCall map[int]struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3ScaleActor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV3ScaleActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3ScaleActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].appName, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3ScaleActor) GetApplicationByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3ScaleActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3ScaleActor) GetProcessSummariesByApplication(appGUID string) (v3action.ProcessSummaries, v3action.Warnings, error) {
+	fake.getProcessSummariesByApplicationMutex.Lock()
+	ret, specificReturn := fake.getProcessSummariesByApplicationReturnsOnCall[len(fake.getProcessSummariesByApplicationArgsForCall)]
+	fake.getProcessSummariesByApplicationArgsForCall = append(fake.getProcessSummariesByApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetProcessSummariesByApplication", []interface{}{appGUID})
+	fake.getProcessSummariesByApplicationMutex.Unlock()
+	if fake.GetProcessSummariesByApplicationStub != nil {
+		return fake.GetProcessSummariesByApplicationStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getProcessSummariesByApplicationReturns.result1, fake.getProcessSummariesByApplicationReturns.result2, fake.getProcessSummariesByApplicationReturns.result3
+}
+
+func (fake *FakeV3ScaleActor) GetProcessSummariesByApplicationCallCount() int {
+	fake.getProcessSummariesByApplicationMutex.RLock()
+	defer fake.getProcessSummariesByApplicationMutex.RUnlock()
+	return len(fake.getProcessSummariesByApplicationArgsForCall)
+}
+
+func (fake *FakeV3ScaleActor) GetProcessSummariesByApplicationArgsForCall(i int) string {
+	fake.getProcessSummariesByApplicationMutex.RLock()
+	defer fake.getProcessSummariesByApplicationMutex.RUnlock()
+	return fake.getProcessSummariesByApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV3ScaleActor) GetProcessSummariesByApplicationReturns(result1 v3action.ProcessSummaries, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessSummariesByApplicationStub = nil
+	fake.getProcessSummariesByApplicationReturns = struct {
+		result1 v3action.ProcessSummaries
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3ScaleActor) GetProcessSummariesByApplicationReturnsOnCall(i int, result1 v3action.ProcessSummaries, result2 v3action.Warnings, result3 error) {
+	fake.GetProcessSummariesByApplicationStub = nil
+	if fake.getProcessSummariesByApplicationReturnsOnCall == nil {
+		fake.getProcessSummariesByApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.ProcessSummaries
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessSummariesByApplicationReturnsOnCall[i] = struct {
+		result1 v3action.ProcessSummaries
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3ScaleActor) ScaleProcessByApplication(appGUID string, processType string, scale v3action.ProcessScaleOptions) (v3action.Warnings, error) {
+	fake.scaleProcessByApplicationMutex.Lock()
+	ret, specificReturn := fake.scaleProcessByApplicationReturnsOnCall[len(fake.scaleProcessByApplicationArgsForCall)]
+	fake.scaleProcessByApplicationArgsForCall = append(fake.scaleProcessByApplicationArgsForCall, struct {
+		appGUID     string
+		processType string
+		scale       v3action.ProcessScaleOptions
+	}{appGUID, processType, scale})
+	fake.recordInvocation("ScaleProcessByApplication", []interface{}{appGUID, processType, scale})
+	fake.scaleProcessByApplicationMutex.Unlock()
+	if fake.ScaleProcessByApplicationStub != nil {
+		return fake.ScaleProcessByApplicationStub(appGUID, processType, scale)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.scaleProcessByApplicationReturns.result1, fake.scaleProcessByApplicationReturns.result2
+}
+
+func (fake *FakeV3ScaleActor) ScaleProcessByApplicationCallCount() int {
+	fake.scaleProcessByApplicationMutex.RLock()
+	defer fake.scaleProcessByApplicationMutex.RUnlock()
+	return len(fake.scaleProcessByApplicationArgsForCall)
+}
+
+func (fake *FakeV3ScaleActor) ScaleProcessByApplicationArgsForCall(i int) (string, string, v3action.ProcessScaleOptions) {
+	fake.scaleProcessByApplicationMutex.RLock()
+	defer fake.scaleProcessByApplicationMutex.RUnlock()
+	return fake.scaleProcessByApplicationArgsForCall[i].appGUID, fake.scaleProcessByApplicationArgsForCall[i].processType, fake.scaleProcessByApplicationArgsForCall[i].scale
+}
+
+func (fake *FakeV3ScaleActor) ScaleProcessByApplicationReturns(result1 v3action.Warnings, result2 error) {
+	fake.ScaleProcessByApplicationStub = nil
+	fake.scaleProcessByApplicationReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ScaleActor) ScaleProcessByApplicationReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.ScaleProcessByApplicationStub = nil
+	if fake.scaleProcessByApplicationReturnsOnCall == nil {
+		fake.scaleProcessByApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.scaleProcessByApplicationReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ScaleActor) StartApplication(appGUID string) (v3action.Application, v3action.Warnings, error) {
+	fake.startApplicationMutex.Lock()
+	ret, specificReturn := fake.startApplicationReturnsOnCall[len(fake.startApplicationArgsForCall)]
+	fake.startApplicationArgsForCall = append(fake.startApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("StartApplication", []interface{}{appGUID})
+	fake.startApplicationMutex.Unlock()
+	if fake.StartApplicationStub != nil {
+		return fake.StartApplicationStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.startApplicationReturns.result1, fake.startApplicationReturns.result2, fake.startApplicationReturns.result3
+}
+
+func (fake *FakeV3ScaleActor) StartApplicationCallCount() int {
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	return len(fake.startApplicationArgsForCall)
+}
+
+func (fake *FakeV3ScaleActor) StartApplicationArgsForCall(i int) string {
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	return fake.startApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV3ScaleActor) StartApplicationReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.StartApplicationStub = nil
+	fake.startApplicationReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3ScaleActor) StartApplicationReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.StartApplicationStub = nil
+	if fake.startApplicationReturnsOnCall == nil {
+		fake.startApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.startApplicationReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3ScaleActor) StopApplication(appGUID string) (v3action.Application, v3action.Warnings, error) {
+	fake.stopApplicationMutex.Lock()
+	ret, specificReturn := fake.stopApplicationReturnsOnCall[len(fake.stopApplicationArgsForCall)]
+	fake.stopApplicationArgsForCall = append(fake.stopApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("StopApplication", []interface{}{appGUID})
+	fake.stopApplicationMutex.Unlock()
+	if fake.StopApplicationStub != nil {
+		return fake.StopApplicationStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.stopApplicationReturns.result1, fake.stopApplicationReturns.result2, fake.stopApplicationReturns.result3
+}
+
+func (fake *FakeV3ScaleActor) StopApplicationCallCount() int {
+	fake.stopApplicationMutex.RLock()
+	defer fake.stopApplicationMutex.RUnlock()
+	return len(fake.stopApplicationArgsForCall)
+}
+
+func (fake *FakeV3ScaleActor) StopApplicationArgsForCall(i int) string {
+	fake.stopApplicationMutex.RLock()
+	defer fake.stopApplicationMutex.RUnlock()
+	return fake.stopApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV3ScaleActor) StopApplicationReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.StopApplicationStub = nil
+	fake.stopApplicationReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3ScaleActor) StopApplicationReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.StopApplicationStub = nil
+	if fake.stopApplicationReturnsOnCall == nil {
+		fake.stopApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.stopApplicationReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3ScaleActor) WaitForApplicationToStart(app v3action.Application) (v3action.Warnings, error) {
+	fake.waitForApplicationToStartMutex.Lock()
+	ret, specificReturn := fake.waitForApplicationToStartReturnsOnCall[len(fake.waitForApplicationToStartArgsForCall)]
+	fake.waitForApplicationToStartArgsForCall = append(fake.waitForApplicationToStartArgsForCall, struct {
+		app v3action.Application
+	}{app})
+	fake.recordInvocation("WaitForApplicationToStart", []interface{}{app})
+	fake.waitForApplicationToStartMutex.Unlock()
+	if fake.WaitForApplicationToStartStub != nil {
+		return fake.WaitForApplicationToStartStub(app)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.waitForApplicationToStartReturns.result1, fake.waitForApplicationToStartReturns.result2
+}
+
+func (fake *FakeV3ScaleActor) WaitForApplicationToStartCallCount() int {
+	fake.waitForApplicationToStartMutex.RLock()
+	defer fake.waitForApplicationToStartMutex.RUnlock()
+	return len(fake.waitForApplicationToStartArgsForCall)
+}
+
+func (fake *FakeV3ScaleActor) WaitForApplicationToStartArgsForCall(i int) v3action.Application {
+	fake.waitForApplicationToStartMutex.RLock()
+	defer fake.waitForApplicationToStartMutex.RUnlock()
+	return fake.waitForApplicationToStartArgsForCall[i].app
+}
+
+func (fake *FakeV3ScaleActor) WaitForApplicationToStartReturns(result1 v3action.Warnings, result2 error) {
+	fake.WaitForApplicationToStartStub = nil
+	fake.waitForApplicationToStartReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ScaleActor) WaitForApplicationToStartReturnsOnCall(i int, result1 v3action.Warnings, result2 error) {
+	fake.WaitForApplicationToStartStub = nil
+	if fake.waitForApplicationToStartReturnsOnCall == nil {
+		fake.waitForApplicationToStartReturnsOnCall = make(map[int]struct {
+			result1 v3action.Warnings
+			result2 error
+		})
+	}
+	fake.waitForApplicationToStartReturnsOnCall[i] = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV3ScaleActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getProcessSummariesByApplicationMutex.RLock()
+	defer fake.getProcessSummariesByApplicationMutex.RUnlock()
+	fake.scaleProcessByApplicationMutex.RLock()
+	defer fake.scaleProcessByApplicationMutex.RUnlock()
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	fake.stopApplicationMutex.RLock()
+	defer fake.stopApplicationMutex.RUnlock()
+	fake.waitForApplicationToStartMutex.RLock()
+	defer fake.waitForApplicationToStartMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeV3ScaleActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.V3ScaleActor = new(FakeV3ScaleActor)
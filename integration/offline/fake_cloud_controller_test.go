@@ -0,0 +1,65 @@
+package offline
+
+import (
+	"code.cloudfoundry.org/cli/integration/helpers"
+	"code.cloudfoundry.org/cli/util/testhelpers/fakecf"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("the cf binary against a fake Cloud Controller", func() {
+	var (
+		server  *fakecf.Server
+		appGUID string
+	)
+
+	BeforeEach(func() {
+		server = fakecf.NewServer()
+		server.AddUser("some-user", "some-password")
+		orgGUID := server.AddOrganization("some-org")
+		spaceGUID := server.AddSpace(orgGUID, "some-space")
+		server.AddSharedDomain("example.com")
+		appGUID = server.AddApplication(spaceGUID, "some-app")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("logs in, targets and lists the apps with the legacy commands", func() {
+		session := helpers.CF("login", "-a", server.URL(), "-u", "some-user", "-p", "some-password", "-o", "some-org", "-s", "some-space")
+		Eventually(session).Should(Say("Authenticating..."))
+		Eventually(session).Should(Say("OK"))
+		Eventually(session).Should(Say(`Org:\s+some-org`))
+		Eventually(session).Should(Say(`Space:\s+some-space`))
+		Eventually(session).Should(Exit(0))
+
+		session = helpers.CF("apps")
+		Eventually(session).Should(Say("Getting apps in org some-org / space some-space as some-user..."))
+		Eventually(session).Should(Say(`some-app\s+stopped\s+0/1`))
+		Eventually(session).Should(Exit(0))
+	})
+
+	It("authenticates, targets and runs a task", func() {
+		Eventually(helpers.CF("api", server.URL())).Should(Exit(0))
+		Eventually(helpers.CF("auth", "some-user", "some-password")).Should(Exit(0))
+
+		session := helpers.CF("target", "-o", "some-org", "-s", "some-space")
+		Eventually(session).Should(Say(`org:\s+some-org`))
+		Eventually(session).Should(Say(`space:\s+some-space`))
+		Eventually(session).Should(Exit(0))
+
+		session = helpers.CF("run-task", "some-app", "echo hello", "--name", "some-task")
+		Eventually(session).Should(Say("Creating task for app some-app in org some-org / space some-space as some-user..."))
+		Eventually(session).Should(Say("Task has been submitted successfully for execution."))
+		Eventually(session).Should(Say(`task name:\s+some-task`))
+		Eventually(session).Should(Exit(0))
+
+		tasks := server.Tasks(appGUID)
+		Expect(tasks).To(HaveLen(1))
+		Expect(tasks[0].Name).To(Equal("some-task"))
+		Expect(tasks[0].Command).To(Equal("echo hello"))
+	})
+})
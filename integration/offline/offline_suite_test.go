@@ -0,0 +1,40 @@
+package offline
+
+import (
+	"testing"
+	"time"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	CFEventuallyTimeout = 30 * time.Second
+)
+
+var (
+	// Per Test Level
+	homeDir string
+)
+
+func TestOffline(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Offline Integration Suite")
+}
+
+var _ = BeforeSuite(func() {
+	// Ginkgo Globals
+	SetDefaultEventuallyTimeout(CFEventuallyTimeout)
+
+	// Setup common environment variables
+	helpers.TurnOffColors()
+})
+
+var _ = BeforeEach(func() {
+	homeDir = helpers.SetHomeDir()
+})
+
+var _ = AfterEach(func() {
+	helpers.DestroyHomeDir(homeDir)
+})
@@ -98,6 +98,10 @@ func parse(args []string) {
 			parse([]string{"help", args[0]})
 			os.Exit(1)
 		case flags.ErrUnknownCommand:
+			if common.Commands.Output.Format != "" {
+				fmt.Fprintf(os.Stderr, "Incorrect Usage: '--output' is not supported by the '%s' command.\n", extraArgs[0])
+				os.Exit(1)
+			}
 			cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 		case flags.ErrCommandRequired:
 			if common.Commands.VerboseOrVersion {
@@ -135,7 +139,8 @@ func isOption(s string) bool {
 
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, err := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		OutputFormat: configv3.OutputFormat(common.Commands.Output.Format),
+		Verbose:      common.Commands.VerboseOrVersion,
 	})
 	if err != nil {
 		return err
@@ -154,6 +159,11 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 			return err
 		}
 
+		err = command.StructuredOutputCheck(cmd, commandName(cmd), cfConfig.OutputFormat())
+		if err != nil {
+			return handleError(err, commandUI)
+		}
+
 		err = extendedCmd.Setup(cfConfig, commandUI)
 		if err != nil {
 			return handleError(err, commandUI)
@@ -164,6 +174,19 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 	return fmt.Errorf("command does not conform to ExtendedCommander")
 }
 
+// commandName returns the name of the command in common.Commands that cmd
+// points to.
+func commandName(cmd flags.Commander) string {
+	commands := reflect.ValueOf(&common.Commands).Elem()
+	for i := 0; i < commands.NumField(); i++ {
+		field := commands.Field(i)
+		if field.CanAddr() && field.Addr().Interface() == cmd {
+			return commands.Type().Field(i).Tag.Get("command")
+		}
+	}
+	return ""
+}
+
 func handleError(err error, commandUI UI) error {
 	if err == nil {
 		return nil
@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/rpc"
 	"os"
+	"strconv"
 	"time"
 
 	"code.cloudfoundry.org/cli/plugin/models"
@@ -381,3 +382,156 @@ func (c *cliConnection) GetService(serviceInstance string) (plugin_models.GetSer
 
 	return result, err
 }
+
+func (c *cliConnection) GetV3App(appName string) (plugin_models.GetV3App_Model, error) {
+	var result plugin_models.GetV3App_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3App", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetV3AppPackages(appName string) ([]plugin_models.GetV3AppPackages_Model, error) {
+	var result []plugin_models.GetV3AppPackages_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetV3AppPackages", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetTasks(appName string) ([]plugin_models.Task, error) {
+	var result []plugin_models.Task
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetTasks", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) RunTask(appName string, command string, options plugin_models.RunTaskOptions) (plugin_models.Task, error) {
+	var result plugin_models.Task
+
+	args := plugin_models.RunTask_Args{
+		AppName: appName,
+		Command: command,
+		Options: options,
+	}
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.RunTask", args, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) TerminateTask(appName string, sequenceID int) (plugin_models.Task, error) {
+	var result plugin_models.Task
+
+	args := []string{appName, strconv.Itoa(sequenceID)}
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.TerminateTask", args, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetIsolationSegments() ([]plugin_models.GetIsolationSegments_Model, error) {
+	var result []plugin_models.GetIsolationSegments_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetIsolationSegments", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetIsolationSegment(name string) (plugin_models.GetIsolationSegment_Model, error) {
+	var result plugin_models.GetIsolationSegment_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetIsolationSegment", name, &result)
+	})
+
+	return result, err
+}
+
+// GetStreamingLogs streams the logs of an app in the targeted space until
+// stop is closed or the stream ends, after which both channels are closed.
+// An error on the error channel also ends the stream.
+func (c *cliConnection) GetStreamingLogs(appName string, stop <-chan struct{}) (<-chan plugin_models.LogMessage, <-chan error, error) {
+	var streamID string
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.StartLogStream", appName, &streamID)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages := make(chan plugin_models.LogMessage)
+	errs := make(chan error, 1)
+	done := make(chan struct{})
+
+	go func() {
+		select {
+		case <-stop:
+			_ = c.withClientDo(func(client *rpc.Client) error {
+				var success bool
+				return client.Call("CliRpcCmd.StopLogStream", streamID, &success)
+			})
+		case <-done:
+		}
+	}()
+
+	go func() {
+		defer close(done)
+		defer close(errs)
+		defer close(messages)
+
+		for {
+			var batch plugin_models.LogMessages_Batch
+			err := c.withClientDo(func(client *rpc.Client) error {
+				return client.Call("CliRpcCmd.GetLogMessages", streamID, &batch)
+			})
+			if err != nil {
+				select {
+				case <-stop:
+				default:
+					errs <- err
+				}
+				return
+			}
+
+			// Keep polling after stop so the stream is drained, but drop the
+			// messages nobody is reading anymore.
+			for _, message := range batch.Messages {
+				select {
+				case messages <- message:
+				case <-stop:
+				}
+			}
+
+			if batch.Done {
+				return
+			}
+		}
+	}()
+
+	return messages, errs, nil
+}
+
+func (c *cliConnection) CloudControllerRequest(request plugin_models.CloudControllerRequest) (plugin_models.CloudControllerResponse, error) {
+	var result plugin_models.CloudControllerResponse
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.CloudControllerRequest", request, &result)
+	})
+
+	return result, err
+}
@@ -0,0 +1,14 @@
+package plugin_models
+
+type CloudControllerRequest struct {
+	Method  string // defaults to GET
+	Path    string // relative to the API root, e.g. /v2/apps?q=name:my-app
+	Headers map[string][]string
+	Body    []byte
+}
+
+type CloudControllerResponse struct {
+	StatusCode int
+	Headers    map[string][]string
+	Body       []byte
+}
@@ -0,0 +1,6 @@
+package plugin_models
+
+type GetIsolationSegment_Model struct {
+	Guid string
+	Name string
+}
@@ -0,0 +1,18 @@
+package plugin_models
+
+type GetV3App_Model struct {
+	Guid      string
+	Name      string
+	State     string
+	SpaceGuid string
+	Processes []GetV3App_Process
+}
+
+type GetV3App_Process struct {
+	Guid             string
+	Type             string
+	Instances        int
+	RunningInstances int
+	MemoryInMb       uint64
+	DiskInMb         uint64
+}
@@ -0,0 +1,10 @@
+package plugin_models
+
+import "time"
+
+type GetV3AppPackages_Model struct {
+	Guid      string
+	Type      string
+	State     string
+	CreatedAt time.Time
+}
@@ -0,0 +1,18 @@
+package plugin_models
+
+import "time"
+
+type LogMessage struct {
+	Message        string
+	MessageType    string // OUT or ERR
+	Timestamp      time.Time
+	SourceType     string
+	SourceInstance string
+}
+
+// LogMessages_Batch holds the messages received from a log stream since it
+// was last polled. Done is set once the stream has ended.
+type LogMessages_Batch struct {
+	Messages []LogMessage
+	Done     bool
+}
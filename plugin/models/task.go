@@ -0,0 +1,27 @@
+package plugin_models
+
+import "time"
+
+type Task struct {
+	Guid       string
+	SequenceId int
+	Name       string
+	Command    string
+	State      string
+	MemoryInMb uint64
+	DiskInMb   uint64
+	CreatedAt  time.Time
+}
+
+type RunTaskOptions struct {
+	Name       string
+	MemoryInMb uint64 // 0 uses the Cloud Controller default
+	DiskInMb   uint64 // 0 uses the Cloud Controller default
+}
+
+// RunTask_Args are the arguments sent to the CLI to run a task.
+type RunTask_Args struct {
+	AppName string
+	Command string
+	Options RunTaskOptions
+}
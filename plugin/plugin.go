@@ -42,6 +42,15 @@ type CliConnection interface {
 	GetService(string) (plugin_models.GetService_Model, error)
 	GetOrg(string) (plugin_models.GetOrg_Model, error)
 	GetSpace(string) (plugin_models.GetSpace_Model, error)
+	GetV3App(string) (plugin_models.GetV3App_Model, error)
+	GetV3AppPackages(string) ([]plugin_models.GetV3AppPackages_Model, error)
+	GetTasks(string) ([]plugin_models.Task, error)
+	RunTask(string, string, plugin_models.RunTaskOptions) (plugin_models.Task, error)
+	TerminateTask(string, int) (plugin_models.Task, error)
+	GetIsolationSegments() ([]plugin_models.GetIsolationSegments_Model, error)
+	GetIsolationSegment(string) (plugin_models.GetIsolationSegment_Model, error)
+	GetStreamingLogs(string, <-chan struct{}) (<-chan plugin_models.LogMessage, <-chan error, error)
+	CloudControllerRequest(plugin_models.CloudControllerRequest) (plugin_models.CloudControllerResponse, error)
 }
 
 type VersionType struct {
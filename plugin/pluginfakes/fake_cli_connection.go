@@ -216,6 +216,90 @@ type FakeCliConnection struct {
 		result1 plugin_models.GetSpace_Model
 		result2 error
 	}
+	GetV3AppStub        func(string) (plugin_models.GetV3App_Model, error)
+	getV3AppMutex       sync.RWMutex
+	getV3AppArgsForCall []struct {
+		arg1 string
+	}
+	getV3AppReturns struct {
+		result1 plugin_models.GetV3App_Model
+		result2 error
+	}
+	GetV3AppPackagesStub        func(string) ([]plugin_models.GetV3AppPackages_Model, error)
+	getV3AppPackagesMutex       sync.RWMutex
+	getV3AppPackagesArgsForCall []struct {
+		arg1 string
+	}
+	getV3AppPackagesReturns struct {
+		result1 []plugin_models.GetV3AppPackages_Model
+		result2 error
+	}
+	GetTasksStub        func(string) ([]plugin_models.Task, error)
+	getTasksMutex       sync.RWMutex
+	getTasksArgsForCall []struct {
+		arg1 string
+	}
+	getTasksReturns struct {
+		result1 []plugin_models.Task
+		result2 error
+	}
+	RunTaskStub        func(string, string, plugin_models.RunTaskOptions) (plugin_models.Task, error)
+	runTaskMutex       sync.RWMutex
+	runTaskArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 plugin_models.RunTaskOptions
+	}
+	runTaskReturns struct {
+		result1 plugin_models.Task
+		result2 error
+	}
+	TerminateTaskStub        func(string, int) (plugin_models.Task, error)
+	terminateTaskMutex       sync.RWMutex
+	terminateTaskArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	terminateTaskReturns struct {
+		result1 plugin_models.Task
+		result2 error
+	}
+	GetIsolationSegmentsStub        func() ([]plugin_models.GetIsolationSegments_Model, error)
+	getIsolationSegmentsMutex       sync.RWMutex
+	getIsolationSegmentsArgsForCall []struct{}
+	getIsolationSegmentsReturns     struct {
+		result1 []plugin_models.GetIsolationSegments_Model
+		result2 error
+	}
+	GetIsolationSegmentStub        func(string) (plugin_models.GetIsolationSegment_Model, error)
+	getIsolationSegmentMutex       sync.RWMutex
+	getIsolationSegmentArgsForCall []struct {
+		arg1 string
+	}
+	getIsolationSegmentReturns struct {
+		result1 plugin_models.GetIsolationSegment_Model
+		result2 error
+	}
+	GetStreamingLogsStub        func(string, <-chan struct{}) (<-chan plugin_models.LogMessage, <-chan error, error)
+	getStreamingLogsMutex       sync.RWMutex
+	getStreamingLogsArgsForCall []struct {
+		arg1 string
+		arg2 <-chan struct{}
+	}
+	getStreamingLogsReturns struct {
+		result1 <-chan plugin_models.LogMessage
+		result2 <-chan error
+		result3 error
+	}
+	CloudControllerRequestStub        func(plugin_models.CloudControllerRequest) (plugin_models.CloudControllerResponse, error)
+	cloudControllerRequestMutex       sync.RWMutex
+	cloudControllerRequestArgsForCall []struct {
+		arg1 plugin_models.CloudControllerRequest
+	}
+	cloudControllerRequestReturns struct {
+		result1 plugin_models.CloudControllerResponse
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -988,6 +1072,309 @@ func (fake *FakeCliConnection) GetSpaceReturns(result1 plugin_models.GetSpace_Mo
 	}{result1, result2}
 }
 
+func (fake *FakeCliConnection) GetV3App(arg1 string) (plugin_models.GetV3App_Model, error) {
+	fake.getV3AppMutex.Lock()
+	fake.getV3AppArgsForCall = append(fake.getV3AppArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetV3App", []interface{}{arg1})
+	fake.getV3AppMutex.Unlock()
+	if fake.GetV3AppStub != nil {
+		return fake.GetV3AppStub(arg1)
+	} else {
+		return fake.getV3AppReturns.result1, fake.getV3AppReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) GetV3AppCallCount() int {
+	fake.getV3AppMutex.RLock()
+	defer fake.getV3AppMutex.RUnlock()
+	return len(fake.getV3AppArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetV3AppArgsForCall(i int) string {
+	fake.getV3AppMutex.RLock()
+	defer fake.getV3AppMutex.RUnlock()
+	return fake.getV3AppArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnection) GetV3AppReturns(result1 plugin_models.GetV3App_Model, result2 error) {
+	fake.GetV3AppStub = nil
+	fake.getV3AppReturns = struct {
+		result1 plugin_models.GetV3App_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetV3AppPackages(arg1 string) ([]plugin_models.GetV3AppPackages_Model, error) {
+	fake.getV3AppPackagesMutex.Lock()
+	fake.getV3AppPackagesArgsForCall = append(fake.getV3AppPackagesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetV3AppPackages", []interface{}{arg1})
+	fake.getV3AppPackagesMutex.Unlock()
+	if fake.GetV3AppPackagesStub != nil {
+		return fake.GetV3AppPackagesStub(arg1)
+	} else {
+		return fake.getV3AppPackagesReturns.result1, fake.getV3AppPackagesReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) GetV3AppPackagesCallCount() int {
+	fake.getV3AppPackagesMutex.RLock()
+	defer fake.getV3AppPackagesMutex.RUnlock()
+	return len(fake.getV3AppPackagesArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetV3AppPackagesArgsForCall(i int) string {
+	fake.getV3AppPackagesMutex.RLock()
+	defer fake.getV3AppPackagesMutex.RUnlock()
+	return fake.getV3AppPackagesArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnection) GetV3AppPackagesReturns(result1 []plugin_models.GetV3AppPackages_Model, result2 error) {
+	fake.GetV3AppPackagesStub = nil
+	fake.getV3AppPackagesReturns = struct {
+		result1 []plugin_models.GetV3AppPackages_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetTasks(arg1 string) ([]plugin_models.Task, error) {
+	fake.getTasksMutex.Lock()
+	fake.getTasksArgsForCall = append(fake.getTasksArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetTasks", []interface{}{arg1})
+	fake.getTasksMutex.Unlock()
+	if fake.GetTasksStub != nil {
+		return fake.GetTasksStub(arg1)
+	} else {
+		return fake.getTasksReturns.result1, fake.getTasksReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) GetTasksCallCount() int {
+	fake.getTasksMutex.RLock()
+	defer fake.getTasksMutex.RUnlock()
+	return len(fake.getTasksArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetTasksArgsForCall(i int) string {
+	fake.getTasksMutex.RLock()
+	defer fake.getTasksMutex.RUnlock()
+	return fake.getTasksArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnection) GetTasksReturns(result1 []plugin_models.Task, result2 error) {
+	fake.GetTasksStub = nil
+	fake.getTasksReturns = struct {
+		result1 []plugin_models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) RunTask(arg1 string, arg2 string, arg3 plugin_models.RunTaskOptions) (plugin_models.Task, error) {
+	fake.runTaskMutex.Lock()
+	fake.runTaskArgsForCall = append(fake.runTaskArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 plugin_models.RunTaskOptions
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RunTask", []interface{}{arg1, arg2, arg3})
+	fake.runTaskMutex.Unlock()
+	if fake.RunTaskStub != nil {
+		return fake.RunTaskStub(arg1, arg2, arg3)
+	} else {
+		return fake.runTaskReturns.result1, fake.runTaskReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) RunTaskCallCount() int {
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	return len(fake.runTaskArgsForCall)
+}
+
+func (fake *FakeCliConnection) RunTaskArgsForCall(i int) (string, string, plugin_models.RunTaskOptions) {
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	return fake.runTaskArgsForCall[i].arg1, fake.runTaskArgsForCall[i].arg2, fake.runTaskArgsForCall[i].arg3
+}
+
+func (fake *FakeCliConnection) RunTaskReturns(result1 plugin_models.Task, result2 error) {
+	fake.RunTaskStub = nil
+	fake.runTaskReturns = struct {
+		result1 plugin_models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) TerminateTask(arg1 string, arg2 int) (plugin_models.Task, error) {
+	fake.terminateTaskMutex.Lock()
+	fake.terminateTaskArgsForCall = append(fake.terminateTaskArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("TerminateTask", []interface{}{arg1, arg2})
+	fake.terminateTaskMutex.Unlock()
+	if fake.TerminateTaskStub != nil {
+		return fake.TerminateTaskStub(arg1, arg2)
+	} else {
+		return fake.terminateTaskReturns.result1, fake.terminateTaskReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) TerminateTaskCallCount() int {
+	fake.terminateTaskMutex.RLock()
+	defer fake.terminateTaskMutex.RUnlock()
+	return len(fake.terminateTaskArgsForCall)
+}
+
+func (fake *FakeCliConnection) TerminateTaskArgsForCall(i int) (string, int) {
+	fake.terminateTaskMutex.RLock()
+	defer fake.terminateTaskMutex.RUnlock()
+	return fake.terminateTaskArgsForCall[i].arg1, fake.terminateTaskArgsForCall[i].arg2
+}
+
+func (fake *FakeCliConnection) TerminateTaskReturns(result1 plugin_models.Task, result2 error) {
+	fake.TerminateTaskStub = nil
+	fake.terminateTaskReturns = struct {
+		result1 plugin_models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetIsolationSegments() ([]plugin_models.GetIsolationSegments_Model, error) {
+	fake.getIsolationSegmentsMutex.Lock()
+	fake.getIsolationSegmentsArgsForCall = append(fake.getIsolationSegmentsArgsForCall, struct{}{})
+	fake.recordInvocation("GetIsolationSegments", []interface{}{})
+	fake.getIsolationSegmentsMutex.Unlock()
+	if fake.GetIsolationSegmentsStub != nil {
+		return fake.GetIsolationSegmentsStub()
+	} else {
+		return fake.getIsolationSegmentsReturns.result1, fake.getIsolationSegmentsReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) GetIsolationSegmentsCallCount() int {
+	fake.getIsolationSegmentsMutex.RLock()
+	defer fake.getIsolationSegmentsMutex.RUnlock()
+	return len(fake.getIsolationSegmentsArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetIsolationSegmentsReturns(result1 []plugin_models.GetIsolationSegments_Model, result2 error) {
+	fake.GetIsolationSegmentsStub = nil
+	fake.getIsolationSegmentsReturns = struct {
+		result1 []plugin_models.GetIsolationSegments_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetIsolationSegment(arg1 string) (plugin_models.GetIsolationSegment_Model, error) {
+	fake.getIsolationSegmentMutex.Lock()
+	fake.getIsolationSegmentArgsForCall = append(fake.getIsolationSegmentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetIsolationSegment", []interface{}{arg1})
+	fake.getIsolationSegmentMutex.Unlock()
+	if fake.GetIsolationSegmentStub != nil {
+		return fake.GetIsolationSegmentStub(arg1)
+	} else {
+		return fake.getIsolationSegmentReturns.result1, fake.getIsolationSegmentReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) GetIsolationSegmentCallCount() int {
+	fake.getIsolationSegmentMutex.RLock()
+	defer fake.getIsolationSegmentMutex.RUnlock()
+	return len(fake.getIsolationSegmentArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetIsolationSegmentArgsForCall(i int) string {
+	fake.getIsolationSegmentMutex.RLock()
+	defer fake.getIsolationSegmentMutex.RUnlock()
+	return fake.getIsolationSegmentArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnection) GetIsolationSegmentReturns(result1 plugin_models.GetIsolationSegment_Model, result2 error) {
+	fake.GetIsolationSegmentStub = nil
+	fake.getIsolationSegmentReturns = struct {
+		result1 plugin_models.GetIsolationSegment_Model
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCliConnection) GetStreamingLogs(arg1 string, arg2 <-chan struct{}) (<-chan plugin_models.LogMessage, <-chan error, error) {
+	fake.getStreamingLogsMutex.Lock()
+	fake.getStreamingLogsArgsForCall = append(fake.getStreamingLogsArgsForCall, struct {
+		arg1 string
+		arg2 <-chan struct{}
+	}{arg1, arg2})
+	fake.recordInvocation("GetStreamingLogs", []interface{}{arg1, arg2})
+	fake.getStreamingLogsMutex.Unlock()
+	if fake.GetStreamingLogsStub != nil {
+		return fake.GetStreamingLogsStub(arg1, arg2)
+	} else {
+		return fake.getStreamingLogsReturns.result1, fake.getStreamingLogsReturns.result2, fake.getStreamingLogsReturns.result3
+	}
+}
+
+func (fake *FakeCliConnection) GetStreamingLogsCallCount() int {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return len(fake.getStreamingLogsArgsForCall)
+}
+
+func (fake *FakeCliConnection) GetStreamingLogsArgsForCall(i int) (string, <-chan struct{}) {
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	return fake.getStreamingLogsArgsForCall[i].arg1, fake.getStreamingLogsArgsForCall[i].arg2
+}
+
+func (fake *FakeCliConnection) GetStreamingLogsReturns(result1 <-chan plugin_models.LogMessage, result2 <-chan error, result3 error) {
+	fake.GetStreamingLogsStub = nil
+	fake.getStreamingLogsReturns = struct {
+		result1 <-chan plugin_models.LogMessage
+		result2 <-chan error
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCliConnection) CloudControllerRequest(arg1 plugin_models.CloudControllerRequest) (plugin_models.CloudControllerResponse, error) {
+	fake.cloudControllerRequestMutex.Lock()
+	fake.cloudControllerRequestArgsForCall = append(fake.cloudControllerRequestArgsForCall, struct {
+		arg1 plugin_models.CloudControllerRequest
+	}{arg1})
+	fake.recordInvocation("CloudControllerRequest", []interface{}{arg1})
+	fake.cloudControllerRequestMutex.Unlock()
+	if fake.CloudControllerRequestStub != nil {
+		return fake.CloudControllerRequestStub(arg1)
+	} else {
+		return fake.cloudControllerRequestReturns.result1, fake.cloudControllerRequestReturns.result2
+	}
+}
+
+func (fake *FakeCliConnection) CloudControllerRequestCallCount() int {
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
+	return len(fake.cloudControllerRequestArgsForCall)
+}
+
+func (fake *FakeCliConnection) CloudControllerRequestArgsForCall(i int) plugin_models.CloudControllerRequest {
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
+	return fake.cloudControllerRequestArgsForCall[i].arg1
+}
+
+func (fake *FakeCliConnection) CloudControllerRequestReturns(result1 plugin_models.CloudControllerResponse, result2 error) {
+	fake.CloudControllerRequestStub = nil
+	fake.cloudControllerRequestReturns = struct {
+		result1 plugin_models.CloudControllerResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCliConnection) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1045,6 +1432,24 @@ func (fake *FakeCliConnection) Invocations() map[string][][]interface{} {
 	defer fake.getOrgMutex.RUnlock()
 	fake.getSpaceMutex.RLock()
 	defer fake.getSpaceMutex.RUnlock()
+	fake.getV3AppMutex.RLock()
+	defer fake.getV3AppMutex.RUnlock()
+	fake.getV3AppPackagesMutex.RLock()
+	defer fake.getV3AppPackagesMutex.RUnlock()
+	fake.getTasksMutex.RLock()
+	defer fake.getTasksMutex.RUnlock()
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	fake.terminateTaskMutex.RLock()
+	defer fake.terminateTaskMutex.RUnlock()
+	fake.getIsolationSegmentsMutex.RLock()
+	defer fake.getIsolationSegmentsMutex.RUnlock()
+	fake.getIsolationSegmentMutex.RLock()
+	defer fake.getIsolationSegmentMutex.RUnlock()
+	fake.getStreamingLogsMutex.RLock()
+	defer fake.getStreamingLogsMutex.RUnlock()
+	fake.cloudControllerRequestMutex.RLock()
+	defer fake.cloudControllerRequestMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -39,6 +39,8 @@ type CliRpcService struct {
 type CliRpcCmd struct {
 	PluginMetadata       *plugin.PluginMetadata
 	MetadataMutex        *sync.RWMutex
+	NewV3Actor           V3ActorFactory
+	NewLogsActor         LogsActorFactory
 	outputCapture        OutputCapture
 	terminalOutputSwitch TerminalOutputSwitch
 	cliConfig            coreconfig.Repository
@@ -47,6 +49,7 @@ type CliRpcCmd struct {
 	outputBucket         *bytes.Buffer
 	logger               trace.Printer
 	stdout               io.Writer
+	logStreams           *logStreams
 }
 
 //go:generate counterfeiter . TerminalOutputSwitch
@@ -76,6 +79,8 @@ func NewRpcService(
 		RpcCmd: &CliRpcCmd{
 			PluginMetadata:       &plugin.PluginMetadata{},
 			MetadataMutex:        &sync.RWMutex{},
+			NewV3Actor:           NewV3Actor,
+			NewLogsActor:         NewLogsActor,
 			outputCapture:        outputCapture,
 			terminalOutputSwitch: terminalOutputSwitch,
 			cliConfig:            cliConfig,
@@ -84,6 +89,7 @@ func NewRpcService(
 			logger:               logger,
 			outputBucket:         &bytes.Buffer{},
 			stdout:               w,
+			logStreams:           newLogStreams(),
 		},
 	}
 
@@ -96,6 +102,7 @@ func NewRpcService(
 }
 
 func (cli *CliRpcService) Stop() {
+	cli.RpcCmd.logStreams.stopAll()
 	close(cli.stopCh)
 	cli.listener.Close()
 }
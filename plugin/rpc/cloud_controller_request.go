@@ -0,0 +1,35 @@
+package rpc
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/plugin/models"
+)
+
+// CloudControllerRequest makes a request to the targeted Cloud Controller on
+// behalf of a plugin. The request goes through the same connection as the
+// CLI's own requests, so tokens are refreshed, failed requests are retried
+// and CF_TRACE is honored. Error status codes are returned in the response.
+func (cmd *CliRpcCmd) CloudControllerRequest(request plugin_models.CloudControllerRequest, retVal *plugin_models.CloudControllerResponse) error {
+	actor, err := cmd.NewV3Actor()
+	if err != nil {
+		return err
+	}
+
+	response, warnings, err := actor.MakeRawRequest(v3action.RawRequest{
+		Method:  request.Method,
+		Path:    request.Path,
+		Headers: http.Header(request.Headers),
+		Body:    request.Body,
+	})
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	retVal.StatusCode = response.StatusCode
+	retVal.Headers = response.Headers
+	retVal.Body = response.Body
+	return nil
+}
@@ -0,0 +1,118 @@
+package rpc_test
+
+import (
+	"errors"
+	"net/http"
+	"net/rpc"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/plugin/models"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cloud Controller requests", func() {
+	var (
+		err         error
+		rpcService  *CliRpcService
+		fakeActor   *rpcfakes.FakeV3Actor
+		newActorErr error
+		connection  plugin.CliConnection
+	)
+
+	BeforeEach(func() {
+		rpc.DefaultServer = rpc.NewServer()
+
+		fakeActor = new(rpcfakes.FakeV3Actor)
+		newActorErr = nil
+	})
+
+	JustBeforeEach(func() {
+		rpcService, err = NewRpcService(nil, nil, testconfig.NewRepositoryWithDefaults(), api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+		Expect(err).ToNot(HaveOccurred())
+		rpcService.RpcCmd.NewV3Actor = func() (V3Actor, error) {
+			return fakeActor, newActorErr
+		}
+
+		err = rpcService.Start()
+		Expect(err).ToNot(HaveOccurred())
+
+		pingCli(rpcService.Port())
+
+		connection = plugin.NewCliConnection(rpcService.Port())
+	})
+
+	AfterEach(func() {
+		rpcService.Stop()
+
+		//give time for server to stop
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	Context("when the request is made", func() {
+		BeforeEach(func() {
+			fakeActor.MakeRawRequestReturns(
+				v3action.RawResponse{
+					StatusCode: http.StatusNotFound,
+					Headers:    http.Header{"X-Vcap-Request-Id": {"some-request-id"}},
+					Body:       []byte(`{"error_code":"CF-AppNotFound"}`),
+				},
+				v3action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("returns the response of the Cloud Controller", func() {
+			response, err := connection.CloudControllerRequest(plugin_models.CloudControllerRequest{
+				Method:  http.MethodPut,
+				Path:    "/v2/apps/some-guid",
+				Headers: map[string][]string{"Content-Type": {"application/json"}},
+				Body:    []byte(`{"name":"new-name"}`),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(response).To(Equal(plugin_models.CloudControllerResponse{
+				StatusCode: http.StatusNotFound,
+				Headers:    map[string][]string{"X-Vcap-Request-Id": {"some-request-id"}},
+				Body:       []byte(`{"error_code":"CF-AppNotFound"}`),
+			}))
+
+			Expect(fakeActor.MakeRawRequestCallCount()).To(Equal(1))
+			Expect(fakeActor.MakeRawRequestArgsForCall(0)).To(Equal(v3action.RawRequest{
+				Method:  http.MethodPut,
+				Path:    "/v2/apps/some-guid",
+				Headers: http.Header{"Content-Type": {"application/json"}},
+				Body:    []byte(`{"name":"new-name"}`),
+			}))
+		})
+	})
+
+	Context("when no response is received", func() {
+		BeforeEach(func() {
+			fakeActor.MakeRawRequestReturns(v3action.RawResponse{}, nil, errors.New("connection refused"))
+		})
+
+		It("returns the error", func() {
+			_, err := connection.CloudControllerRequest(plugin_models.CloudControllerRequest{Path: "/v2/apps"})
+			Expect(err).To(MatchError("connection refused"))
+		})
+	})
+
+	Context("when the actor cannot be created", func() {
+		BeforeEach(func() {
+			newActorErr = errors.New("config error")
+		})
+
+		It("returns the error", func() {
+			_, err := connection.CloudControllerRequest(plugin_models.CloudControllerRequest{Path: "/v2/apps"})
+			Expect(err).To(MatchError("config error"))
+			Expect(fakeActor.MakeRawRequestCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,175 @@
+package rpc
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/plugin/models"
+)
+
+// maxLogMessagesPerBatch bounds the number of messages returned by a single
+// GetLogMessages call.
+const maxLogMessagesPerBatch = 100
+
+// logStream is an application log stream opened on behalf of a plugin.
+type logStream struct {
+	messages <-chan *v2action.LogMessage
+	errs     <-chan error
+	client   v2action.NOAAClient
+	stopOnce sync.Once
+}
+
+func (stream *logStream) stop() {
+	stream.stopOnce.Do(func() {
+		stream.client.Close()
+	})
+}
+
+// logStreams tracks the log streams opened by plugins. net/rpc has no
+// streaming calls, so plugins poll an open stream by its ID.
+type logStreams struct {
+	mutex   sync.Mutex
+	nextID  int
+	streams map[string]*logStream
+}
+
+func newLogStreams() *logStreams {
+	return &logStreams{streams: map[string]*logStream{}}
+}
+
+func (streams *logStreams) add(stream *logStream) string {
+	streams.mutex.Lock()
+	defer streams.mutex.Unlock()
+
+	streams.nextID++
+	id := strconv.Itoa(streams.nextID)
+	streams.streams[id] = stream
+	return id
+}
+
+func (streams *logStreams) get(id string) (*logStream, bool) {
+	streams.mutex.Lock()
+	defer streams.mutex.Unlock()
+
+	stream, ok := streams.streams[id]
+	return stream, ok
+}
+
+func (streams *logStreams) remove(id string) {
+	streams.mutex.Lock()
+	defer streams.mutex.Unlock()
+
+	delete(streams.streams, id)
+}
+
+func (streams *logStreams) stopAll() {
+	streams.mutex.Lock()
+	defer streams.mutex.Unlock()
+
+	for id, stream := range streams.streams {
+		stream.stop()
+		delete(streams.streams, id)
+	}
+}
+
+// StartLogStream starts streaming the logs of an app in the targeted space
+// and returns the ID plugins use to poll the stream.
+func (cmd *CliRpcCmd) StartLogStream(appName string, retVal *string) error {
+	if !cmd.cliConfig.HasSpace() {
+		return errors.New("No space targeted, use 'cf target -s SPACE' to target a space.")
+	}
+
+	actor, noaaClient, config, err := cmd.NewLogsActor()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, cmd.cliConfig.SpaceFields().GUID)
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	messages, errs := actor.GetStreamingLogs(app.GUID, noaaClient, config)
+	*retVal = cmd.logStreams.add(&logStream{
+		messages: messages,
+		errs:     errs,
+		client:   noaaClient,
+	})
+	return nil
+}
+
+// GetLogMessages waits for messages on a log stream and returns all that are
+// available. Once the stream has ended the batch is marked as done and the
+// stream is forgotten. A streaming error also ends the stream.
+func (cmd *CliRpcCmd) GetLogMessages(streamID string, retVal *plugin_models.LogMessages_Batch) error {
+	stream, ok := cmd.logStreams.get(streamID)
+	if !ok {
+		return errors.New("Unknown log stream " + streamID)
+	}
+
+	retVal.Messages = []plugin_models.LogMessage{}
+
+	select {
+	case message, ok := <-stream.messages:
+		if !ok {
+			cmd.endLogStream(streamID, stream, retVal)
+			return nil
+		}
+		retVal.Messages = append(retVal.Messages, convertLogMessage(message))
+	case err, ok := <-stream.errs:
+		if !ok {
+			cmd.endLogStream(streamID, stream, retVal)
+			return nil
+		}
+		stream.stop()
+		cmd.logStreams.remove(streamID)
+		return err
+	}
+
+	for len(retVal.Messages) < maxLogMessagesPerBatch {
+		select {
+		case message, ok := <-stream.messages:
+			if !ok {
+				cmd.endLogStream(streamID, stream, retVal)
+				return nil
+			}
+			retVal.Messages = append(retVal.Messages, convertLogMessage(message))
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// StopLogStream closes the connection of a log stream. Messages that were
+// already received can still be polled until the stream reports that it is
+// done.
+func (cmd *CliRpcCmd) StopLogStream(streamID string, retVal *bool) error {
+	stream, ok := cmd.logStreams.get(streamID)
+	if ok {
+		stream.stop()
+	}
+
+	*retVal = true
+	return nil
+}
+
+func (cmd *CliRpcCmd) endLogStream(streamID string, stream *logStream, retVal *plugin_models.LogMessages_Batch) {
+	stream.stop()
+	cmd.logStreams.remove(streamID)
+	retVal.Done = true
+}
+
+func convertLogMessage(message *v2action.LogMessage) plugin_models.LogMessage {
+	return plugin_models.LogMessage{
+		Message:        message.Message(),
+		MessageType:    message.Type(),
+		Timestamp:      message.Timestamp(),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+	}
+}
@@ -0,0 +1,201 @@
+package rpc_test
+
+import (
+	"errors"
+	"net/rpc"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/plugin"
+	"code.cloudfoundry.org/cli/plugin/models"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Log streaming", func() {
+	var (
+		err            error
+		rpcService     *CliRpcService
+		config         coreconfig.Repository
+		fakeActor      *rpcfakes.FakeLogsActor
+		fakeNOAAClient *v2actionfakes.FakeNOAAClient
+		newActorErr    error
+
+		actorMessages chan *v2action.LogMessage
+		actorErrs     chan error
+
+		connection plugin.CliConnection
+		stop       chan struct{}
+	)
+
+	BeforeEach(func() {
+		rpc.DefaultServer = rpc.NewServer()
+
+		config = testconfig.NewRepositoryWithDefaults()
+		config.SetSpaceFields(models.SpaceFields{GUID: "space-guid", Name: "space-name"})
+
+		fakeActor = new(rpcfakes.FakeLogsActor)
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+		newActorErr = nil
+
+		actorMessages = make(chan *v2action.LogMessage)
+		actorErrs = make(chan error)
+		fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "app-guid"}, v2action.Warnings{"some-warning"}, nil)
+		fakeActor.GetStreamingLogsReturns(actorMessages, actorErrs)
+
+		// Closing the NOAA client ends the stream, as the real client does.
+		fakeNOAAClient.CloseStub = func() error {
+			close(actorMessages)
+			close(actorErrs)
+			return nil
+		}
+
+		stop = make(chan struct{})
+	})
+
+	JustBeforeEach(func() {
+		rpcService, err = NewRpcService(nil, nil, config, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+		Expect(err).ToNot(HaveOccurred())
+		rpcService.RpcCmd.NewLogsActor = func() (LogsActor, v2action.NOAAClient, v2action.Config, error) {
+			return fakeActor, fakeNOAAClient, nil, newActorErr
+		}
+
+		err = rpcService.Start()
+		Expect(err).ToNot(HaveOccurred())
+
+		pingCli(rpcService.Port())
+
+		connection = plugin.NewCliConnection(rpcService.Port())
+	})
+
+	AfterEach(func() {
+		rpcService.Stop()
+
+		//give time for server to stop
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	It("streams the log messages of the app in the targeted space", func() {
+		messages, errs, err := connection.GetStreamingLogs("some-app", stop)
+		Expect(err).ToNot(HaveOccurred())
+
+		name, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(name).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("space-guid"))
+
+		appGUID, noaaClient, _ := fakeActor.GetStreamingLogsArgsForCall(0)
+		Expect(appGUID).To(Equal("app-guid"))
+		Expect(noaaClient).To(Equal(fakeNOAAClient))
+
+		timestamp := time.Unix(0, 1500000000000000000)
+		go func() {
+			actorMessages <- v2action.NewLogMessage("message-1", 1, timestamp, "APP", "0")
+			actorMessages <- v2action.NewLogMessage("message-2", 2, timestamp, "STG", "1")
+		}()
+
+		Eventually(messages).Should(Receive(Equal(plugin_models.LogMessage{
+			Message:        "message-1",
+			MessageType:    "OUT",
+			Timestamp:      timestamp,
+			SourceType:     "APP",
+			SourceInstance: "0",
+		})))
+		Eventually(messages).Should(Receive(Equal(plugin_models.LogMessage{
+			Message:        "message-2",
+			MessageType:    "ERR",
+			Timestamp:      timestamp,
+			SourceType:     "STG",
+			SourceInstance: "1",
+		})))
+
+		close(stop)
+
+		Eventually(messages).Should(BeClosed())
+		Eventually(errs).Should(BeClosed())
+		Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+	})
+
+	Context("when the stream ends", func() {
+		BeforeEach(func() {
+			fakeNOAAClient.CloseStub = nil
+		})
+
+		It("closes the channels", func() {
+			messages, errs, err := connection.GetStreamingLogs("some-app", stop)
+			Expect(err).ToNot(HaveOccurred())
+
+			close(actorMessages)
+			close(actorErrs)
+
+			Eventually(messages).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+	})
+
+	Context("when the stream errors", func() {
+		It("sends the error and closes the channels", func() {
+			messages, errs, err := connection.GetStreamingLogs("some-app", stop)
+			Expect(err).ToNot(HaveOccurred())
+
+			actorErrs <- errors.New("streaming error")
+
+			Eventually(errs).Should(Receive(MatchError("streaming error")))
+			Eventually(messages).Should(BeClosed())
+			Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error without starting a stream", func() {
+			_, _, err := connection.GetStreamingLogs("some-app", stop)
+			Expect(err).To(MatchError("Application 'some-app' not found."))
+			Expect(fakeActor.GetStreamingLogsCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when no space is targeted", func() {
+		BeforeEach(func() {
+			config.SetSpaceFields(models.SpaceFields{})
+		})
+
+		It("returns an error", func() {
+			_, _, err := connection.GetStreamingLogs("some-app", stop)
+			Expect(err).To(MatchError("No space targeted, use 'cf target -s SPACE' to target a space."))
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the actor cannot be created", func() {
+		BeforeEach(func() {
+			newActorErr = errors.New("config error")
+		})
+
+		It("returns the error", func() {
+			_, _, err := connection.GetStreamingLogs("some-app", stop)
+			Expect(err).To(MatchError("config error"))
+		})
+	})
+
+	Describe("CliRpcService.Stop", func() {
+		It("closes the open log streams", func() {
+			_, _, err := connection.GetStreamingLogs("some-app", stop)
+			Expect(err).ToNot(HaveOccurred())
+
+			rpcService.Stop()
+			Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+
+			Expect(rpcService.Start()).To(Succeed())
+		})
+	})
+})
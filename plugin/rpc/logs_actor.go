@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . LogsActor
+
+// LogsActor is the subset of v2action.Actor backing the log streaming RPC
+// methods.
+type LogsActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetStreamingLogs(appGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error)
+}
+
+// LogsActorFactory creates the LogsActor, NOAA client and config used by a
+// single log stream.
+type LogsActorFactory func() (LogsActor, v2action.NOAAClient, v2action.Config, error)
+
+// NewLogsActor returns a v2action.Actor and a NOAA client targeting the API
+// in the CLI config. The NOAA client refreshes its token through UAA, the
+// same way the logs command does.
+func NewLogsActor() (LogsActor, v2action.NOAAClient, v2action.Config, error) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ccClient, uaaClient, err := sharedV2.NewClients(config, commandUI, true)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	noaaClient := sharedV2.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, commandUI)
+	return v2action.NewActor(ccClient, uaaClient), noaaClient, config, nil
+}
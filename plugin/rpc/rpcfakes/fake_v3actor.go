@@ -0,0 +1,752 @@
+// This file was generated by counterfeiter
+package rpcfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/plugin/rpc"
+)
+
+type FakeV3Actor struct {
+	GetApplicationPackagesStub        func(appName string, spaceGUID string) ([]v3action.Package, v3action.Warnings, error)
+	getApplicationPackagesMutex       sync.RWMutex
+	getApplicationPackagesArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationPackagesReturns struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationPackagesReturnsOnCall map[int]struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetApplicationSummaryByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.ApplicationSummary, v3action.Warnings, error)
+	getApplicationSummaryByNameAndSpaceMutex       sync.RWMutex
+	getApplicationSummaryByNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationSummaryByNameAndSpaceReturns struct {
+		result1 v3action.ApplicationSummary
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationSummaryByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v3action.ApplicationSummary
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetApplicationTasksStub        func(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	getApplicationTasksMutex       sync.RWMutex
+	getApplicationTasksArgsForCall []struct {
+		appGUID   string
+		sortOrder v3action.SortOrder
+	}
+	getApplicationTasksReturns struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationTasksReturnsOnCall map[int]struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetIsolationSegmentByNameStub        func(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	getIsolationSegmentByNameMutex       sync.RWMutex
+	getIsolationSegmentByNameArgsForCall []struct {
+		name string
+	}
+	getIsolationSegmentByNameReturns struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentByNameReturnsOnCall map[int]struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetIsolationSegmentSummariesStub        func() ([]v3action.IsolationSegmentSummary, v3action.Warnings, error)
+	getIsolationSegmentSummariesMutex       sync.RWMutex
+	getIsolationSegmentSummariesArgsForCall []struct{}
+	getIsolationSegmentSummariesReturns     struct {
+		result1 []v3action.IsolationSegmentSummary
+		result2 v3action.Warnings
+		result3 error
+	}
+	getIsolationSegmentSummariesReturnsOnCall map[int]struct {
+		result1 []v3action.IsolationSegmentSummary
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetTaskBySequenceIDAndApplicationStub        func(sequenceID int, appGUID string) (v3action.Task, v3action.Warnings, error)
+	getTaskBySequenceIDAndApplicationMutex       sync.RWMutex
+	getTaskBySequenceIDAndApplicationArgsForCall []struct {
+		sequenceID int
+		appGUID    string
+	}
+	getTaskBySequenceIDAndApplicationReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	getTaskBySequenceIDAndApplicationReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	MakeRawRequestStub        func(request v3action.RawRequest) (v3action.RawResponse, v3action.Warnings, error)
+	makeRawRequestMutex       sync.RWMutex
+	makeRawRequestArgsForCall []struct {
+		request v3action.RawRequest
+	}
+	makeRawRequestReturns struct {
+		result1 v3action.RawResponse
+		result2 v3action.Warnings
+		result3 error
+	}
+	makeRawRequestReturnsOnCall map[int]struct {
+		result1 v3action.RawResponse
+		result2 v3action.Warnings
+		result3 error
+	}
+	RunTaskStub        func(appGUID string, command string, name string, memory uint64, disk uint64) (v3action.Task, v3action.Warnings, error)
+	runTaskMutex       sync.RWMutex
+	runTaskArgsForCall []struct {
+		appGUID string
+		command string
+		name    string
+		memory  uint64
+		disk    uint64
+	}
+	runTaskReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	runTaskReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	TerminateTaskStub        func(taskGUID string) (v3action.Task, v3action.Warnings, error)
+	terminateTaskMutex       sync.RWMutex
+	terminateTaskArgsForCall []struct {
+		taskGUID string
+	}
+	terminateTaskReturns struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	terminateTaskReturnsOnCall map[int]struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3Actor) GetApplicationPackages(appName string, spaceGUID string) ([]v3action.Package, v3action.Warnings, error) {
+	fake.getApplicationPackagesMutex.Lock()
+	ret, specificReturn := fake.getApplicationPackagesReturnsOnCall[len(fake.getApplicationPackagesArgsForCall)]
+	fake.getApplicationPackagesArgsForCall = append(fake.getApplicationPackagesArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationPackages", []interface{}{appName, spaceGUID})
+	fake.getApplicationPackagesMutex.Unlock()
+	if fake.GetApplicationPackagesStub != nil {
+		return fake.GetApplicationPackagesStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationPackagesReturns.result1, fake.getApplicationPackagesReturns.result2, fake.getApplicationPackagesReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationPackagesCallCount() int {
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	return len(fake.getApplicationPackagesArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationPackagesArgsForCall(i int) (string, string) {
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	return fake.getApplicationPackagesArgsForCall[i].appName, fake.getApplicationPackagesArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3Actor) GetApplicationPackagesReturns(result1 []v3action.Package, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationPackagesStub = nil
+	fake.getApplicationPackagesReturns = struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationPackagesReturnsOnCall(i int, result1 []v3action.Package, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationPackagesStub = nil
+	if fake.getApplicationPackagesReturnsOnCall == nil {
+		fake.getApplicationPackagesReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Package
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationPackagesReturnsOnCall[i] = struct {
+		result1 []v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].appName, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.Application
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string) (v3action.ApplicationSummary, v3action.Warnings, error) {
+	fake.getApplicationSummaryByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationSummaryByNameAndSpaceReturnsOnCall[len(fake.getApplicationSummaryByNameAndSpaceArgsForCall)]
+	fake.getApplicationSummaryByNameAndSpaceArgsForCall = append(fake.getApplicationSummaryByNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationSummaryByNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.getApplicationSummaryByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationSummaryByNameAndSpaceStub != nil {
+		return fake.GetApplicationSummaryByNameAndSpaceStub(appName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationSummaryByNameAndSpaceReturns.result1, fake.getApplicationSummaryByNameAndSpaceReturns.result2, fake.getApplicationSummaryByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpaceCallCount() int {
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationSummaryByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationSummaryByNameAndSpaceArgsForCall[i].appName, fake.getApplicationSummaryByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpaceReturns(result1 v3action.ApplicationSummary, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationSummaryByNameAndSpaceStub = nil
+	fake.getApplicationSummaryByNameAndSpaceReturns = struct {
+		result1 v3action.ApplicationSummary
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationSummaryByNameAndSpaceReturnsOnCall(i int, result1 v3action.ApplicationSummary, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationSummaryByNameAndSpaceStub = nil
+	if fake.getApplicationSummaryByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationSummaryByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v3action.ApplicationSummary
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSummaryByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v3action.ApplicationSummary
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error) {
+	fake.getApplicationTasksMutex.Lock()
+	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
+	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
+		appGUID   string
+		sortOrder v3action.SortOrder
+	}{appGUID, sortOrder})
+	fake.recordInvocation("GetApplicationTasks", []interface{}{appGUID, sortOrder})
+	fake.getApplicationTasksMutex.Unlock()
+	if fake.GetApplicationTasksStub != nil {
+		return fake.GetApplicationTasksStub(appGUID, sortOrder)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationTasksReturns.result1, fake.getApplicationTasksReturns.result2, fake.getApplicationTasksReturns.result3
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksCallCount() int {
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	return len(fake.getApplicationTasksArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksArgsForCall(i int) (string, v3action.SortOrder) {
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	return fake.getApplicationTasksArgsForCall[i].appGUID, fake.getApplicationTasksArgsForCall[i].sortOrder
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksReturns(result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationTasksStub = nil
+	fake.getApplicationTasksReturns = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetApplicationTasksReturnsOnCall(i int, result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationTasksStub = nil
+	if fake.getApplicationTasksReturnsOnCall == nil {
+		fake.getApplicationTasksReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationTasksReturnsOnCall[i] = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error) {
+	fake.getIsolationSegmentByNameMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentByNameReturnsOnCall[len(fake.getIsolationSegmentByNameArgsForCall)]
+	fake.getIsolationSegmentByNameArgsForCall = append(fake.getIsolationSegmentByNameArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("GetIsolationSegmentByName", []interface{}{name})
+	fake.getIsolationSegmentByNameMutex.Unlock()
+	if fake.GetIsolationSegmentByNameStub != nil {
+		return fake.GetIsolationSegmentByNameStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentByNameReturns.result1, fake.getIsolationSegmentByNameReturns.result2, fake.getIsolationSegmentByNameReturns.result3
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameCallCount() int {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return len(fake.getIsolationSegmentByNameArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameArgsForCall(i int) string {
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	return fake.getIsolationSegmentByNameArgsForCall[i].name
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameReturns(result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentByNameStub = nil
+	fake.getIsolationSegmentByNameReturns = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentByNameReturnsOnCall(i int, result1 v3action.IsolationSegment, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentByNameStub = nil
+	if fake.getIsolationSegmentByNameReturnsOnCall == nil {
+		fake.getIsolationSegmentByNameReturnsOnCall = make(map[int]struct {
+			result1 v3action.IsolationSegment
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentByNameReturnsOnCall[i] = struct {
+		result1 v3action.IsolationSegment
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentSummaries() ([]v3action.IsolationSegmentSummary, v3action.Warnings, error) {
+	fake.getIsolationSegmentSummariesMutex.Lock()
+	ret, specificReturn := fake.getIsolationSegmentSummariesReturnsOnCall[len(fake.getIsolationSegmentSummariesArgsForCall)]
+	fake.getIsolationSegmentSummariesArgsForCall = append(fake.getIsolationSegmentSummariesArgsForCall, struct{}{})
+	fake.recordInvocation("GetIsolationSegmentSummaries", []interface{}{})
+	fake.getIsolationSegmentSummariesMutex.Unlock()
+	if fake.GetIsolationSegmentSummariesStub != nil {
+		return fake.GetIsolationSegmentSummariesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getIsolationSegmentSummariesReturns.result1, fake.getIsolationSegmentSummariesReturns.result2, fake.getIsolationSegmentSummariesReturns.result3
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentSummariesCallCount() int {
+	fake.getIsolationSegmentSummariesMutex.RLock()
+	defer fake.getIsolationSegmentSummariesMutex.RUnlock()
+	return len(fake.getIsolationSegmentSummariesArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentSummariesReturns(result1 []v3action.IsolationSegmentSummary, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentSummariesStub = nil
+	fake.getIsolationSegmentSummariesReturns = struct {
+		result1 []v3action.IsolationSegmentSummary
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetIsolationSegmentSummariesReturnsOnCall(i int, result1 []v3action.IsolationSegmentSummary, result2 v3action.Warnings, result3 error) {
+	fake.GetIsolationSegmentSummariesStub = nil
+	if fake.getIsolationSegmentSummariesReturnsOnCall == nil {
+		fake.getIsolationSegmentSummariesReturnsOnCall = make(map[int]struct {
+			result1 []v3action.IsolationSegmentSummary
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getIsolationSegmentSummariesReturnsOnCall[i] = struct {
+		result1 []v3action.IsolationSegmentSummary
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (v3action.Task, v3action.Warnings, error) {
+	fake.getTaskBySequenceIDAndApplicationMutex.Lock()
+	ret, specificReturn := fake.getTaskBySequenceIDAndApplicationReturnsOnCall[len(fake.getTaskBySequenceIDAndApplicationArgsForCall)]
+	fake.getTaskBySequenceIDAndApplicationArgsForCall = append(fake.getTaskBySequenceIDAndApplicationArgsForCall, struct {
+		sequenceID int
+		appGUID    string
+	}{sequenceID, appGUID})
+	fake.recordInvocation("GetTaskBySequenceIDAndApplication", []interface{}{sequenceID, appGUID})
+	fake.getTaskBySequenceIDAndApplicationMutex.Unlock()
+	if fake.GetTaskBySequenceIDAndApplicationStub != nil {
+		return fake.GetTaskBySequenceIDAndApplicationStub(sequenceID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getTaskBySequenceIDAndApplicationReturns.result1, fake.getTaskBySequenceIDAndApplicationReturns.result2, fake.getTaskBySequenceIDAndApplicationReturns.result3
+}
+
+func (fake *FakeV3Actor) GetTaskBySequenceIDAndApplicationCallCount() int {
+	fake.getTaskBySequenceIDAndApplicationMutex.RLock()
+	defer fake.getTaskBySequenceIDAndApplicationMutex.RUnlock()
+	return len(fake.getTaskBySequenceIDAndApplicationArgsForCall)
+}
+
+func (fake *FakeV3Actor) GetTaskBySequenceIDAndApplicationArgsForCall(i int) (int, string) {
+	fake.getTaskBySequenceIDAndApplicationMutex.RLock()
+	defer fake.getTaskBySequenceIDAndApplicationMutex.RUnlock()
+	return fake.getTaskBySequenceIDAndApplicationArgsForCall[i].sequenceID, fake.getTaskBySequenceIDAndApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV3Actor) GetTaskBySequenceIDAndApplicationReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetTaskBySequenceIDAndApplicationStub = nil
+	fake.getTaskBySequenceIDAndApplicationReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) GetTaskBySequenceIDAndApplicationReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetTaskBySequenceIDAndApplicationStub = nil
+	if fake.getTaskBySequenceIDAndApplicationReturnsOnCall == nil {
+		fake.getTaskBySequenceIDAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getTaskBySequenceIDAndApplicationReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) MakeRawRequest(request v3action.RawRequest) (v3action.RawResponse, v3action.Warnings, error) {
+	fake.makeRawRequestMutex.Lock()
+	ret, specificReturn := fake.makeRawRequestReturnsOnCall[len(fake.makeRawRequestArgsForCall)]
+	fake.makeRawRequestArgsForCall = append(fake.makeRawRequestArgsForCall, struct {
+		request v3action.RawRequest
+	}{request})
+	fake.recordInvocation("MakeRawRequest", []interface{}{request})
+	fake.makeRawRequestMutex.Unlock()
+	if fake.MakeRawRequestStub != nil {
+		return fake.MakeRawRequestStub(request)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.makeRawRequestReturns.result1, fake.makeRawRequestReturns.result2, fake.makeRawRequestReturns.result3
+}
+
+func (fake *FakeV3Actor) MakeRawRequestCallCount() int {
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
+	return len(fake.makeRawRequestArgsForCall)
+}
+
+func (fake *FakeV3Actor) MakeRawRequestArgsForCall(i int) v3action.RawRequest {
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
+	return fake.makeRawRequestArgsForCall[i].request
+}
+
+func (fake *FakeV3Actor) MakeRawRequestReturns(result1 v3action.RawResponse, result2 v3action.Warnings, result3 error) {
+	fake.MakeRawRequestStub = nil
+	fake.makeRawRequestReturns = struct {
+		result1 v3action.RawResponse
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) MakeRawRequestReturnsOnCall(i int, result1 v3action.RawResponse, result2 v3action.Warnings, result3 error) {
+	fake.MakeRawRequestStub = nil
+	if fake.makeRawRequestReturnsOnCall == nil {
+		fake.makeRawRequestReturnsOnCall = make(map[int]struct {
+			result1 v3action.RawResponse
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.makeRawRequestReturnsOnCall[i] = struct {
+		result1 v3action.RawResponse
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) RunTask(appGUID string, command string, name string, memory uint64, disk uint64) (v3action.Task, v3action.Warnings, error) {
+	fake.runTaskMutex.Lock()
+	ret, specificReturn := fake.runTaskReturnsOnCall[len(fake.runTaskArgsForCall)]
+	fake.runTaskArgsForCall = append(fake.runTaskArgsForCall, struct {
+		appGUID string
+		command string
+		name    string
+		memory  uint64
+		disk    uint64
+	}{appGUID, command, name, memory, disk})
+	fake.recordInvocation("RunTask", []interface{}{appGUID, command, name, memory, disk})
+	fake.runTaskMutex.Unlock()
+	if fake.RunTaskStub != nil {
+		return fake.RunTaskStub(appGUID, command, name, memory, disk)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.runTaskReturns.result1, fake.runTaskReturns.result2, fake.runTaskReturns.result3
+}
+
+func (fake *FakeV3Actor) RunTaskCallCount() int {
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	return len(fake.runTaskArgsForCall)
+}
+
+func (fake *FakeV3Actor) RunTaskArgsForCall(i int) (string, string, string, uint64, uint64) {
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	return fake.runTaskArgsForCall[i].appGUID, fake.runTaskArgsForCall[i].command, fake.runTaskArgsForCall[i].name, fake.runTaskArgsForCall[i].memory, fake.runTaskArgsForCall[i].disk
+}
+
+func (fake *FakeV3Actor) RunTaskReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.RunTaskStub = nil
+	fake.runTaskReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) RunTaskReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.RunTaskStub = nil
+	if fake.runTaskReturnsOnCall == nil {
+		fake.runTaskReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.runTaskReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) TerminateTask(taskGUID string) (v3action.Task, v3action.Warnings, error) {
+	fake.terminateTaskMutex.Lock()
+	ret, specificReturn := fake.terminateTaskReturnsOnCall[len(fake.terminateTaskArgsForCall)]
+	fake.terminateTaskArgsForCall = append(fake.terminateTaskArgsForCall, struct {
+		taskGUID string
+	}{taskGUID})
+	fake.recordInvocation("TerminateTask", []interface{}{taskGUID})
+	fake.terminateTaskMutex.Unlock()
+	if fake.TerminateTaskStub != nil {
+		return fake.TerminateTaskStub(taskGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.terminateTaskReturns.result1, fake.terminateTaskReturns.result2, fake.terminateTaskReturns.result3
+}
+
+func (fake *FakeV3Actor) TerminateTaskCallCount() int {
+	fake.terminateTaskMutex.RLock()
+	defer fake.terminateTaskMutex.RUnlock()
+	return len(fake.terminateTaskArgsForCall)
+}
+
+func (fake *FakeV3Actor) TerminateTaskArgsForCall(i int) string {
+	fake.terminateTaskMutex.RLock()
+	defer fake.terminateTaskMutex.RUnlock()
+	return fake.terminateTaskArgsForCall[i].taskGUID
+}
+
+func (fake *FakeV3Actor) TerminateTaskReturns(result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.TerminateTaskStub = nil
+	fake.terminateTaskReturns = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) TerminateTaskReturnsOnCall(i int, result1 v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.TerminateTaskStub = nil
+	if fake.terminateTaskReturnsOnCall == nil {
+		fake.terminateTaskReturnsOnCall = make(map[int]struct {
+			result1 v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.terminateTaskReturnsOnCall[i] = struct {
+		result1 v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3Actor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationPackagesMutex.RLock()
+	defer fake.getApplicationPackagesMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationSummaryByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationSummaryByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationTasksMutex.RLock()
+	defer fake.getApplicationTasksMutex.RUnlock()
+	fake.getIsolationSegmentByNameMutex.RLock()
+	defer fake.getIsolationSegmentByNameMutex.RUnlock()
+	fake.getIsolationSegmentSummariesMutex.RLock()
+	defer fake.getIsolationSegmentSummariesMutex.RUnlock()
+	fake.getTaskBySequenceIDAndApplicationMutex.RLock()
+	defer fake.getTaskBySequenceIDAndApplicationMutex.RUnlock()
+	fake.makeRawRequestMutex.RLock()
+	defer fake.makeRawRequestMutex.RUnlock()
+	fake.runTaskMutex.RLock()
+	defer fake.runTaskMutex.RUnlock()
+	fake.terminateTaskMutex.RLock()
+	defer fake.terminateTaskMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeV3Actor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rpc.V3Actor = new(FakeV3Actor)
@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . V3Actor
+
+// V3Actor is the subset of v3action.Actor backing the V3 RPC methods.
+type V3Actor interface {
+	GetApplicationPackages(appName string, spaceGUID string) ([]v3action.Package, v3action.Warnings, error)
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string) (v3action.ApplicationSummary, v3action.Warnings, error)
+	GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	GetIsolationSegmentByName(name string) (v3action.IsolationSegment, v3action.Warnings, error)
+	GetIsolationSegmentSummaries() ([]v3action.IsolationSegmentSummary, v3action.Warnings, error)
+	GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (v3action.Task, v3action.Warnings, error)
+	MakeRawRequest(request v3action.RawRequest) (v3action.RawResponse, v3action.Warnings, error)
+	RunTask(appGUID string, command string, name string, memory uint64, disk uint64) (v3action.Task, v3action.Warnings, error)
+	TerminateTask(taskGUID string) (v3action.Task, v3action.Warnings, error)
+}
+
+// V3ActorFactory creates the V3Actor used by a single RPC call.
+type V3ActorFactory func() (V3Actor, error)
+
+// NewV3Actor returns a v3action.Actor targeting the API in the CLI config,
+// with the same authentication, retry and tracing as the V3 commands.
+func NewV3Actor() (V3Actor, error) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := shared.NewClients(config, commandUI, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return v3action.NewActor(client, config), nil
+}
@@ -0,0 +1,237 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/plugin/models"
+)
+
+func (cmd *CliRpcCmd) GetV3App(appName string, retVal *plugin_models.GetV3App_Model) error {
+	actor, spaceGUID, err := cmd.v3ActorForTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	summary, warnings, err := actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID)
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	retVal.Guid = summary.GUID
+	retVal.Name = summary.Name
+	retVal.State = string(summary.State)
+	retVal.SpaceGuid = summary.Relationships.Space.GUID
+	retVal.Processes = []plugin_models.GetV3App_Process{}
+	for _, process := range summary.ProcessSummaries {
+		retVal.Processes = append(retVal.Processes, plugin_models.GetV3App_Process{
+			Guid:             process.GUID,
+			Type:             process.Type,
+			Instances:        process.Instances,
+			RunningInstances: process.HealthyInstanceCount(),
+			MemoryInMb:       process.MemoryInMB,
+			DiskInMb:         process.DiskInMB,
+		})
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetV3AppPackages(appName string, retVal *[]plugin_models.GetV3AppPackages_Model) error {
+	actor, spaceGUID, err := cmd.v3ActorForTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	packages, warnings, err := actor.GetApplicationPackages(appName, spaceGUID)
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = []plugin_models.GetV3AppPackages_Model{}
+	for _, pkg := range packages {
+		*retVal = append(*retVal, plugin_models.GetV3AppPackages_Model{
+			Guid:      pkg.GUID,
+			Type:      string(pkg.Type),
+			State:     string(pkg.State),
+			CreatedAt: parseTimestamp(pkg.CreatedAt),
+		})
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetTasks(appName string, retVal *[]plugin_models.Task) error {
+	actor, spaceGUID, err := cmd.v3ActorForTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	tasks, warnings, err := actor.GetApplicationTasks(app.GUID, v3action.Descending)
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = []plugin_models.Task{}
+	for _, task := range tasks {
+		*retVal = append(*retVal, convertTask(task))
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmd) RunTask(args plugin_models.RunTask_Args, retVal *plugin_models.Task) error {
+	actor, spaceGUID, err := cmd.v3ActorForTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(args.AppName, spaceGUID)
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	task, warnings, err := actor.RunTask(app.GUID, args.Command, args.Options.Name, args.Options.MemoryInMb, args.Options.DiskInMb)
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = convertTask(task)
+	return nil
+}
+
+func (cmd *CliRpcCmd) TerminateTask(args []string, retVal *plugin_models.Task) error {
+	if len(args) != 2 {
+		return errors.New("TerminateTask requires an app name and a task sequence ID")
+	}
+
+	sequenceID, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("Invalid task sequence ID %s", args[1])
+	}
+
+	actor, spaceGUID, err := cmd.v3ActorForTargetedSpace()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := actor.GetApplicationByNameAndSpace(args[0], spaceGUID)
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	task, warnings, err := actor.GetTaskBySequenceIDAndApplication(sequenceID, app.GUID)
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	task, warnings, err = actor.TerminateTask(task.GUID)
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = convertTask(task)
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetIsolationSegments(_ string, retVal *[]plugin_models.GetIsolationSegments_Model) error {
+	actor, err := cmd.NewV3Actor()
+	if err != nil {
+		return err
+	}
+
+	summaries, warnings, err := actor.GetIsolationSegmentSummaries()
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	*retVal = []plugin_models.GetIsolationSegments_Model{}
+	for _, summary := range summaries {
+		*retVal = append(*retVal, plugin_models.GetIsolationSegments_Model{
+			Name: summary.Name,
+			Orgs: summary.EntitledOrgs,
+		})
+	}
+
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetIsolationSegment(name string, retVal *plugin_models.GetIsolationSegment_Model) error {
+	actor, err := cmd.NewV3Actor()
+	if err != nil {
+		return err
+	}
+
+	isolationSegment, warnings, err := actor.GetIsolationSegmentByName(name)
+	displayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	retVal.Guid = isolationSegment.GUID
+	retVal.Name = isolationSegment.Name
+	return nil
+}
+
+// v3ActorForTargetedSpace returns a V3Actor along with the GUID of the
+// targeted space, which app and task lookups are scoped to.
+func (cmd *CliRpcCmd) v3ActorForTargetedSpace() (V3Actor, string, error) {
+	if !cmd.cliConfig.HasSpace() {
+		return nil, "", errors.New("No space targeted, use 'cf target -s SPACE' to target a space.")
+	}
+
+	actor, err := cmd.NewV3Actor()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return actor, cmd.cliConfig.SpaceFields().GUID, nil
+}
+
+// displayWarnings prints Cloud Controller warnings to stderr, which the
+// plugin process shares with the CLI.
+func displayWarnings(warnings []string) {
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, warning)
+	}
+}
+
+func convertTask(task v3action.Task) plugin_models.Task {
+	return plugin_models.Task{
+		Guid:       task.GUID,
+		SequenceId: task.SequenceID,
+		Name:       task.Name,
+		Command:    task.Command,
+		State:      task.State,
+		MemoryInMb: task.MemoryInMB,
+		DiskInMb:   task.DiskInMB,
+		CreatedAt:  parseTimestamp(task.CreatedAt),
+	}
+}
+
+func parseTimestamp(timestamp string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
@@ -0,0 +1,301 @@
+package rpc_test
+
+import (
+	"errors"
+	"net/rpc"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/plugin/models"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V3 Plugin API", func() {
+	var (
+		err         error
+		client      *rpc.Client
+		rpcService  *CliRpcService
+		config      coreconfig.Repository
+		fakeActor   *rpcfakes.FakeV3Actor
+		newActorErr error
+	)
+
+	BeforeEach(func() {
+		rpc.DefaultServer = rpc.NewServer()
+
+		config = testconfig.NewRepositoryWithDefaults()
+		config.SetSpaceFields(models.SpaceFields{GUID: "space-guid", Name: "space-name"})
+
+		fakeActor = new(rpcfakes.FakeV3Actor)
+		newActorErr = nil
+	})
+
+	JustBeforeEach(func() {
+		rpcService, err = NewRpcService(nil, nil, config, api.RepositoryLocator{}, nil, nil, nil, rpc.DefaultServer)
+		Expect(err).ToNot(HaveOccurred())
+		rpcService.RpcCmd.NewV3Actor = func() (V3Actor, error) {
+			return fakeActor, newActorErr
+		}
+
+		err = rpcService.Start()
+		Expect(err).ToNot(HaveOccurred())
+
+		pingCli(rpcService.Port())
+
+		client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		if client != nil {
+			client.Close()
+		}
+		rpcService.Stop()
+
+		//give time for server to stop
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	Describe(".GetV3App", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+				v3action.ApplicationSummary{
+					Application: v3action.Application{
+						GUID:  "app-guid",
+						Name:  "some-app",
+						State: ccv3.ApplicationStateStarted,
+						Relationships: ccv3.ApplicationRelationships{
+							Space: ccv3.Relationship{GUID: "space-guid"},
+						},
+					},
+					ProcessSummaries: v3action.ProcessSummaries{
+						{
+							Process: v3action.Process{GUID: "process-guid", Type: "web", Instances: 2, MemoryInMB: 32, DiskInMB: 64},
+							InstanceDetails: []v3action.ProcessInstance{
+								{State: ccv3.ProcessInstanceStateRunning},
+								{State: "CRASHED"},
+							},
+						},
+					},
+				},
+				v3action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("returns the app and its processes in the targeted space", func() {
+			var result plugin_models.GetV3App_Model
+			err = client.Call("CliRpcCmd.GetV3App", "some-app", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(Equal(plugin_models.GetV3App_Model{
+				Guid:      "app-guid",
+				Name:      "some-app",
+				State:     "STARTED",
+				SpaceGuid: "space-guid",
+				Processes: []plugin_models.GetV3App_Process{
+					{Guid: "process-guid", Type: "web", Instances: 2, RunningInstances: 1, MemoryInMb: 32, DiskInMb: 64},
+				},
+			}))
+
+			Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("space-guid"))
+		})
+
+		Context("when no space is targeted", func() {
+			BeforeEach(func() {
+				config.SetSpaceFields(models.SpaceFields{})
+			})
+
+			It("returns an error", func() {
+				var result plugin_models.GetV3App_Model
+				err = client.Call("CliRpcCmd.GetV3App", "some-app", &result)
+				Expect(err).To(MatchError("No space targeted, use 'cf target -s SPACE' to target a space."))
+				Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the actor cannot be created", func() {
+			BeforeEach(func() {
+				newActorErr = errors.New("config error")
+			})
+
+			It("returns the error", func() {
+				var result plugin_models.GetV3App_Model
+				err = client.Call("CliRpcCmd.GetV3App", "some-app", &result)
+				Expect(err).To(MatchError("config error"))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{}, nil, v3action.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns the error", func() {
+				var result plugin_models.GetV3App_Model
+				err = client.Call("CliRpcCmd.GetV3App", "some-app", &result)
+				Expect(err).To(MatchError("Application 'some-app' not found."))
+			})
+		})
+	})
+
+	Describe(".GetV3AppPackages", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationPackagesReturns(
+				[]v3action.Package{
+					{GUID: "package-guid", Type: ccv3.PackageTypeBits, State: ccv3.PackageStateReady, CreatedAt: "2017-08-14T21:16:42Z"},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("returns the packages of the app", func() {
+			var result []plugin_models.GetV3AppPackages_Model
+			err = client.Call("CliRpcCmd.GetV3AppPackages", "some-app", &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].Guid).To(Equal("package-guid"))
+			Expect(result[0].Type).To(Equal("bits"))
+			Expect(result[0].State).To(Equal("READY"))
+			Expect(result[0].CreatedAt.Equal(time.Date(2017, 8, 14, 21, 16, 42, 0, time.UTC))).To(BeTrue())
+
+			appName, spaceGUID := fakeActor.GetApplicationPackagesArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("space-guid"))
+		})
+	})
+
+	Describe("tasks", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "app-guid"}, nil, nil)
+		})
+
+		Describe(".GetTasks", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationTasksReturns(
+					[]v3action.Task{
+						{GUID: "task-guid-2", SequenceID: 2, Name: "task-2", Command: "echo 2", State: "RUNNING", MemoryInMB: 256, DiskInMB: 512},
+						{GUID: "task-guid-1", SequenceID: 1, Name: "task-1", Command: "echo 1", State: "SUCCEEDED"},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("returns the tasks of the app, newest first", func() {
+				var result []plugin_models.Task
+				err = client.Call("CliRpcCmd.GetTasks", "some-app", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal([]plugin_models.Task{
+					{Guid: "task-guid-2", SequenceId: 2, Name: "task-2", Command: "echo 2", State: "RUNNING", MemoryInMb: 256, DiskInMb: 512},
+					{Guid: "task-guid-1", SequenceId: 1, Name: "task-1", Command: "echo 1", State: "SUCCEEDED"},
+				}))
+
+				appGUID, sortOrder := fakeActor.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("app-guid"))
+				Expect(sortOrder).To(Equal(v3action.Descending))
+			})
+		})
+
+		Describe(".RunTask", func() {
+			BeforeEach(func() {
+				fakeActor.RunTaskReturns(v3action.Task{GUID: "task-guid", SequenceID: 3, Name: "some-task", State: "RUNNING"}, nil, nil)
+			})
+
+			It("runs the task on the app", func() {
+				var result plugin_models.Task
+				err = client.Call("CliRpcCmd.RunTask", plugin_models.RunTask_Args{
+					AppName: "some-app",
+					Command: "echo hi",
+					Options: plugin_models.RunTaskOptions{Name: "some-task", MemoryInMb: 128, DiskInMb: 256},
+				}, &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal(plugin_models.Task{Guid: "task-guid", SequenceId: 3, Name: "some-task", State: "RUNNING"}))
+
+				appGUID, command, name, memory, disk := fakeActor.RunTaskArgsForCall(0)
+				Expect(appGUID).To(Equal("app-guid"))
+				Expect(command).To(Equal("echo hi"))
+				Expect(name).To(Equal("some-task"))
+				Expect(memory).To(Equal(uint64(128)))
+				Expect(disk).To(Equal(uint64(256)))
+			})
+		})
+
+		Describe(".TerminateTask", func() {
+			BeforeEach(func() {
+				fakeActor.GetTaskBySequenceIDAndApplicationReturns(v3action.Task{GUID: "task-guid"}, nil, nil)
+				fakeActor.TerminateTaskReturns(v3action.Task{GUID: "task-guid", SequenceID: 3, State: "CANCELING"}, nil, nil)
+			})
+
+			It("terminates the task with the given sequence ID", func() {
+				var result plugin_models.Task
+				err = client.Call("CliRpcCmd.TerminateTask", []string{"some-app", "3"}, &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal(plugin_models.Task{Guid: "task-guid", SequenceId: 3, State: "CANCELING"}))
+
+				sequenceID, appGUID := fakeActor.GetTaskBySequenceIDAndApplicationArgsForCall(0)
+				Expect(sequenceID).To(Equal(3))
+				Expect(appGUID).To(Equal("app-guid"))
+				Expect(fakeActor.TerminateTaskArgsForCall(0)).To(Equal("task-guid"))
+			})
+
+			It("returns an error when the sequence ID is not a number", func() {
+				var result plugin_models.Task
+				err = client.Call("CliRpcCmd.TerminateTask", []string{"some-app", "three"}, &result)
+				Expect(err).To(MatchError("Invalid task sequence ID three"))
+				Expect(fakeActor.TerminateTaskCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("isolation segments", func() {
+		Context("when no space is targeted", func() {
+			BeforeEach(func() {
+				config.SetSpaceFields(models.SpaceFields{})
+				fakeActor.GetIsolationSegmentSummariesReturns(
+					[]v3action.IsolationSegmentSummary{
+						{Name: "segment-1", EntitledOrgs: []string{"org-1", "org-2"}},
+					},
+					nil,
+					nil,
+				)
+				fakeActor.GetIsolationSegmentByNameReturns(v3action.IsolationSegment{GUID: "segment-guid", Name: "segment-1"}, nil, nil)
+			})
+
+			It("lists the isolation segments and their entitled orgs", func() {
+				var result []plugin_models.GetIsolationSegments_Model
+				err = client.Call("CliRpcCmd.GetIsolationSegments", "", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal([]plugin_models.GetIsolationSegments_Model{
+					{Name: "segment-1", Orgs: []string{"org-1", "org-2"}},
+				}))
+			})
+
+			It("gets an isolation segment by name", func() {
+				var result plugin_models.GetIsolationSegment_Model
+				err = client.Call("CliRpcCmd.GetIsolationSegment", "segment-1", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal(plugin_models.GetIsolationSegment_Model{Guid: "segment-guid", Name: "segment-1"}))
+				Expect(fakeActor.GetIsolationSegmentByNameArgsForCall(0)).To(Equal("segment-1"))
+			})
+		})
+	})
+})
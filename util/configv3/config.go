@@ -4,6 +4,7 @@ package configv3
 import (
 	"encoding/json"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -27,6 +28,14 @@ const (
 	// DefaultDialTimeout is the default timeout for the dail.
 	DefaultDialTimeout = 5 * time.Second
 
+	// DefaultRetryMax is the default number of times a failed Cloud Controller
+	// request is retried.
+	DefaultRetryMax = 2
+
+	// DefaultRetryMaxDelay is the default maximum delay between two retries of
+	// a Cloud Controller request.
+	DefaultRetryMaxDelay = 30 * time.Second
+
 	// DefaultOverallPollingTimeout is the default maximum time that the CLI will
 	// poll a job running on the Cloud Controller. By default it's infinit, which
 	// is represented by MaxInt64.
@@ -99,6 +108,7 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	config.ENV = EnvOverride{
 		BinaryName:       filepath.Base(os.Args[0]),
 		CFColor:          os.Getenv("CF_COLOR"),
+		CFContext:        os.Getenv("CF_CONTEXT"),
 		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
 		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
 		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
@@ -108,9 +118,16 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		LCAll:            os.Getenv("LC_ALL"),
 		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
 		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
+		CFRetryMax:       os.Getenv("CF_RETRY_MAX"),
+		CFRetryMaxDelay:  os.Getenv("CF_RETRY_MAX_DELAY"),
 		ForceTTY:         os.Getenv("FORCE_TTY"),
 	}
 
+	err := config.loadActiveContext()
+	if err != nil {
+		return nil, err
+	}
+
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
 	if _, err := os.Stat(pluginFilePath); os.IsNotExist(err) {
 		config.pluginConfig = PluginsConfig{}
@@ -130,14 +147,21 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		config.Flags = flags[0]
 	}
 
-	// Developer Note: The following is untested! Change at your own risk.
-	terminalWidth, _, err := terminal.GetSize(int(os.Stdout.Fd()))
-	if err != nil {
-		return nil, err
+	// Output that is not a terminal, such as a pipe or a file, has no size,
+	// so it is given an unlimited width instead of failing to load.
+	isTTY := isatty.IsTerminal(os.Stdout.Fd())
+	terminalWidth := math.MaxInt32
+
+	if isTTY {
+		var err error
+		terminalWidth, _, err = terminal.GetSize(int(os.Stdout.Fd()))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	config.detectedSettings = detectedSettings{
-		tty:           isatty.IsTerminal(os.Stdout.Fd()),
+		tty:           isTTY,
 		terminalWidth: terminalWidth,
 	}
 
@@ -148,7 +172,7 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	rawConfig, err := json.MarshalIndent(c.fileConfig(), "", "  ")
 	if err != nil {
 		return err
 	}
@@ -177,6 +201,10 @@ type Config struct {
 	detectedSettings detectedSettings
 
 	pluginConfig PluginsConfig
+
+	// fileContext are the target settings read from the top level of the
+	// .cf/config before the active context was applied.
+	fileContext ContextSettings
 }
 
 // CFConfig represents .cf/config.json
@@ -203,6 +231,9 @@ type CFConfig struct {
 	PluginRepos              []PluginRepos `json:"PluginRepos"`
 	MinCLIVersion            string        `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string        `json:"MinRecommendedCLIVersion"`
+
+	CurrentContext string                     `json:"CurrentContext,omitempty"`
+	Contexts       map[string]ContextSettings `json:"Contexts,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization
@@ -236,6 +267,7 @@ type Space struct {
 type EnvOverride struct {
 	BinaryName       string
 	CFColor          string
+	CFContext        string
 	CFHome           string
 	CFPluginHome     string
 	CFStagingTimeout string
@@ -246,12 +278,15 @@ type EnvOverride struct {
 	LCAll            string
 	Experimental     string
 	CFDialTimeout    string
+	CFRetryMax       string
+	CFRetryMaxDelay  string
 	ForceTTY         string
 }
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	OutputFormat OutputFormat
+	Verbose      bool
 }
 
 // detectedSettings are automatically detected settings determined by the CLI.
@@ -459,6 +494,36 @@ func (config *Config) DialTimeout() time.Duration {
 	return DefaultDialTimeout
 }
 
+// RetryMax returns the number of times a failed request is retried. This is
+// based off of:
+//   1. The $CF_RETRY_MAX environment variable if set to a non-negative integer
+//   2. Defaults to DefaultRetryMax
+func (config *Config) RetryMax() int {
+	if config.ENV.CFRetryMax != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFRetryMax)
+		if err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+
+	return DefaultRetryMax
+}
+
+// RetryMaxDelay returns the maximum delay between two retries. This is based
+// off of:
+//   1. The $CF_RETRY_MAX_DELAY environment variable (in seconds) if set
+//   2. Defaults to DefaultRetryMaxDelay
+func (config *Config) RetryMaxDelay() time.Duration {
+	if config.ENV.CFRetryMaxDelay != "" {
+		envVal, err := strconv.ParseInt(config.ENV.CFRetryMaxDelay, 10, 64)
+		if err == nil && envVal > 0 {
+			return time.Duration(envVal) * time.Second
+		}
+	}
+
+	return DefaultRetryMaxDelay
+}
+
 func (config *Config) BinaryVersion() string {
 	return version.VersionString()
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"time"
@@ -83,6 +84,36 @@ var _ = Describe("Config", func() {
 		})
 	})
 
+	Context("when stdout is not a terminal", func() {
+		var (
+			originalStdout *os.File
+			reader         *os.File
+			writer         *os.File
+		)
+
+		BeforeEach(func() {
+			var err error
+			reader, writer, err = os.Pipe()
+			Expect(err).ToNot(HaveOccurred())
+
+			originalStdout = os.Stdout
+			os.Stdout = writer
+		})
+
+		AfterEach(func() {
+			os.Stdout = originalStdout
+			Expect(writer.Close()).To(Succeed())
+			Expect(reader.Close()).To(Succeed())
+		})
+
+		It("loads the config without a TTY and with an unlimited terminal width", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.IsTTY()).To(BeFalse())
+			Expect(config.TerminalWidth()).To(Equal(math.MaxInt32))
+		})
+	})
+
 	Context("when there is a config set", func() {
 		var (
 			config *Config
@@ -431,6 +462,18 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("OutputFormat", func() {
+			It("returns the format set by the global flag", func() {
+				config := Config{
+					Flags: FlagOverride{
+						OutputFormat: OutputYAML,
+					},
+				}
+
+				Expect(config.OutputFormat()).To(Equal(OutputYAML))
+			})
+		})
+
 		DescribeTable("Verbose",
 			func(env string, configTrace string, flag bool, expected bool, location []string) {
 				rawConfig := fmt.Sprintf(`{ "Trace":"%s" }`, configTrace)
@@ -503,6 +546,43 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("RetryMax and RetryMaxDelay", func() {
+			var config *Config
+
+			BeforeEach(func() {
+				config = &Config{}
+			})
+
+			Context("when the environment variables are not set", func() {
+				It("returns the defaults", func() {
+					Expect(config.RetryMax()).To(Equal(DefaultRetryMax))
+					Expect(config.RetryMaxDelay()).To(Equal(DefaultRetryMaxDelay))
+				})
+			})
+
+			Context("when the environment variables are set", func() {
+				BeforeEach(func() {
+					config.ENV = EnvOverride{CFRetryMax: "0", CFRetryMaxDelay: "5"}
+				})
+
+				It("returns their values", func() {
+					Expect(config.RetryMax()).To(Equal(0))
+					Expect(config.RetryMaxDelay()).To(Equal(5 * time.Second))
+				})
+			})
+
+			Context("when the environment variables are invalid", func() {
+				BeforeEach(func() {
+					config.ENV = EnvOverride{CFRetryMax: "-1", CFRetryMaxDelay: "banana"}
+				})
+
+				It("returns the defaults", func() {
+					Expect(config.RetryMax()).To(Equal(DefaultRetryMax))
+					Expect(config.RetryMaxDelay()).To(Equal(DefaultRetryMaxDelay))
+				})
+			})
+		})
+
 		Describe("BinaryVersion", func() {
 			It("returns back version.BinaryVersion", func() {
 				conf := Config{}
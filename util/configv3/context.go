@@ -0,0 +1,187 @@
+package configv3
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ContextSettings are the target specific settings stored in a named context.
+type ContextSettings struct {
+	Target                   string       `json:"Target"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	AccessToken              string       `json:"AccessToken"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	RefreshToken             string       `json:"RefreshToken"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+}
+
+// ContextNotFoundError is returned when a named context does not exist in the
+// config.
+type ContextNotFoundError struct {
+	Name string
+}
+
+func (e ContextNotFoundError) Error() string {
+	return fmt.Sprintf("Context '%s' not found", e.Name)
+}
+
+// ActiveContext returns the name of the context in use. The CF_CONTEXT
+// environment variable takes precedence over the config's current context.
+// An empty name means no named context is in use.
+func (config *Config) ActiveContext() string {
+	if config.ENV.CFContext != "" {
+		return config.ENV.CFContext
+	}
+	return config.ConfigFile.CurrentContext
+}
+
+// ContextNames returns the names of all saved contexts, sorted alphabetically.
+func (config *Config) ContextNames() []string {
+	names := make([]string, 0, len(config.ConfigFile.Contexts))
+	for name := range config.ConfigFile.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Context returns the settings of the named context. The active context
+// reflects any changes made since the config was loaded.
+func (config *Config) Context(name string) (ContextSettings, error) {
+	if name == config.ActiveContext() && name != "" {
+		return config.ConfigFile.contextSettings(), nil
+	}
+
+	settings, ok := config.ConfigFile.Contexts[name]
+	if !ok {
+		return ContextSettings{}, ContextNotFoundError{Name: name}
+	}
+	return settings, nil
+}
+
+// SaveContext stores the current target under name. Unless CF_CONTEXT is set,
+// the saved context also becomes the current context.
+func (config *Config) SaveContext(name string) {
+	if config.ConfigFile.Contexts == nil {
+		config.ConfigFile.Contexts = map[string]ContextSettings{}
+	}
+	config.ConfigFile.Contexts[name] = config.ConfigFile.contextSettings()
+
+	if config.ENV.CFContext == "" {
+		config.ConfigFile.CurrentContext = name
+	}
+}
+
+// UseContext makes the named context the current context. The active
+// context's settings are saved before switching. When CF_CONTEXT is set, the
+// current context is changed but the target used by this process is not.
+func (config *Config) UseContext(name string) error {
+	settings, ok := config.ConfigFile.Contexts[name]
+	if !ok {
+		return ContextNotFoundError{Name: name}
+	}
+
+	if active := config.ActiveContext(); active != "" {
+		config.ConfigFile.Contexts[active] = config.ConfigFile.contextSettings()
+	}
+
+	config.ConfigFile.CurrentContext = name
+	if config.ENV.CFContext == "" {
+		config.ConfigFile.applyContextSettings(settings)
+	}
+	return nil
+}
+
+// loadActiveContext replaces the target settings read from the config file
+// with those of the active context.
+func (config *Config) loadActiveContext() error {
+	config.fileContext = config.ConfigFile.contextSettings()
+
+	name := config.ActiveContext()
+	if name == "" {
+		return nil
+	}
+
+	settings, ok := config.ConfigFile.Contexts[name]
+	if !ok {
+		if config.ENV.CFContext != "" {
+			return ContextNotFoundError{Name: name}
+		}
+		return nil
+	}
+
+	config.ConfigFile.applyContextSettings(settings)
+	return nil
+}
+
+// fileConfig returns the CFConfig to be written to disk. The active context
+// is saved into the list of contexts, and the top level target settings are
+// kept as those of the current context.
+func (config *Config) fileConfig() CFConfig {
+	file := config.ConfigFile
+
+	active := config.ActiveContext()
+	if active == "" {
+		return file
+	}
+
+	file.Contexts = make(map[string]ContextSettings, len(config.ConfigFile.Contexts)+1)
+	for name, settings := range config.ConfigFile.Contexts {
+		file.Contexts[name] = settings
+	}
+	file.Contexts[active] = config.ConfigFile.contextSettings()
+
+	if active != file.CurrentContext {
+		if current, ok := file.Contexts[file.CurrentContext]; ok {
+			file.applyContextSettings(current)
+		} else {
+			file.applyContextSettings(config.fileContext)
+		}
+	}
+
+	return file
+}
+
+func (cfConfig CFConfig) contextSettings() ContextSettings {
+	return ContextSettings{
+		Target:                   cfConfig.Target,
+		APIVersion:               cfConfig.APIVersion,
+		AuthorizationEndpoint:    cfConfig.AuthorizationEndpoint,
+		DopplerEndpoint:          cfConfig.DopplerEndpoint,
+		UAAEndpoint:              cfConfig.UAAEndpoint,
+		RoutingEndpoint:          cfConfig.RoutingEndpoint,
+		AccessToken:              cfConfig.AccessToken,
+		SSHOAuthClient:           cfConfig.SSHOAuthClient,
+		RefreshToken:             cfConfig.RefreshToken,
+		TargetedOrganization:     cfConfig.TargetedOrganization,
+		TargetedSpace:            cfConfig.TargetedSpace,
+		SkipSSLValidation:        cfConfig.SkipSSLValidation,
+		MinCLIVersion:            cfConfig.MinCLIVersion,
+		MinRecommendedCLIVersion: cfConfig.MinRecommendedCLIVersion,
+	}
+}
+
+func (cfConfig *CFConfig) applyContextSettings(settings ContextSettings) {
+	cfConfig.Target = settings.Target
+	cfConfig.APIVersion = settings.APIVersion
+	cfConfig.AuthorizationEndpoint = settings.AuthorizationEndpoint
+	cfConfig.DopplerEndpoint = settings.DopplerEndpoint
+	cfConfig.UAAEndpoint = settings.UAAEndpoint
+	cfConfig.RoutingEndpoint = settings.RoutingEndpoint
+	cfConfig.AccessToken = settings.AccessToken
+	cfConfig.SSHOAuthClient = settings.SSHOAuthClient
+	cfConfig.RefreshToken = settings.RefreshToken
+	cfConfig.TargetedOrganization = settings.TargetedOrganization
+	cfConfig.TargetedSpace = settings.TargetedSpace
+	cfConfig.SkipSSLValidation = settings.SkipSSLValidation
+	cfConfig.MinCLIVersion = settings.MinCLIVersion
+	cfConfig.MinRecommendedCLIVersion = settings.MinRecommendedCLIVersion
+}
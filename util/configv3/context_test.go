@@ -0,0 +1,240 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Contexts", func() {
+	var (
+		homeDir string
+		config  *Config
+		err     error
+	)
+
+	readWrittenConfig := func() CFConfig {
+		file, readErr := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(readErr).ToNot(HaveOccurred())
+
+		var written CFConfig
+		Expect(json.Unmarshal(file, &written)).To(Succeed())
+		return written
+	}
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		rawConfig := `
+			{
+				"Target": "https://api.prod.com",
+				"AccessToken": "prod-access-token",
+				"OrganizationFields": {"GUID": "prod-org-guid", "Name": "prod-org"},
+				"SpaceFields": {"GUID": "prod-space-guid", "Name": "prod-space"},
+				"CurrentContext": "prod",
+				"Contexts": {
+					"prod": {
+						"Target": "https://api.prod.com",
+						"AccessToken": "prod-access-token",
+						"OrganizationFields": {"GUID": "prod-org-guid", "Name": "prod-org"},
+						"SpaceFields": {"GUID": "prod-space-guid", "Name": "prod-space"}
+					},
+					"dev": {
+						"Target": "https://api.dev.com",
+						"AccessToken": "dev-access-token",
+						"RefreshToken": "dev-refresh-token",
+						"OrganizationFields": {"GUID": "dev-org-guid", "Name": "dev-org"},
+						"SpaceFields": {"GUID": "dev-space-guid", "Name": "dev-space"}
+					}
+				}
+			}`
+		setConfig(homeDir, rawConfig)
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("CF_CONTEXT")
+		teardown(homeDir)
+	})
+
+	Describe("LoadConfig", func() {
+		Context("when CF_CONTEXT is not set", func() {
+			BeforeEach(func() {
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("uses the current context", func() {
+				Expect(config.ActiveContext()).To(Equal("prod"))
+				Expect(config.Target()).To(Equal("https://api.prod.com"))
+				Expect(config.AccessToken()).To(Equal("prod-access-token"))
+			})
+		})
+
+		Context("when CF_CONTEXT is set to a saved context", func() {
+			BeforeEach(func() {
+				os.Setenv("CF_CONTEXT", "dev")
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("uses the context from the environment", func() {
+				Expect(config.ActiveContext()).To(Equal("dev"))
+				Expect(config.Target()).To(Equal("https://api.dev.com"))
+				Expect(config.AccessToken()).To(Equal("dev-access-token"))
+				Expect(config.RefreshToken()).To(Equal("dev-refresh-token"))
+				Expect(config.TargetedOrganization().Name).To(Equal("dev-org"))
+				Expect(config.TargetedSpace().Name).To(Equal("dev-space"))
+			})
+		})
+
+		Context("when CF_CONTEXT is set to an unknown context", func() {
+			BeforeEach(func() {
+				os.Setenv("CF_CONTEXT", "staging")
+			})
+
+			It("returns a ContextNotFoundError", func() {
+				_, err = LoadConfig()
+				Expect(err).To(MatchError(ContextNotFoundError{Name: "staging"}))
+			})
+		})
+	})
+
+	Describe("ContextNames", func() {
+		BeforeEach(func() {
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the saved contexts sorted by name", func() {
+			Expect(config.ContextNames()).To(Equal([]string{"dev", "prod"}))
+		})
+	})
+
+	Describe("Context", func() {
+		BeforeEach(func() {
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the settings of the active context including unsaved changes", func() {
+			config.SetAccessToken("new-prod-access-token")
+
+			settings, contextErr := config.Context("prod")
+			Expect(contextErr).ToNot(HaveOccurred())
+			Expect(settings.AccessToken).To(Equal("new-prod-access-token"))
+		})
+
+		It("returns an error for an unknown context", func() {
+			_, contextErr := config.Context("staging")
+			Expect(contextErr).To(MatchError(ContextNotFoundError{Name: "staging"}))
+		})
+	})
+
+	Describe("SaveContext", func() {
+		BeforeEach(func() {
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			config.SetTargetInformation("https://api.staging.com", "2.80.0", "", "", "", "", "", false)
+
+			config.SaveContext("staging")
+		})
+
+		It("stores the current target and makes it the current context", func() {
+			Expect(config.ActiveContext()).To(Equal("staging"))
+			Expect(config.ContextNames()).To(Equal([]string{"dev", "prod", "staging"}))
+
+			Expect(WriteConfig(config)).To(Succeed())
+			written := readWrittenConfig()
+			Expect(written.CurrentContext).To(Equal("staging"))
+			Expect(written.Contexts["staging"].Target).To(Equal("https://api.staging.com"))
+			Expect(written.Contexts["prod"].Target).To(Equal("https://api.prod.com"))
+		})
+	})
+
+	Describe("UseContext", func() {
+		Context("when CF_CONTEXT is not set", func() {
+			BeforeEach(func() {
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				config.SetAccessToken("refreshed-prod-access-token")
+			})
+
+			It("switches the target to the named context and saves the previous one", func() {
+				Expect(config.UseContext("dev")).To(Succeed())
+				Expect(config.Target()).To(Equal("https://api.dev.com"))
+
+				Expect(WriteConfig(config)).To(Succeed())
+				written := readWrittenConfig()
+				Expect(written.CurrentContext).To(Equal("dev"))
+				Expect(written.Target).To(Equal("https://api.dev.com"))
+				Expect(written.Contexts["prod"].AccessToken).To(Equal("refreshed-prod-access-token"))
+			})
+
+			It("returns an error for an unknown context", func() {
+				Expect(config.UseContext("staging")).To(MatchError(ContextNotFoundError{Name: "staging"}))
+				Expect(config.ActiveContext()).To(Equal("prod"))
+			})
+		})
+
+		Context("when CF_CONTEXT is set", func() {
+			BeforeEach(func() {
+				os.Setenv("CF_CONTEXT", "dev")
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("changes the current context without changing this process' target", func() {
+				Expect(config.UseContext("prod")).To(Succeed())
+				Expect(config.Target()).To(Equal("https://api.dev.com"))
+
+				Expect(WriteConfig(config)).To(Succeed())
+				written := readWrittenConfig()
+				Expect(written.CurrentContext).To(Equal("prod"))
+				Expect(written.Target).To(Equal("https://api.prod.com"))
+			})
+		})
+	})
+
+	Describe("WriteConfig", func() {
+		Context("when CF_CONTEXT overrides the current context", func() {
+			BeforeEach(func() {
+				os.Setenv("CF_CONTEXT", "dev")
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				config.SetAccessToken("refreshed-dev-access-token")
+
+				Expect(WriteConfig(config)).To(Succeed())
+			})
+
+			It("saves changes to the overriding context and leaves the current context alone", func() {
+				written := readWrittenConfig()
+				Expect(written.CurrentContext).To(Equal("prod"))
+				Expect(written.Target).To(Equal("https://api.prod.com"))
+				Expect(written.AccessToken).To(Equal("prod-access-token"))
+				Expect(written.Contexts["dev"].AccessToken).To(Equal("refreshed-dev-access-token"))
+			})
+		})
+
+		Context("when no context is in use", func() {
+			BeforeEach(func() {
+				setConfig(homeDir, `{"Target": "https://api.example.com"}`)
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(WriteConfig(config)).To(Succeed())
+			})
+
+			It("does not write any contexts", func() {
+				file, readErr := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(string(file)).ToNot(ContainSubstring("Contexts"))
+			})
+		})
+	})
+})
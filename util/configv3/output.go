@@ -0,0 +1,20 @@
+package configv3
+
+const (
+	// OutputDefault displays command output as text and tables.
+	OutputDefault OutputFormat = ""
+
+	// OutputJSON displays command output as a JSON document.
+	OutputJSON OutputFormat = "json"
+
+	// OutputYAML displays command output as a YAML document.
+	OutputYAML OutputFormat = "yaml"
+)
+
+// OutputFormat is the format command output is displayed in.
+type OutputFormat string
+
+// OutputFormat returns the format set by the global '--output' flag.
+func (config *Config) OutputFormat() OutputFormat {
+	return config.Flags.OutputFormat
+}
@@ -0,0 +1,392 @@
+// Package manifest reads and writes application manifests used by push.
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/words/generator"
+	"github.com/cloudfoundry/bytefmt"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Application is a single application entry of a manifest, with any global
+// properties of the manifest already applied.
+type Application struct {
+	Buildpack               string
+	Command                 string
+	DiskQuota               uint64
+	DockerImage             string
+	Domains                 []string
+	EnvironmentVariables    map[string]string
+	HealthCheckHTTPEndpoint string
+	HealthCheckTimeout      int
+	HealthCheckType         string
+	Hosts                   []string
+	Instances               *int
+	Memory                  uint64
+	Name                    string
+	NoHostname              bool
+	NoRoute                 bool
+	Path                    string
+	RandomRoute             bool
+	Routes                  []string
+	Services                []string
+	StackName               string
+}
+
+// ManifestNotFoundError is returned when no manifest exists at the given path.
+type ManifestNotFoundError struct {
+	Path string
+}
+
+func (e ManifestNotFoundError) Error() string {
+	return fmt.Sprintf("Manifest not found at '%s'", e.Path)
+}
+
+// InvalidManifestError is returned when the manifest cannot be parsed.
+type InvalidManifestError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidManifestError) Error() string {
+	return fmt.Sprintf("Invalid manifest '%s': %s", e.Path, e.Message)
+}
+
+type rawApplication struct {
+	Name                    string            `yaml:"name"`
+	Instances               *int              `yaml:"instances,omitempty"`
+	Memory                  string            `yaml:"memory,omitempty"`
+	DiskQuota               string            `yaml:"disk_quota,omitempty"`
+	Routes                  []rawRoute        `yaml:"routes,omitempty"`
+	NoRoute                 bool              `yaml:"no-route,omitempty"`
+	Buildpack               string            `yaml:"buildpack,omitempty"`
+	Command                 string            `yaml:"command,omitempty"`
+	Docker                  rawDocker         `yaml:"docker,omitempty"`
+	EnvironmentVariables    map[string]string `yaml:"env,omitempty"`
+	Services                []string          `yaml:"services,omitempty"`
+	StackName               string            `yaml:"stack,omitempty"`
+	Timeout                 int               `yaml:"timeout,omitempty"`
+	HealthCheckType         string            `yaml:"health-check-type,omitempty"`
+	HealthCheckHTTPEndpoint string            `yaml:"health-check-http-endpoint,omitempty"`
+	Domain                  string            `yaml:"domain,omitempty"`
+	Domains                 []string          `yaml:"domains,omitempty"`
+	Host                    string            `yaml:"host,omitempty"`
+	Hosts                   []string          `yaml:"hosts,omitempty"`
+	NoHostname              bool              `yaml:"no-hostname,omitempty"`
+	Path                    string            `yaml:"path,omitempty"`
+	RandomRoute             bool              `yaml:"random-route,omitempty"`
+}
+
+type rawDocker struct {
+	Image string `yaml:"image,omitempty"`
+}
+
+type rawRoute struct {
+	Route string `yaml:"route"`
+}
+
+type rawManifest struct {
+	Applications []rawApplication `yaml:"applications"`
+}
+
+// ReadAndMergeManifests reads the manifest at the provided path and returns
+// all the applications defined in it. If the path is a directory, manifest.yml
+// or manifest.yaml in that directory is used. Manifests referenced with
+// 'inherit' are merged in, ((name)) placeholders are replaced with the
+// provided variables, ${random-word} is replaced with random words, and
+// global properties are applied to every application.
+func ReadAndMergeManifests(pathToManifest string, vars Variables) ([]Application, error) {
+	manifestPath, err := findManifest(pathToManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	rawManifest, err := readManifestWithInheritance(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	rawManifest, err = interpolateVariables(manifestPath, rawManifest, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	rawManifest, err = expandProperties(manifestPath, rawManifest, generator.NewWordGenerator())
+	if err != nil {
+		return nil, err
+	}
+
+	rawApps, err := splitApplications(manifestPath, rawManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var apps []Application
+	for _, rawApp := range rawApps {
+		app, err := convertApplication(manifestPath, rawApp)
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, app)
+	}
+
+	return apps, nil
+}
+
+func findManifest(path string) (string, error) {
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", ManifestNotFoundError{Path: path}
+		}
+		return "", err
+	}
+
+	if !fileInfo.IsDir() {
+		return path, nil
+	}
+
+	for _, name := range []string{"manifest.yml", "manifest.yaml"} {
+		manifestPath := filepath.Join(path, name)
+		if _, err := os.Stat(manifestPath); err == nil {
+			return manifestPath, nil
+		}
+	}
+
+	return "", ManifestNotFoundError{Path: path}
+}
+
+func readManifestWithInheritance(path string) (map[string]interface{}, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest map[string]interface{}
+	err = yaml.Unmarshal(raw, &manifest)
+	if err != nil {
+		return nil, InvalidManifestError{Path: path, Message: err.Error()}
+	}
+	if len(manifest) == 0 {
+		return nil, InvalidManifestError{Path: path, Message: "expected a map"}
+	}
+
+	inherit, ok := manifest["inherit"]
+	if !ok {
+		return manifest, nil
+	}
+	delete(manifest, "inherit")
+
+	inheritedPath, ok := inherit.(string)
+	if !ok {
+		return nil, InvalidManifestError{Path: path, Message: "invalid inherit path"}
+	}
+	if !filepath.IsAbs(inheritedPath) {
+		inheritedPath = filepath.Join(filepath.Dir(path), inheritedPath)
+	}
+
+	parent, err := readManifestWithInheritance(inheritedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range manifest {
+		parent[key] = value
+	}
+	return parent, nil
+}
+
+func splitApplications(path string, manifest map[string]interface{}) ([]rawApplication, error) {
+	globals := map[string]interface{}{}
+	for key, value := range manifest {
+		if key != "applications" {
+			globals[key] = value
+		}
+	}
+
+	appMaps := []interface{}{map[interface{}]interface{}{}}
+	if rawApps, ok := manifest["applications"]; ok {
+		appMaps, ok = rawApps.([]interface{})
+		if !ok {
+			return nil, InvalidManifestError{Path: path, Message: "expected applications to be a list"}
+		}
+	}
+
+	var apps []rawApplication
+	for _, appMap := range appMaps {
+		properties, ok := appMap.(map[interface{}]interface{})
+		if !ok {
+			return nil, InvalidManifestError{Path: path, Message: "expected each application to be a map"}
+		}
+
+		merged := map[string]interface{}{}
+		for key, value := range globals {
+			merged[key] = value
+		}
+		for key, value := range properties {
+			merged[fmt.Sprint(key)] = value
+		}
+
+		parseNonStringProperties(merged)
+
+		raw, err := yaml.Marshal(merged)
+		if err != nil {
+			return nil, err
+		}
+
+		var app rawApplication
+		err = yaml.Unmarshal(raw, &app)
+		if err != nil {
+			return nil, InvalidManifestError{Path: path, Message: err.Error()}
+		}
+		apps = append(apps, app)
+	}
+
+	return apps, nil
+}
+
+// nonStringProperties are the application properties that are numbers or
+// booleans.
+var nonStringProperties = []string{"instances", "no-hostname", "no-route", "random-route", "timeout"}
+
+// parseNonStringProperties parses the string values of properties that are
+// not strings, such as those filled in from variables given on the command
+// line, which are always strings.
+func parseNonStringProperties(properties map[string]interface{}) {
+	for _, key := range nonStringProperties {
+		value, ok := properties[key].(string)
+		if !ok {
+			continue
+		}
+
+		var parsedValue interface{}
+		err := yaml.Unmarshal([]byte(value), &parsedValue)
+		if err == nil && parsedValue != nil {
+			properties[key] = parsedValue
+		}
+	}
+}
+
+func convertApplication(manifestPath string, rawApp rawApplication) (Application, error) {
+	app := Application{
+		Buildpack:               rawApp.Buildpack,
+		Command:                 rawApp.Command,
+		DockerImage:             rawApp.Docker.Image,
+		Domains:                 appendIfSet(rawApp.Domains, rawApp.Domain),
+		EnvironmentVariables:    rawApp.EnvironmentVariables,
+		HealthCheckHTTPEndpoint: rawApp.HealthCheckHTTPEndpoint,
+		HealthCheckTimeout:      rawApp.Timeout,
+		HealthCheckType:         rawApp.HealthCheckType,
+		Hosts:                   appendIfSet(rawApp.Hosts, rawApp.Host),
+		Instances:               rawApp.Instances,
+		Name:                    rawApp.Name,
+		NoHostname:              rawApp.NoHostname,
+		NoRoute:                 rawApp.NoRoute,
+		RandomRoute:             rawApp.RandomRoute,
+		Services:                rawApp.Services,
+		StackName:               rawApp.StackName,
+	}
+
+	if app.Name == "" {
+		return Application{}, InvalidManifestError{Path: manifestPath, Message: "every application must have a name"}
+	}
+
+	var err error
+	if rawApp.DiskQuota != "" {
+		app.DiskQuota, err = bytefmt.ToMegabytes(rawApp.DiskQuota)
+		if err != nil {
+			return Application{}, InvalidManifestError{Path: manifestPath, Message: fmt.Sprintf("invalid disk_quota for application '%s': %s", app.Name, err)}
+		}
+	}
+	if rawApp.Memory != "" {
+		app.Memory, err = bytefmt.ToMegabytes(rawApp.Memory)
+		if err != nil {
+			return Application{}, InvalidManifestError{Path: manifestPath, Message: fmt.Sprintf("invalid memory for application '%s': %s", app.Name, err)}
+		}
+	}
+
+	for _, route := range rawApp.Routes {
+		if route.Route == "" {
+			return Application{}, InvalidManifestError{Path: manifestPath, Message: "each route in 'routes' must have a 'route' property"}
+		}
+		app.Routes = append(app.Routes, strings.TrimPrefix(strings.TrimPrefix(route.Route, "http://"), "https://"))
+	}
+
+	if rawApp.Path != "" {
+		if filepath.IsAbs(rawApp.Path) {
+			app.Path = filepath.Clean(rawApp.Path)
+		} else {
+			app.Path = filepath.Join(filepath.Dir(manifestPath), rawApp.Path)
+		}
+	}
+
+	return app, nil
+}
+
+// WriteApplicationManifest writes the applications to a manifest at the
+// provided path that push can read back. Routes are written as 'routes', an
+// application without routes is marked 'no-route' and paths are left out.
+// The file is only readable by the current user as it holds environment
+// variables.
+func WriteApplicationManifest(pathToManifest string, apps []Application) error {
+	manifest := rawManifest{Applications: make([]rawApplication, 0, len(apps))}
+	for _, app := range apps {
+		manifest.Applications = append(manifest.Applications, convertToRawApplication(app))
+	}
+
+	contents, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(pathToManifest, append([]byte("---\n"), contents...), 0600)
+}
+
+func convertToRawApplication(app Application) rawApplication {
+	rawApp := rawApplication{
+		Name:                    app.Name,
+		Instances:               app.Instances,
+		NoRoute:                 app.NoRoute || len(app.Routes) == 0,
+		Buildpack:               app.Buildpack,
+		Command:                 app.Command,
+		Docker:                  rawDocker{Image: app.DockerImage},
+		EnvironmentVariables:    app.EnvironmentVariables,
+		Services:                app.Services,
+		StackName:               app.StackName,
+		Timeout:                 app.HealthCheckTimeout,
+		HealthCheckType:         app.HealthCheckType,
+		HealthCheckHTTPEndpoint: app.HealthCheckHTTPEndpoint,
+	}
+
+	if app.Memory != 0 {
+		rawApp.Memory = bytefmt.ByteSize(app.Memory * bytefmt.MEGABYTE)
+	}
+	if app.DiskQuota != 0 {
+		rawApp.DiskQuota = bytefmt.ByteSize(app.DiskQuota * bytefmt.MEGABYTE)
+	}
+
+	if !rawApp.NoRoute {
+		for _, route := range app.Routes {
+			rawApp.Routes = append(rawApp.Routes, rawRoute{Route: route})
+		}
+	}
+
+	return rawApp
+}
+
+func appendIfSet(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,334 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/manifest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest", func() {
+	var (
+		tmpDir       string
+		manifestPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "manifest-test")
+		Expect(err).ToNot(HaveOccurred())
+		manifestPath = filepath.Join(tmpDir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	writeManifest := func(path string, contents string) {
+		Expect(ioutil.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+	}
+
+	Describe("ReadAndMergeManifests", func() {
+		var (
+			apps       []Application
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			apps, executeErr = ReadAndMergeManifests(manifestPath, nil)
+		})
+
+		Context("when the manifest contains multiple applications", func() {
+			BeforeEach(func() {
+				writeManifest(manifestPath, `---
+applications:
+- name: app-1
+  buildpack: some-buildpack
+  command: some-command
+  disk_quota: 2G
+  docker:
+    image: some-image
+  env:
+    SOME_VAR: some-value
+  health-check-type: http
+  health-check-http-endpoint: /health
+  timeout: 120
+  instances: 3
+  memory: 256M
+  path: some-path
+  routes:
+  - route: https://foo.example.com/bar
+  services:
+  - some-service
+  stack: some-stack
+- name: app-2
+  host: some-host
+  domains:
+  - example.com
+  no-route: true
+`)
+			})
+
+			It("returns all the applications", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(Equal([]Application{
+					{
+						Buildpack:               "some-buildpack",
+						Command:                 "some-command",
+						DiskQuota:               2048,
+						DockerImage:             "some-image",
+						EnvironmentVariables:    map[string]string{"SOME_VAR": "some-value"},
+						HealthCheckHTTPEndpoint: "/health",
+						HealthCheckTimeout:      120,
+						HealthCheckType:         "http",
+						Instances:               instances(3),
+						Memory:                  256,
+						Name:                    "app-1",
+						Path:                    filepath.Join(tmpDir, "some-path"),
+						Routes:                  []string{"foo.example.com/bar"},
+						Services:                []string{"some-service"},
+						StackName:               "some-stack",
+					},
+					{
+						Domains: []string{"example.com"},
+						Hosts:   []string{"some-host"},
+						Name:    "app-2",
+						NoRoute: true,
+					},
+				}))
+			})
+		})
+
+		Context("when the manifest has global properties", func() {
+			BeforeEach(func() {
+				writeManifest(manifestPath, `---
+memory: 128M
+instances: 2
+applications:
+- name: app-1
+- name: app-2
+  memory: 1G
+`)
+			})
+
+			It("applies them to every application that does not override them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(Equal([]Application{
+					{Name: "app-1", Memory: 128, Instances: instances(2)},
+					{Name: "app-2", Memory: 1024, Instances: instances(2)},
+				}))
+			})
+		})
+
+		Context("when the manifest inherits from another manifest", func() {
+			BeforeEach(func() {
+				writeManifest(filepath.Join(tmpDir, "base.yml"), `---
+memory: 64M
+stack: base-stack
+`)
+				writeManifest(manifestPath, `---
+inherit: base.yml
+stack: child-stack
+applications:
+- name: app-1
+`)
+			})
+
+			It("merges the inherited properties", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(Equal([]Application{
+					{Name: "app-1", Memory: 64, StackName: "child-stack"},
+				}))
+			})
+		})
+
+		Context("when the instances are set to 0", func() {
+			BeforeEach(func() {
+				writeManifest(manifestPath, `---
+applications:
+- name: app-1
+  instances: 0
+- name: app-2
+`)
+			})
+
+			It("keeps the 0 and leaves unset instances nil", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(Equal([]Application{
+					{Name: "app-1", Instances: instances(0)},
+					{Name: "app-2"},
+				}))
+			})
+		})
+
+		Context("when the manifest uses ${random-word}", func() {
+			BeforeEach(func() {
+				writeManifest(manifestPath, `---
+applications:
+- name: app-1
+  host: app-${random-word}
+  routes:
+  - route: ${random-word}.example.com/${random-word}
+`)
+			})
+
+			It("replaces it with random words", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(HaveLen(1))
+				Expect(apps[0].Hosts).To(ConsistOf(MatchRegexp(`^app-[a-z]+-[a-z]+$`)))
+				Expect(apps[0].Routes).To(ConsistOf(MatchRegexp(`^([a-z]+-[a-z]+)\.example\.com/([a-z]+-[a-z]+)$`)))
+
+				words := strings.Split(apps[0].Routes[0], ".example.com/")
+				Expect(words[0]).To(Equal(words[1]))
+			})
+		})
+
+		Context("when the manifest uses another ${} property", func() {
+			BeforeEach(func() {
+				writeManifest(manifestPath, `---
+applications:
+- name: app-1
+  host: ${some-property}
+`)
+			})
+
+			It("returns an InvalidManifestError", func() {
+				Expect(executeErr).To(MatchError(InvalidManifestError{
+					Path:    manifestPath,
+					Message: "properties are no longer supported, remove ${some-property}",
+				}))
+			})
+		})
+
+		Context("when provided a directory", func() {
+			BeforeEach(func() {
+				writeManifest(filepath.Join(tmpDir, "manifest.yaml"), `---
+applications:
+- name: app-1
+`)
+				manifestPath = tmpDir
+			})
+
+			It("reads the manifest in that directory", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(Equal([]Application{{Name: "app-1"}}))
+			})
+		})
+
+		Context("when the manifest does not exist", func() {
+			BeforeEach(func() {
+				manifestPath = filepath.Join(tmpDir, "does-not-exist.yml")
+			})
+
+			It("returns a ManifestNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ManifestNotFoundError{Path: manifestPath}))
+			})
+		})
+
+		Context("when an application does not have a name", func() {
+			BeforeEach(func() {
+				writeManifest(manifestPath, `---
+applications:
+- memory: 1G
+`)
+			})
+
+			It("returns an InvalidManifestError", func() {
+				Expect(executeErr).To(MatchError(InvalidManifestError{
+					Path:    manifestPath,
+					Message: "every application must have a name",
+				}))
+			})
+		})
+
+		Context("when the memory is invalid", func() {
+			BeforeEach(func() {
+				writeManifest(manifestPath, `---
+applications:
+- name: app-1
+  memory: lots
+`)
+			})
+
+			It("returns an InvalidManifestError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(InvalidManifestError{}))
+			})
+		})
+	})
+
+	Describe("WriteApplicationManifest", func() {
+		var apps []Application
+
+		BeforeEach(func() {
+			apps = []Application{
+				{
+					Name:                    "app-1",
+					Instances:               instances(3),
+					Memory:                  1024,
+					DiskQuota:               512,
+					Routes:                  []string{"app-1.example.com", "example.com/app-1"},
+					Buildpack:               "ruby_buildpack",
+					Command:                 "bundle exec rackup",
+					EnvironmentVariables:    map[string]string{"SOME_VAR": "some-value"},
+					Services:                []string{"some-db"},
+					StackName:               "cflinuxfs2",
+					HealthCheckTimeout:      120,
+					HealthCheckType:         "http",
+					HealthCheckHTTPEndpoint: "/health",
+				},
+				{
+					Name:        "app-2",
+					DockerImage: "some-image",
+				},
+			}
+		})
+
+		It("writes a manifest that can be read back", func() {
+			Expect(WriteApplicationManifest(manifestPath, apps)).To(Succeed())
+
+			contents, err := ioutil.ReadFile(manifestPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal(`---
+applications:
+- name: app-1
+  instances: 3
+  memory: 1G
+  disk_quota: 512M
+  routes:
+  - route: app-1.example.com
+  - route: example.com/app-1
+  buildpack: ruby_buildpack
+  command: bundle exec rackup
+  env:
+    SOME_VAR: some-value
+  services:
+  - some-db
+  stack: cflinuxfs2
+  timeout: 120
+  health-check-type: http
+  health-check-http-endpoint: /health
+- name: app-2
+  no-route: true
+  docker:
+    image: some-image
+`))
+
+			readApps, err := ReadAndMergeManifests(manifestPath, nil)
+			Expect(err).ToNot(HaveOccurred())
+			apps[1].NoRoute = true
+			Expect(readApps).To(Equal(apps))
+		})
+
+		It("is only readable by the current user", func() {
+			Expect(WriteApplicationManifest(manifestPath, apps)).To(Succeed())
+
+			info, err := os.Stat(manifestPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package manifest
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/words/generator"
+)
+
+// propertyPattern matches a ${name} property in a manifest.
+var propertyPattern = regexp.MustCompile(`\${[\w-]+}`)
+
+// randomWordProperty is the only ${name} property still supported. It is
+// replaced with a random pair of words.
+const randomWordProperty = "${random-word}"
+
+// expandProperties replaces ${random-word} in every string of the manifest
+// with a random pair of words, the same pair for all the occurrences within a
+// string. Any other ${name} property is no longer supported and is returned
+// as an error.
+func expandProperties(path string, manifest map[string]interface{}, babbler generator.WordGenerator) (map[string]interface{}, error) {
+	unsupported := map[string]bool{}
+
+	expanded := map[string]interface{}{}
+	for key, value := range manifest {
+		expanded[key] = expandNode(value, babbler, unsupported)
+	}
+
+	if len(unsupported) > 0 {
+		var names []string
+		for name := range unsupported {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, InvalidManifestError{
+			Path:    path,
+			Message: fmt.Sprintf("properties are no longer supported, remove %s", strings.Join(names, ", ")),
+		}
+	}
+
+	return expanded, nil
+}
+
+func expandNode(node interface{}, babbler generator.WordGenerator, unsupported map[string]bool) interface{} {
+	switch typedNode := node.(type) {
+	case map[interface{}]interface{}:
+		expanded := map[interface{}]interface{}{}
+		for key, value := range typedNode {
+			expanded[key] = expandNode(value, babbler, unsupported)
+		}
+		return expanded
+	case []interface{}:
+		expanded := make([]interface{}, len(typedNode))
+		for i, value := range typedNode {
+			expanded[i] = expandNode(value, babbler, unsupported)
+		}
+		return expanded
+	case string:
+		for _, property := range propertyPattern.FindAllString(typedNode, -1) {
+			if property != randomWordProperty {
+				unsupported[property] = true
+			}
+		}
+		if strings.Contains(typedNode, randomWordProperty) {
+			return strings.Replace(typedNode, randomWordProperty, strings.ToLower(babbler.Babble()), -1)
+		}
+		return typedNode
+	default:
+		return node
+	}
+}
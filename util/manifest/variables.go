@@ -0,0 +1,126 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// variablePattern matches a ((name)) placeholder in a manifest.
+var variablePattern = regexp.MustCompile(`\(\(([-/\.\w\pL]+)\)\)`)
+
+// Variables are the values substituted for ((name)) placeholders in a
+// manifest.
+type Variables map[string]interface{}
+
+// InvalidVariablesFileError is returned when a variables file cannot be
+// parsed.
+type InvalidVariablesFileError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidVariablesFileError) Error() string {
+	return fmt.Sprintf("Invalid variables file '%s': %s", e.Path, e.Message)
+}
+
+// UnresolvedVariablesError is returned when a manifest references variables
+// that have not been provided.
+type UnresolvedVariablesError struct {
+	Path  string
+	Names []string
+}
+
+func (e UnresolvedVariablesError) Error() string {
+	return fmt.Sprintf("Unable to resolve variables in manifest '%s': %s", e.Path, strings.Join(e.Names, ", "))
+}
+
+// ReadVariablesFile reads a YAML file mapping variable names to values.
+func ReadVariablesFile(path string) (Variables, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileVars map[string]interface{}
+	err = yaml.Unmarshal(raw, &fileVars)
+	if err != nil {
+		return nil, InvalidVariablesFileError{Path: path, Message: err.Error()}
+	}
+
+	vars := Variables{}
+	for name, value := range fileVars {
+		vars[name] = value
+	}
+	return vars, nil
+}
+
+// interpolateVariables replaces every ((name)) placeholder in the manifest
+// with the value of the variable. A placeholder that makes up a whole value
+// is replaced with the variable as is, so variables can be numbers, lists
+// or maps. A placeholder within a larger string is replaced with the string
+// form of the variable.
+func interpolateVariables(path string, manifest map[string]interface{}, vars Variables) (map[string]interface{}, error) {
+	missing := map[string]bool{}
+
+	interpolated := map[string]interface{}{}
+	for key, value := range manifest {
+		interpolated[key] = interpolateNode(value, vars, missing)
+	}
+
+	if len(missing) > 0 {
+		var names []string
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, UnresolvedVariablesError{Path: path, Names: names}
+	}
+
+	return interpolated, nil
+}
+
+func interpolateNode(node interface{}, vars Variables, missing map[string]bool) interface{} {
+	switch typedNode := node.(type) {
+	case map[interface{}]interface{}:
+		interpolated := map[interface{}]interface{}{}
+		for key, value := range typedNode {
+			interpolated[key] = interpolateNode(value, vars, missing)
+		}
+		return interpolated
+	case []interface{}:
+		interpolated := make([]interface{}, len(typedNode))
+		for i, value := range typedNode {
+			interpolated[i] = interpolateNode(value, vars, missing)
+		}
+		return interpolated
+	case string:
+		return interpolateString(typedNode, vars, missing)
+	default:
+		return node
+	}
+}
+
+func interpolateString(value string, vars Variables, missing map[string]bool) interface{} {
+	if match := variablePattern.FindStringSubmatch(value); match != nil && match[0] == value {
+		if variable, ok := vars[match[1]]; ok {
+			return variable
+		}
+		missing[match[1]] = true
+		return value
+	}
+
+	return variablePattern.ReplaceAllStringFunc(value, func(placeholder string) string {
+		name := variablePattern.FindStringSubmatch(placeholder)[1]
+		variable, ok := vars[name]
+		if !ok {
+			missing[name] = true
+			return placeholder
+		}
+		return fmt.Sprint(variable)
+	})
+}
@@ -0,0 +1,129 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/manifest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Variables", func() {
+	var (
+		tmpDir       string
+		manifestPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "manifest-variables-test")
+		Expect(err).ToNot(HaveOccurred())
+		manifestPath = filepath.Join(tmpDir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	writeFile := func(path string, contents string) {
+		Expect(ioutil.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+	}
+
+	Describe("ReadAndMergeManifests with variables", func() {
+		var (
+			vars       Variables
+			apps       []Application
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			writeFile(manifestPath, `---
+applications:
+- name: ((app-name))
+  instances: ((instances))
+  memory: ((memory))
+  routes:
+  - route: ((app-name)).((domain))
+  env:
+    STAGE: ((stage))
+`)
+			vars = Variables{
+				"app-name":  "some-app",
+				"instances": 3,
+				"memory":    "256M",
+				"domain":    "some-domain.com",
+				"stage":     "staging",
+			}
+		})
+
+		JustBeforeEach(func() {
+			apps, executeErr = ReadAndMergeManifests(manifestPath, vars)
+		})
+
+		It("replaces the placeholders with the variables", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(apps).To(Equal([]Application{{
+				Name:                 "some-app",
+				Instances:            instances(3),
+				Memory:               256,
+				Routes:               []string{"some-app.some-domain.com"},
+				EnvironmentVariables: map[string]string{"STAGE": "staging"},
+			}}))
+		})
+
+		Context("when the variables are strings, as given on the command line", func() {
+			BeforeEach(func() {
+				vars["instances"] = "3"
+				vars["stage"] = "0123"
+			})
+
+			It("parses numbers for numeric properties only", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps[0].Instances).To(Equal(instances(3)))
+				Expect(apps[0].EnvironmentVariables).To(Equal(map[string]string{"STAGE": "0123"}))
+			})
+		})
+
+		Context("when variables are missing", func() {
+			BeforeEach(func() {
+				delete(vars, "stage")
+				delete(vars, "domain")
+			})
+
+			It("returns an UnresolvedVariablesError listing all of them", func() {
+				Expect(executeErr).To(MatchError(UnresolvedVariablesError{
+					Path:  manifestPath,
+					Names: []string{"domain", "stage"},
+				}))
+				Expect(executeErr.Error()).To(Equal("Unable to resolve variables in manifest '" + manifestPath + "': domain, stage"))
+			})
+		})
+	})
+
+	Describe("ReadVariablesFile", func() {
+		var varsPath string
+
+		BeforeEach(func() {
+			varsPath = filepath.Join(tmpDir, "vars.yml")
+		})
+
+		It("returns the variables in the file", func() {
+			writeFile(varsPath, "instances: 2\nstage: prod\n")
+
+			vars, err := ReadVariablesFile(varsPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vars).To(Equal(Variables{"instances": 2, "stage": "prod"}))
+		})
+
+		Context("when the file is not valid YAML", func() {
+			It("returns an InvalidVariablesFileError", func() {
+				writeFile(varsPath, "- not\n- a\n- map\n")
+
+				_, err := ReadVariablesFile(varsPath)
+				Expect(err).To(BeAssignableToTypeOf(InvalidVariablesFileError{}))
+			})
+		})
+	})
+})
@@ -0,0 +1,141 @@
+package taskschedule
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/cloudfoundry/bytefmt"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Definition is a task that runs on a schedule.
+type Definition struct {
+	Name     string
+	App      string
+	Command  string
+	Memory   uint64
+	Disk     uint64
+	Schedule Schedule
+}
+
+// DefinitionsNotFoundError is returned when no file exists at the given path.
+type DefinitionsNotFoundError struct {
+	Path string
+}
+
+func (e DefinitionsNotFoundError) Error() string {
+	return fmt.Sprintf("Task definitions not found at '%s'", e.Path)
+}
+
+// InvalidDefinitionsError is returned when the file cannot be parsed or a
+// definition in it is incomplete.
+type InvalidDefinitionsError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidDefinitionsError) Error() string {
+	return fmt.Sprintf("Invalid task definitions '%s': %s", e.Path, e.Message)
+}
+
+type rawDefinitions struct {
+	Tasks []rawDefinition `yaml:"tasks"`
+}
+
+type rawDefinition struct {
+	Name     string `yaml:"name"`
+	App      string `yaml:"app"`
+	Command  string `yaml:"command"`
+	Memory   string `yaml:"memory"`
+	Disk     string `yaml:"disk"`
+	Schedule string `yaml:"schedule"`
+}
+
+// ReadDefinitions reads the task definitions in the YAML file at path. The
+// file contains a 'tasks' list whose entries have a name, app, command and
+// schedule (a cron expression), and optionally memory and disk (e.g. 512M,
+// 1G). Task names must be unique within the file.
+func ReadDefinitions(path string) ([]Definition, error) {
+	contents, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, DefinitionsNotFoundError{Path: path}
+	} else if err != nil {
+		return nil, err
+	}
+
+	var raw rawDefinitions
+	err = yaml.Unmarshal(contents, &raw)
+	if err != nil {
+		return nil, InvalidDefinitionsError{Path: path, Message: err.Error()}
+	}
+	if len(raw.Tasks) == 0 {
+		return nil, InvalidDefinitionsError{Path: path, Message: "no tasks defined"}
+	}
+
+	definitions := make([]Definition, 0, len(raw.Tasks))
+	names := map[string]bool{}
+	for _, rawDefinition := range raw.Tasks {
+		definition, err := convertDefinition(path, rawDefinition)
+		if err != nil {
+			return nil, err
+		}
+
+		if names[definition.Name] {
+			return nil, InvalidDefinitionsError{Path: path, Message: fmt.Sprintf("task '%s' is defined more than once", definition.Name)}
+		}
+		names[definition.Name] = true
+
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, nil
+}
+
+func convertDefinition(path string, raw rawDefinition) (Definition, error) {
+	if raw.Name == "" {
+		return Definition{}, InvalidDefinitionsError{Path: path, Message: "every task must have a name"}
+	}
+
+	required := []struct {
+		property string
+		value    string
+	}{
+		{"app", raw.App},
+		{"command", raw.Command},
+		{"schedule", raw.Schedule},
+	}
+	for _, field := range required {
+		if field.value == "" {
+			return Definition{}, InvalidDefinitionsError{Path: path, Message: fmt.Sprintf("task '%s' must have a %s", raw.Name, field.property)}
+		}
+	}
+
+	definition := Definition{
+		Name:    raw.Name,
+		App:     raw.App,
+		Command: raw.Command,
+	}
+
+	var err error
+	definition.Schedule, err = ParseSchedule(raw.Schedule)
+	if err != nil {
+		return Definition{}, InvalidDefinitionsError{Path: path, Message: fmt.Sprintf("invalid schedule for task '%s': %s", raw.Name, err)}
+	}
+
+	if raw.Memory != "" {
+		definition.Memory, err = bytefmt.ToMegabytes(raw.Memory)
+		if err != nil {
+			return Definition{}, InvalidDefinitionsError{Path: path, Message: fmt.Sprintf("invalid memory for task '%s': %s", raw.Name, err)}
+		}
+	}
+
+	if raw.Disk != "" {
+		definition.Disk, err = bytefmt.ToMegabytes(raw.Disk)
+		if err != nil {
+			return Definition{}, InvalidDefinitionsError{Path: path, Message: fmt.Sprintf("invalid disk for task '%s': %s", raw.Name, err)}
+		}
+	}
+
+	return definition, nil
+}
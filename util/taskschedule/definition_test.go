@@ -0,0 +1,111 @@
+package taskschedule_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/taskschedule"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadDefinitions", func() {
+	var (
+		tmpDir      string
+		path        string
+		definitions []Definition
+		executeErr  error
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "task-definitions-test")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(tmpDir, "tasks.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		definitions, executeErr = ReadDefinitions(path)
+	})
+
+	writeDefinitions := func(contents string) {
+		Expect(ioutil.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+	}
+
+	Context("when the file contains valid definitions", func() {
+		BeforeEach(func() {
+			writeDefinitions(`---
+tasks:
+- name: nightly-report
+  app: reports
+  command: bundle exec rake report
+  memory: 512M
+  disk: 1G
+  schedule: "0 2 * * *"
+- name: cleanup
+  app: worker
+  command: ./cleanup
+  schedule: "@hourly"
+`)
+		})
+
+		It("returns the definitions", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(definitions).To(HaveLen(2))
+
+			Expect(definitions[0].Name).To(Equal("nightly-report"))
+			Expect(definitions[0].App).To(Equal("reports"))
+			Expect(definitions[0].Command).To(Equal("bundle exec rake report"))
+			Expect(definitions[0].Memory).To(BeEquivalentTo(512))
+			Expect(definitions[0].Disk).To(BeEquivalentTo(1024))
+			Expect(definitions[0].Schedule.String()).To(Equal("0 2 * * *"))
+
+			Expect(definitions[1].Name).To(Equal("cleanup"))
+			Expect(definitions[1].Memory).To(BeZero())
+			Expect(definitions[1].Disk).To(BeZero())
+			Expect(definitions[1].Schedule.String()).To(Equal("@hourly"))
+		})
+	})
+
+	Context("when the file does not exist", func() {
+		It("returns a DefinitionsNotFoundError", func() {
+			Expect(executeErr).To(MatchError(DefinitionsNotFoundError{Path: path}))
+		})
+	})
+
+	Context("when the file is invalid", func() {
+		DescribeInvalid := func(description string, contents string, message string) {
+			Context(description, func() {
+				BeforeEach(func() {
+					writeDefinitions(contents)
+				})
+
+				It("returns an InvalidDefinitionsError", func() {
+					Expect(executeErr).To(MatchError(InvalidDefinitionsError{Path: path, Message: message}))
+				})
+			})
+		}
+
+		DescribeInvalid("when there are no tasks", "---\ntasks: []\n", "no tasks defined")
+		DescribeInvalid("when a task has no name",
+			"tasks:\n- app: a\n  command: c\n  schedule: '@daily'\n",
+			"every task must have a name")
+		DescribeInvalid("when a task has no command",
+			"tasks:\n- name: t\n  app: a\n  schedule: '@daily'\n",
+			"task 't' must have a command")
+		DescribeInvalid("when a task has an invalid schedule",
+			"tasks:\n- name: t\n  app: a\n  command: c\n  schedule: '* *'\n",
+			"invalid schedule for task 't': expected 5 fields in schedule '* *', found 2")
+		DescribeInvalid("when a task has invalid memory",
+			"tasks:\n- name: t\n  app: a\n  command: c\n  schedule: '@daily'\n  memory: lots\n",
+			"invalid memory for task 't': Byte quantity must be a positive integer with a unit of measurement like M, MB, G, or GB")
+		DescribeInvalid("when a task is defined twice",
+			"tasks:\n- name: t\n  app: a\n  command: c\n  schedule: '@daily'\n- name: t\n  app: b\n  command: c\n  schedule: '@daily'\n",
+			"task 't' is defined more than once")
+	})
+})
@@ -0,0 +1,98 @@
+package taskschedule
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Run is an entry in the run history of scheduled tasks. Exactly one of
+// SequenceID, Skipped or Error describes its outcome.
+type Run struct {
+	Name        string    `json:"name"`
+	App         string    `json:"app"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+
+	// AppGUID and SequenceID identify the task that was started.
+	AppGUID    string `json:"app_guid,omitempty"`
+	SequenceID int    `json:"sequence_id,omitempty"`
+
+	// Skipped is set when the task was not started because its previous run
+	// was still in progress.
+	Skipped bool `json:"skipped,omitempty"`
+
+	// Error is set when the task could not be started.
+	Error string `json:"error,omitempty"`
+}
+
+// Started reports whether the run started a task.
+func (r Run) Started() bool {
+	return r.SequenceID != 0
+}
+
+// History is a file recording every run as a line of JSON.
+type History struct {
+	path string
+}
+
+// NewHistory returns the history stored at path.
+func NewHistory(path string) History {
+	return History{path: path}
+}
+
+// Path returns where the history is stored.
+func (h History) Path() string {
+	return h.path
+}
+
+// Append adds a run to the end of the history, creating the file if needed.
+func (h History) Append(run Run) error {
+	err := os.MkdirAll(filepath.Dir(h.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(h.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	line, err := json.Marshal(run)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
+
+// LastStarted returns, by task name, the most recent run that started a task.
+// A missing history is empty, and lines that cannot be parsed (e.g. one cut
+// short by a crash) are ignored.
+func (h History) LastStarted() (map[string]Run, error) {
+	lastStarted := map[string]Run{}
+
+	file, err := os.Open(h.path)
+	if os.IsNotExist(err) {
+		return lastStarted, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var run Run
+		if json.Unmarshal(scanner.Bytes(), &run) != nil {
+			continue
+		}
+		if run.Started() {
+			lastStarted[run.Name] = run
+		}
+	}
+
+	return lastStarted, scanner.Err()
+}
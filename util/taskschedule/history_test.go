@@ -0,0 +1,82 @@
+package taskschedule_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/taskschedule"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("History", func() {
+	var (
+		tmpDir  string
+		history History
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "task-history-test")
+		Expect(err).ToNot(HaveOccurred())
+		history = NewHistory(filepath.Join(tmpDir, "nested", "history.json"))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Context("when there is no history", func() {
+		It("returns no runs", func() {
+			lastStarted, err := history.LastStarted()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lastStarted).To(BeEmpty())
+		})
+	})
+
+	Context("when runs have been appended", func() {
+		var scheduledAt time.Time
+
+		BeforeEach(func() {
+			scheduledAt = time.Date(2017, 6, 1, 2, 0, 0, 0, time.UTC)
+
+			Expect(history.Append(Run{Name: "report", App: "reports", ScheduledAt: scheduledAt, AppGUID: "app-guid", SequenceID: 1})).To(Succeed())
+			Expect(history.Append(Run{Name: "report", App: "reports", ScheduledAt: scheduledAt.Add(time.Hour), AppGUID: "app-guid", SequenceID: 2})).To(Succeed())
+			Expect(history.Append(Run{Name: "report", App: "reports", ScheduledAt: scheduledAt.Add(2 * time.Hour), Skipped: true})).To(Succeed())
+			Expect(history.Append(Run{Name: "cleanup", App: "worker", ScheduledAt: scheduledAt, Error: "App worker not found"})).To(Succeed())
+		})
+
+		It("returns the last run that started a task for each name", func() {
+			lastStarted, err := history.LastStarted()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lastStarted).To(Equal(map[string]Run{
+				"report": {Name: "report", App: "reports", ScheduledAt: scheduledAt.Add(time.Hour), AppGUID: "app-guid", SequenceID: 2},
+			}))
+		})
+
+		It("writes one line of JSON per run", func() {
+			contents, err := ioutil.ReadFile(history.Path())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(HavePrefix(`{"name":"report","app":"reports","scheduled_at":"2017-06-01T02:00:00Z","app_guid":"app-guid","sequence_id":1}` + "\n"))
+			Expect(string(contents)).To(ContainSubstring(`"skipped":true`))
+		})
+
+		Context("when the history ends with a partial line", func() {
+			BeforeEach(func() {
+				file, err := os.OpenFile(history.Path(), os.O_APPEND|os.O_WRONLY, 0600)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = file.WriteString(`{"name":"report","sequ`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(file.Close()).To(Succeed())
+			})
+
+			It("ignores it", func() {
+				lastStarted, err := history.LastStarted()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(lastStarted["report"].SequenceID).To(Equal(2))
+			})
+		})
+	})
+})
@@ -0,0 +1,228 @@
+// Package taskschedule reads scheduled task definitions, works out when they
+// are due and records the runs made from them.
+package taskschedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var scheduleMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// daysInMonth is the longest each month can be, i.e. in a leap year.
+var daysInMonth = [13]int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	expression string
+
+	minutes     bitSet
+	hours       bitSet
+	daysOfMonth bitSet
+	months      bitSet
+	daysOfWeek  bitSet
+
+	// anyDayOfMonth and anyDayOfWeek are set when the field is '*'. As in
+	// cron, when both day fields are restricted a day matching either runs.
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+type bitSet uint64
+
+func (b bitSet) has(i int) bool {
+	return b&(1<<uint(i)) != 0
+}
+
+// ParseSchedule parses a standard five field cron expression (minute, hour,
+// day of month, month and day of week) or one of the @yearly, @monthly,
+// @weekly, @daily and @hourly shorthands. Fields accept '*', numbers, ranges
+// ('1-5'), steps ('*/15', '0-30/10') and comma separated lists of those.
+func ParseSchedule(expression string) (Schedule, error) {
+	spec := strings.TrimSpace(expression)
+	if macro, ok := scheduleMacros[spec]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("expected 5 fields in schedule '%s', found %d", expression, len(fields))
+	}
+
+	schedule := Schedule{
+		expression:    strings.TrimSpace(expression),
+		anyDayOfMonth: fields[2] == "*",
+		anyDayOfWeek:  fields[4] == "*",
+	}
+
+	var err error
+	if schedule.minutes, err = parseField(fields[0], "minute", 0, 59); err != nil {
+		return Schedule{}, err
+	}
+	if schedule.hours, err = parseField(fields[1], "hour", 0, 23); err != nil {
+		return Schedule{}, err
+	}
+	if schedule.daysOfMonth, err = parseField(fields[2], "day of month", 1, 31); err != nil {
+		return Schedule{}, err
+	}
+	if schedule.months, err = parseField(fields[3], "month", 1, 12); err != nil {
+		return Schedule{}, err
+	}
+	if schedule.daysOfWeek, err = parseField(fields[4], "day of week", 0, 7); err != nil {
+		return Schedule{}, err
+	}
+	// Both 0 and 7 are Sunday.
+	if schedule.daysOfWeek.has(7) {
+		schedule.daysOfWeek |= 1
+	}
+
+	if !schedule.canRun() {
+		return Schedule{}, fmt.Errorf("schedule '%s' never runs", expression)
+	}
+
+	return schedule, nil
+}
+
+// String returns the expression the schedule was parsed from.
+func (s Schedule) String() string {
+	return s.expression
+}
+
+// Next returns the first time after t that the schedule runs, in t's
+// location. It returns the zero time if the schedule does not run within
+// five years.
+func (s Schedule) Next(t time.Time) time.Time {
+	location := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, location).Add(time.Minute)
+	yearLimit := t.Year() + 5
+
+	for t.Year() <= yearLimit {
+		if !s.months.has(int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, location)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, location)
+			continue
+		}
+		if !s.hours.has(t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, location)
+			continue
+		}
+		if !s.minutes.has(t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	dayOfMonth := s.daysOfMonth.has(t.Day())
+	dayOfWeek := s.daysOfWeek.has(int(t.Weekday()))
+
+	switch {
+	case s.anyDayOfMonth && s.anyDayOfWeek:
+		return true
+	case s.anyDayOfMonth:
+		return dayOfWeek
+	case s.anyDayOfWeek:
+		return dayOfMonth
+	default:
+		return dayOfMonth || dayOfWeek
+	}
+}
+
+// canRun reports whether some day of a selected month matches the schedule.
+// Only a day of month restriction such as '30' with month '2' can rule out
+// every day; a restricted day of week always matches some day.
+func (s Schedule) canRun() bool {
+	if !s.anyDayOfWeek {
+		return true
+	}
+
+	for month := 1; month <= 12; month++ {
+		if !s.months.has(month) {
+			continue
+		}
+		for day := 1; day <= daysInMonth[month]; day++ {
+			if s.daysOfMonth.has(day) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func parseField(field string, name string, min int, max int) (bitSet, error) {
+	var bits bitSet
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i != -1 {
+			rangePart = part[:i]
+
+			var err error
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step in %s field '%s'", name, field)
+			}
+		}
+
+		var start, end int
+		switch {
+		case rangePart == "*":
+			start, end = min, max
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if start, err = parseFieldValue(bounds[0], name, min, max); err != nil {
+				return 0, err
+			}
+			if end, err = parseFieldValue(bounds[1], name, min, max); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("invalid range in %s field '%s'", name, field)
+			}
+		default:
+			var err error
+			if start, err = parseFieldValue(rangePart, name, min, max); err != nil {
+				return 0, err
+			}
+			end = start
+			if step > 1 {
+				end = max
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+
+	return bits, nil
+}
+
+func parseFieldValue(value string, name string, min int, max int) (int, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s '%s'", name, value)
+	}
+	if i < min || i > max {
+		return 0, fmt.Errorf("%s '%d' must be between %d and %d", name, i, min, max)
+	}
+	return i, nil
+}
@@ -0,0 +1,61 @@
+package taskschedule_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/taskschedule"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	// Thursday 1 June 2017, 10:30:15 UTC.
+	var from = time.Date(2017, 6, 1, 10, 30, 15, 0, time.UTC)
+
+	DescribeTable("Next",
+		func(expression string, expected time.Time) {
+			schedule, err := ParseSchedule(expression)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.Next(from)).To(Equal(expected))
+		},
+		Entry("every minute", "* * * * *", time.Date(2017, 6, 1, 10, 31, 0, 0, time.UTC)),
+		Entry("a step of minutes", "*/15 * * * *", time.Date(2017, 6, 1, 10, 45, 0, 0, time.UTC)),
+		Entry("a list of hours", "0 9,17 * * *", time.Date(2017, 6, 1, 17, 0, 0, 0, time.UTC)),
+		Entry("a range of days of the week", "0 8 * * 1-5", time.Date(2017, 6, 2, 8, 0, 0, 0, time.UTC)),
+		Entry("Sunday as 7", "0 0 * * 7", time.Date(2017, 6, 4, 0, 0, 0, 0, time.UTC)),
+		Entry("a day of the month", "30 2 15 * *", time.Date(2017, 6, 15, 2, 30, 0, 0, time.UTC)),
+		Entry("either restricted day", "0 0 13 * 5", time.Date(2017, 6, 2, 0, 0, 0, 0, time.UTC)),
+		Entry("a month in the next year", "0 0 1 3 *", time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("a leap day", "0 0 29 2 *", time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("a stepped range", "10-30/10 11 * * *", time.Date(2017, 6, 1, 11, 10, 0, 0, time.UTC)),
+		Entry("the @daily shorthand", "@daily", time.Date(2017, 6, 2, 0, 0, 0, 0, time.UTC)),
+		Entry("the @hourly shorthand", "@hourly", time.Date(2017, 6, 1, 11, 0, 0, 0, time.UTC)),
+	)
+
+	It("keeps the location of the time", func() {
+		location := time.FixedZone("UTC+2", 2*60*60)
+		schedule, err := ParseSchedule("0 3 * * *")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.Next(from.In(location))).To(Equal(time.Date(2017, 6, 2, 3, 0, 0, 0, location)))
+	})
+
+	It("returns the expression it was parsed from", func() {
+		schedule, err := ParseSchedule(" 0 3 * * * ")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schedule.String()).To(Equal("0 3 * * *"))
+	})
+
+	DescribeTable("invalid expressions",
+		func(expression string, message string) {
+			_, err := ParseSchedule(expression)
+			Expect(err).To(MatchError(message))
+		},
+		Entry("too few fields", "* * * *", "expected 5 fields in schedule '* * * *', found 4"),
+		Entry("a value out of range", "60 * * * *", "minute '60' must be between 0 and 59"),
+		Entry("not a number", "* noon * * *", "invalid hour 'noon'"),
+		Entry("a backwards range", "* * 5-1 * *", "invalid range in day of month field '5-1'"),
+		Entry("a zero step", "*/0 * * * *", "invalid step in minute field '*/0'"),
+		Entry("a day that never comes", "0 0 30 2 *", "schedule '0 0 30 2 *' never runs"),
+	)
+})
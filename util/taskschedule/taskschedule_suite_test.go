@@ -0,0 +1,13 @@
+package taskschedule_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestTaskSchedule(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Task Schedule Suite")
+}
@@ -0,0 +1,13 @@
+package fakecf_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestFakeCF(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Fake CF Suite")
+}
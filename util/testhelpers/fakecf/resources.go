@@ -0,0 +1,267 @@
+package fakecf
+
+import (
+	"fmt"
+	"time"
+)
+
+// Organization is an organization stored by the fake Cloud Controller.
+type Organization struct {
+	GUID string
+	Name string
+}
+
+// Space is a space stored by the fake Cloud Controller.
+type Space struct {
+	GUID             string
+	Name             string
+	OrganizationGUID string
+}
+
+// Domain is a shared domain stored by the fake Cloud Controller.
+type Domain struct {
+	GUID string
+	Name string
+}
+
+// Application is an application stored by the fake Cloud Controller. It is
+// served by both the V2 and V3 endpoints.
+type Application struct {
+	GUID            string
+	Name            string
+	SpaceGUID       string
+	State           string
+	Instances       int
+	Memory          int
+	DiskQuota       int
+	Command         string
+	Buildpack       string
+	DockerImage     string
+	HealthCheckType string
+	Environment     map[string]string
+	StartedAt       time.Time
+}
+
+// Route is a route stored by the fake Cloud Controller.
+type Route struct {
+	GUID       string
+	Host       string
+	Path       string
+	DomainGUID string
+	SpaceGUID  string
+	AppGUIDs   []string
+}
+
+// Task is a task stored by the fake Cloud Controller.
+type Task struct {
+	GUID       string
+	SequenceID int
+	Name       string
+	Command    string
+	State      string
+	AppGUID    string
+	MemoryInMB uint64
+	DiskInMB   uint64
+	CreatedAt  time.Time
+}
+
+// User is a user known to the fake UAA.
+type User struct {
+	GUID     string
+	Username string
+	Password string
+}
+
+// AddUser adds a user that can log in with the given password and returns
+// its GUID.
+func (server *Server) AddUser(username string, password string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	user := &User{GUID: server.newGUID("user"), Username: username, Password: password}
+	server.users = append(server.users, user)
+	return user.GUID
+}
+
+// AddOrganization adds an organization and returns its GUID.
+func (server *Server) AddOrganization(name string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	org := &Organization{GUID: server.newGUID("org"), Name: name}
+	server.orgs = append(server.orgs, org)
+	return org.GUID
+}
+
+// AddSpace adds a space to an organization and returns its GUID.
+func (server *Server) AddSpace(orgGUID string, name string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	space := &Space{GUID: server.newGUID("space"), Name: name, OrganizationGUID: orgGUID}
+	server.spaces = append(server.spaces, space)
+	return space.GUID
+}
+
+// AddSharedDomain adds a shared domain and returns its GUID.
+func (server *Server) AddSharedDomain(name string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	domain := &Domain{GUID: server.newGUID("domain"), Name: name}
+	server.domains = append(server.domains, domain)
+	return domain.GUID
+}
+
+// AddApplication adds a stopped application with one instance to a space and
+// returns its GUID.
+func (server *Server) AddApplication(spaceGUID string, name string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return server.addApplication(Application{Name: name, SpaceGUID: spaceGUID}).GUID
+}
+
+// AddRoute adds a route to a space and returns its GUID.
+func (server *Server) AddRoute(spaceGUID string, domainGUID string, host string) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	route := &Route{GUID: server.newGUID("route"), Host: host, DomainGUID: domainGUID, SpaceGUID: spaceGUID}
+	server.routes = append(server.routes, route)
+	return route.GUID
+}
+
+// Application returns a copy of the application with the given GUID.
+func (server *Server) Application(guid string) (Application, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	app := server.findApplication(guid)
+	if app == nil {
+		return Application{}, false
+	}
+	return *app, true
+}
+
+// Routes returns copies of all routes.
+func (server *Server) Routes() []Route {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	routes := []Route{}
+	for _, route := range server.routes {
+		routeCopy := *route
+		routeCopy.AppGUIDs = append([]string{}, route.AppGUIDs...)
+		routes = append(routes, routeCopy)
+	}
+	return routes
+}
+
+// Tasks returns copies of the tasks of an application, oldest first.
+func (server *Server) Tasks(appGUID string) []Task {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	tasks := []Task{}
+	for _, task := range server.tasks {
+		if task.AppGUID == appGUID {
+			tasks = append(tasks, *task)
+		}
+	}
+	return tasks
+}
+
+// SetTaskState changes the state of a task, e.g. to mark it as SUCCEEDED.
+func (server *Server) SetTaskState(taskGUID string, state string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if task := server.findTask(taskGUID); task != nil {
+		task.State = state
+	}
+}
+
+func (server *Server) newGUID(kind string) string {
+	server.guidCount++
+	return fmt.Sprintf("%s-guid-%d", kind, server.guidCount)
+}
+
+func (server *Server) addApplication(app Application) *Application {
+	app.GUID = server.newGUID("app")
+	if app.State == "" {
+		app.State = "STOPPED"
+	}
+	if app.Instances == 0 {
+		app.Instances = 1
+	}
+	if app.Memory == 0 {
+		app.Memory = 1024
+	}
+	if app.DiskQuota == 0 {
+		app.DiskQuota = 1024
+	}
+	if app.HealthCheckType == "" {
+		app.HealthCheckType = "port"
+	}
+	if app.State == "STARTED" {
+		app.StartedAt = time.Now()
+	}
+
+	server.apps = append(server.apps, &app)
+	return &app
+}
+
+func (server *Server) findOrganization(guid string) *Organization {
+	for _, org := range server.orgs {
+		if org.GUID == guid {
+			return org
+		}
+	}
+	return nil
+}
+
+func (server *Server) findSpace(guid string) *Space {
+	for _, space := range server.spaces {
+		if space.GUID == guid {
+			return space
+		}
+	}
+	return nil
+}
+
+func (server *Server) findDomain(guid string) *Domain {
+	for _, domain := range server.domains {
+		if domain.GUID == guid {
+			return domain
+		}
+	}
+	return nil
+}
+
+func (server *Server) findApplication(guid string) *Application {
+	for _, app := range server.apps {
+		if app.GUID == guid {
+			return app
+		}
+	}
+	return nil
+}
+
+func (server *Server) findRoute(guid string) *Route {
+	for _, route := range server.routes {
+		if route.GUID == guid {
+			return route
+		}
+	}
+	return nil
+}
+
+func (server *Server) findTask(guid string) *Task {
+	for _, task := range server.tasks {
+		if task.GUID == guid {
+			return task
+		}
+	}
+	return nil
+}
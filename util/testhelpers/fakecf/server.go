@@ -0,0 +1,168 @@
+// Package fakecf provides an in-process fake Cloud Controller and UAA for
+// exercising the CLI's API clients and commands without a real Cloud Foundry.
+//
+// The server keeps orgs, spaces, shared domains, apps, routes and tasks in
+// memory. It implements the subset of the V2 and V3 Cloud Controller APIs
+// used by ccv2 and ccv3 for those resources, and the UAA password and
+// refresh token grants. Every Cloud Controller request other than the API
+// root and info endpoints requires an access token issued by the server.
+//
+//	server := fakecf.NewServer()
+//	defer server.Close()
+//	server.AddUser("admin", "admin")
+//	orgGUID := server.AddOrganization("some-org")
+//	server.AddSpace(orgGUID, "some-space")
+package fakecf
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/tedsuo/rata"
+)
+
+const (
+	// APIVersion is the V2 API version reported by the server.
+	APIVersion = "2.75.0"
+
+	// V3APIVersion is the V3 API version reported by the server.
+	V3APIVersion = "3.10.0"
+)
+
+// Server is a fake Cloud Controller and UAA listening on localhost. The
+// Cloud Controller and UAA share the same URL.
+type Server struct {
+	httpServer *httptest.Server
+
+	mutex         sync.Mutex
+	guidCount     int
+	tokenCount    int
+	accessTokens  map[string]string // access token to user GUID
+	refreshTokens map[string]string // refresh token to user GUID
+
+	users   []*User
+	orgs    []*Organization
+	spaces  []*Space
+	domains []*Domain
+	apps    []*Application
+	routes  []*Route
+	tasks   []*Task
+}
+
+// NewServer starts a new fake Cloud Controller and UAA.
+func NewServer() *Server {
+	server := &Server{
+		accessTokens:  map[string]string{},
+		refreshTokens: map[string]string{},
+	}
+
+	handler, err := rata.NewRouter(server.apiRoutes(), server.apiHandlers())
+	if err != nil {
+		panic(err)
+	}
+
+	server.httpServer = httptest.NewServer(handler)
+	return server
+}
+
+// URL returns the API URL of the server.
+func (server *Server) URL() string {
+	return server.httpServer.URL
+}
+
+// Close shuts down the server.
+func (server *Server) Close() {
+	server.httpServer.Close()
+}
+
+// ExpireAccessTokens invalidates all issued access tokens. Refresh tokens
+// stay valid, so clients have to refresh their token on the next request.
+func (server *Server) ExpireAccessTokens() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.accessTokens = map[string]string{}
+}
+
+func (server *Server) apiRoutes() rata.Routes {
+	routes := rata.Routes{}
+	routes = append(routes, uaaRoutes...)
+	routes = append(routes, v2Routes...)
+	routes = append(routes, v3Routes...)
+
+	// "/" matches every path in the router, so it has to be added last.
+	routes = append(routes, rata.Route{Name: "GetRoot", Method: http.MethodGet, Path: "/"})
+	return routes
+}
+
+func (server *Server) apiHandlers() rata.Handlers {
+	handlers := rata.Handlers{
+		"GetRoot": http.HandlerFunc(server.getRoot),
+	}
+	for name, handler := range server.uaaHandlers() {
+		handlers[name] = handler
+	}
+	for name, handler := range server.v2Handlers() {
+		handlers[name] = handler
+	}
+	for name, handler := range server.v3Handlers() {
+		handlers[name] = handler
+	}
+	return handlers
+}
+
+// locked serializes access to the server state while the handler runs.
+func (server *Server) locked(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		handler(w, r)
+	}
+}
+
+// authenticatedUser returns the GUID of the user the request's access token
+// was issued to.
+func (server *Server) authenticatedUser(r *http.Request) (string, bool) {
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(strings.ToLower(authorization), "bearer ") {
+		return "", false
+	}
+
+	userGUID, ok := server.accessTokens[authorization[len("bearer "):]]
+	return userGUID, ok
+}
+
+func (server *Server) getRoot(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"links": map[string]interface{}{
+			"self": link(server.URL()),
+			"cloud_controller_v2": map[string]interface{}{
+				"href": server.URL() + "/v2",
+				"meta": map[string]string{"version": APIVersion},
+			},
+			"cloud_controller_v3": map[string]interface{}{
+				"href": server.URL() + "/v3",
+				"meta": map[string]string{"version": V3APIVersion},
+			},
+			"uaa": link(server.URL()),
+		},
+	})
+}
+
+func link(href string) map[string]string {
+	return map[string]string{"href": href}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,332 @@
+package fakecf_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa"
+	. "code.cloudfoundry.org/cli/util/testhelpers/fakecf"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type tokenCache struct {
+	accessToken  string
+	refreshToken string
+}
+
+func (cache *tokenCache) AccessToken() string          { return cache.accessToken }
+func (cache *tokenCache) RefreshToken() string         { return cache.refreshToken }
+func (cache *tokenCache) SetAccessToken(token string)  { cache.accessToken = token }
+func (cache *tokenCache) SetRefreshToken(token string) { cache.refreshToken = token }
+
+func login(server *Server, username string, password string) (*tokenCache, int) {
+	response, err := http.PostForm(server.URL()+"/oauth/token", url.Values{
+		"grant_type": {"password"},
+		"username":   {username},
+		"password":   {password},
+	})
+	Expect(err).ToNot(HaveOccurred())
+	defer response.Body.Close()
+
+	var token uaa.RefreshToken
+	Expect(json.NewDecoder(response.Body).Decode(&token)).To(Succeed())
+	return &tokenCache{accessToken: token.AuthorizationToken(), refreshToken: token.RefreshToken}, response.StatusCode
+}
+
+// getV2 makes an authenticated V2 request the way the legacy CLI code does
+// and decodes the response body.
+func getV2(server *Server, cache *tokenCache, path string, body interface{}) int {
+	request, err := http.NewRequest(http.MethodGet, server.URL()+path, nil)
+	Expect(err).ToNot(HaveOccurred())
+	request.Header.Set("Authorization", cache.AccessToken())
+
+	response, err := http.DefaultClient.Do(request)
+	Expect(err).ToNot(HaveOccurred())
+	defer response.Body.Close()
+
+	Expect(json.NewDecoder(response.Body).Decode(body)).To(Succeed())
+	return response.StatusCode
+}
+
+var _ = Describe("Server", func() {
+	var (
+		server *Server
+		cache  *tokenCache
+
+		ccv2Client *ccv2.Client
+		ccv3Client *ccv3.Client
+
+		orgGUID    string
+		spaceGUID  string
+		domainGUID string
+	)
+
+	BeforeEach(func() {
+		server = NewServer()
+		server.AddUser("admin", "some-password")
+		orgGUID = server.AddOrganization("some-org")
+		spaceGUID = server.AddSpace(orgGUID, "some-space")
+		server.AddSpace(server.AddOrganization("other-org"), "other-space")
+		domainGUID = server.AddSharedDomain("example.com")
+
+		var status int
+		cache, status = login(server, "admin", "some-password")
+		Expect(status).To(Equal(http.StatusOK))
+
+		uaaClient := uaa.NewClient(uaa.Config{URL: server.URL()})
+
+		ccv2Client = ccv2.NewClient(ccv2.Config{
+			AppName:            "fakecf-test",
+			AppVersion:         "1.0.0",
+			JobPollingTimeout:  time.Second,
+			JobPollingInterval: 10 * time.Millisecond,
+			Wrappers:           []ccv2.ConnectionWrapper{ccWrapper.NewUAAAuthentication(uaaClient, cache)},
+		})
+		_, err := ccv2Client.TargetCF(ccv2.TargetSettings{URL: server.URL()})
+		Expect(err).ToNot(HaveOccurred())
+
+		ccv3Client = ccv3.NewClient(ccv3.Config{
+			AppName:    "fakecf-test",
+			AppVersion: "1.0.0",
+			Wrappers:   []ccv3.ConnectionWrapper{ccWrapper.NewUAAAuthentication(uaaClient, cache)},
+		})
+		_, err = ccv3Client.TargetCF(ccv3.TargetSettings{URL: server.URL()})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("targeting", func() {
+		It("reports the server as the API, UAA and token endpoint", func() {
+			Expect(ccv2Client.APIVersion()).To(Equal(APIVersion))
+			Expect(ccv2Client.AuthorizationEndpoint()).To(Equal(server.URL()))
+			Expect(ccv2Client.TokenEndpoint()).To(Equal(server.URL()))
+			Expect(ccv3Client.UAA()).To(Equal(server.URL()))
+			Expect(ccv3Client.CloudControllerAPIVersion()).To(Equal(V3APIVersion))
+		})
+	})
+
+	Describe("authentication", func() {
+		It("rejects invalid credentials", func() {
+			_, status := login(server, "admin", "wrong-password")
+			Expect(status).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("rejects Cloud Controller requests without a token", func() {
+			client := ccv2.NewClient(ccv2.Config{})
+			_, err := client.TargetCF(ccv2.TargetSettings{URL: server.URL()})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = client.GetOrganizations(nil)
+			Expect(err).To(MatchError(cloudcontroller.InvalidAuthTokenError{Message: "Invalid Auth Token"}))
+		})
+
+		Context("when the access token has expired", func() {
+			It("lets the client refresh the token", func() {
+				oldToken := cache.AccessToken()
+				server.ExpireAccessTokens()
+
+				orgs, _, err := ccv2Client.GetOrganizations(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orgs).To(HaveLen(2))
+				Expect(cache.AccessToken()).ToNot(Equal(oldToken))
+
+				_, _, err = ccv3Client.GetOrganizations(nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("V2 organizations and spaces", func() {
+		It("filters by the given queries", func() {
+			orgs, _, err := ccv2Client.GetOrganizations([]ccv2.Query{{Filter: ccv2.NameFilter, Operator: ccv2.EqualOperator, Value: "some-org"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orgs).To(Equal([]ccv2.Organization{{GUID: orgGUID, Name: "some-org"}}))
+
+			spaces, _, err := ccv2Client.GetSpaces([]ccv2.Query{{Filter: ccv2.OrganizationGUIDFilter, Operator: ccv2.EqualOperator, Value: orgGUID}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spaces).To(Equal([]ccv2.Space{{GUID: spaceGUID, Name: "some-space", AllowSSH: true}}))
+		})
+
+		It("lists and finds the spaces of an organization", func() {
+			var spaces struct {
+				Resources []struct {
+					Metadata struct{ GUID string }
+					Entity   struct{ Name string }
+				}
+			}
+			status := getV2(server, cache, "/v2/organizations/"+orgGUID+"/spaces?q=name%3Asome-space&inline-relations-depth=1", &spaces)
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(spaces.Resources).To(HaveLen(1))
+			Expect(spaces.Resources[0].Metadata.GUID).To(Equal(spaceGUID))
+
+			status = getV2(server, cache, "/v2/organizations/"+orgGUID+"/spaces?q=name%3Aother-space", &spaces)
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(spaces.Resources).To(BeEmpty())
+
+			var space struct{ Entity struct{ Name string } }
+			status = getV2(server, cache, "/v2/spaces/"+spaceGUID, &space)
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(space.Entity.Name).To(Equal("some-space"))
+		})
+
+		It("deletes organizations with a job", func() {
+			job, _, err := ccv2Client.DeleteOrganization(orgGUID)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = ccv2Client.PollJob(job)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = ccv2Client.GetOrganization(orgGUID)
+			Expect(err).To(BeAssignableToTypeOf(cloudcontroller.ResourceNotFoundError{}))
+		})
+	})
+
+	Describe("V2 apps and routes", func() {
+		var app ccv2.Application
+
+		BeforeEach(func() {
+			var err error
+			app, _, err = ccv2Client.NewApplication(ccv2.Application{Name: "some-app", SpaceGUID: spaceGUID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(app.State).To(Equal(ccv2.ApplicationStopped))
+		})
+
+		It("updates and starts apps", func() {
+			_, _, err := ccv2Client.GetApplicationInstanceStatusesByApplication(app.GUID)
+			Expect(err).To(MatchError(ccv2.AppStoppedStatsError{Message: "Could not fetch stats for stopped app: some-app"}))
+
+			app, _, err = ccv2Client.UpdateApplication(ccv2.Application{GUID: app.GUID, Instances: 2, State: ccv2.ApplicationStarted})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(app.Name).To(Equal("some-app"))
+			Expect(app.Instances).To(Equal(2))
+
+			instances, _, err := ccv2Client.GetApplicationInstancesByApplication(app.GUID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(HaveLen(2))
+			Expect(instances[1].State).To(Equal(ccv2.ApplicationInstanceRunning))
+
+			apps, _, err := ccv2Client.GetApplications([]ccv2.Query{{Filter: ccv2.SpaceGUIDFilter, Operator: ccv2.EqualOperator, Value: spaceGUID}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(HaveLen(1))
+			Expect(apps[0].State).To(Equal(ccv2.ApplicationStarted))
+		})
+
+		It("summarizes the apps of a space with their routes", func() {
+			route, _, err := ccv2Client.NewRoute(ccv2.Route{Host: "some-host", DomainGUID: domainGUID, SpaceGUID: spaceGUID})
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = ccv2Client.UpdateRouteApplication(route.GUID, app.GUID)
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = ccv2Client.UpdateApplication(ccv2.Application{GUID: app.GUID, Instances: 2, State: ccv2.ApplicationStarted})
+			Expect(err).ToNot(HaveOccurred())
+
+			var summary struct {
+				Apps []struct {
+					GUID             string
+					Name             string
+					State            string
+					Instances        int
+					RunningInstances int `json:"running_instances"`
+					URLs             []string
+				}
+			}
+			status := getV2(server, cache, "/v2/spaces/"+spaceGUID+"/summary", &summary)
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(summary.Apps).To(HaveLen(1))
+			Expect(summary.Apps[0].GUID).To(Equal(app.GUID))
+			Expect(summary.Apps[0].Name).To(Equal("some-app"))
+			Expect(summary.Apps[0].State).To(Equal("STARTED"))
+			Expect(summary.Apps[0].Instances).To(Equal(2))
+			Expect(summary.Apps[0].RunningInstances).To(Equal(2))
+			Expect(summary.Apps[0].URLs).To(ConsistOf("some-host.example.com"))
+		})
+
+		It("creates and maps routes", func() {
+			route, _, err := ccv2Client.NewRoute(ccv2.Route{Host: "some-host", DomainGUID: domainGUID, SpaceGUID: spaceGUID})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = ccv2Client.UpdateRouteApplication(route.GUID, app.GUID)
+			Expect(err).ToNot(HaveOccurred())
+
+			routes, _, err := ccv2Client.GetApplicationRoutes(app.GUID, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(routes).To(Equal([]ccv2.Route{route}))
+			Expect(server.Routes()[0].AppGUIDs).To(ConsistOf(app.GUID))
+
+			_, err = ccv2Client.DeleteRouteApplication(route.GUID, app.GUID)
+			Expect(err).ToNot(HaveOccurred())
+
+			routes, _, err = ccv2Client.GetApplicationRoutes(app.GUID, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(routes).To(BeEmpty())
+		})
+	})
+
+	Describe("V3 apps and tasks", func() {
+		var appGUID string
+
+		BeforeEach(func() {
+			appGUID = server.AddApplication(spaceGUID, "some-app")
+		})
+
+		It("filters apps by name and space", func() {
+			apps, _, err := ccv3Client.GetApplications(url.Values{
+				ccv3.NameFilter:      {"some-app"},
+				ccv3.SpaceGUIDFilter: {spaceGUID},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(HaveLen(1))
+			Expect(apps[0].GUID).To(Equal(appGUID))
+
+			apps, _, err = ccv3Client.GetApplications(url.Values{ccv3.NameFilter: {"other-app"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apps).To(BeEmpty())
+		})
+
+		It("runs, gets, lists and cancels tasks", func() {
+			task, _, err := ccv3Client.NewTask(appGUID, "echo hi", "some-task", 0, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(task.SequenceID).To(Equal(1))
+			Expect(task.State).To(Equal("RUNNING"))
+
+			_, _, err = ccv3Client.NewTask(appGUID, "echo bye", "", 0, 0)
+			Expect(err).ToNot(HaveOccurred())
+			server.SetTaskState(task.GUID, "SUCCEEDED")
+
+			fetched, _, err := ccv3Client.GetTask(task.GUID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetched.State).To(Equal("SUCCEEDED"))
+
+			tasks, _, err := ccv3Client.GetApplicationTasks(appGUID, url.Values{"sequence_ids": {"2"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tasks).To(HaveLen(1))
+			Expect(tasks[0].Command).To(Equal("echo bye"))
+
+			latest, _, err := ccv3Client.GetApplicationTasksPage(appGUID, url.Values{
+				ccv3.OrderBy: {"-created_at"},
+				ccv3.PerPage: {"1"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(latest).To(HaveLen(1))
+			Expect(latest[0].SequenceID).To(Equal(2))
+
+			canceled, _, err := ccv3Client.UpdateTask(tasks[0].GUID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(canceled.State).To(Equal("CANCELING"))
+
+			_, _, err = ccv3Client.UpdateTask(task.GUID)
+			Expect(err).To(HaveOccurred())
+
+			Expect(server.Tasks(appGUID)).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,152 @@
+package fakecf
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tedsuo/rata"
+)
+
+var uaaRoutes = rata.Routes{
+	{Name: "GetLogin", Method: http.MethodGet, Path: "/login"},
+	{Name: "PostToken", Method: http.MethodPost, Path: "/oauth/token"},
+	{Name: "PostUsers", Method: http.MethodPost, Path: "/Users"},
+}
+
+func (server *Server) uaaHandlers() rata.Handlers {
+	return rata.Handlers{
+		"GetLogin":  server.locked(server.getLogin),
+		"PostToken": server.locked(server.postToken),
+		"PostUsers": server.locked(server.postUsers),
+	}
+}
+
+func (server *Server) getLogin(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"app":   map[string]string{"version": "4.7.0"},
+		"links": map[string]string{"login": server.URL(), "uaa": server.URL()},
+		"prompts": map[string][]string{
+			"username": {"text", "Email"},
+			"password": {"password", "Password"},
+		},
+	})
+}
+
+// postToken implements the password and refresh_token grants.
+func (server *Server) postToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeUAAError(w, http.StatusBadRequest, "invalid_request", err.Error())
+		return
+	}
+
+	var user *User
+	switch grantType := r.PostForm.Get("grant_type"); grantType {
+	case "password":
+		for _, u := range server.users {
+			if u.Username == r.PostForm.Get("username") && u.Password == r.PostForm.Get("password") {
+				user = u
+			}
+		}
+		if user == nil {
+			writeUAAError(w, http.StatusUnauthorized, "unauthorized", "Bad credentials")
+			return
+		}
+	case "refresh_token":
+		userGUID, ok := server.refreshTokens[r.PostForm.Get("refresh_token")]
+		if !ok {
+			writeUAAError(w, http.StatusUnauthorized, "invalid_token", "Invalid refresh token")
+			return
+		}
+		user = server.findUser(userGUID)
+	default:
+		writeUAAError(w, http.StatusBadRequest, "unsupported_grant_type", fmt.Sprintf("Unsupported grant type: %s", grantType))
+		return
+	}
+
+	accessToken := server.newAccessToken(user)
+	server.accessTokens[accessToken] = user.GUID
+
+	server.tokenCount++
+	refreshToken := fmt.Sprintf("refresh-token-%d", server.tokenCount)
+	server.refreshTokens[refreshToken] = user.GUID
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  accessToken,
+		"refresh_token": refreshToken,
+		"token_type":    "bearer",
+		"expires_in":    599,
+		"scope":         "cloud_controller.read cloud_controller.write openid",
+		"jti":           fmt.Sprintf("jti-%d", server.tokenCount),
+	})
+}
+
+func (server *Server) postUsers(w http.ResponseWriter, r *http.Request) {
+	if _, ok := server.authenticatedUser(r); !ok {
+		writeUAAError(w, http.StatusUnauthorized, "invalid_token", "Invalid access token")
+		return
+	}
+
+	var body struct {
+		Username string `json:"userName"`
+		Password string `json:"password"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Username == "" {
+		writeUAAError(w, http.StatusBadRequest, "invalid_scim_resource", "A username must be provided.")
+		return
+	}
+
+	for _, user := range server.users {
+		if user.Username == body.Username {
+			writeUAAError(w, http.StatusConflict, "scim_resource_already_exists", "Username already in use: "+body.Username)
+			return
+		}
+	}
+
+	user := &User{GUID: server.newGUID("user"), Username: body.Username, Password: body.Password}
+	server.users = append(server.users, user)
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"id":       user.GUID,
+		"userName": user.Username,
+	})
+}
+
+// newAccessToken returns an unsigned JWT carrying the claims the CLI reads
+// from its access token.
+func (server *Server) newAccessToken(user *User) string {
+	server.tokenCount++
+
+	header, _ := json.Marshal(map[string]string{"alg": "none", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"jti":       fmt.Sprintf("access-token-%d", server.tokenCount),
+		"user_id":   user.GUID,
+		"user_name": user.Username,
+		"email":     user.Username,
+		"exp":       time.Now().Add(10 * time.Minute).Unix(),
+	})
+
+	return fmt.Sprintf("%s.%s.%s",
+		base64.RawURLEncoding.EncodeToString(header),
+		base64.RawURLEncoding.EncodeToString(claims),
+		base64.RawURLEncoding.EncodeToString([]byte("signature")),
+	)
+}
+
+func (server *Server) findUser(guid string) *User {
+	for _, user := range server.users {
+		if user.GUID == guid {
+			return user
+		}
+	}
+	return nil
+}
+
+func writeUAAError(w http.ResponseWriter, status int, errorType string, description string) {
+	writeJSON(w, status, map[string]string{
+		"error":             errorType,
+		"error_description": description,
+	})
+}
@@ -0,0 +1,793 @@
+package fakecf
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tedsuo/rata"
+)
+
+var v2Routes = rata.Routes{
+	{Name: "GetInfo", Method: http.MethodGet, Path: "/v2/info"},
+	{Name: "GetJob", Method: http.MethodGet, Path: "/v2/jobs/:guid"},
+
+	{Name: "GetOrganizations", Method: http.MethodGet, Path: "/v2/organizations"},
+	{Name: "GetOrganization", Method: http.MethodGet, Path: "/v2/organizations/:guid"},
+	{Name: "DeleteOrganization", Method: http.MethodDelete, Path: "/v2/organizations/:guid"},
+	{Name: "GetOrganizationPrivateDomains", Method: http.MethodGet, Path: "/v2/organizations/:guid/private_domains"},
+	{Name: "GetOrganizationSpaces", Method: http.MethodGet, Path: "/v2/organizations/:guid/spaces"},
+
+	{Name: "GetSpaces", Method: http.MethodGet, Path: "/v2/spaces"},
+	{Name: "GetSpace", Method: http.MethodGet, Path: "/v2/spaces/:guid"},
+	{Name: "GetSpaceRoutes", Method: http.MethodGet, Path: "/v2/spaces/:guid/routes"},
+	{Name: "GetSpaceSummary", Method: http.MethodGet, Path: "/v2/spaces/:guid/summary"},
+
+	{Name: "GetSharedDomains", Method: http.MethodGet, Path: "/v2/shared_domains"},
+	{Name: "GetSharedDomain", Method: http.MethodGet, Path: "/v2/shared_domains/:guid"},
+	{Name: "GetPrivateDomain", Method: http.MethodGet, Path: "/v2/private_domains/:guid"},
+
+	{Name: "GetApps", Method: http.MethodGet, Path: "/v2/apps"},
+	{Name: "PostApp", Method: http.MethodPost, Path: "/v2/apps"},
+	{Name: "GetApp", Method: http.MethodGet, Path: "/v2/apps/:guid"},
+	{Name: "PutApp", Method: http.MethodPut, Path: "/v2/apps/:guid"},
+	{Name: "DeleteApp", Method: http.MethodDelete, Path: "/v2/apps/:guid"},
+	{Name: "GetAppInstances", Method: http.MethodGet, Path: "/v2/apps/:guid/instances"},
+	{Name: "GetAppStats", Method: http.MethodGet, Path: "/v2/apps/:guid/stats"},
+	{Name: "GetAppRoutes", Method: http.MethodGet, Path: "/v2/apps/:guid/routes"},
+
+	{Name: "GetRoutes", Method: http.MethodGet, Path: "/v2/routes"},
+	{Name: "PostRoute", Method: http.MethodPost, Path: "/v2/routes"},
+	{Name: "DeleteRoute", Method: http.MethodDelete, Path: "/v2/routes/:guid"},
+	{Name: "GetRouteApps", Method: http.MethodGet, Path: "/v2/routes/:guid/apps"},
+	{Name: "PutRouteApp", Method: http.MethodPut, Path: "/v2/routes/:guid/apps/:app_guid"},
+	{Name: "DeleteRouteApp", Method: http.MethodDelete, Path: "/v2/routes/:guid/apps/:app_guid"},
+}
+
+func (server *Server) v2Handlers() rata.Handlers {
+	handlers := rata.Handlers{
+		"GetJob": server.v2Authenticated(server.getJob),
+
+		"GetOrganizations":              server.v2Authenticated(server.getOrganizations),
+		"GetOrganization":               server.v2Authenticated(server.getOrganization),
+		"DeleteOrganization":            server.v2Authenticated(server.deleteOrganization),
+		"GetOrganizationPrivateDomains": server.v2Authenticated(server.getOrganizationPrivateDomains),
+		"GetOrganizationSpaces":         server.v2Authenticated(server.getOrganizationSpaces),
+
+		"GetSpaces":       server.v2Authenticated(server.getSpaces),
+		"GetSpace":        server.v2Authenticated(server.getSpace),
+		"GetSpaceRoutes":  server.v2Authenticated(server.getSpaceRoutes),
+		"GetSpaceSummary": server.v2Authenticated(server.getSpaceSummary),
+
+		"GetSharedDomains": server.v2Authenticated(server.getSharedDomains),
+		"GetSharedDomain":  server.v2Authenticated(server.getSharedDomain),
+		"GetPrivateDomain": server.v2Authenticated(server.getPrivateDomain),
+
+		"GetApps":         server.v2Authenticated(server.getApps),
+		"PostApp":         server.v2Authenticated(server.postApp),
+		"GetApp":          server.v2Authenticated(server.getApp),
+		"PutApp":          server.v2Authenticated(server.putApp),
+		"DeleteApp":       server.v2Authenticated(server.deleteApp),
+		"GetAppInstances": server.v2Authenticated(server.getAppInstances),
+		"GetAppStats":     server.v2Authenticated(server.getAppStats),
+		"GetAppRoutes":    server.v2Authenticated(server.getAppRoutes),
+
+		"GetRoutes":      server.v2Authenticated(server.getRoutes),
+		"PostRoute":      server.v2Authenticated(server.postRoute),
+		"DeleteRoute":    server.v2Authenticated(server.deleteRoute),
+		"GetRouteApps":   server.v2Authenticated(server.getRouteApps),
+		"PutRouteApp":    server.v2Authenticated(server.putRouteApp),
+		"DeleteRouteApp": server.v2Authenticated(server.deleteRouteApp),
+	}
+	handlers["GetInfo"] = server.locked(server.getInfo)
+	return handlers
+}
+
+// v2Authenticated rejects requests without a valid access token the way the
+// V2 API does.
+func (server *Server) v2Authenticated(handler http.HandlerFunc) http.HandlerFunc {
+	return server.locked(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := server.authenticatedUser(r); !ok {
+			writeV2Error(w, http.StatusUnauthorized, 1000, "CF-InvalidAuthToken", "Invalid Auth Token")
+			return
+		}
+		handler(w, r)
+	})
+}
+
+func (server *Server) getInfo(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name":                     "fakecf",
+		"api_version":              APIVersion,
+		"authorization_endpoint":   server.URL(),
+		"token_endpoint":           server.URL(),
+		"doppler_logging_endpoint": strings.Replace(server.URL(), "http", "ws", 1),
+		"min_cli_version":          nil,
+	})
+}
+
+// getJob reports every job as finished; jobs complete synchronously.
+func (server *Server) getJob(w http.ResponseWriter, r *http.Request) {
+	guid := rata.Param(r, "guid")
+	writeJSON(w, http.StatusOK, server.v2Resource(guid, "/v2/jobs/", map[string]interface{}{
+		"guid":   guid,
+		"status": "finished",
+	}))
+}
+
+func (server *Server) getOrganizations(w http.ResponseWriter, r *http.Request) {
+	resources := []interface{}{}
+	for _, org := range server.orgs {
+		if matchesV2Query(r, map[string]string{"name": org.Name}) {
+			resources = append(resources, server.v2Organization(org))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+func (server *Server) getOrganization(w http.ResponseWriter, r *http.Request) {
+	org := server.findOrganization(rata.Param(r, "guid"))
+	if org == nil {
+		writeV2NotFound(w, "organization", rata.Param(r, "guid"))
+		return
+	}
+	writeJSON(w, http.StatusOK, server.v2Organization(org))
+}
+
+// deleteOrganization deletes the organization and everything in it.
+func (server *Server) deleteOrganization(w http.ResponseWriter, r *http.Request) {
+	org := server.findOrganization(rata.Param(r, "guid"))
+	if org == nil {
+		writeV2NotFound(w, "organization", rata.Param(r, "guid"))
+		return
+	}
+
+	for _, space := range server.spaces {
+		if space.OrganizationGUID != org.GUID {
+			continue
+		}
+		for _, app := range server.apps {
+			if app.SpaceGUID == space.GUID {
+				server.removeApplication(app.GUID)
+			}
+		}
+		for _, route := range server.routes {
+			if route.SpaceGUID == space.GUID {
+				server.removeRoute(route.GUID)
+			}
+		}
+	}
+
+	spaces := []*Space{}
+	for _, space := range server.spaces {
+		if space.OrganizationGUID != org.GUID {
+			spaces = append(spaces, space)
+		}
+	}
+	server.spaces = spaces
+
+	orgs := []*Organization{}
+	for _, o := range server.orgs {
+		if o.GUID != org.GUID {
+			orgs = append(orgs, o)
+		}
+	}
+	server.orgs = orgs
+
+	jobGUID := server.newGUID("job")
+	writeJSON(w, http.StatusAccepted, server.v2Resource(jobGUID, "/v2/jobs/", map[string]interface{}{
+		"guid":   jobGUID,
+		"status": "queued",
+	}))
+}
+
+// getOrganizationPrivateDomains always returns an empty list; the server
+// only knows about shared domains.
+func (server *Server) getOrganizationPrivateDomains(w http.ResponseWriter, r *http.Request) {
+	if server.findOrganization(rata.Param(r, "guid")) == nil {
+		writeV2NotFound(w, "organization", rata.Param(r, "guid"))
+		return
+	}
+	writeV2List(w, []interface{}{})
+}
+
+// getOrganizationSpaces lists the spaces of the organization; the legacy
+// login and target commands look spaces up this way.
+func (server *Server) getOrganizationSpaces(w http.ResponseWriter, r *http.Request) {
+	org := server.findOrganization(rata.Param(r, "guid"))
+	if org == nil {
+		writeV2NotFound(w, "organization", rata.Param(r, "guid"))
+		return
+	}
+
+	resources := []interface{}{}
+	for _, space := range server.spaces {
+		if space.OrganizationGUID == org.GUID && matchesV2Query(r, map[string]string{"name": space.Name}) {
+			resources = append(resources, server.v2Space(space))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+func (server *Server) getSpaces(w http.ResponseWriter, r *http.Request) {
+	resources := []interface{}{}
+	for _, space := range server.spaces {
+		if matchesV2Query(r, map[string]string{"name": space.Name, "organization_guid": space.OrganizationGUID}) {
+			resources = append(resources, server.v2Space(space))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+func (server *Server) getSpace(w http.ResponseWriter, r *http.Request) {
+	space := server.findSpace(rata.Param(r, "guid"))
+	if space == nil {
+		writeV2NotFound(w, "space", rata.Param(r, "guid"))
+		return
+	}
+	writeJSON(w, http.StatusOK, server.v2Space(space))
+}
+
+func (server *Server) getSpaceRoutes(w http.ResponseWriter, r *http.Request) {
+	space := server.findSpace(rata.Param(r, "guid"))
+	if space == nil {
+		writeV2NotFound(w, "space", rata.Param(r, "guid"))
+		return
+	}
+	server.writeV2Routes(w, r, func(route *Route) bool { return route.SpaceGUID == space.GUID })
+}
+
+// getSpaceSummary returns the apps of the space with their routes, as read
+// by the legacy apps command. Every instance of a started app is reported as
+// running, and the space has no services.
+func (server *Server) getSpaceSummary(w http.ResponseWriter, r *http.Request) {
+	space := server.findSpace(rata.Param(r, "guid"))
+	if space == nil {
+		writeV2NotFound(w, "space", rata.Param(r, "guid"))
+		return
+	}
+
+	apps := []interface{}{}
+	for _, app := range server.apps {
+		if app.SpaceGUID != space.GUID {
+			continue
+		}
+
+		runningInstances := 0
+		if app.State == "STARTED" {
+			runningInstances = app.Instances
+		}
+
+		routes := []interface{}{}
+		urls := []string{}
+		for _, route := range server.routes {
+			domain := server.findDomain(route.DomainGUID)
+			if domain == nil || !contains(route.AppGUIDs, app.GUID) {
+				continue
+			}
+
+			routes = append(routes, map[string]interface{}{
+				"guid": route.GUID,
+				"host": route.Host,
+				"path": route.Path,
+				"domain": map[string]interface{}{
+					"guid": domain.GUID,
+					"name": domain.Name,
+				},
+			})
+
+			url := domain.Name + route.Path
+			if route.Host != "" {
+				url = route.Host + "." + url
+			}
+			urls = append(urls, url)
+		}
+
+		apps = append(apps, map[string]interface{}{
+			"guid":              app.GUID,
+			"name":              app.Name,
+			"space_guid":        app.SpaceGUID,
+			"state":             app.State,
+			"instances":         app.Instances,
+			"running_instances": runningInstances,
+			"memory":            app.Memory,
+			"disk_quota":        app.DiskQuota,
+			"buildpack":         app.Buildpack,
+			"command":           app.Command,
+			"routes":            routes,
+			"urls":              urls,
+			"service_names":     []string{},
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"guid":     space.GUID,
+		"name":     space.Name,
+		"apps":     apps,
+		"services": []interface{}{},
+	})
+}
+
+func (server *Server) getSharedDomains(w http.ResponseWriter, r *http.Request) {
+	resources := []interface{}{}
+	for _, domain := range server.domains {
+		if matchesV2Query(r, map[string]string{"name": domain.Name}) {
+			resources = append(resources, server.v2Domain(domain))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+func (server *Server) getSharedDomain(w http.ResponseWriter, r *http.Request) {
+	domain := server.findDomain(rata.Param(r, "guid"))
+	if domain == nil {
+		writeV2NotFound(w, "domain", rata.Param(r, "guid"))
+		return
+	}
+	writeJSON(w, http.StatusOK, server.v2Domain(domain))
+}
+
+func (server *Server) getPrivateDomain(w http.ResponseWriter, r *http.Request) {
+	writeV2NotFound(w, "domain", rata.Param(r, "guid"))
+}
+
+func (server *Server) getApps(w http.ResponseWriter, r *http.Request) {
+	resources := []interface{}{}
+	for _, app := range server.apps {
+		if matchesV2Query(r, map[string]string{"name": app.Name, "space_guid": app.SpaceGUID}) {
+			resources = append(resources, server.v2Application(app))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+// v2ApplicationRequest is the body of app create and update requests. Fields
+// absent from the request are left unchanged.
+type v2ApplicationRequest struct {
+	Buildpack       *string            `json:"buildpack"`
+	Command         *string            `json:"command"`
+	DiskQuota       *int               `json:"disk_quota"`
+	DockerImage     *string            `json:"docker_image"`
+	EnvironmentJSON *map[string]string `json:"environment_json"`
+	HealthCheckType *string            `json:"health_check_type"`
+	Instances       *int               `json:"instances"`
+	Memory          *int               `json:"memory"`
+	Name            *string            `json:"name"`
+	SpaceGUID       *string            `json:"space_guid"`
+	State           *string            `json:"state"`
+}
+
+func (appRequest v2ApplicationRequest) apply(app *Application) {
+	if appRequest.Buildpack != nil {
+		app.Buildpack = *appRequest.Buildpack
+	}
+	if appRequest.Command != nil {
+		app.Command = *appRequest.Command
+	}
+	if appRequest.DiskQuota != nil {
+		app.DiskQuota = *appRequest.DiskQuota
+	}
+	if appRequest.DockerImage != nil {
+		app.DockerImage = *appRequest.DockerImage
+	}
+	if appRequest.EnvironmentJSON != nil {
+		app.Environment = *appRequest.EnvironmentJSON
+	}
+	if appRequest.HealthCheckType != nil {
+		app.HealthCheckType = *appRequest.HealthCheckType
+	}
+	if appRequest.Instances != nil {
+		app.Instances = *appRequest.Instances
+	}
+	if appRequest.Memory != nil {
+		app.Memory = *appRequest.Memory
+	}
+	if appRequest.Name != nil {
+		app.Name = *appRequest.Name
+	}
+	if appRequest.SpaceGUID != nil {
+		app.SpaceGUID = *appRequest.SpaceGUID
+	}
+	if appRequest.State != nil {
+		if *appRequest.State == "STARTED" && app.State != "STARTED" {
+			app.StartedAt = time.Now()
+		}
+		app.State = *appRequest.State
+	}
+}
+
+func (server *Server) postApp(w http.ResponseWriter, r *http.Request) {
+	var appRequest v2ApplicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&appRequest); err != nil {
+		writeV2Error(w, http.StatusBadRequest, 1001, "CF-MessageParseError", "Request invalid due to parse error: "+err.Error())
+		return
+	}
+	if appRequest.Name == nil || appRequest.SpaceGUID == nil {
+		writeV2Error(w, http.StatusBadRequest, 1001, "CF-MessageParseError", "Request invalid due to parse error: name and space_guid are required")
+		return
+	}
+	if server.findSpace(*appRequest.SpaceGUID) == nil {
+		writeV2NotFound(w, "space", *appRequest.SpaceGUID)
+		return
+	}
+	for _, app := range server.apps {
+		if app.Name == *appRequest.Name && app.SpaceGUID == *appRequest.SpaceGUID {
+			writeV2Error(w, http.StatusBadRequest, 100002, "CF-AppNameTaken", fmt.Sprintf("The app name is taken: %s", app.Name))
+			return
+		}
+	}
+
+	var app Application
+	appRequest.apply(&app)
+	writeJSON(w, http.StatusCreated, server.v2Application(server.addApplication(app)))
+}
+
+func (server *Server) getApp(w http.ResponseWriter, r *http.Request) {
+	app := server.findApplication(rata.Param(r, "guid"))
+	if app == nil {
+		writeV2NotFound(w, "app", rata.Param(r, "guid"))
+		return
+	}
+	writeJSON(w, http.StatusOK, server.v2Application(app))
+}
+
+func (server *Server) putApp(w http.ResponseWriter, r *http.Request) {
+	app := server.findApplication(rata.Param(r, "guid"))
+	if app == nil {
+		writeV2NotFound(w, "app", rata.Param(r, "guid"))
+		return
+	}
+
+	var appRequest v2ApplicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&appRequest); err != nil {
+		writeV2Error(w, http.StatusBadRequest, 1001, "CF-MessageParseError", "Request invalid due to parse error: "+err.Error())
+		return
+	}
+	appRequest.apply(app)
+	writeJSON(w, http.StatusCreated, server.v2Application(app))
+}
+
+func (server *Server) deleteApp(w http.ResponseWriter, r *http.Request) {
+	if server.findApplication(rata.Param(r, "guid")) == nil {
+		writeV2NotFound(w, "app", rata.Param(r, "guid"))
+		return
+	}
+	server.removeApplication(rata.Param(r, "guid"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getAppInstances reports every instance of a started app as running.
+func (server *Server) getAppInstances(w http.ResponseWriter, r *http.Request) {
+	app := server.findApplication(rata.Param(r, "guid"))
+	if app == nil {
+		writeV2NotFound(w, "app", rata.Param(r, "guid"))
+		return
+	}
+	if app.State != "STARTED" {
+		writeV2Error(w, http.StatusBadRequest, 220001, "CF-InstancesError", "Instances error: Request failed for app: "+app.Name+" as the app is in stopped state.")
+		return
+	}
+
+	instances := map[string]interface{}{}
+	for i := 0; i < app.Instances; i++ {
+		instances[fmt.Sprint(i)] = map[string]interface{}{
+			"state": "RUNNING",
+			"since": float64(app.StartedAt.Unix()),
+		}
+	}
+	writeJSON(w, http.StatusOK, instances)
+}
+
+func (server *Server) getAppStats(w http.ResponseWriter, r *http.Request) {
+	app := server.findApplication(rata.Param(r, "guid"))
+	if app == nil {
+		writeV2NotFound(w, "app", rata.Param(r, "guid"))
+		return
+	}
+	if app.State != "STARTED" {
+		writeV2Error(w, http.StatusBadRequest, 200003, "CF-AppStoppedStatsError", "Could not fetch stats for stopped app: "+app.Name)
+		return
+	}
+
+	stats := map[string]interface{}{}
+	for i := 0; i < app.Instances; i++ {
+		stats[fmt.Sprint(i)] = map[string]interface{}{
+			"state": "RUNNING",
+			"stats": map[string]interface{}{
+				"usage": map[string]interface{}{
+					"disk": 64 * 1024 * 1024,
+					"mem":  32 * 1024 * 1024,
+					"cpu":  0.01,
+				},
+				"mem_quota":  app.Memory * 1024 * 1024,
+				"disk_quota": app.DiskQuota * 1024 * 1024,
+				"uptime":     int(time.Since(app.StartedAt).Seconds()),
+			},
+		}
+	}
+	writeJSON(w, http.StatusOK, stats)
+}
+
+func (server *Server) getAppRoutes(w http.ResponseWriter, r *http.Request) {
+	app := server.findApplication(rata.Param(r, "guid"))
+	if app == nil {
+		writeV2NotFound(w, "app", rata.Param(r, "guid"))
+		return
+	}
+	server.writeV2Routes(w, r, func(route *Route) bool { return contains(route.AppGUIDs, app.GUID) })
+}
+
+func (server *Server) getRoutes(w http.ResponseWriter, r *http.Request) {
+	server.writeV2Routes(w, r, func(*Route) bool { return true })
+}
+
+func (server *Server) postRoute(w http.ResponseWriter, r *http.Request) {
+	var routeRequest struct {
+		DomainGUID string `json:"domain_guid"`
+		Host       string `json:"host"`
+		Path       string `json:"path"`
+		SpaceGUID  string `json:"space_guid"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&routeRequest); err != nil {
+		writeV2Error(w, http.StatusBadRequest, 1001, "CF-MessageParseError", "Request invalid due to parse error: "+err.Error())
+		return
+	}
+	if server.findSpace(routeRequest.SpaceGUID) == nil {
+		writeV2NotFound(w, "space", routeRequest.SpaceGUID)
+		return
+	}
+	if server.findDomain(routeRequest.DomainGUID) == nil {
+		writeV2NotFound(w, "domain", routeRequest.DomainGUID)
+		return
+	}
+	for _, route := range server.routes {
+		if route.Host == routeRequest.Host && route.DomainGUID == routeRequest.DomainGUID && route.Path == routeRequest.Path {
+			writeV2Error(w, http.StatusBadRequest, 210003, "CF-RouteHostTaken", "The host is taken: "+route.Host)
+			return
+		}
+	}
+
+	route := &Route{
+		GUID:       server.newGUID("route"),
+		Host:       routeRequest.Host,
+		Path:       routeRequest.Path,
+		DomainGUID: routeRequest.DomainGUID,
+		SpaceGUID:  routeRequest.SpaceGUID,
+	}
+	server.routes = append(server.routes, route)
+	writeJSON(w, http.StatusCreated, server.v2Route(route))
+}
+
+func (server *Server) deleteRoute(w http.ResponseWriter, r *http.Request) {
+	if server.findRoute(rata.Param(r, "guid")) == nil {
+		writeV2NotFound(w, "route", rata.Param(r, "guid"))
+		return
+	}
+	server.removeRoute(rata.Param(r, "guid"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) getRouteApps(w http.ResponseWriter, r *http.Request) {
+	route := server.findRoute(rata.Param(r, "guid"))
+	if route == nil {
+		writeV2NotFound(w, "route", rata.Param(r, "guid"))
+		return
+	}
+
+	resources := []interface{}{}
+	for _, app := range server.apps {
+		if contains(route.AppGUIDs, app.GUID) && matchesV2Query(r, map[string]string{"name": app.Name, "space_guid": app.SpaceGUID}) {
+			resources = append(resources, server.v2Application(app))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+func (server *Server) putRouteApp(w http.ResponseWriter, r *http.Request) {
+	route := server.findRoute(rata.Param(r, "guid"))
+	if route == nil {
+		writeV2NotFound(w, "route", rata.Param(r, "guid"))
+		return
+	}
+	app := server.findApplication(rata.Param(r, "app_guid"))
+	if app == nil {
+		writeV2NotFound(w, "app", rata.Param(r, "app_guid"))
+		return
+	}
+
+	if !contains(route.AppGUIDs, app.GUID) {
+		route.AppGUIDs = append(route.AppGUIDs, app.GUID)
+	}
+	writeJSON(w, http.StatusCreated, server.v2Route(route))
+}
+
+func (server *Server) deleteRouteApp(w http.ResponseWriter, r *http.Request) {
+	route := server.findRoute(rata.Param(r, "guid"))
+	if route == nil {
+		writeV2NotFound(w, "route", rata.Param(r, "guid"))
+		return
+	}
+	route.AppGUIDs = remove(route.AppGUIDs, rata.Param(r, "app_guid"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (server *Server) writeV2Routes(w http.ResponseWriter, r *http.Request, include func(*Route) bool) {
+	resources := []interface{}{}
+	for _, route := range server.routes {
+		fields := map[string]string{
+			"host":        route.Host,
+			"path":        route.Path,
+			"domain_guid": route.DomainGUID,
+			"space_guid":  route.SpaceGUID,
+		}
+		if include(route) && matchesV2Query(r, fields) {
+			resources = append(resources, server.v2Route(route))
+		}
+	}
+	writeV2List(w, resources)
+}
+
+// removeApplication deletes an app along with its tasks and route mappings.
+func (server *Server) removeApplication(guid string) {
+	apps := []*Application{}
+	for _, app := range server.apps {
+		if app.GUID != guid {
+			apps = append(apps, app)
+		}
+	}
+	server.apps = apps
+
+	tasks := []*Task{}
+	for _, task := range server.tasks {
+		if task.AppGUID != guid {
+			tasks = append(tasks, task)
+		}
+	}
+	server.tasks = tasks
+
+	for _, route := range server.routes {
+		route.AppGUIDs = remove(route.AppGUIDs, guid)
+	}
+}
+
+func (server *Server) removeRoute(guid string) {
+	routes := []*Route{}
+	for _, route := range server.routes {
+		if route.GUID != guid {
+			routes = append(routes, route)
+		}
+	}
+	server.routes = routes
+}
+
+func (server *Server) v2Organization(org *Organization) map[string]interface{} {
+	return server.v2Resource(org.GUID, "/v2/organizations/", map[string]interface{}{
+		"name":                  org.Name,
+		"status":                "active",
+		"quota_definition_guid": "",
+	})
+}
+
+func (server *Server) v2Space(space *Space) map[string]interface{} {
+	return server.v2Resource(space.GUID, "/v2/spaces/", map[string]interface{}{
+		"name":                        space.Name,
+		"organization_guid":           space.OrganizationGUID,
+		"allow_ssh":                   true,
+		"space_quota_definition_guid": "",
+	})
+}
+
+func (server *Server) v2Domain(domain *Domain) map[string]interface{} {
+	return server.v2Resource(domain.GUID, "/v2/shared_domains/", map[string]interface{}{
+		"name": domain.Name,
+	})
+}
+
+func (server *Server) v2Application(app *Application) map[string]interface{} {
+	packageState := "PENDING"
+	if app.State == "STARTED" {
+		packageState = "STAGED"
+	}
+
+	return server.v2Resource(app.GUID, "/v2/apps/", map[string]interface{}{
+		"name":              app.Name,
+		"space_guid":        app.SpaceGUID,
+		"state":             app.State,
+		"instances":         app.Instances,
+		"memory":            app.Memory,
+		"disk_quota":        app.DiskQuota,
+		"command":           app.Command,
+		"buildpack":         app.Buildpack,
+		"docker_image":      app.DockerImage,
+		"health_check_type": app.HealthCheckType,
+		"environment_json":  app.Environment,
+		"package_state":     packageState,
+	})
+}
+
+func (server *Server) v2Route(route *Route) map[string]interface{} {
+	return server.v2Resource(route.GUID, "/v2/routes/", map[string]interface{}{
+		"host":        route.Host,
+		"path":        route.Path,
+		"port":        nil,
+		"domain_guid": route.DomainGUID,
+		"space_guid":  route.SpaceGUID,
+	})
+}
+
+func (server *Server) v2Resource(guid string, path string, entity map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"guid":       guid,
+			"url":        path + guid,
+			"created_at": "2017-01-01T00:00:00Z",
+		},
+		"entity": entity,
+	}
+}
+
+// matchesV2Query reports whether a resource with the given filterable fields
+// matches all "q=field:value" and "q=field IN value,value" parameters of the
+// request. Unknown fields never match.
+func matchesV2Query(r *http.Request, fields map[string]string) bool {
+	for _, query := range r.URL.Query()["q"] {
+		var field string
+		var values []string
+		if parts := strings.SplitN(query, " IN ", 2); len(parts) == 2 {
+			field, values = parts[0], strings.Split(parts[1], ",")
+		} else if parts := strings.SplitN(query, ":", 2); len(parts) == 2 {
+			field, values = parts[0], []string{parts[1]}
+		} else {
+			return false
+		}
+
+		value, ok := fields[field]
+		if !ok || !contains(values, value) {
+			return false
+		}
+	}
+	return true
+}
+
+func writeV2List(w http.ResponseWriter, resources []interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"total_results": len(resources),
+		"total_pages":   1,
+		"prev_url":      nil,
+		"next_url":      nil,
+		"resources":     resources,
+	})
+}
+
+func writeV2NotFound(w http.ResponseWriter, kind string, guid string) {
+	writeV2Error(w, http.StatusNotFound, 10000, "CF-NotFound", fmt.Sprintf("The %s could not be found: %s", kind, guid))
+}
+
+func writeV2Error(w http.ResponseWriter, status int, code int, errorCode string, description string) {
+	writeJSON(w, status, map[string]interface{}{
+		"code":        code,
+		"description": description,
+		"error_code":  errorCode,
+	})
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(values []string, value string) []string {
+	remaining := []string{}
+	for _, v := range values {
+		if v != value {
+			remaining = append(remaining, v)
+		}
+	}
+	return remaining
+}
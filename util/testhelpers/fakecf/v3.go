@@ -0,0 +1,441 @@
+package fakecf
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tedsuo/rata"
+)
+
+var v3Routes = rata.Routes{
+	{Name: "GetV3", Method: http.MethodGet, Path: "/v3"},
+
+	{Name: "GetV3Organizations", Method: http.MethodGet, Path: "/v3/organizations"},
+
+	{Name: "GetV3Apps", Method: http.MethodGet, Path: "/v3/apps"},
+	{Name: "PostV3App", Method: http.MethodPost, Path: "/v3/apps"},
+	{Name: "PostV3AppStart", Method: http.MethodPost, Path: "/v3/apps/:guid/actions/start"},
+	{Name: "PostV3AppStop", Method: http.MethodPost, Path: "/v3/apps/:guid/actions/stop"},
+	{Name: "GetV3AppProcesses", Method: http.MethodGet, Path: "/v3/apps/:guid/processes"},
+	{Name: "PostV3AppProcessScale", Method: http.MethodPost, Path: "/v3/apps/:guid/processes/:type/actions/scale"},
+	{Name: "GetV3AppTasks", Method: http.MethodGet, Path: "/v3/apps/:guid/tasks"},
+	{Name: "PostV3AppTasks", Method: http.MethodPost, Path: "/v3/apps/:guid/tasks"},
+
+	{Name: "GetV3ProcessStats", Method: http.MethodGet, Path: "/v3/processes/:guid/stats"},
+
+	{Name: "GetV3Task", Method: http.MethodGet, Path: "/v3/tasks/:guid"},
+	{Name: "PutV3TaskCancel", Method: http.MethodPut, Path: "/v3/tasks/:guid/cancel"},
+}
+
+func (server *Server) v3Handlers() rata.Handlers {
+	handlers := rata.Handlers{
+		"GetV3Organizations": server.v3Authenticated(server.getV3Organizations),
+
+		"GetV3Apps":             server.v3Authenticated(server.getV3Apps),
+		"PostV3App":             server.v3Authenticated(server.postV3App),
+		"PostV3AppStart":        server.v3Authenticated(server.postV3AppState("STARTED")),
+		"PostV3AppStop":         server.v3Authenticated(server.postV3AppState("STOPPED")),
+		"GetV3AppProcesses":     server.v3Authenticated(server.getV3AppProcesses),
+		"PostV3AppProcessScale": server.v3Authenticated(server.postV3AppProcessScale),
+		"GetV3AppTasks":         server.v3Authenticated(server.getV3AppTasks),
+		"PostV3AppTasks":        server.v3Authenticated(server.postV3AppTasks),
+
+		"GetV3ProcessStats": server.v3Authenticated(server.getV3ProcessStats),
+
+		"GetV3Task":       server.v3Authenticated(server.getV3Task),
+		"PutV3TaskCancel": server.v3Authenticated(server.putV3TaskCancel),
+	}
+	handlers["GetV3"] = server.locked(server.getV3)
+	return handlers
+}
+
+// v3Authenticated rejects requests without a valid access token the way the
+// V3 API does.
+func (server *Server) v3Authenticated(handler http.HandlerFunc) http.HandlerFunc {
+	return server.locked(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := server.authenticatedUser(r); !ok {
+			writeV3Error(w, http.StatusUnauthorized, 1000, "CF-InvalidAuthToken", "Invalid Auth Token")
+			return
+		}
+		handler(w, r)
+	})
+}
+
+func (server *Server) getV3(w http.ResponseWriter, r *http.Request) {
+	links := map[string]interface{}{
+		"self": link(server.URL() + "/v3"),
+	}
+	for _, resource := range []string{"apps", "builds", "droplets", "isolation_segments", "organizations", "packages", "processes", "spaces", "tasks"} {
+		links[resource] = link(server.URL() + "/v3/" + resource)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"links": links})
+}
+
+func (server *Server) getV3Organizations(w http.ResponseWriter, r *http.Request) {
+	resources := []interface{}{}
+	for _, org := range server.orgs {
+		if matchesV3Query(r, map[string]string{"names": org.Name, "guids": org.GUID}) {
+			resources = append(resources, map[string]interface{}{
+				"guid": org.GUID,
+				"name": org.Name,
+			})
+		}
+	}
+	writeV3List(w, resources)
+}
+
+func (server *Server) getV3Apps(w http.ResponseWriter, r *http.Request) {
+	resources := []interface{}{}
+	for _, app := range server.apps {
+		fields := map[string]string{"names": app.Name, "guids": app.GUID, "space_guids": app.SpaceGUID}
+		if matchesV3Query(r, fields) {
+			resources = append(resources, server.v3Application(app))
+		}
+	}
+	writeV3List(w, resources)
+}
+
+func (server *Server) postV3App(w http.ResponseWriter, r *http.Request) {
+	var appRequest struct {
+		Name          string `json:"name"`
+		Relationships struct {
+			Space struct {
+				Data struct {
+					GUID string `json:"guid"`
+				} `json:"data"`
+			} `json:"space"`
+		} `json:"relationships"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&appRequest); err != nil {
+		writeV3Error(w, http.StatusBadRequest, 1001, "CF-MessageParseError", "Request invalid due to parse error: "+err.Error())
+		return
+	}
+
+	spaceGUID := appRequest.Relationships.Space.Data.GUID
+	if appRequest.Name == "" || server.findSpace(spaceGUID) == nil {
+		writeV3Error(w, http.StatusUnprocessableEntity, 10008, "CF-UnprocessableEntity", "Name must be provided and Space must exist")
+		return
+	}
+	for _, app := range server.apps {
+		if app.Name == appRequest.Name && app.SpaceGUID == spaceGUID {
+			writeV3Error(w, http.StatusUnprocessableEntity, 10008, "CF-UnprocessableEntity", "name must be unique in space")
+			return
+		}
+	}
+
+	app := server.addApplication(Application{Name: appRequest.Name, SpaceGUID: spaceGUID})
+	writeJSON(w, http.StatusCreated, server.v3Application(app))
+}
+
+func (server *Server) postV3AppState(state string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		app := server.findApplication(rata.Param(r, "guid"))
+		if app == nil {
+			writeV3NotFound(w, "App")
+			return
+		}
+
+		if state == "STARTED" && app.State != "STARTED" {
+			app.StartedAt = time.Now()
+		}
+		app.State = state
+		writeJSON(w, http.StatusOK, server.v3Application(app))
+	}
+}
+
+// getV3AppProcesses returns the app's web process. Its GUID is the app GUID,
+// as it is on a real Cloud Controller.
+func (server *Server) getV3AppProcesses(w http.ResponseWriter, r *http.Request) {
+	app := server.findApplication(rata.Param(r, "guid"))
+	if app == nil {
+		writeV3NotFound(w, "App")
+		return
+	}
+	writeV3List(w, []interface{}{v3Process(app)})
+}
+
+func (server *Server) postV3AppProcessScale(w http.ResponseWriter, r *http.Request) {
+	app := server.findApplication(rata.Param(r, "guid"))
+	if app == nil || rata.Param(r, "type") != "web" {
+		writeV3NotFound(w, "Process")
+		return
+	}
+
+	var scale struct {
+		Instances  *int `json:"instances"`
+		MemoryInMB *int `json:"memory_in_mb"`
+		DiskInMB   *int `json:"disk_in_mb"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&scale); err != nil {
+		writeV3Error(w, http.StatusBadRequest, 1001, "CF-MessageParseError", "Request invalid due to parse error: "+err.Error())
+		return
+	}
+	if scale.Instances != nil {
+		app.Instances = *scale.Instances
+	}
+	if scale.MemoryInMB != nil {
+		app.Memory = *scale.MemoryInMB
+	}
+	if scale.DiskInMB != nil {
+		app.DiskQuota = *scale.DiskInMB
+	}
+	writeJSON(w, http.StatusAccepted, v3Process(app))
+}
+
+// getV3ProcessStats reports every instance of a started app as running and
+// every instance of a stopped app as down.
+func (server *Server) getV3ProcessStats(w http.ResponseWriter, r *http.Request) {
+	app := server.findApplication(rata.Param(r, "guid"))
+	if app == nil {
+		writeV3NotFound(w, "Process")
+		return
+	}
+
+	instances := []interface{}{}
+	for i := 0; i < app.Instances; i++ {
+		instance := map[string]interface{}{
+			"type":       "web",
+			"index":      i,
+			"state":      "DOWN",
+			"uptime":     0,
+			"mem_quota":  app.Memory * 1024 * 1024,
+			"disk_quota": app.DiskQuota * 1024 * 1024,
+			"usage":      map[string]interface{}{},
+		}
+		if app.State == "STARTED" {
+			instance["state"] = "RUNNING"
+			instance["uptime"] = int(time.Since(app.StartedAt).Seconds())
+			instance["usage"] = map[string]interface{}{
+				"cpu":  0.01,
+				"mem":  32 * 1024 * 1024,
+				"disk": 64 * 1024 * 1024,
+			}
+		}
+		instances = append(instances, instance)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"resources": instances})
+}
+
+func (server *Server) getV3AppTasks(w http.ResponseWriter, r *http.Request) {
+	app := server.findApplication(rata.Param(r, "guid"))
+	if app == nil {
+		writeV3NotFound(w, "App")
+		return
+	}
+
+	var createdAfter time.Time
+	if value := r.URL.Query().Get("created_ats[gt]"); value != "" {
+		var err error
+		createdAfter, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			writeV3Error(w, http.StatusBadRequest, 10005, "CF-BadQueryParameter", "The query parameter is invalid: created_ats has an invalid timestamp format")
+			return
+		}
+	}
+
+	tasks := []*Task{}
+	for _, task := range server.tasks {
+		fields := map[string]string{
+			"guids":        task.GUID,
+			"names":        task.Name,
+			"states":       task.State,
+			"sequence_ids": fmt.Sprint(task.SequenceID),
+		}
+		if task.AppGUID == app.GUID && matchesV3Query(r, fields) && task.CreatedAt.After(createdAfter) {
+			tasks = append(tasks, task)
+		}
+	}
+
+	// Tasks are stored oldest first, so only the descending order needs work.
+	if r.URL.Query().Get("order_by") == "-created_at" {
+		for i, j := 0, len(tasks)-1; i < j; i, j = i+1, j-1 {
+			tasks[i], tasks[j] = tasks[j], tasks[i]
+		}
+	}
+	if perPage, err := strconv.Atoi(r.URL.Query().Get("per_page")); err == nil && perPage < len(tasks) {
+		tasks = tasks[:perPage]
+	}
+
+	resources := []interface{}{}
+	for _, task := range tasks {
+		resources = append(resources, v3Task(task))
+	}
+	writeV3List(w, resources)
+}
+
+// postV3AppTasks creates a task in the RUNNING state. Tests move it to a
+// terminal state with SetTaskState.
+func (server *Server) postV3AppTasks(w http.ResponseWriter, r *http.Request) {
+	app := server.findApplication(rata.Param(r, "guid"))
+	if app == nil {
+		writeV3NotFound(w, "App")
+		return
+	}
+
+	var taskRequest struct {
+		Command    string `json:"command"`
+		Name       string `json:"name"`
+		MemoryInMB uint64 `json:"memory_in_mb"`
+		DiskInMB   uint64 `json:"disk_in_mb"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&taskRequest); err != nil {
+		writeV3Error(w, http.StatusBadRequest, 1001, "CF-MessageParseError", "Request invalid due to parse error: "+err.Error())
+		return
+	}
+	if taskRequest.Command == "" {
+		writeV3Error(w, http.StatusUnprocessableEntity, 10008, "CF-UnprocessableEntity", "The request is semantically invalid: command presence")
+		return
+	}
+
+	sequenceID := 1
+	for _, task := range server.tasks {
+		if task.AppGUID == app.GUID && task.SequenceID >= sequenceID {
+			sequenceID = task.SequenceID + 1
+		}
+	}
+
+	task := &Task{
+		GUID:       server.newGUID("task"),
+		SequenceID: sequenceID,
+		Name:       taskRequest.Name,
+		Command:    taskRequest.Command,
+		State:      "RUNNING",
+		AppGUID:    app.GUID,
+		MemoryInMB: taskRequest.MemoryInMB,
+		DiskInMB:   taskRequest.DiskInMB,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if task.Name == "" {
+		task.Name = fmt.Sprintf("%x", sequenceID)
+	}
+	if task.MemoryInMB == 0 {
+		task.MemoryInMB = 256
+	}
+	if task.DiskInMB == 0 {
+		task.DiskInMB = 1024
+	}
+	server.tasks = append(server.tasks, task)
+
+	writeJSON(w, http.StatusAccepted, v3Task(task))
+}
+
+func (server *Server) getV3Task(w http.ResponseWriter, r *http.Request) {
+	task := server.findTask(rata.Param(r, "guid"))
+	if task == nil {
+		writeV3NotFound(w, "Task")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, v3Task(task))
+}
+
+func (server *Server) putV3TaskCancel(w http.ResponseWriter, r *http.Request) {
+	task := server.findTask(rata.Param(r, "guid"))
+	if task == nil {
+		writeV3NotFound(w, "Task")
+		return
+	}
+	if task.State != "RUNNING" && task.State != "PENDING" {
+		writeV3Error(w, http.StatusUnprocessableEntity, 10008, "CF-UnprocessableEntity", "Task state is "+task.State+" and therefore cannot be canceled")
+		return
+	}
+
+	task.State = "CANCELING"
+	writeJSON(w, http.StatusAccepted, v3Task(task))
+}
+
+func (server *Server) v3Application(app *Application) map[string]interface{} {
+	return map[string]interface{}{
+		"guid":  app.GUID,
+		"name":  app.Name,
+		"state": app.State,
+		"relationships": map[string]interface{}{
+			"space": map[string]interface{}{
+				"data": map[string]string{"guid": app.SpaceGUID},
+			},
+		},
+		"links": map[string]interface{}{
+			"self": link(server.URL() + "/v3/apps/" + app.GUID),
+		},
+	}
+}
+
+func v3Process(app *Application) map[string]interface{} {
+	return map[string]interface{}{
+		"guid":         app.GUID,
+		"type":         "web",
+		"command":      app.Command,
+		"instances":    app.Instances,
+		"memory_in_mb": app.Memory,
+		"disk_in_mb":   app.DiskQuota,
+	}
+}
+
+func v3Task(task *Task) map[string]interface{} {
+	return map[string]interface{}{
+		"guid":         task.GUID,
+		"sequence_id":  task.SequenceID,
+		"name":         task.Name,
+		"command":      task.Command,
+		"state":        task.State,
+		"memory_in_mb": task.MemoryInMB,
+		"disk_in_mb":   task.DiskInMB,
+		"created_at":   task.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// matchesV3Query reports whether a resource with the given filterable fields
+// matches all comma separated list filters of the request. Paging and
+// ordering parameters are ignored, as are comparison filters such as
+// "created_ats[gt]" and the ":name" route parameters the router adds to the
+// query.
+func matchesV3Query(r *http.Request, fields map[string]string) bool {
+	for key, values := range r.URL.Query() {
+		if strings.HasPrefix(key, ":") || strings.HasSuffix(key, "]") || key == "page" || key == "per_page" || key == "order_by" {
+			continue
+		}
+
+		value, ok := fields[key]
+		if !ok {
+			return false
+		}
+
+		matched := false
+		for _, v := range values {
+			if contains(strings.Split(v, ","), value) {
+				matched = true
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func writeV3List(w http.ResponseWriter, resources []interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"pagination": map[string]interface{}{
+			"total_results": len(resources),
+			"total_pages":   1,
+			"next":          nil,
+		},
+		"resources": resources,
+	})
+}
+
+func writeV3NotFound(w http.ResponseWriter, kind string) {
+	writeV3Error(w, http.StatusNotFound, 10010, "CF-ResourceNotFound", kind+" not found")
+}
+
+func writeV3Error(w http.ResponseWriter, status int, code int, title string, detail string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{"code": code, "title": title, "detail": detail},
+		},
+	})
+}
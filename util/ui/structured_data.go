@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// HasStructuredOutput returns true when command output should be displayed
+// as a JSON or YAML document instead of text and tables.
+func (ui *UI) HasStructuredOutput() bool {
+	return ui.OutputFormat == configv3.OutputJSON || ui.OutputFormat == configv3.OutputYAML
+}
+
+// DisplayStructuredData outputs data to ui.Out as a document in the
+// configured output format. The data is always marshalled through its JSON
+// representation so that both formats use the same keys and key order.
+func (ui *UI) DisplayStructuredData(data interface{}) error {
+	raw, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if ui.OutputFormat == configv3.OutputYAML {
+		raw, err = jsonToYAML(raw)
+		if err != nil {
+			return err
+		}
+	} else {
+		raw = append(raw, '\n')
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = fmt.Fprintf(ui.Out, "%s", raw)
+	return err
+}
+
+// jsonToYAML converts a JSON document to YAML while preserving the order of
+// object keys. The document is wrapped in an object so that every nested
+// object is decoded as an ordered yaml.MapSlice.
+func jsonToYAML(raw []byte) ([]byte, error) {
+	wrapped := append(append([]byte(`{"document": `), raw...), '}')
+
+	var document yaml.MapSlice
+	err := yaml.Unmarshal(wrapped, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(document[0].Value)
+}
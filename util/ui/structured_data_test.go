@@ -0,0 +1,95 @@
+package ui_test
+
+import (
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Structured Data", func() {
+	type someSpace struct {
+		Name     string   `json:"name"`
+		Apps     []string `json:"apps"`
+		AllowSSH bool     `json:"allow_ssh"`
+	}
+
+	var (
+		ui  *UI
+		out *Buffer
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		ui = NewTestUI(nil, out, NewBuffer())
+	})
+
+	Describe("HasStructuredOutput", func() {
+		It("returns false by default", func() {
+			Expect(ui.HasStructuredOutput()).To(BeFalse())
+		})
+
+		It("returns true when the output format is json or yaml", func() {
+			ui.OutputFormat = configv3.OutputJSON
+			Expect(ui.HasStructuredOutput()).To(BeTrue())
+
+			ui.OutputFormat = configv3.OutputYAML
+			Expect(ui.HasStructuredOutput()).To(BeTrue())
+		})
+	})
+
+	Describe("DisplayStructuredData", func() {
+		var data someSpace
+
+		BeforeEach(func() {
+			data = someSpace{Name: "some-space", Apps: []string{"app-1", "app-2"}, AllowSSH: true}
+		})
+
+		Context("when the output format is json", func() {
+			BeforeEach(func() {
+				ui.OutputFormat = configv3.OutputJSON
+			})
+
+			It("displays the data as indented JSON", func() {
+				Expect(ui.DisplayStructuredData(data)).To(Succeed())
+				Expect(string(out.Contents())).To(Equal(`{
+  "name": "some-space",
+  "apps": [
+    "app-1",
+    "app-2"
+  ],
+  "allow_ssh": true
+}
+`))
+			})
+		})
+
+		Context("when the output format is yaml", func() {
+			BeforeEach(func() {
+				ui.OutputFormat = configv3.OutputYAML
+			})
+
+			It("displays the data as YAML, preserving the JSON keys and their order", func() {
+				Expect(ui.DisplayStructuredData(data)).To(Succeed())
+				Expect(string(out.Contents())).To(Equal(`name: some-space
+apps:
+- app-1
+- app-2
+allow_ssh: true
+`))
+			})
+
+			It("preserves the key order of lists of data", func() {
+				Expect(ui.DisplayStructuredData([]someSpace{data})).To(Succeed())
+				Expect(out).To(Say("- name: some-space\n  apps:\n  - app-1\n  - app-2\n  allow_ssh: true\n"))
+			})
+		})
+
+		Context("when the data cannot be marshalled", func() {
+			It("returns the error", func() {
+				Expect(ui.DisplayStructuredData(make(chan int))).ToNot(Succeed())
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -33,6 +34,8 @@ type Config interface {
 	IsTTY() bool
 	// TerminalWidth returns back the width of the terminal
 	TerminalWidth() int
+	// OutputFormat is the format to display command output in
+	OutputFormat() configv3.OutputFormat
 }
 
 //go:generate counterfeiter . TranslatableError
@@ -50,6 +53,7 @@ type TranslatableError interface {
 // LogMessage is a log response representing one to many joined lines of a log
 // message.
 type LogMessage interface {
+	AppName() string
 	Message() string
 	Type() string
 	Timestamp() time.Time
@@ -72,9 +76,15 @@ type UI struct {
 	terminalLock *sync.Mutex
 	fileLock     *sync.Mutex
 
+	// appColors are the colors assigned to application names in log output.
+	appColors map[string]*color.Color
+
 	IsTTY         bool
 	TerminalWidth int
 
+	// OutputFormat is the format structured command output is displayed in.
+	OutputFormat configv3.OutputFormat
+
 	TimezoneLocation *time.Location
 }
 
@@ -96,8 +106,10 @@ func NewUI(config Config) (*UI, error) {
 		translate:        translateFunc,
 		terminalLock:     &sync.Mutex{},
 		fileLock:         &sync.Mutex{},
+		appColors:        map[string]*color.Color{},
 		IsTTY:            config.IsTTY(),
 		TerminalWidth:    config.TerminalWidth(),
+		OutputFormat:     config.OutputFormat(),
 		TimezoneLocation: location,
 	}, nil
 }
@@ -113,6 +125,7 @@ func NewTestUI(in io.Reader, out io.Writer, err io.Writer) *UI {
 		translate:        translationWrapper(i18n.IdentityTfunc()),
 		terminalLock:     &sync.Mutex{},
 		fileLock:         &sync.Mutex{},
+		appColors:        map[string]*color.Color{},
 		TimezoneLocation: time.UTC,
 	}
 }
@@ -357,7 +370,8 @@ func (ui *UI) DisplayWarnings(warnings []string) {
 
 // DisplayError outputs the translated error message to ui.Err if the error
 // satisfies TranslatableError, otherwise it outputs the original error message
-// to ui.Err. It also outputs "FAILED" in bold red to ui.Out.
+// to ui.Err. It also outputs "FAILED" in bold red to ui.Out, or to ui.Err
+// when displaying structured output.
 func (ui *UI) DisplayError(err error) {
 	var errMsg string
 	if translatableError, ok := err.(TranslatableError); ok {
@@ -370,16 +384,37 @@ func (ui *UI) DisplayError(err error) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
+	out := ui.Out
+	if ui.HasStructuredOutput() {
+		out = ui.Err
+	}
+	fmt.Fprintf(out, "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
 }
 
 const LogTimestampFormat = "2006-01-02T15:04:05.00-0700"
 
-// DisplayLogMessage formats and outputs a given log message.
+// appNameColors are the colors used to tell apart the log messages of
+// different applications. Red is left out since it marks ERR messages.
+var appNameColors = []color.Attribute{
+	color.FgCyan,
+	color.FgMagenta,
+	color.FgYellow,
+	color.FgGreen,
+	color.FgBlue,
+}
+
+// DisplayLogMessage formats and outputs a given log message. Messages with an
+// application name are prefixed with the name in a color unique to that
+// application.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
+	var prefix string
+	if appName := message.AppName(); appName != "" {
+		prefix = fmt.Sprintf("%s | ", ui.modifyColor(appName, ui.appColor(appName)))
+	}
+
 	var header string
 	if displayHeader {
 		time := message.Timestamp().In(ui.TimezoneLocation).Format(LogTimestampFormat)
@@ -397,8 +432,54 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 		if message.Type() == "ERR" {
 			logLine = ui.modifyColor(logLine, color.New(color.FgRed))
 		}
-		fmt.Fprintf(ui.Out, "%s\n", logLine)
+		fmt.Fprintf(ui.Out, "%s%s\n", prefix, logLine)
+	}
+}
+
+// appColor returns the color assigned to appName, assigning the next color
+// in appNameColors to names seen for the first time.
+func (ui *UI) appColor(appName string) *color.Color {
+	if ui.appColors == nil {
+		ui.appColors = map[string]*color.Color{}
+	}
+
+	appColor, ok := ui.appColors[appName]
+	if !ok {
+		appColor = color.New(appNameColors[len(ui.appColors)%len(appNameColors)], color.Bold)
+		ui.appColors[appName] = appColor
 	}
+	return appColor
+}
+
+// jsonLogMessage is the JSON representation of a log message.
+type jsonLogMessage struct {
+	AppName        string `json:"app,omitempty"`
+	Timestamp      string `json:"timestamp"`
+	SourceType     string `json:"source_type"`
+	SourceInstance string `json:"source_instance"`
+	Stream         string `json:"stream"`
+	Message        string `json:"message"`
+}
+
+// DisplayJSONLogMessage outputs a given log message as a single line of JSON.
+func (ui *UI) DisplayJSONLogMessage(message LogMessage) error {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	line, err := json.Marshal(jsonLogMessage{
+		AppName:        message.AppName(),
+		Timestamp:      message.Timestamp().In(ui.TimezoneLocation).Format(time.RFC3339Nano),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		Stream:         message.Type(),
+		Message:        strings.TrimRight(message.Message(), "\r\n"),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ui.Out, "%s\n", line)
+	return nil
 }
 
 func (ui *UI) modifyColor(text string, colorPrinter *color.Color) string {
@@ -456,6 +456,16 @@ var _ = Describe("UI", func() {
 				Expect(ui.Out).To(Say("\x1b\\[31;1mFAILED\x1b\\[0m\n"))
 			})
 		})
+
+		Context("when displaying structured output", func() {
+			It("displays FAILED to ui.Err so ui.Out only contains the document", func() {
+				ui.OutputFormat = configv3.OutputJSON
+				ui.DisplayError(errors.New("I am a BANANA!"))
+				Expect(ui.Err).To(Say("I am a BANANA!\n"))
+				Expect(ui.Err).To(Say("\x1b\\[31;1mFAILED\x1b\\[0m\n"))
+				Expect(ui.Out).ToNot(Say("FAILED"))
+			})
+		})
 	})
 
 	Describe("DisplayLogMessage", func() {
@@ -524,6 +534,28 @@ var _ = Describe("UI", func() {
 			})
 		})
 
+		Context("log messages with an app name", func() {
+			var otherMessage *uifakes.FakeLogMessage
+
+			BeforeEach(func() {
+				message.AppNameReturns("app-1")
+
+				otherMessage = new(uifakes.FakeLogMessage)
+				otherMessage.MessageReturns("This is another log message")
+				otherMessage.TypeReturns("OUT")
+				otherMessage.AppNameReturns("app-2")
+			})
+
+			It("prefixes each line with the app name in a color unique to the app", func() {
+				ui.DisplayLogMessage(message, false)
+				ui.DisplayLogMessage(otherMessage, false)
+				ui.DisplayLogMessage(message, false)
+				Expect(ui.Out).To(Say("\x1b\\[36;1mapp-1\x1b\\[0m \\| This is a log message\n"))
+				Expect(ui.Out).To(Say("\x1b\\[35;1mapp-2\x1b\\[0m \\| This is another log message\n"))
+				Expect(ui.Out).To(Say("\x1b\\[36;1mapp-1\x1b\\[0m \\| This is a log message\n"))
+			})
+		})
+
 		Context("error log lines", func() {
 			BeforeEach(func() {
 				message.TypeReturns("ERR")
@@ -534,4 +566,36 @@ var _ = Describe("UI", func() {
 			})
 		})
 	})
+
+	Describe("DisplayJSONLogMessage", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			var err error
+			ui.TimezoneLocation, err = time.LoadLocation("America/Los_Angeles")
+			Expect(err).NotTo(HaveOccurred())
+
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message\nwith two lines\r\n")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 5000)) // "2016-07-19T16:08:12.000005-07:00"
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("includes the app name when it is set", func() {
+			message.AppNameReturns("app-1")
+			err := ui.DisplayJSONLogMessage(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(HavePrefix(`{"app":"app-1","timestamp":`))
+		})
+
+		It("prints the message as a single line of JSON to STDOUT", func() {
+			err := ui.DisplayJSONLogMessage(message)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out.Contents())).To(Equal(
+				`{"timestamp":"2016-07-19T16:08:12.000005-07:00","source_type":"APP/PROC/WEB","source_instance":"12","stream":"ERR","message":"This is a log message\nwith two lines"}` + "\n",
+			))
+		})
+	})
 })
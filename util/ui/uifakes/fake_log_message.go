@@ -9,6 +9,15 @@ import (
 )
 
 type FakeLogMessage struct {
+	AppNameStub        func() string
+	appNameMutex       sync.RWMutex
+	appNameArgsForCall []struct{}
+	appNameReturns     struct {
+		result1 string
+	}
+	appNameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	MessageStub        func() string
 	messageMutex       sync.RWMutex
 	messageArgsForCall []struct{}
@@ -58,6 +67,46 @@ type FakeLogMessage struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeLogMessage) AppName() string {
+	fake.appNameMutex.Lock()
+	ret, specificReturn := fake.appNameReturnsOnCall[len(fake.appNameArgsForCall)]
+	fake.appNameArgsForCall = append(fake.appNameArgsForCall, struct{}{})
+	fake.recordInvocation("AppName", []interface{}{})
+	fake.appNameMutex.Unlock()
+	if fake.AppNameStub != nil {
+		return fake.AppNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appNameReturns.result1
+}
+
+func (fake *FakeLogMessage) AppNameCallCount() int {
+	fake.appNameMutex.RLock()
+	defer fake.appNameMutex.RUnlock()
+	return len(fake.appNameArgsForCall)
+}
+
+func (fake *FakeLogMessage) AppNameReturns(result1 string) {
+	fake.AppNameStub = nil
+	fake.appNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeLogMessage) AppNameReturnsOnCall(i int, result1 string) {
+	fake.AppNameStub = nil
+	if fake.appNameReturnsOnCall == nil {
+		fake.appNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.appNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeLogMessage) Message() string {
 	fake.messageMutex.Lock()
 	ret, specificReturn := fake.messageReturnsOnCall[len(fake.messageArgsForCall)]
@@ -261,6 +310,8 @@ func (fake *FakeLogMessage) SourceInstanceReturnsOnCall(i int, result1 string) {
 func (fake *FakeLogMessage) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.appNameMutex.RLock()
+	defer fake.appNameMutex.RUnlock()
 	fake.messageMutex.RLock()
 	defer fake.messageMutex.RUnlock()
 	fake.typeMutex.RLock()